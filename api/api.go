@@ -1,17 +1,17 @@
 package api
 
 import (
-	"encoding/hex"
+	"crypto/tls"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"reflect"
+	"strings"
 	"time"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
-	"github.com/ElrondNetwork/elrond-go/hashing"
-	"github.com/ElrondNetwork/elrond-go/hashing/factory"
-	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
 	"github.com/ElrondNetwork/elrond-proxy-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-proxy-go/api/openapi"
 	"github.com/ElrondNetwork/elrond-proxy-go/config"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 	"github.com/gin-contrib/cors"
@@ -23,12 +23,14 @@ import (
 
 var log = logger.GetOrCreate("api")
 
+const specificationPath = "/openapi.json"
+
 type validatorInput struct {
 	Name      string
 	Validator validator.Func
 }
 
-// CreateServer creates a HTTP server
+// CreateServer creates a HTTP server. If a TLS config is provided, the server should be started with ListenAndServeTLS
 func CreateServer(
 	versionsRegistry data.VersionsRegistryHandler,
 	port int,
@@ -36,6 +38,7 @@ func CreateServer(
 	credentialsConfig config.CredentialsConfig,
 	rateLimitTimeWindowInSeconds int,
 	isProfileModeActivated bool,
+	tlsConfig *tls.Config,
 ) (*http.Server, error) {
 	ws := gin.Default()
 	ws.Use(cors.Default())
@@ -45,14 +48,24 @@ func CreateServer(
 		return nil, err
 	}
 
-	err = registerRoutes(ws, versionsRegistry, apiLoggingConfig, credentialsConfig, rateLimitTimeWindowInSeconds, isProfileModeActivated)
+	isClientCertificateVerificationEnabled := tlsConfig != nil && tlsConfig.ClientCAs != nil
+	err = registerRoutes(
+		ws,
+		versionsRegistry,
+		apiLoggingConfig,
+		credentialsConfig,
+		rateLimitTimeWindowInSeconds,
+		isProfileModeActivated,
+		isClientCertificateVerificationEnabled,
+	)
 	if err != nil {
 		return nil, err
 	}
 
 	httpServer := &http.Server{
-		Addr:    fmt.Sprintf(":%d", port),
-		Handler: ws,
+		Addr:      fmt.Sprintf(":%d", port),
+		Handler:   ws,
+		TLSConfig: tlsConfig,
 	}
 
 	return httpServer, nil
@@ -80,6 +93,7 @@ func registerRoutes(
 	credentialsConfig config.CredentialsConfig,
 	rateLimitTimeWindowInSeconds int,
 	isProfileModeActivated bool,
+	isClientCertificateVerificationEnabled bool,
 ) error {
 	versionsMap, err := versionsRegistry.GetAllVersions()
 	if err != nil {
@@ -91,6 +105,8 @@ func registerRoutes(
 		ws.Use(responseLoggerMiddleware.MiddlewareHandlerFunc())
 	}
 
+	clientCertificateVerifier := middleware.NewClientCertificateVerifier()
+
 	for version, versionData := range versionsMap {
 		limitsMap := getLimitsMapForVersion(versionData)
 		rateLimitTimeWindowDuration := time.Duration(rateLimitTimeWindowInSeconds) * time.Second
@@ -102,6 +118,12 @@ func registerRoutes(
 		versionGroup := ws.Group(version)
 		for path, group := range versionData.ApiHandler.GetAllGroups() {
 			subGroup := versionGroup.Group(path)
+			if requiresClientCertificate(versionData.ApiConfig, path) {
+				if !isClientCertificateVerificationEnabled {
+					return fmt.Errorf("%w for package %s", ErrClientCertificateVerificationDisabled, path)
+				}
+				subGroup.Use(clientCertificateVerifier.MiddlewareHandlerFunc())
+			}
 			group.RegisterRoutes(
 				subGroup,
 				versionData.ApiConfig,
@@ -109,6 +131,11 @@ func registerRoutes(
 				rateLimiter.MiddlewareHandlerFunc(),
 			)
 		}
+
+		err = registerSpecification(versionGroup, version, versionData, rateLimitTimeWindowInSeconds)
+		if err != nil {
+			return err
+		}
 	}
 
 	if isProfileModeActivated {
@@ -118,6 +145,39 @@ func registerRoutes(
 	return nil
 }
 
+// registerSpecification generates the OpenAPI document of the version, which will be served at /{version}/openapi.json
+func registerSpecification(
+	versionGroup *gin.RouterGroup,
+	version string,
+	versionData *data.VersionData,
+	rateLimitTimeWindowInSeconds int,
+) error {
+	specification, err := openapi.CreateSpecification(version, versionData, rateLimitTimeWindowInSeconds)
+	if err != nil {
+		return err
+	}
+
+	specificationBytes, err := json.Marshal(specification)
+	if err != nil {
+		return err
+	}
+
+	versionGroup.GET(specificationPath, func(c *gin.Context) {
+		c.Data(http.StatusOK, "application/json; charset=utf-8", specificationBytes)
+	})
+
+	return nil
+}
+
+func requiresClientCertificate(apiConfig data.ApiRoutesConfig, groupPath string) bool {
+	packageConfig, ok := apiConfig.APIPackages[strings.TrimPrefix(groupPath, "/")]
+	if !ok {
+		return false
+	}
+
+	return packageConfig.RequireClientCertificate
+}
+
 func getAuthenticationFunc(credentialsConfig config.CredentialsConfig) gin.HandlerFunc {
 	if len(credentialsConfig.Credentials) == 0 {
 		return func(c *gin.Context) {
@@ -132,19 +192,7 @@ func getAuthenticationFunc(credentialsConfig config.CredentialsConfig) gin.Handl
 		}
 	}
 
-	var hasher hashing.Hasher
-	var err error
-	hasher, err = factory.NewHasher(credentialsConfig.Hasher.Type)
-	if err != nil {
-		log.Warn("cannot create hasher from config. Will use Sha256 as default", "error", err)
-		hasher = sha256.Sha256{} // fallback in case the hasher creation failed
-	}
-
-	accounts := gin.Accounts{}
-	for _, pair := range credentialsConfig.Credentials {
-		accounts[pair.Username] = pair.Password
-	}
-
+	verifier := middleware.NewCredentialsVerifier(credentialsConfig)
 	authenticationFunction := func(c *gin.Context) {
 		user, pass, ok := c.Request.BasicAuth()
 		if !ok {
@@ -156,20 +204,11 @@ func getAuthenticationFunc(credentialsConfig config.CredentialsConfig) gin.Handl
 			return
 		}
 
-		userPassword, ok := accounts[user]
-		if !ok {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, data.GenericAPIResponse{
-				Data:  nil,
-				Error: "username does not exist",
-				Code:  data.ReturnCodeRequestError,
-			})
-			return
-		}
-
-		if userPassword != hex.EncodeToString(hasher.Compute(pass)) {
+		err := verifier.Verify(user, pass)
+		if err != nil {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, data.GenericAPIResponse{
 				Data:  nil,
-				Error: "invalid password",
+				Error: err.Error(),
 				Code:  data.ReturnCodeRequestError,
 			})
 			return
@@ -84,6 +84,16 @@ func initBaseGroupsWithFacade(facade data.FacadeHandler) (map[string]data.GroupH
 		return nil, err
 	}
 
+	rpcGroup, err := groups.NewRpcGroup(facade)
+	if err != nil {
+		return nil, err
+	}
+
+	graphQLGroup, err := groups.NewGraphQLGroup(facade)
+	if err != nil {
+		return nil, err
+	}
+
 	return map[string]data.GroupHandler{
 		"/actions":     actionsGroup,
 		"/address":     accountsGroup,
@@ -96,6 +106,8 @@ func initBaseGroupsWithFacade(facade data.FacadeHandler) (map[string]data.GroupH
 		"/validator":   validatorsGroup,
 		"/vm-values":   vmValuesGroup,
 		"/proof":       proofGroup,
+		"/rpc":         rpcGroup,
+		"/graphql":     graphQLGroup,
 	}, nil
 }
 
@@ -13,3 +13,8 @@ var ErrGroupDoesNotExist = errors.New("group does not exist")
 
 // ErrNilFacade signals that a nil facade has been provided
 var ErrNilFacade = errors.New("nil facade")
+
+// ErrClientCertificateVerificationDisabled signals that a package requires client certificates but the server does
+// not verify them
+var ErrClientCertificateVerificationDisabled = errors.New("client certificates are required but the server TLS " +
+	"configuration does not define a client CA file")
@@ -50,6 +50,9 @@ var ErrValidation = errors.New("validation error")
 // ErrValidationQueryParameterWithResult signals that an invalid query parameter has been provided
 var ErrValidationQueryParameterWithResult = errors.New("invalid query parameter withResults")
 
+// ErrValidationQueryParameterDecode signals that an invalid decode query parameter has been provided
+var ErrValidationQueryParameterDecode = errors.New("invalid query parameter decode")
+
 // ErrValidatorQueryParameterCheckSignature signals that an invalid query parameter has been provided
 var ErrValidatorQueryParameterCheckSignature = errors.New("invalid query parameter checkSignature")
 
@@ -86,6 +89,15 @@ var ErrInvalidShardIDParam = errors.New("invalid shard ID parameter")
 // ErrEmptyRootHash signals that an empty root hash has been provided
 var ErrEmptyRootHash = errors.New("empty root hash")
 
+// ErrInvalidTransactionsHistoryParams signals that invalid transactions history query parameters have been provided
+var ErrInvalidTransactionsHistoryParams = errors.New("invalid transactions history parameters")
+
+// ErrInvalidAccountQueryParams signals that invalid account query parameters have been provided
+var ErrInvalidAccountQueryParams = errors.New("invalid account query parameters")
+
+// ErrInvalidVmQueriesBatch signals that an empty or a too large batch of VM queries has been provided
+var ErrInvalidVmQueriesBatch = errors.New("invalid VM queries batch")
+
 // ErrInvalidTxFields signals that one or more field of a transaction are invalid
 type ErrInvalidTxFields struct {
 	Message string
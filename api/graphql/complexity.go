@@ -0,0 +1,129 @@
+package graphql
+
+import (
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+const (
+	defaultFieldCost  = 1
+	observerFieldCost = 10
+)
+
+// observerFields holds the fields whose resolving requires (at least) a request towards the observers
+var observerFields = map[string]struct{}{
+	"account":         {},
+	"transaction":     {},
+	"block":           {},
+	"hyperblock":      {},
+	"senderAccount":   {},
+	"receiverAccount": {},
+	"esdtTokens":      {},
+	"esdtToken":       {},
+}
+
+// listFieldsMultipliers holds the estimated number of items returned by the list fields. The selections of a list
+// field are counted once for each estimated item
+var listFieldsMultipliers = map[string]int{
+	"transactions":         50,
+	"shardBlocks":          10,
+	"notarizedBlocks":      10,
+	"miniBlocks":           10,
+	"smartContractResults": 10,
+	"esdtTokens":           20,
+	"uris":                 5,
+}
+
+type complexityCalculator struct {
+	fragments map[string]*ast.FragmentDefinition
+	maxDepth  int
+}
+
+// computeComplexity returns the estimated cost and the depth of the operation to be executed. It expects an already
+// validated document, so the fragment cycles are already rejected
+func computeComplexity(document *ast.Document, operationName string, maxDepth int) (int, int, error) {
+	calculator := &complexityCalculator{
+		fragments: make(map[string]*ast.FragmentDefinition),
+		maxDepth:  maxDepth,
+	}
+
+	var operation *ast.OperationDefinition
+	for _, definition := range document.Definitions {
+		switch def := definition.(type) {
+		case *ast.FragmentDefinition:
+			calculator.fragments[def.Name.Value] = def
+		case *ast.OperationDefinition:
+			if operationName == "" || (def.Name != nil && def.Name.Value == operationName) {
+				operation = def
+			}
+		}
+	}
+	if operation == nil {
+		return 0, 0, nil
+	}
+
+	return calculator.selectionSetComplexity(operation.SelectionSet, 0)
+}
+
+func (cc *complexityCalculator) selectionSetComplexity(selectionSet *ast.SelectionSet, depth int) (int, int, error) {
+	if selectionSet == nil {
+		return 0, depth, nil
+	}
+
+	complexity := 0
+	maxReachedDepth := depth
+	for _, selection := range selectionSet.Selections {
+		var selectionComplexity, selectionDepth int
+		var err error
+
+		switch sel := selection.(type) {
+		case *ast.Field:
+			selectionComplexity, selectionDepth, err = cc.fieldComplexity(sel, depth)
+		case *ast.InlineFragment:
+			selectionComplexity, selectionDepth, err = cc.selectionSetComplexity(sel.SelectionSet, depth)
+		case *ast.FragmentSpread:
+			fragment, ok := cc.fragments[sel.Name.Value]
+			if !ok {
+				continue
+			}
+			selectionComplexity, selectionDepth, err = cc.selectionSetComplexity(fragment.SelectionSet, depth)
+		}
+		if err != nil {
+			return 0, selectionDepth, err
+		}
+
+		complexity += selectionComplexity
+		if selectionDepth > maxReachedDepth {
+			maxReachedDepth = selectionDepth
+		}
+	}
+
+	return complexity, maxReachedDepth, nil
+}
+
+func (cc *complexityCalculator) fieldComplexity(field *ast.Field, depth int) (int, int, error) {
+	if depth+1 > cc.maxDepth {
+		return 0, depth + 1, ErrQueryTooDeep
+	}
+
+	cost := defaultFieldCost
+	_, isObserverField := observerFields[field.Name.Value]
+	if isObserverField {
+		cost = observerFieldCost
+	}
+
+	if field.SelectionSet == nil {
+		return cost, depth + 1, nil
+	}
+
+	childrenComplexity, childrenDepth, err := cc.selectionSetComplexity(field.SelectionSet, depth+1)
+	if err != nil {
+		return 0, childrenDepth, err
+	}
+
+	multiplier, isList := listFieldsMultipliers[field.Name.Value]
+	if !isList {
+		multiplier = 1
+	}
+
+	return cost + multiplier*childrenComplexity, childrenDepth, nil
+}
@@ -0,0 +1,67 @@
+package graphql
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func computeQueryComplexity(t *testing.T, query string, operationName string, maxDepth int) (int, int, error) {
+	document, err := parser.Parse(parser.ParseParams{Source: query})
+	require.NoError(t, err)
+
+	return computeComplexity(document, operationName, maxDepth)
+}
+
+func TestComputeComplexity_SimpleFields(t *testing.T) {
+	t.Parallel()
+
+	complexity, depth, err := computeQueryComplexity(t, `{ account(address: "a") { address nonce } }`, "", 10)
+	require.NoError(t, err)
+	assert.Equal(t, observerFieldCost+2*defaultFieldCost, complexity)
+	assert.Equal(t, 2, depth)
+}
+
+func TestComputeComplexity_ListFieldsShouldMultiplyTheirSelections(t *testing.T) {
+	t.Parallel()
+
+	complexity, depth, err := computeQueryComplexity(t, `{ hyperblock(nonce: 1) { transactions { hash senderAccount { balance } } } }`, "", 10)
+	require.NoError(t, err)
+	expectedComplexity := observerFieldCost + defaultFieldCost +
+		listFieldsMultipliers["transactions"]*(defaultFieldCost+observerFieldCost+defaultFieldCost)
+	assert.Equal(t, expectedComplexity, complexity)
+	assert.Equal(t, 4, depth)
+}
+
+func TestComputeComplexity_FragmentsShouldBeCounted(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query first { account(address: "a") { ...accountFields } }
+		query second { account(address: "a") { ... on Account { address } } }
+		fragment accountFields on Account { address nonce balance }
+	`
+
+	complexity, _, err := computeQueryComplexity(t, query, "first", 10)
+	require.NoError(t, err)
+	assert.Equal(t, observerFieldCost+3*defaultFieldCost, complexity)
+
+	complexity, _, err = computeQueryComplexity(t, query, "second", 10)
+	require.NoError(t, err)
+	assert.Equal(t, observerFieldCost+defaultFieldCost, complexity)
+}
+
+func TestComputeComplexity_TooDeepShouldErr(t *testing.T) {
+	t.Parallel()
+
+	query := `{ transaction(hash: "a") { senderAccount { esdtTokens { tokenIdentifier } } } }`
+
+	_, depth, err := computeQueryComplexity(t, query, "", 4)
+	require.NoError(t, err)
+	assert.Equal(t, 4, depth)
+
+	_, _, err = computeQueryComplexity(t, query, "", 3)
+	assert.Equal(t, ErrQueryTooDeep, err)
+}
@@ -0,0 +1,88 @@
+package graphql
+
+import (
+	"sync"
+)
+
+type loadResult struct {
+	value interface{}
+	err   error
+	done  chan struct{}
+}
+
+// dataLoader deduplicates and batches the observer calls made while resolving a single GraphQL request. The keys
+// requested while resolving a level of the query are collected and fetched concurrently when the first of the
+// returned thunks is evaluated. A dataLoader must not be shared between requests
+type dataLoader struct {
+	fetch              func(key string) (interface{}, error)
+	maxConcurrentLoads int
+
+	mut     sync.Mutex
+	results map[string]*loadResult
+	pending []string
+}
+
+func newDataLoader(fetch func(key string) (interface{}, error), maxConcurrentLoads int) *dataLoader {
+	return &dataLoader{
+		fetch:              fetch,
+		maxConcurrentLoads: maxConcurrentLoads,
+		results:            make(map[string]*loadResult),
+	}
+}
+
+// load schedules the fetching of the given key and returns a thunk that waits for its result
+func (dl *dataLoader) load(key string) func() (interface{}, error) {
+	dl.mut.Lock()
+	result, exists := dl.results[key]
+	if !exists {
+		result = &loadResult{
+			done: make(chan struct{}),
+		}
+		dl.results[key] = result
+		dl.pending = append(dl.pending, key)
+	}
+	dl.mut.Unlock()
+
+	return func() (interface{}, error) {
+		dl.dispatch()
+		<-result.done
+
+		return result.value, result.err
+	}
+}
+
+func (dl *dataLoader) dispatch() {
+	dl.mut.Lock()
+	keys := dl.pending
+	dl.pending = nil
+	dl.mut.Unlock()
+
+	if len(keys) == 0 {
+		return
+	}
+
+	semaphore := make(chan struct{}, dl.maxConcurrentLoads)
+	for _, key := range keys {
+		dl.mut.Lock()
+		result := dl.results[key]
+		dl.mut.Unlock()
+
+		semaphore <- struct{}{}
+		go func(k string, res *loadResult) {
+			defer func() {
+				<-semaphore
+				close(res.done)
+			}()
+
+			res.value, res.err = dl.fetch(k)
+		}(key, result)
+	}
+}
+
+// numLoaded returns the number of distinct keys requested so far
+func (dl *dataLoader) numLoaded() int {
+	dl.mut.Lock()
+	defer dl.mut.Unlock()
+
+	return len(dl.results)
+}
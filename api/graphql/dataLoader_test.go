@@ -0,0 +1,59 @@
+package graphql
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataLoader_ShouldDeduplicateKeys(t *testing.T) {
+	t.Parallel()
+
+	mutCalls := sync.Mutex{}
+	calls := make(map[string]int)
+	loader := newDataLoader(func(key string) (interface{}, error) {
+		mutCalls.Lock()
+		calls[key]++
+		mutCalls.Unlock()
+
+		return "value-" + key, nil
+	}, 2)
+
+	thunks := []func() (interface{}, error){
+		loader.load("a"),
+		loader.load("b"),
+		loader.load("a"),
+		loader.load("c"),
+	}
+	assert.Equal(t, 3, loader.numLoaded())
+
+	expectedValues := []string{"value-a", "value-b", "value-a", "value-c"}
+	for i, thunk := range thunks {
+		value, err := thunk()
+		require.NoError(t, err)
+		assert.Equal(t, expectedValues[i], value)
+	}
+
+	assert.Equal(t, map[string]int{"a": 1, "b": 1, "c": 1}, calls)
+
+	value, err := loader.load("a")()
+	require.NoError(t, err)
+	assert.Equal(t, "value-a", value)
+	assert.Equal(t, 1, calls["a"])
+}
+
+func TestDataLoader_ShouldReturnTheFetchError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	loader := newDataLoader(func(key string) (interface{}, error) {
+		return nil, expectedErr
+	}, 1)
+
+	value, err := loader.load("a")()
+	assert.Nil(t, value)
+	assert.Equal(t, expectedErr, err)
+}
@@ -0,0 +1,30 @@
+package graphql
+
+import "errors"
+
+// ErrNilFacade signals that a nil facade has been provided
+var ErrNilFacade = errors.New("nil facade")
+
+// ErrInvalidMaxComplexity signals that an invalid maximum query complexity has been provided
+var ErrInvalidMaxComplexity = errors.New("invalid maximum query complexity")
+
+// ErrInvalidMaxDepth signals that an invalid maximum query depth has been provided
+var ErrInvalidMaxDepth = errors.New("invalid maximum query depth")
+
+// ErrInvalidMaxConcurrentLoads signals that an invalid maximum number of concurrent observer calls has been provided
+var ErrInvalidMaxConcurrentLoads = errors.New("invalid maximum number of concurrent loads")
+
+// ErrQueryTooComplex signals that the query exceeds the maximum allowed complexity
+var ErrQueryTooComplex = errors.New("query is too complex")
+
+// ErrQueryTooDeep signals that the query exceeds the maximum allowed depth
+var ErrQueryTooDeep = errors.New("query is too deep")
+
+// ErrMissingBlockIdentifier signals that neither a nonce nor a hash were provided for a block lookup
+var ErrMissingBlockIdentifier = errors.New("either nonce or hash must be provided")
+
+// ErrMissingLoaders signals that the per-request data loaders were not found in the resolver's context
+var ErrMissingLoaders = errors.New("missing data loaders in request context")
+
+// ErrInvalidShardID signals that an invalid shard ID has been provided
+var ErrInvalidShardID = errors.New("invalid shard ID")
@@ -0,0 +1,106 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+)
+
+// Request represents a GraphQL request, as sent by the clients
+type Request struct {
+	Query         string                 `json:"query"`
+	Variables     map[string]interface{} `json:"variables"`
+	OperationName string                 `json:"operationName"`
+}
+
+type graphQLHandler struct {
+	facade             FacadeHandler
+	schema             graphql.Schema
+	maxComplexity      int
+	maxDepth           int
+	maxConcurrentLoads int
+}
+
+// NewGraphQLHandler returns a new instance of graphQLHandler
+func NewGraphQLHandler(facade FacadeHandler, maxComplexity int, maxDepth int, maxConcurrentLoads int) (*graphQLHandler, error) {
+	if check.IfNilReflect(facade) {
+		return nil, ErrNilFacade
+	}
+	if maxComplexity < 1 {
+		return nil, ErrInvalidMaxComplexity
+	}
+	if maxDepth < 1 {
+		return nil, ErrInvalidMaxDepth
+	}
+	if maxConcurrentLoads < 1 {
+		return nil, ErrInvalidMaxConcurrentLoads
+	}
+
+	schema, err := newSchema(facade)
+	if err != nil {
+		return nil, err
+	}
+
+	return &graphQLHandler{
+		facade:             facade,
+		schema:             schema,
+		maxComplexity:      maxComplexity,
+		maxDepth:           maxDepth,
+		maxConcurrentLoads: maxConcurrentLoads,
+	}, nil
+}
+
+// Execute parses, validates and executes the provided request. The returned boolean is false if the request has been
+// rejected before execution (malformed query, validation failure or too expensive query)
+func (gh *graphQLHandler) Execute(ctx context.Context, request *Request) (*graphql.Result, bool) {
+	document, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{
+			Body: []byte(request.Query),
+			Name: "GraphQL request",
+		}),
+	})
+	if err != nil {
+		return &graphql.Result{Errors: []gqlerrors.FormattedError{gqlerrors.FormatError(err)}}, false
+	}
+
+	validationResult := graphql.ValidateDocument(&gh.schema, document, nil)
+	if !validationResult.IsValid {
+		return &graphql.Result{Errors: validationResult.Errors}, false
+	}
+
+	complexity, _, err := computeComplexity(document, request.OperationName, gh.maxDepth)
+	if err != nil {
+		return newErrorResult(err), false
+	}
+	if complexity > gh.maxComplexity {
+		return newErrorResult(fmt.Errorf("%w: complexity %d exceeds the maximum of %d",
+			ErrQueryTooComplex, complexity, gh.maxComplexity)), false
+	}
+
+	requestContext := context.WithValue(ctx, loadersContextKey, newLoaders(gh.facade, gh.maxConcurrentLoads))
+	result := graphql.Execute(graphql.ExecuteParams{
+		Schema:        gh.schema,
+		AST:           document,
+		OperationName: request.OperationName,
+		Args:          request.Variables,
+		Context:       requestContext,
+	})
+
+	return result, true
+}
+
+func newErrorResult(err error) *graphql.Result {
+	return &graphql.Result{
+		Errors: []gqlerrors.FormattedError{gqlerrors.NewFormattedError(err.Error())},
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gh *graphQLHandler) IsInterfaceNil() bool {
+	return gh == nil
+}
@@ -0,0 +1,212 @@
+package graphql_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/api/graphql"
+	"github.com/ElrondNetwork/elrond-proxy-go/api/mock"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func executeQuery(t *testing.T, facade graphql.FacadeHandler, request *graphql.Request) (map[string]interface{}, []string, bool) {
+	handler, err := graphql.NewGraphQLHandler(facade, 5000, 10, 10)
+	require.NoError(t, err)
+
+	result, isExecuted := handler.Execute(context.Background(), request)
+
+	messages := make([]string, 0, len(result.Errors))
+	for _, formattedError := range result.Errors {
+		messages = append(messages, formattedError.Message)
+	}
+
+	buff, err := json.Marshal(result.Data)
+	require.NoError(t, err)
+	resultData := make(map[string]interface{})
+	_ = json.Unmarshal(buff, &resultData)
+
+	return resultData, messages, isExecuted
+}
+
+func TestNewGraphQLHandler(t *testing.T) {
+	t.Parallel()
+
+	handler, err := graphql.NewGraphQLHandler(nil, 1, 1, 1)
+	assert.Nil(t, handler)
+	assert.Equal(t, graphql.ErrNilFacade, err)
+
+	handler, err = graphql.NewGraphQLHandler(&mock.Facade{}, 0, 1, 1)
+	assert.Nil(t, handler)
+	assert.Equal(t, graphql.ErrInvalidMaxComplexity, err)
+
+	handler, err = graphql.NewGraphQLHandler(&mock.Facade{}, 1, 0, 1)
+	assert.Nil(t, handler)
+	assert.Equal(t, graphql.ErrInvalidMaxDepth, err)
+
+	handler, err = graphql.NewGraphQLHandler(&mock.Facade{}, 1, 1, 0)
+	assert.Nil(t, handler)
+	assert.Equal(t, graphql.ErrInvalidMaxConcurrentLoads, err)
+
+	handler, err = graphql.NewGraphQLHandler(&mock.Facade{}, 1, 1, 1)
+	assert.NoError(t, err)
+	assert.False(t, handler.IsInterfaceNil())
+}
+
+func TestGraphQLHandler_ExecuteAccountWithESDTTokens(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetAccountHandler: func(address string) (*data.Account, error) {
+			return &data.Account{Address: address, Nonce: 37, Balance: "1000", CodeHash: []byte{0xaa}}, nil
+		},
+		GetAllESDTTokensCalled: func(address string) (*data.GenericAPIResponse, error) {
+			return &data.GenericAPIResponse{
+				Data: map[string]interface{}{
+					"esdts": map[string]interface{}{
+						"TKN-0102": map[string]interface{}{"tokenIdentifier": "TKN-0102", "balance": "5"},
+						"ABC-0304": map[string]interface{}{"tokenIdentifier": "ABC-0304", "balance": "7"},
+					},
+				},
+			}, nil
+		},
+	}
+
+	resultData, errs, isExecuted := executeQuery(t, facade, &graphql.Request{
+		Query: `query acc($address: String!) { account(address: $address) { address nonce balance codeHash esdtTokens { tokenIdentifier balance } } }`,
+		Variables: map[string]interface{}{
+			"address": "erd1a",
+		},
+	})
+	require.True(t, isExecuted)
+	require.Empty(t, errs)
+
+	account := resultData["account"].(map[string]interface{})
+	assert.Equal(t, "erd1a", account["address"])
+	assert.Equal(t, float64(37), account["nonce"])
+	assert.Equal(t, "aa", account["codeHash"])
+	tokens := account["esdtTokens"].([]interface{})
+	require.Len(t, tokens, 2)
+	assert.Equal(t, "ABC-0304", tokens[0].(map[string]interface{})["tokenIdentifier"])
+	assert.Equal(t, "TKN-0102", tokens[1].(map[string]interface{})["tokenIdentifier"])
+}
+
+func TestGraphQLHandler_ExecuteHyperblockShouldLoadEachAccountOnce(t *testing.T) {
+	t.Parallel()
+
+	mutAccountCalls := sync.Mutex{}
+	accountCalls := make(map[string]int)
+	facade := &mock.Facade{
+		GetAccountHandler: func(address string) (*data.Account, error) {
+			mutAccountCalls.Lock()
+			accountCalls[address]++
+			mutAccountCalls.Unlock()
+
+			return &data.Account{Address: address, Balance: "1"}, nil
+		},
+		GetHyperBlockByNonceCalled: func(nonce uint64) (*data.HyperblockApiResponse, error) {
+			return data.NewHyperblockApiResponse(data.Hyperblock{
+				Nonce: nonce,
+				Transactions: []*data.FullTransaction{
+					{Hash: "h1", Sender: "erd1a", Receiver: "erd1b", Data: []byte("transfer")},
+					{Hash: "h2", Sender: "erd1b", Receiver: "erd1a"},
+					{Hash: "h3", Sender: "erd1a", Receiver: "erd1c"},
+				},
+			}), nil
+		},
+	}
+
+	resultData, errs, isExecuted := executeQuery(t, facade, &graphql.Request{
+		Query: `{ hyperblock(nonce: "18446744073709551615") { nonce transactions { hash data senderAccount { balance } receiverAccount { address } } } }`,
+	})
+	require.True(t, isExecuted)
+	require.Empty(t, errs)
+
+	hyperblock := resultData["hyperblock"].(map[string]interface{})
+	transactions := hyperblock["transactions"].([]interface{})
+	require.Len(t, transactions, 3)
+	firstTx := transactions[0].(map[string]interface{})
+	assert.Equal(t, "transfer", firstTx["data"])
+	assert.Equal(t, "erd1b", firstTx["receiverAccount"].(map[string]interface{})["address"])
+	assert.Equal(t, map[string]int{"erd1a": 1, "erd1b": 1, "erd1c": 1}, accountCalls)
+}
+
+func TestGraphQLHandler_ExecuteBlock(t *testing.T) {
+	t.Parallel()
+
+	var calledShard uint32
+	facade := &mock.Facade{
+		GetBlockByHashCalled: func(shardID uint32, hash string, withTxs bool) (*data.BlockApiResponse, error) {
+			calledShard = shardID
+			return &data.BlockApiResponse{Data: data.BlockApiResponsePayload{Block: data.Block{Hash: hash, Shard: shardID}}}, nil
+		},
+	}
+
+	resultData, errs, isExecuted := executeQuery(t, facade, &graphql.Request{
+		Query: `{ block(shard: 4294967295, hash: "abcd") { hash shard } }`,
+	})
+	require.True(t, isExecuted)
+	require.Empty(t, errs)
+	assert.Equal(t, uint32(4294967295), calledShard)
+	block := resultData["block"].(map[string]interface{})
+	assert.Equal(t, "abcd", block["hash"])
+	assert.Equal(t, float64(4294967295), block["shard"])
+
+	_, errs, isExecuted = executeQuery(t, facade, &graphql.Request{
+		Query: `{ block(shard: 1) { hash } }`,
+	})
+	require.True(t, isExecuted)
+	require.Equal(t, []string{graphql.ErrMissingBlockIdentifier.Error()}, errs)
+}
+
+func TestGraphQLHandler_ExecuteFacadeErrorShouldReturnFieldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := &mock.Facade{
+		GetTransactionHandler: func(txHash string, withResults bool) (*data.FullTransaction, error) {
+			return nil, expectedErr
+		},
+	}
+
+	resultData, errs, isExecuted := executeQuery(t, facade, &graphql.Request{
+		Query: `{ transaction(hash: "aa") { hash } }`,
+	})
+	require.True(t, isExecuted)
+	assert.Equal(t, []string{expectedErr.Error()}, errs)
+	assert.Nil(t, resultData["transaction"])
+}
+
+func TestGraphQLHandler_ExecuteInvalidQueriesShouldBeRejected(t *testing.T) {
+	t.Parallel()
+
+	numCalls := int32(0)
+	facade := &mock.Facade{
+		GetAccountHandler: func(address string) (*data.Account, error) {
+			atomic.AddInt32(&numCalls, 1)
+			return &data.Account{}, nil
+		},
+	}
+
+	_, errs, isExecuted := executeQuery(t, facade, &graphql.Request{Query: `{ account(address: "a") { `})
+	assert.False(t, isExecuted)
+	assert.Len(t, errs, 1)
+
+	_, errs, isExecuted = executeQuery(t, facade, &graphql.Request{Query: `{ account(address: "a") { unknownField } }`})
+	assert.False(t, isExecuted)
+	assert.Len(t, errs, 1)
+
+	_, errs, isExecuted = executeQuery(t, facade, &graphql.Request{
+		Query: `{ hyperblock(nonce: 1) { transactions { senderAccount { esdtTokens { tokenIdentifier balance name } } receiverAccount { esdtTokens { tokenIdentifier } } } } }`,
+	})
+	assert.False(t, isExecuted)
+	require.Len(t, errs, 1)
+	assert.Contains(t, errs[0], graphql.ErrQueryTooComplex.Error())
+
+	assert.Equal(t, int32(0), atomic.LoadInt32(&numCalls))
+}
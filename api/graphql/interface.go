@@ -0,0 +1,15 @@
+package graphql
+
+import "github.com/ElrondNetwork/elrond-proxy-go/data"
+
+// FacadeHandler defines the facade methods used by the GraphQL resolvers
+type FacadeHandler interface {
+	GetAccount(address string) (*data.Account, error)
+	GetAllESDTTokens(address string) (*data.GenericAPIResponse, error)
+	GetESDTTokenData(address string, key string) (*data.GenericAPIResponse, error)
+	GetTransaction(txHash string, withResults bool) (*data.FullTransaction, error)
+	GetBlockByNonce(shardID uint32, nonce uint64, withTxs bool) (*data.BlockApiResponse, error)
+	GetBlockByHash(shardID uint32, hash string, withTxs bool) (*data.BlockApiResponse, error)
+	GetHyperBlockByNonce(nonce uint64) (*data.HyperblockApiResponse, error)
+	GetHyperBlockByHash(hash string) (*data.HyperblockApiResponse, error)
+}
@@ -0,0 +1,79 @@
+package graphql
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// uint64Scalar is used for nonces, rounds, shard IDs and the other unsigned values exceeding the 32 bits signed
+// integers supported by the built-in Int type
+var uint64Scalar = graphql.NewScalar(graphql.ScalarConfig{
+	Name:         "Uint64",
+	Description:  "The `Uint64` scalar type represents an unsigned 64 bits integer",
+	Serialize:    serializeUint64,
+	ParseValue:   parseUint64Value,
+	ParseLiteral: parseUint64Literal,
+})
+
+func serializeUint64(value interface{}) interface{} {
+	switch v := value.(type) {
+	case uint64:
+		return v
+	case uint32:
+		return uint64(v)
+	case int:
+		if v < 0 {
+			return nil
+		}
+		return uint64(v)
+	case time.Duration:
+		if v < 0 {
+			return nil
+		}
+		return uint64(v)
+	default:
+		return nil
+	}
+}
+
+func parseUint64Value(value interface{}) interface{} {
+	switch v := value.(type) {
+	case float64:
+		if v < 0 || v != float64(uint64(v)) {
+			return nil
+		}
+		return uint64(v)
+	case int:
+		if v < 0 {
+			return nil
+		}
+		return uint64(v)
+	case string:
+		return parseUint64String(v)
+	default:
+		return nil
+	}
+}
+
+func parseUint64Literal(valueAST ast.Value) interface{} {
+	switch v := valueAST.(type) {
+	case *ast.IntValue:
+		return parseUint64String(v.Value)
+	case *ast.StringValue:
+		return parseUint64String(v.Value)
+	default:
+		return nil
+	}
+}
+
+func parseUint64String(value string) interface{} {
+	result, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return nil
+	}
+
+	return result
+}
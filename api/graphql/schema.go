@@ -0,0 +1,483 @@
+package graphql
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"math"
+	"math/big"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/graphql-go/graphql"
+)
+
+type contextKey string
+
+const loadersContextKey = contextKey("loaders")
+
+// loaders holds the data loaders used while resolving a single request
+type loaders struct {
+	accounts   *dataLoader
+	esdtTokens *dataLoader
+}
+
+func newLoaders(facade FacadeHandler, maxConcurrentLoads int) *loaders {
+	return &loaders{
+		accounts: newDataLoader(func(address string) (interface{}, error) {
+			return facade.GetAccount(address)
+		}, maxConcurrentLoads),
+		esdtTokens: newDataLoader(func(address string) (interface{}, error) {
+			return fetchESDTTokens(facade, address)
+		}, maxConcurrentLoads),
+	}
+}
+
+func loadersFromContext(ctx context.Context) (*loaders, error) {
+	if ctx == nil {
+		return nil, ErrMissingLoaders
+	}
+
+	l, ok := ctx.Value(loadersContextKey).(*loaders)
+	if !ok {
+		return nil, ErrMissingLoaders
+	}
+
+	return l, nil
+}
+
+func fetchESDTTokens(facade FacadeHandler, address string) (interface{}, error) {
+	response, err := facade.GetAllESDTTokens(address)
+	if err != nil {
+		return nil, err
+	}
+
+	tokensData := &data.ESDTTokensResponseData{}
+	err = convertResponseData(response, tokensData)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := make([]*data.ESDTToken, 0, len(tokensData.ESDTs))
+	for _, token := range tokensData.ESDTs {
+		tokens = append(tokens, token)
+	}
+	sort.Slice(tokens, func(i, j int) bool {
+		return tokens[i].TokenIdentifier < tokens[j].TokenIdentifier
+	})
+
+	return tokens, nil
+}
+
+func fetchESDTToken(facade FacadeHandler, address string, tokenIdentifier string) (interface{}, error) {
+	response, err := facade.GetESDTTokenData(address, tokenIdentifier)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenData := &data.ESDTTokenResponseData{}
+	err = convertResponseData(response, tokenData)
+	if err != nil {
+		return nil, err
+	}
+
+	return tokenData.TokenData, nil
+}
+
+// convertResponseData converts the generic data field of a node's response into the provided structure
+func convertResponseData(response *data.GenericAPIResponse, destination interface{}) error {
+	if response == nil || response.Data == nil {
+		return nil
+	}
+
+	buff, err := json.Marshal(response.Data)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(buff, destination)
+}
+
+func newSchema(facade FacadeHandler) (graphql.Schema, error) {
+	esdtTokenType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "ESDTToken",
+		Fields: graphql.Fields{
+			"tokenIdentifier": &graphql.Field{Type: graphql.String},
+			"balance":         &graphql.Field{Type: graphql.String},
+			"properties":      &graphql.Field{Type: graphql.String},
+			"nonce":           &graphql.Field{Type: uint64Scalar},
+			"name":            &graphql.Field{Type: graphql.String},
+			"creator":         &graphql.Field{Type: graphql.String},
+			"royalties":       &graphql.Field{Type: graphql.String},
+			"hash": &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return hex.EncodeToString(p.Source.(*data.ESDTToken).Hash), nil
+				},
+			},
+			"uris": &graphql.Field{
+				Type: graphql.NewList(graphql.String),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					uris := p.Source.(*data.ESDTToken).URIs
+					result := make([]string, 0, len(uris))
+					for _, uri := range uris {
+						result = append(result, string(uri))
+					}
+
+					return result, nil
+				},
+			},
+			"attributes": &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return hex.EncodeToString(p.Source.(*data.ESDTToken).Attributes), nil
+				},
+			},
+		},
+	})
+
+	accountType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Account",
+		Fields: graphql.Fields{
+			"address":         &graphql.Field{Type: graphql.String},
+			"nonce":           &graphql.Field{Type: uint64Scalar},
+			"balance":         &graphql.Field{Type: graphql.String},
+			"username":        &graphql.Field{Type: graphql.String},
+			"code":            &graphql.Field{Type: graphql.String},
+			"codeHash":        bytesAsHexField(func(source interface{}) []byte { return source.(*data.Account).CodeHash }),
+			"rootHash":        bytesAsHexField(func(source interface{}) []byte { return source.(*data.Account).RootHash }),
+			"codeMetadata":    bytesAsHexField(func(source interface{}) []byte { return source.(*data.Account).CodeMetadata }),
+			"developerReward": &graphql.Field{Type: graphql.String},
+			"ownerAddress":    &graphql.Field{Type: graphql.String},
+			"esdtTokens": &graphql.Field{
+				Type: graphql.NewList(esdtTokenType),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					l, err := loadersFromContext(p.Context)
+					if err != nil {
+						return nil, err
+					}
+
+					return l.esdtTokens.load(p.Source.(*data.Account).Address), nil
+				},
+			},
+			"esdtToken": &graphql.Field{
+				Type: esdtTokenType,
+				Args: graphql.FieldConfigArgument{
+					"tokenIdentifier": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return fetchESDTToken(facade, p.Source.(*data.Account).Address, p.Args["tokenIdentifier"].(string))
+				},
+			},
+		},
+	})
+
+	smartContractResultType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "SmartContractResult",
+		Fields: graphql.Fields{
+			"hash":  &graphql.Field{Type: graphql.String},
+			"nonce": &graphql.Field{Type: uint64Scalar},
+			"value": bigIntAsStringField(func(source interface{}) *big.Int {
+				return source.(*transaction.ApiSmartContractResult).Value
+			}),
+			"receiver":       &graphql.Field{Type: graphql.String},
+			"sender":         &graphql.Field{Type: graphql.String},
+			"data":           &graphql.Field{Type: graphql.String},
+			"prevTxHash":     &graphql.Field{Type: graphql.String},
+			"originalTxHash": &graphql.Field{Type: graphql.String},
+			"gasLimit":       &graphql.Field{Type: uint64Scalar},
+			"gasPrice":       &graphql.Field{Type: uint64Scalar},
+			"returnMessage":  &graphql.Field{Type: graphql.String},
+			"originalSender": &graphql.Field{Type: graphql.String},
+		},
+	})
+
+	receiptType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Receipt",
+		Fields: graphql.Fields{
+			"value": bigIntAsStringField(func(source interface{}) *big.Int {
+				return source.(*transaction.ReceiptApi).Value
+			}),
+			"sender": &graphql.Field{Type: graphql.String},
+			"data":   &graphql.Field{Type: graphql.String},
+			"txHash": &graphql.Field{Type: graphql.String},
+		},
+	})
+
+	transactionType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Transaction",
+		Fields: graphql.Fields{
+			"type":     &graphql.Field{Type: graphql.String},
+			"hash":     &graphql.Field{Type: graphql.String},
+			"nonce":    &graphql.Field{Type: uint64Scalar},
+			"round":    &graphql.Field{Type: uint64Scalar},
+			"epoch":    &graphql.Field{Type: uint64Scalar},
+			"value":    &graphql.Field{Type: graphql.String},
+			"receiver": &graphql.Field{Type: graphql.String},
+			"sender":   &graphql.Field{Type: graphql.String},
+			"senderUsername": bytesAsStringField(func(source interface{}) []byte {
+				return source.(*data.FullTransaction).SenderUsername
+			}),
+			"receiverUsername": bytesAsStringField(func(source interface{}) []byte {
+				return source.(*data.FullTransaction).ReceiverUsername
+			}),
+			"gasPrice": &graphql.Field{Type: uint64Scalar},
+			"gasLimit": &graphql.Field{Type: uint64Scalar},
+			"data": bytesAsStringField(func(source interface{}) []byte {
+				return source.(*data.FullTransaction).Data
+			}),
+			"code":                              &graphql.Field{Type: graphql.String},
+			"previousTransactionHash":           &graphql.Field{Type: graphql.String},
+			"originalTransactionHash":           &graphql.Field{Type: graphql.String},
+			"returnMessage":                     &graphql.Field{Type: graphql.String},
+			"originalSender":                    &graphql.Field{Type: graphql.String},
+			"signature":                         &graphql.Field{Type: graphql.String},
+			"sourceShard":                       &graphql.Field{Type: uint64Scalar},
+			"destinationShard":                  &graphql.Field{Type: uint64Scalar},
+			"blockNonce":                        &graphql.Field{Type: uint64Scalar},
+			"blockHash":                         &graphql.Field{Type: graphql.String},
+			"notarizedAtSourceInMetaNonce":      &graphql.Field{Type: uint64Scalar},
+			"notarizedAtSourceInMetaHash":       &graphql.Field{Type: graphql.String},
+			"notarizedAtDestinationInMetaNonce": &graphql.Field{Type: uint64Scalar},
+			"notarizedAtDestinationInMetaHash":  &graphql.Field{Type: graphql.String},
+			"miniblockType":                     &graphql.Field{Type: graphql.String},
+			"miniblockHash":                     &graphql.Field{Type: graphql.String},
+			"status":                            &graphql.Field{Type: graphql.String},
+			"hyperblockNonce":                   &graphql.Field{Type: uint64Scalar},
+			"hyperblockHash":                    &graphql.Field{Type: graphql.String},
+			"receipt":                           &graphql.Field{Type: receiptType},
+			"smartContractResults":              &graphql.Field{Type: graphql.NewList(smartContractResultType)},
+			"senderAccount": accountField(accountType, func(source interface{}) string {
+				return source.(*data.FullTransaction).Sender
+			}),
+			"receiverAccount": accountField(accountType, func(source interface{}) string {
+				return source.(*data.FullTransaction).Receiver
+			}),
+		},
+	})
+
+	notarizedBlockType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "NotarizedBlock",
+		Fields: graphql.Fields{
+			"hash":  &graphql.Field{Type: graphql.String},
+			"nonce": &graphql.Field{Type: uint64Scalar},
+			"round": &graphql.Field{Type: uint64Scalar},
+			"shard": &graphql.Field{Type: uint64Scalar},
+		},
+	})
+
+	miniBlockType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "MiniBlock",
+		Fields: graphql.Fields{
+			"hash":             &graphql.Field{Type: graphql.String},
+			"type":             &graphql.Field{Type: graphql.String},
+			"sourceShard":      &graphql.Field{Type: uint64Scalar},
+			"destinationShard": &graphql.Field{Type: uint64Scalar},
+			"transactions":     &graphql.Field{Type: graphql.NewList(transactionType)},
+		},
+	})
+
+	epochStartInfoType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "EpochStartInfo",
+		Fields: graphql.Fields{
+			"totalSupply":                      &graphql.Field{Type: graphql.String},
+			"totalToDistribute":                &graphql.Field{Type: graphql.String},
+			"totalNewlyMinted":                 &graphql.Field{Type: graphql.String},
+			"rewardsPerBlock":                  &graphql.Field{Type: graphql.String},
+			"rewardsForProtocolSustainability": &graphql.Field{Type: graphql.String},
+			"nodePrice":                        &graphql.Field{Type: graphql.String},
+			"prevEpochStartRound":              &graphql.Field{Type: uint64Scalar},
+			"prevEpochStartHash":               &graphql.Field{Type: graphql.String},
+		},
+	})
+
+	blockType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Block",
+		Fields: graphql.Fields{
+			"nonce":                  &graphql.Field{Type: uint64Scalar},
+			"round":                  &graphql.Field{Type: uint64Scalar},
+			"hash":                   &graphql.Field{Type: graphql.String},
+			"prevBlockHash":          &graphql.Field{Type: graphql.String},
+			"epoch":                  &graphql.Field{Type: uint64Scalar},
+			"shard":                  &graphql.Field{Type: uint64Scalar},
+			"numTxs":                 &graphql.Field{Type: uint64Scalar},
+			"notarizedBlocks":        &graphql.Field{Type: graphql.NewList(notarizedBlockType)},
+			"miniBlocks":             &graphql.Field{Type: graphql.NewList(miniBlockType)},
+			"timestamp":              &graphql.Field{Type: uint64Scalar},
+			"accumulatedFees":        &graphql.Field{Type: graphql.String},
+			"developerFees":          &graphql.Field{Type: graphql.String},
+			"accumulatedFeesInEpoch": &graphql.Field{Type: graphql.String},
+			"developerFeesInEpoch":   &graphql.Field{Type: graphql.String},
+			"epochStartInfo":         &graphql.Field{Type: epochStartInfoType},
+			"status":                 &graphql.Field{Type: graphql.String},
+		},
+	})
+
+	hyperblockType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Hyperblock",
+		Fields: graphql.Fields{
+			"nonce":                  &graphql.Field{Type: uint64Scalar},
+			"round":                  &graphql.Field{Type: uint64Scalar},
+			"hash":                   &graphql.Field{Type: graphql.String},
+			"prevBlockHash":          &graphql.Field{Type: graphql.String},
+			"epoch":                  &graphql.Field{Type: uint64Scalar},
+			"numTxs":                 &graphql.Field{Type: uint64Scalar},
+			"shardBlocks":            &graphql.Field{Type: graphql.NewList(notarizedBlockType)},
+			"transactions":           &graphql.Field{Type: graphql.NewList(transactionType)},
+			"accumulatedFees":        &graphql.Field{Type: graphql.String},
+			"developerFees":          &graphql.Field{Type: graphql.String},
+			"accumulatedFeesInEpoch": &graphql.Field{Type: graphql.String},
+			"developerFeesInEpoch":   &graphql.Field{Type: graphql.String},
+			"epochStartInfo":         &graphql.Field{Type: epochStartInfoType},
+			"status":                 &graphql.Field{Type: graphql.String},
+		},
+	})
+
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"account": &graphql.Field{
+				Type: accountType,
+				Args: graphql.FieldConfigArgument{
+					"address": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					l, err := loadersFromContext(p.Context)
+					if err != nil {
+						return nil, err
+					}
+
+					return l.accounts.load(p.Args["address"].(string)), nil
+				},
+			},
+			"transaction": &graphql.Field{
+				Type: transactionType,
+				Args: graphql.FieldConfigArgument{
+					"hash":        &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					"withResults": &graphql.ArgumentConfig{Type: graphql.Boolean, DefaultValue: false},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return facade.GetTransaction(p.Args["hash"].(string), p.Args["withResults"].(bool))
+				},
+			},
+			"block": &graphql.Field{
+				Type: blockType,
+				Args: graphql.FieldConfigArgument{
+					"shard":   &graphql.ArgumentConfig{Type: graphql.NewNonNull(uint64Scalar)},
+					"nonce":   &graphql.ArgumentConfig{Type: uint64Scalar},
+					"hash":    &graphql.ArgumentConfig{Type: graphql.String},
+					"withTxs": &graphql.ArgumentConfig{Type: graphql.Boolean, DefaultValue: false},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return resolveBlock(facade, p.Args)
+				},
+			},
+			"hyperblock": &graphql.Field{
+				Type: hyperblockType,
+				Args: graphql.FieldConfigArgument{
+					"nonce": &graphql.ArgumentConfig{Type: uint64Scalar},
+					"hash":  &graphql.ArgumentConfig{Type: graphql.String},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return resolveHyperblock(facade, p.Args)
+				},
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{
+		Query: queryType,
+	})
+}
+
+func resolveBlock(facade FacadeHandler, args map[string]interface{}) (interface{}, error) {
+	shard := args["shard"].(uint64)
+	if shard > math.MaxUint32 {
+		return nil, ErrInvalidShardID
+	}
+	withTxs := args["withTxs"].(bool)
+
+	var response *data.BlockApiResponse
+	var err error
+	if nonce, ok := args["nonce"].(uint64); ok {
+		response, err = facade.GetBlockByNonce(uint32(shard), nonce, withTxs)
+	} else if hash, ok := args["hash"].(string); ok {
+		response, err = facade.GetBlockByHash(uint32(shard), hash, withTxs)
+	} else {
+		return nil, ErrMissingBlockIdentifier
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Data.Block, nil
+}
+
+func resolveHyperblock(facade FacadeHandler, args map[string]interface{}) (interface{}, error) {
+	var response *data.HyperblockApiResponse
+	var err error
+	if nonce, ok := args["nonce"].(uint64); ok {
+		response, err = facade.GetHyperBlockByNonce(nonce)
+	} else if hash, ok := args["hash"].(string); ok {
+		response, err = facade.GetHyperBlockByHash(hash)
+	} else {
+		return nil, ErrMissingBlockIdentifier
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Data.Hyperblock, nil
+}
+
+func accountField(accountType *graphql.Object, getAddress func(source interface{}) string) *graphql.Field {
+	return &graphql.Field{
+		Type: accountType,
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			address := getAddress(p.Source)
+			if len(address) == 0 {
+				return nil, nil
+			}
+
+			l, err := loadersFromContext(p.Context)
+			if err != nil {
+				return nil, err
+			}
+
+			return l.accounts.load(address), nil
+		},
+	}
+}
+
+func bytesAsHexField(getBytes func(source interface{}) []byte) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.String,
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return hex.EncodeToString(getBytes(p.Source)), nil
+		},
+	}
+}
+
+func bytesAsStringField(getBytes func(source interface{}) []byte) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.String,
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			return string(getBytes(p.Source)), nil
+		},
+	}
+}
+
+func bigIntAsStringField(getValue func(source interface{}) *big.Int) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.String,
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			value := getValue(p.Source)
+			if value == nil {
+				return nil, nil
+			}
+
+			return value.String(), nil
+		},
+	}
+}
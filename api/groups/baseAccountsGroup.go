@@ -1,15 +1,56 @@
 package groups
 
 import (
+	goErrors "errors"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/ElrondNetwork/elrond-proxy-go/api/errors"
 	"github.com/ElrondNetwork/elrond-proxy-go/api/shared"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/process"
 	"github.com/gin-gonic/gin"
 )
 
+// defaultMaxBulkAccountsAddresses is the maximum number of addresses of a bulk accounts request, unless the route
+// config sets another MaxBatchSize
+const defaultMaxBulkAccountsAddresses = 500
+
+// accountQueryParameters describes the query parameters selecting the block at which the state of an account is read
+var accountQueryParameters = []data.QueryParameter{
+	{Name: "blockNonce", Type: "integer", Description: "the nonce of the block at which the state is read"},
+	{Name: "blockHash", Type: "string", Description: "the hash of the block at which the state is read"},
+	{Name: "rootHash", Type: "string", Description: "the state root hash at which the state is read"},
+}
+
+// historyPagingQueryParameters describes the paging query parameters of the history routes
+var historyPagingQueryParameters = []data.QueryParameter{
+	{Name: "from", Type: "integer", Description: "the number of skipped items, for the first 10000 items"},
+	{Name: "size", Type: "integer", Description: "the number of returned items, at most 100"},
+	{Name: "cursor", Type: "string", Description: "the cursor returned with the previous page"},
+}
+
+// historyFiltersQueryParameters describes the status and time range filters of the history routes
+var historyFiltersQueryParameters = []data.QueryParameter{
+	{Name: "status", Type: "string", Description: "the status of the returned items"},
+	{Name: "startTime", Type: "integer", Description: "the unix timestamp the returned items start at"},
+	{Name: "endTime", Type: "integer", Description: "the unix timestamp the returned items end at"},
+	{Name: "order", Type: "string", Description: "the order by timestamp: asc or desc (default)"},
+}
+
+// identifierQueryParameter describes the filter of the events route
+var identifierQueryParameter = []data.QueryParameter{
+	{Name: "identifier", Type: "string", Description: "the identifier of the returned events"},
+}
+
+// transfersFiltersQueryParameters describes the filters of the address transactions and transfers routes
+var transfersFiltersQueryParameters = []data.QueryParameter{
+	{Name: "direction", Type: "string", Description: "in or out"},
+	{Name: "token", Type: "string", Description: "the identifier of the transferred token"},
+	{Name: "counterparty", Type: "string", Description: "the address of the counterparty"},
+}
+
 type accountsGroup struct {
 	facade AccountsFacadeHandler
 	*baseGroup
@@ -27,100 +68,315 @@ func NewAccountsGroup(facadeHandler data.FacadeHandler) (*accountsGroup, error)
 		baseGroup: &baseGroup{},
 	}
 
+	historyQueryParameters := joinQueryParameters(historyPagingQueryParameters, transfersFiltersQueryParameters, historyFiltersQueryParameters)
+	eventsQueryParameters := joinQueryParameters(identifierQueryParameter, historyPagingQueryParameters, historyFiltersQueryParameters)
 	baseRoutesHandlers := []*data.EndpointHandlerData{
-		{Path: "/:address", Handler: ag.getAccount, Method: http.MethodGet},
-		{Path: "/:address/balance", Handler: ag.getBalance, Method: http.MethodGet},
-		{Path: "/:address/username", Handler: ag.getUsername, Method: http.MethodGet},
-		{Path: "/:address/nonce", Handler: ag.getNonce, Method: http.MethodGet},
-		{Path: "/:address/shard", Handler: ag.getShard, Method: http.MethodGet},
-		{Path: "/:address/transactions", Handler: ag.getTransactions, Method: http.MethodGet},
-		{Path: "/:address/keys", Handler: ag.getKeyValuePairs, Method: http.MethodGet},
-		{Path: "/:address/key/:key", Handler: ag.getValueForKey, Method: http.MethodGet},
-		{Path: "/:address/esdt", Handler: ag.getESDTTokens, Method: http.MethodGet},
-		{Path: "/:address/esdt/:tokenIdentifier", Handler: ag.getESDTTokenData, Method: http.MethodGet},
-		{Path: "/:address/esdts-with-role/:role", Handler: ag.getESDTsWithRole, Method: http.MethodGet},
-		{Path: "/:address/registered-nfts", Handler: ag.getRegisteredNFTs, Method: http.MethodGet},
-		{Path: "/:address/nft/:tokenIdentifier/nonce/:nonce", Handler: ag.getESDTNftTokenData, Method: http.MethodGet},
+		{Path: "/:address", Handler: ag.getAccount, Method: http.MethodGet, Response: apiResponse(gin.H{"account": data.Account{}}), QueryParameters: accountQueryParameters},
+		{Path: "/:address/balance", Handler: ag.getBalance, Method: http.MethodGet, Response: apiResponse(gin.H{"balance": ""}), QueryParameters: accountQueryParameters},
+		{Path: "/:address/username", Handler: ag.getUsername, Method: http.MethodGet, Response: apiResponse(gin.H{"username": ""}), QueryParameters: accountQueryParameters},
+		{Path: "/:address/nonce", Handler: ag.getNonce, Method: http.MethodGet, Response: apiResponse(gin.H{"nonce": uint64(0)}), QueryParameters: accountQueryParameters},
+		{Path: "/:address/shard", Handler: ag.getShard, Method: http.MethodGet, Response: apiResponse(gin.H{"shardID": uint32(0)})},
+		{Path: "/:address/transactions", Handler: ag.getTransactions, Method: http.MethodGet, Response: apiResponse(gin.H{"transactions": []data.DatabaseTransaction{}, "cursor": ""}), QueryParameters: historyQueryParameters},
+		{Path: "/:address/token-transfers", Handler: ag.getTokenTransfers, Method: http.MethodGet, Response: apiResponse(gin.H{"transfers": []data.DatabaseTransaction{}, "cursor": ""}), QueryParameters: historyQueryParameters},
+		{Path: "/:address/events", Handler: ag.getEvents, Method: http.MethodGet, Response: apiResponse(gin.H{"events": []data.DatabaseEvent{}}), QueryParameters: eventsQueryParameters},
+		{Path: "/:address/keys", Handler: ag.getKeyValuePairs, Method: http.MethodGet, Response: apiResponse(gin.H{"pairs": map[string]string{}}), QueryParameters: accountQueryParameters},
+		{Path: "/:address/key/:key", Handler: ag.getValueForKey, Method: http.MethodGet, Response: apiResponse(gin.H{"value": ""}), QueryParameters: accountQueryParameters},
+		{Path: "/:address/esdt", Handler: ag.getESDTTokens, Method: http.MethodGet, Response: apiResponse(data.ESDTTokensResponseData{}), QueryParameters: accountQueryParameters},
+		{Path: "/:address/esdt/:tokenIdentifier", Handler: ag.getESDTTokenData, Method: http.MethodGet, Response: apiResponse(data.ESDTTokenResponseData{}), QueryParameters: accountQueryParameters},
+		{Path: "/:address/esdts-with-role/:role", Handler: ag.getESDTsWithRole, Method: http.MethodGet, Response: apiResponse(gin.H{"tokens": []string{}})},
+		{Path: "/:address/registered-nfts", Handler: ag.getRegisteredNFTs, Method: http.MethodGet, Response: apiResponse(gin.H{"tokens": []string{}})},
+		{Path: "/:address/nft/:tokenIdentifier/nonce/:nonce", Handler: ag.getESDTNftTokenData, Method: http.MethodGet, Response: apiResponse(data.ESDTTokenResponseData{}), QueryParameters: accountQueryParameters},
+		{Path: "/bulk", Handler: ag.getBulkAccounts, Method: http.MethodPost, Request: data.BulkAccountsRequest{}, Response: apiResponse(gin.H{"accounts": map[string]*data.BulkAccountResult{}})},
 	}
 	ag.baseGroup.endpoints = baseRoutesHandlers
 
 	return ag, nil
 }
 
-func (group *accountsGroup) getAccountFromFacade(c *gin.Context) (*data.Account, int, error) {
+func (group *accountsGroup) getAccountFromFacade(c *gin.Context) (*data.ResponseAccount, int, error) {
 	addr := c.Param("address")
+	options, err := getAccountQueryOptions(c)
+	if err != nil {
+		return nil, http.StatusBadRequest, err
+	}
+
+	if options.IsHistorical() {
+		response, errGet := group.facade.GetAccountAtBlock(addr, options)
+		if errGet != nil {
+			return nil, accountStateErrorStatus(errGet), errGet
+		}
+
+		return response, http.StatusOK, nil
+	}
+
 	acc, err := group.facade.GetAccount(addr)
 	if err != nil {
 		return nil, http.StatusInternalServerError, err
 	}
 
-	return acc, http.StatusOK, nil
+	return &data.ResponseAccount{AccountData: *acc}, http.StatusOK, nil
 }
 
 func (group *accountsGroup) getTransactionsFromFacade(c *gin.Context) ([]data.DatabaseTransaction, int, error) {
 	addr := c.Param("address")
-	transactions, err := group.facade.GetTransactions(addr)
+	options, err := getTransactionsHistoryOptions(c)
+	if err != nil {
+		return nil, http.StatusBadRequest, fmt.Errorf("%w: %v", errors.ErrInvalidTransactionsHistoryParams, err)
+	}
+
+	transactions, err := group.facade.GetTransactions(addr, options)
+	if err != nil && !isHistoryScanLimitError(err) {
+		return nil, http.StatusInternalServerError, err
+	}
+
+	return transactions, http.StatusOK, err
+}
+
+func (group *accountsGroup) getTokenTransfersFromFacade(c *gin.Context) ([]data.DatabaseTransaction, int, error) {
+	addr := c.Param("address")
+	options, err := getTransactionsHistoryOptions(c)
+	if err != nil {
+		return nil, http.StatusBadRequest, fmt.Errorf("%w: %v", errors.ErrInvalidTransactionsHistoryParams, err)
+	}
+
+	transfers, err := group.facade.GetTokenTransfers(addr, options)
+	if err != nil && !isHistoryScanLimitError(err) {
+		return nil, http.StatusInternalServerError, err
+	}
+
+	return transfers, http.StatusOK, err
+}
+
+func (group *accountsGroup) getEventsFromFacade(c *gin.Context) ([]data.DatabaseEvent, int, error) {
+	addr := c.Param("address")
+	options, err := getTransactionsHistoryOptions(c)
+	if err != nil {
+		return nil, http.StatusBadRequest, fmt.Errorf("%w: %v", errors.ErrInvalidTransactionsHistoryParams, err)
+	}
+
+	events, err := group.facade.GetEvents(addr, c.Request.URL.Query().Get("identifier"), options)
 	if err != nil {
 		return nil, http.StatusInternalServerError, err
 	}
 
-	return transactions, http.StatusOK, nil
+	return events, http.StatusOK, nil
+}
+
+// getTransactionsHistoryOptions parses the paging, filtering and sorting query parameters of a transactions request
+func getTransactionsHistoryOptions(c *gin.Context) (data.TransactionsHistoryOptions, error) {
+	query := c.Request.URL.Query()
+	options := data.TransactionsHistoryOptions{
+		Cursor:       query.Get("cursor"),
+		Direction:    query.Get("direction"),
+		Status:       query.Get("status"),
+		Token:        query.Get("token"),
+		Counterparty: query.Get("counterparty"),
+		Order:        query.Get("order"),
+	}
+
+	var err error
+	if options.From, err = parseIntQueryParam(c, "from"); err != nil {
+		return data.TransactionsHistoryOptions{}, err
+	}
+	if options.Size, err = parseIntQueryParam(c, "size"); err != nil {
+		return data.TransactionsHistoryOptions{}, err
+	}
+	if options.StartTime, err = parseUint64QueryParam(c, "startTime"); err != nil {
+		return data.TransactionsHistoryOptions{}, err
+	}
+	if options.EndTime, err = parseUint64QueryParam(c, "endTime"); err != nil {
+		return data.TransactionsHistoryOptions{}, err
+	}
+
+	return options, nil
+}
+
+// getAccountQueryOptions parses the query parameters selecting the block at which the state of an account is read
+func getAccountQueryOptions(c *gin.Context) (data.AccountQueryOptions, error) {
+	query := c.Request.URL.Query()
+	options := data.AccountQueryOptions{
+		BlockHash: query.Get("blockHash"),
+		RootHash:  query.Get("rootHash"),
+	}
+
+	numOptions := 0
+	if options.BlockHash != "" {
+		numOptions++
+	}
+	if options.RootHash != "" {
+		numOptions++
+	}
+	if query.Get("blockNonce") != "" {
+		nonce, err := parseUint64QueryParam(c, "blockNonce")
+		if err != nil {
+			return data.AccountQueryOptions{}, fmt.Errorf("%w: %v", errors.ErrInvalidAccountQueryParams, err)
+		}
+		options.BlockNonce, options.HasBlockNonce = nonce, true
+		numOptions++
+	}
+	if numOptions > 1 {
+		return data.AccountQueryOptions{}, fmt.Errorf("%w: only one of blockNonce, blockHash and rootHash can be provided",
+			errors.ErrInvalidAccountQueryParams)
+	}
+
+	return options, nil
+}
+
+// withBlockInfo adds the coordinates of the block the account state was read at, if any, to the response data
+func withBlockInfo(responseData gin.H, blockInfo *data.BlockInfo) gin.H {
+	if blockInfo != nil {
+		responseData["blockInfo"] = blockInfo
+	}
+
+	return responseData
+}
+
+func respondWithAccountQueryOptionsError(c *gin.Context, err error) {
+	shared.RespondWith(c, http.StatusBadRequest, nil, err.Error(), data.ReturnCodeRequestError)
+}
+
+func parseIntQueryParam(c *gin.Context, name string) (int, error) {
+	valueStr := c.Request.URL.Query().Get(name)
+	if valueStr == "" {
+		return 0, nil
+	}
+
+	value, err := strconv.Atoi(valueStr)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s", name)
+	}
+
+	return value, nil
+}
+
+func parseUint64QueryParam(c *gin.Context, name string) (uint64, error) {
+	valueStr := c.Request.URL.Query().Get(name)
+	if valueStr == "" {
+		return 0, nil
+	}
+
+	value, err := strconv.ParseUint(valueStr, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s", name)
+	}
+
+	return value, nil
 }
 
 // getAccount returns an accountResponse containing information
 // about the account correlated with provided address
 func (group *accountsGroup) getAccount(c *gin.Context) {
-	account, status, err := group.getAccountFromFacade(c)
+	response, status, err := group.getAccountFromFacade(c)
 	if err != nil {
-		shared.RespondWith(c, status, nil, err.Error(), data.ReturnCodeInternalError)
+		respondWithHistoryError(c, status, err)
 		return
 	}
 
-	shared.RespondWith(c, http.StatusOK, gin.H{"account": account}, "", data.ReturnCodeSuccess)
+	shared.RespondWith(c, http.StatusOK, withBlockInfo(gin.H{"account": response.AccountData}, response.BlockInfo), "", data.ReturnCodeSuccess)
 }
 
 // getBalance returns the balance for the address parameter
 func (group *accountsGroup) getBalance(c *gin.Context) {
-	account, status, err := group.getAccountFromFacade(c)
+	response, status, err := group.getAccountFromFacade(c)
 	if err != nil {
-		shared.RespondWith(c, status, nil, err.Error(), data.ReturnCodeInternalError)
+		respondWithHistoryError(c, status, err)
 		return
 	}
 
-	shared.RespondWith(c, http.StatusOK, gin.H{"balance": account.Balance}, "", data.ReturnCodeSuccess)
+	shared.RespondWith(c, http.StatusOK, withBlockInfo(gin.H{"balance": response.AccountData.Balance}, response.BlockInfo), "", data.ReturnCodeSuccess)
 }
 
 // getUsername returns the username for the address parameter
 func (group *accountsGroup) getUsername(c *gin.Context) {
-	account, status, err := group.getAccountFromFacade(c)
+	response, status, err := group.getAccountFromFacade(c)
 	if err != nil {
-		shared.RespondWith(c, status, nil, err.Error(), data.ReturnCodeInternalError)
+		respondWithHistoryError(c, status, err)
 		return
 	}
 
-	shared.RespondWith(c, http.StatusOK, gin.H{"username": account.Username}, "", data.ReturnCodeSuccess)
+	shared.RespondWith(c, http.StatusOK, withBlockInfo(gin.H{"username": response.AccountData.Username}, response.BlockInfo), "", data.ReturnCodeSuccess)
 }
 
 // getNonce returns the nonce for the address parameter
 func (group *accountsGroup) getNonce(c *gin.Context) {
-	account, status, err := group.getAccountFromFacade(c)
+	response, status, err := group.getAccountFromFacade(c)
 	if err != nil {
-		shared.RespondWith(c, status, nil, err.Error(), data.ReturnCodeInternalError)
+		respondWithHistoryError(c, status, err)
 		return
 	}
 
-	shared.RespondWith(c, http.StatusOK, gin.H{"nonce": account.Nonce}, "", data.ReturnCodeSuccess)
+	shared.RespondWith(c, http.StatusOK, withBlockInfo(gin.H{"nonce": response.AccountData.Nonce}, response.BlockInfo), "", data.ReturnCodeSuccess)
 }
 
 // getTransactions returns the transactions for the address parameter
 func (group *accountsGroup) getTransactions(c *gin.Context) {
 	transactions, status, err := group.getTransactionsFromFacade(c)
+	if status != http.StatusOK {
+		respondWithHistoryError(c, status, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"transactions": transactions, "cursor": getHistoryCursor(transactions, err)}, "", data.ReturnCodeSuccess)
+}
+
+// getTokenTransfers returns the ESDT and NFT transfers sent or received by the address parameter
+func (group *accountsGroup) getTokenTransfers(c *gin.Context) {
+	transfers, status, err := group.getTokenTransfersFromFacade(c)
+	if status != http.StatusOK {
+		respondWithHistoryError(c, status, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"transfers": transfers, "cursor": getHistoryCursor(transfers, err)}, "", data.ReturnCodeSuccess)
+}
+
+// getEvents returns the events emitted by the address parameter
+func (group *accountsGroup) getEvents(c *gin.Context) {
+	events, status, err := group.getEventsFromFacade(c)
 	if err != nil {
-		shared.RespondWith(c, status, nil, err.Error(), data.ReturnCodeInternalError)
+		respondWithHistoryError(c, status, err)
 		return
 	}
 
-	shared.RespondWith(c, http.StatusOK, gin.H{"transactions": transactions}, "", data.ReturnCodeSuccess)
+	shared.RespondWith(c, http.StatusOK, gin.H{"events": events}, "", data.ReturnCodeSuccess)
+}
+
+// getHistoryCursor returns the cursor of the page following the given transactions, or the one of the last scanned
+// entry if the storage reached its scan limit before filling the page
+func getHistoryCursor(transactions []data.DatabaseTransaction, historyErr error) string {
+	scanLimitErr := &data.HistoryScanLimitError{}
+	if goErrors.As(historyErr, &scanLimitErr) {
+		return scanLimitErr.Cursor
+	}
+	if len(transactions) == 0 {
+		return ""
+	}
+
+	return transactions[len(transactions)-1].HistoryCursor()
+}
+
+// accountStateErrorStatus returns the status of a failed account state request: the requests rejected by the node, such
+// as the ones for an unknown block, are bad requests
+func accountStateErrorStatus(err error) int {
+	if goErrors.Is(err, process.ErrInvalidAccountStateRequest) {
+		return http.StatusBadRequest
+	}
+
+	return http.StatusInternalServerError
+}
+
+func isHistoryScanLimitError(err error) bool {
+	scanLimitErr := &data.HistoryScanLimitError{}
+
+	return goErrors.As(err, &scanLimitErr)
+}
+
+func respondWithHistoryError(c *gin.Context, status int, err error) {
+	returnCode := data.ReturnCodeInternalError
+	if status == http.StatusBadRequest {
+		returnCode = data.ReturnCodeRequestError
+	}
+	shared.RespondWith(c, status, nil, err.Error(), returnCode)
 }
 
 // getKeyValuePairs returns the key-value pairs for the address parameter
@@ -137,9 +393,20 @@ func (group *accountsGroup) getKeyValuePairs(c *gin.Context) {
 		return
 	}
 
-	keyValuePairs, err := group.facade.GetKeyValuePairs(addr)
+	options, err := getAccountQueryOptions(c)
 	if err != nil {
-		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		respondWithAccountQueryOptionsError(c, err)
+		return
+	}
+
+	var keyValuePairs *data.GenericAPIResponse
+	if options.IsHistorical() {
+		keyValuePairs, err = group.facade.GetKeyValuePairsAtBlock(addr, options)
+	} else {
+		keyValuePairs, err = group.facade.GetKeyValuePairs(addr)
+	}
+	if err != nil {
+		respondWithHistoryError(c, accountStateErrorStatus(err), err)
 		return
 	}
 
@@ -172,19 +439,24 @@ func (group *accountsGroup) getValueForKey(c *gin.Context) {
 		return
 	}
 
-	value, err := group.facade.GetValueForKey(addr, key)
+	options, err := getAccountQueryOptions(c)
 	if err != nil {
-		shared.RespondWith(
-			c,
-			http.StatusInternalServerError,
-			nil,
-			fmt.Sprintf("%s: %s", errors.ErrGetValueForKey.Error(), err.Error()),
-			data.ReturnCodeInternalError,
-		)
+		respondWithAccountQueryOptionsError(c, err)
 		return
 	}
 
-	shared.RespondWith(c, http.StatusOK, gin.H{"value": value}, "", data.ReturnCodeSuccess)
+	response := &data.AccountKeyValueResponseData{}
+	if options.IsHistorical() {
+		response, err = group.facade.GetValueForKeyAtBlock(addr, key, options)
+	} else {
+		response.Value, err = group.facade.GetValueForKey(addr, key)
+	}
+	if err != nil {
+		respondWithHistoryError(c, accountStateErrorStatus(err), fmt.Errorf("%s: %w", errors.ErrGetValueForKey.Error(), err))
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, withBlockInfo(gin.H{"value": response.Value}, response.BlockInfo), "", data.ReturnCodeSuccess)
 }
 
 // getShard returns the shard for the given address based on the current proxy's configuration
@@ -242,15 +514,20 @@ func (group *accountsGroup) getESDTTokenData(c *gin.Context) {
 		return
 	}
 
-	esdtTokenResponse, err := group.facade.GetESDTTokenData(addr, tokenIdentifier)
+	options, err := getAccountQueryOptions(c)
 	if err != nil {
-		shared.RespondWith(
-			c,
-			http.StatusInternalServerError,
-			nil,
-			err.Error(),
-			data.ReturnCodeInternalError,
-		)
+		respondWithAccountQueryOptionsError(c, err)
+		return
+	}
+
+	var esdtTokenResponse *data.GenericAPIResponse
+	if options.IsHistorical() {
+		esdtTokenResponse, err = group.facade.GetESDTTokenDataAtBlock(addr, tokenIdentifier, options)
+	} else {
+		esdtTokenResponse, err = group.facade.GetESDTTokenData(addr, tokenIdentifier)
+	}
+	if err != nil {
+		respondWithHistoryError(c, accountStateErrorStatus(err), err)
 		return
 	}
 
@@ -314,13 +591,7 @@ func (group *accountsGroup) getRegisteredNFTs(c *gin.Context) {
 
 	tokens, err := group.facade.GetNFTTokenIDsRegisteredByAddress(addr)
 	if err != nil {
-		shared.RespondWith(
-			c,
-			http.StatusInternalServerError,
-			nil,
-			err.Error(),
-			data.ReturnCodeInternalError,
-		)
+		respondWithHistoryError(c, accountStateErrorStatus(err), err)
 		return
 	}
 
@@ -359,15 +630,20 @@ func (group *accountsGroup) getESDTNftTokenData(c *gin.Context) {
 		return
 	}
 
-	esdtTokenResponse, err := group.facade.GetESDTNftTokenData(addr, tokenIdentifier, nonce)
+	options, err := getAccountQueryOptions(c)
 	if err != nil {
-		shared.RespondWith(
-			c,
-			http.StatusInternalServerError,
-			nil,
-			err.Error(),
-			data.ReturnCodeInternalError,
-		)
+		respondWithAccountQueryOptionsError(c, err)
+		return
+	}
+
+	var esdtTokenResponse *data.GenericAPIResponse
+	if options.IsHistorical() {
+		esdtTokenResponse, err = group.facade.GetESDTNftTokenDataAtBlock(addr, tokenIdentifier, nonce, options)
+	} else {
+		esdtTokenResponse, err = group.facade.GetESDTNftTokenData(addr, tokenIdentifier, nonce)
+	}
+	if err != nil {
+		respondWithHistoryError(c, accountStateErrorStatus(err), err)
 		return
 	}
 
@@ -388,17 +664,58 @@ func (group *accountsGroup) getESDTTokens(c *gin.Context) {
 		return
 	}
 
-	tokens, err := group.facade.GetAllESDTTokens(addr)
+	options, err := getAccountQueryOptions(c)
+	if err != nil {
+		respondWithAccountQueryOptionsError(c, err)
+		return
+	}
+
+	var tokens *data.GenericAPIResponse
+	if options.IsHistorical() {
+		tokens, err = group.facade.GetAllESDTTokensAtBlock(addr, options)
+	} else {
+		tokens, err = group.facade.GetAllESDTTokens(addr)
+	}
+	if err != nil {
+		respondWithHistoryError(c, accountStateErrorStatus(err), err)
+		return
+	}
+
+	c.JSON(http.StatusOK, tokens)
+}
+
+// getBulkAccounts returns the requested fields of the accounts with the addresses from the request body. The
+// addresses which could not be fetched get an error in their result, without failing the request
+func (group *accountsGroup) getBulkAccounts(c *gin.Context) {
+	var request data.BulkAccountsRequest
+	err := c.ShouldBindJSON(&request)
 	if err != nil {
 		shared.RespondWith(
 			c,
-			http.StatusInternalServerError,
+			http.StatusBadRequest,
 			nil,
-			err.Error(),
-			data.ReturnCodeInternalError,
+			fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+			data.ReturnCodeRequestError,
 		)
 		return
 	}
 
-	c.JSON(http.StatusOK, tokens)
+	maxAddresses := getMaxBatchSize(c, defaultMaxBulkAccountsAddresses)
+	if uint64(len(request.Addresses)) > maxAddresses {
+		err = fmt.Errorf("%w: %d addresses provided, maximum is %d", process.ErrInvalidBulkAccountsRequest, len(request.Addresses), maxAddresses)
+		respondWithHistoryError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	accounts, err := group.facade.GetBulkAccounts(request.Addresses, request.Fields)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if goErrors.Is(err, process.ErrInvalidBulkAccountsRequest) {
+			status = http.StatusBadRequest
+		}
+		respondWithHistoryError(c, status, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"accounts": accounts}, "", data.ReturnCodeSuccess)
 }
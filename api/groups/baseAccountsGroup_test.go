@@ -1,6 +1,7 @@
 package groups_test
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"net/http"
@@ -12,6 +13,8 @@ import (
 	"github.com/ElrondNetwork/elrond-proxy-go/api/groups"
 	"github.com/ElrondNetwork/elrond-proxy-go/api/mock"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/process"
+	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -25,7 +28,8 @@ type GeneralResponse struct {
 }
 
 type accountResponseData struct {
-	Account data.Account `json:"account"`
+	Account   data.Account    `json:"account"`
+	BlockInfo *data.BlockInfo `json:"blockInfo"`
 }
 
 // accountResponse contains the account data and GeneralResponse fields
@@ -35,7 +39,8 @@ type accountResponse struct {
 }
 
 type balanceResponseData struct {
-	Balance string `json:"balance"`
+	Balance   string          `json:"balance"`
+	BlockInfo *data.BlockInfo `json:"blockInfo"`
 }
 
 // balanceResponse contains the balance and GeneralResponse fields
@@ -117,6 +122,44 @@ type getEsdtsWithRoleResponse struct {
 	Data getEsdtsWithRoleResponseData
 }
 
+type transactionsResponseData struct {
+	Transactions []data.DatabaseTransaction `json:"transactions"`
+	Cursor       string                     `json:"cursor"`
+}
+
+type transactionsResponse struct {
+	GeneralResponse
+	Data transactionsResponseData
+}
+
+type tokenTransfersResponseData struct {
+	Transfers []data.DatabaseTransaction `json:"transfers"`
+	Cursor    string                     `json:"cursor"`
+}
+
+type tokenTransfersResponse struct {
+	GeneralResponse
+	Data tokenTransfersResponseData
+}
+
+type eventsResponseData struct {
+	Events []data.DatabaseEvent `json:"events"`
+}
+
+type eventsResponse struct {
+	GeneralResponse
+	Data eventsResponseData
+}
+
+type bulkAccountsResponseData struct {
+	Accounts map[string]*data.BulkAccountResult `json:"accounts"`
+}
+
+type bulkAccountsResponse struct {
+	GeneralResponse
+	Data bulkAccountsResponseData
+}
+
 type nonceResponseData struct {
 	Nonce uint64 `json:"nonce"`
 }
@@ -207,6 +250,81 @@ func TestGetAccount_ReturnsSuccessfully(t *testing.T) {
 	assert.Empty(t, accountResponse.Error)
 }
 
+func TestGetAccount_AtBlockNonceReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetAccountHandler: func(address string) (*data.Account, error) {
+			assert.Fail(t, "should have read the state at the block")
+			return nil, nil
+		},
+		GetAccountAtBlockHandler: func(address string, options data.AccountQueryOptions) (*data.ResponseAccount, error) {
+			assert.Equal(t, data.AccountQueryOptions{BlockNonce: 42, HasBlockNonce: true}, options)
+			return &data.ResponseAccount{
+				AccountData: data.Account{Address: address, Balance: "37"},
+				BlockInfo:   &data.BlockInfo{Nonce: 42, Hash: "hash", RootHash: "root hash"},
+			}, nil
+		},
+	}
+	addressGroup, err := groups.NewAccountsGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(addressGroup, addressPath)
+
+	req, _ := http.NewRequest("GET", "/address/test?blockNonce=42", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	accountResponse := accountResponse{}
+	loadResponse(resp.Body, &accountResponse)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "37", accountResponse.Data.Account.Balance)
+	assert.Equal(t, &data.BlockInfo{Nonce: 42, Hash: "hash", RootHash: "root hash"}, accountResponse.Data.BlockInfo)
+}
+
+func TestGetAccount_AtBlockRejectedByTheNodeShouldReturnBadRequest(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetAccountAtBlockHandler: func(address string, options data.AccountQueryOptions) (*data.ResponseAccount, error) {
+			return nil, fmt.Errorf("%w: block not found", process.ErrInvalidAccountStateRequest)
+		},
+	}
+	addressGroup, err := groups.NewAccountsGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(addressGroup, addressPath)
+
+	req, _ := http.NewRequest("GET", "/address/test?blockHash=aabb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	accountResponse := accountResponse{}
+	loadResponse(resp.Body, &accountResponse)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(accountResponse.Error, "block not found"))
+}
+
+func TestGetAccount_FailWhenAccountQueryParamsAreInvalid(t *testing.T) {
+	t.Parallel()
+
+	addressGroup, err := groups.NewAccountsGroup(&mock.Facade{})
+	require.NoError(t, err)
+	ws := startProxyServer(addressGroup, addressPath)
+
+	for _, query := range []string{"blockNonce=abc", "blockNonce=1&rootHash=aa", "blockHash=bb&rootHash=aa"} {
+		req, _ := http.NewRequest("GET", "/address/test?"+query, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		accountResponse := accountResponse{}
+		loadResponse(resp.Body, &accountResponse)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(accountResponse.Error, apiErrors.ErrInvalidAccountQueryParams.Error()))
+	}
+}
+
 //------- GetBalance
 
 func TestGetBalance_ReturnsSuccessfully(t *testing.T) {
@@ -238,6 +356,34 @@ func TestGetBalance_ReturnsSuccessfully(t *testing.T) {
 	assert.Empty(t, balanceResponse.Error)
 }
 
+func TestGetBalance_AtRootHashReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetAccountAtBlockHandler: func(address string, options data.AccountQueryOptions) (*data.ResponseAccount, error) {
+			assert.Equal(t, data.AccountQueryOptions{RootHash: "aabbcc"}, options)
+			return &data.ResponseAccount{
+				AccountData: data.Account{Address: address, Balance: "37"},
+				BlockInfo:   &data.BlockInfo{RootHash: "aabbcc"},
+			}, nil
+		},
+	}
+	addressGroup, err := groups.NewAccountsGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(addressGroup, addressPath)
+
+	req, _ := http.NewRequest("GET", "/address/test/balance?rootHash=aabbcc", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	balanceResponse := balanceResponse{}
+	loadResponse(resp.Body, &balanceResponse)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "37", balanceResponse.Data.Balance)
+	assert.Equal(t, "aabbcc", balanceResponse.Data.BlockInfo.RootHash)
+}
+
 //------- GetUsername
 
 func TestGetUsername_ReturnsSuccessfully(t *testing.T) {
@@ -302,6 +448,210 @@ func TestGetNonce_ReturnsSuccessfully(t *testing.T) {
 	assert.Empty(t, nonceResponse.Error)
 }
 
+// ---- GetTransactions
+
+func TestGetTransactions_FailWhenParamsAreInvalid(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{}
+	addressGroup, err := groups.NewAccountsGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(addressGroup, addressPath)
+
+	for _, params := range []string{"size=ten", "from=-", "startTime=-1", "endTime=yesterday"} {
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/address/test/transactions?%s", params), nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := transactionsResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidTransactionsHistoryParams.Error()))
+	}
+}
+
+func TestGetTransactions_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	var providedOptions data.TransactionsHistoryOptions
+	facade := &mock.Facade{
+		GetTransactionsHandler: func(address string, options data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error) {
+			providedOptions = options
+
+			tx := data.DatabaseTransaction{Hash: "hash"}
+			tx.Timestamp = 1600000000
+			tx.SearchOrder = 3
+			return []data.DatabaseTransaction{tx}, nil
+		},
+	}
+	addressGroup, err := groups.NewAccountsGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(addressGroup, addressPath)
+
+	params := "size=10&cursor=1600000100-1&direction=in&status=success&startTime=5&endTime=1600000200" +
+		"&token=TKN-123456&counterparty=other&order=asc"
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/test/transactions?%s", params), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := transactionsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, data.TransactionsHistoryOptions{
+		Size:         10,
+		Cursor:       "1600000100-1",
+		Direction:    data.TransactionsDirectionIn,
+		Status:       "success",
+		StartTime:    5,
+		EndTime:      1600000200,
+		Token:        "TKN-123456",
+		Counterparty: "other",
+		Order:        data.SortOrderAscending,
+	}, providedOptions)
+	assert.Equal(t, "hash", response.Data.Transactions[0].Hash)
+	assert.Equal(t, "1600000000-3", response.Data.Cursor)
+}
+
+func TestGetTransactions_ScanLimitReachedShouldReturnTheScanCursor(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetTransactionsHandler: func(address string, options data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error) {
+			tx := data.DatabaseTransaction{Hash: "hash"}
+			tx.Timestamp = 1600000000
+			tx.SearchOrder = 3
+			return []data.DatabaseTransaction{tx}, &data.HistoryScanLimitError{Cursor: "1500000000-7"}
+		},
+	}
+	addressGroup, err := groups.NewAccountsGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(addressGroup, addressPath)
+
+	req, _ := http.NewRequest("GET", "/address/test/transactions?token=TKN-123456", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := transactionsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "hash", response.Data.Transactions[0].Hash)
+	assert.Equal(t, "1500000000-7", response.Data.Cursor)
+}
+
+// ---- GetTokenTransfers
+
+func TestGetTokenTransfers_FailWhenFacadeErrors(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("internal err")
+	facade := &mock.Facade{
+		GetTokenTransfersHandler: func(address string, options data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error) {
+			return nil, expectedErr
+		},
+	}
+	addressGroup, err := groups.NewAccountsGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(addressGroup, addressPath)
+
+	req, _ := http.NewRequest("GET", "/address/test/token-transfers", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := tokenTransfersResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, expectedErr.Error(), response.Error)
+}
+
+func TestGetTokenTransfers_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	var providedAddress string
+	var providedOptions data.TransactionsHistoryOptions
+	facade := &mock.Facade{
+		GetTokenTransfersHandler: func(address string, options data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error) {
+			providedAddress = address
+			providedOptions = options
+
+			tx := data.DatabaseTransaction{Hash: "hash"}
+			tx.Timestamp = 1600000000
+			tx.SearchOrder = 2
+			return []data.DatabaseTransaction{tx}, nil
+		},
+	}
+	addressGroup, err := groups.NewAccountsGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(addressGroup, addressPath)
+
+	req, _ := http.NewRequest("GET", "/address/test/token-transfers?token=TKN-123456&size=5", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := tokenTransfersResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "test", providedAddress)
+	assert.Equal(t, data.TransactionsHistoryOptions{Size: 5, Token: "TKN-123456"}, providedOptions)
+	assert.Equal(t, "hash", response.Data.Transfers[0].Hash)
+	assert.Equal(t, "1600000000-2", response.Data.Cursor)
+}
+
+// ---- GetEvents
+
+func TestGetEvents_FailWhenParamsAreInvalid(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{}
+	addressGroup, err := groups.NewAccountsGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(addressGroup, addressPath)
+
+	req, _ := http.NewRequest("GET", "/address/test/events?size=ten", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := eventsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrInvalidTransactionsHistoryParams.Error()))
+}
+
+func TestGetEvents_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	var providedIdentifier string
+	facade := &mock.Facade{
+		GetEventsHandler: func(address string, identifier string, options data.TransactionsHistoryOptions) ([]data.DatabaseEvent, error) {
+			providedIdentifier = identifier
+
+			event := data.DatabaseEvent{TxHash: "hash"}
+			event.Identifier = identifier
+			return []data.DatabaseEvent{event}, nil
+		},
+	}
+	addressGroup, err := groups.NewAccountsGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(addressGroup, addressPath)
+
+	req, _ := http.NewRequest("GET", "/address/test/events?identifier=transfer", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := eventsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "transfer", providedIdentifier)
+	assert.Equal(t, "hash", response.Data.Events[0].TxHash)
+	assert.Equal(t, "transfer", response.Data.Events[0].Identifier)
+}
+
 // ---- GetShard
 
 func TestGetShard_FailWhenFacadeErrors(t *testing.T) {
@@ -409,6 +759,31 @@ func TestGetESDTTokens_ReturnsSuccessfully(t *testing.T) {
 	assert.Empty(t, shardResponse.Error)
 }
 
+func TestGetESDTTokens_AtBlockHashReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	expectedTokens := []string{"abc"}
+	facade := &mock.Facade{
+		GetAllESDTTokensAtBlockHandler: func(_ string, options data.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+			assert.Equal(t, data.AccountQueryOptions{BlockHash: "aabb"}, options)
+			return &data.GenericAPIResponse{Data: getEsdtTokensResponseData{Tokens: expectedTokens}}, nil
+		},
+	}
+	addressGroup, err := groups.NewAccountsGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(addressGroup, addressPath)
+
+	req, _ := http.NewRequest("GET", "/address/test/esdt?blockHash=aabb", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	tokensResponse := getEsdtTokensResponse{}
+	loadResponse(resp.Body, &tokensResponse)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedTokens, tokensResponse.Data.Tokens)
+}
+
 // ---- GetESDTTokenData
 
 func TestGetESDTTokenData_FailWhenFacadeErrors(t *testing.T) {
@@ -709,3 +1084,111 @@ func TestGetKeyValuePairs_ReturnsSuccessfully(t *testing.T) {
 	assert.Equal(t, expectedResponse, actualResponse)
 	assert.Empty(t, actualResponse.Error)
 }
+
+// ---- GetBulkAccounts
+
+func TestGetBulkAccounts_FailWhenBodyIsInvalid(t *testing.T) {
+	t.Parallel()
+
+	addressGroup, err := groups.NewAccountsGroup(&mock.Facade{})
+	require.NoError(t, err)
+	ws := startProxyServer(addressGroup, addressPath)
+
+	req, _ := http.NewRequest(http.MethodPost, "/address/bulk", bytes.NewBufferString("not a json"))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := bulkAccountsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+}
+
+func TestGetBulkAccounts_ShouldApplyTheMaxBatchSizeFromConfig(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetBulkAccountsHandler: func(addresses []string, fields []string) (map[string]*data.BulkAccountResult, error) {
+			return make(map[string]*data.BulkAccountResult), nil
+		},
+	}
+	addressGroup, err := groups.NewAccountsGroup(facade)
+	require.NoError(t, err)
+	ws := gin.New()
+	apiConfig := data.ApiRoutesConfig{
+		APIPackages: map[string]data.APIPackageConfig{
+			"address": {Routes: []data.RouteConfig{{Name: "/bulk", Open: true, MaxBatchSize: 2}}},
+		},
+	}
+	addressGroup.RegisterRoutes(ws.Group(addressPath), apiConfig, func(_ *gin.Context) {}, func(_ *gin.Context) {})
+
+	req, _ := http.NewRequest(http.MethodPost, "/address/bulk", bytes.NewBufferString(`{"addresses":["alice","bob","carol"]}`))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := bulkAccountsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, "maximum is 2"))
+
+	req, _ = http.NewRequest(http.MethodPost, "/address/bulk", bytes.NewBufferString(`{"addresses":["alice","bob"]}`))
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestGetBulkAccounts_FailWhenRequestIsInvalid(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetBulkAccountsHandler: func(addresses []string, fields []string) (map[string]*data.BulkAccountResult, error) {
+			return nil, fmt.Errorf("%w: unknown field code", process.ErrInvalidBulkAccountsRequest)
+		},
+	}
+	addressGroup, err := groups.NewAccountsGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(addressGroup, addressPath)
+
+	req, _ := http.NewRequest(http.MethodPost, "/address/bulk", bytes.NewBufferString(`{"addresses":["alice"],"fields":["code"]}`))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := bulkAccountsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, process.ErrInvalidBulkAccountsRequest.Error()))
+}
+
+func TestGetBulkAccounts_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	balance := "37"
+	facade := &mock.Facade{
+		GetBulkAccountsHandler: func(addresses []string, fields []string) (map[string]*data.BulkAccountResult, error) {
+			assert.Equal(t, []string{"alice", "bob"}, addresses)
+			assert.Equal(t, []string{data.BulkAccountFieldBalance}, fields)
+			return map[string]*data.BulkAccountResult{
+				"alice": {Balance: &balance},
+				"bob":   {Error: "sending request error"},
+			}, nil
+		},
+	}
+	addressGroup, err := groups.NewAccountsGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(addressGroup, addressPath)
+
+	req, _ := http.NewRequest(http.MethodPost, "/address/bulk", bytes.NewBufferString(`{"addresses":["alice","bob"],"fields":["balance"]}`))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := bulkAccountsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "37", *response.Data.Accounts["alice"].Balance)
+	assert.Nil(t, response.Data.Accounts["alice"].Nonce)
+	assert.Equal(t, "sending request error", response.Data.Accounts["bob"].Error)
+}
@@ -26,8 +26,8 @@ func NewActionsGroup(facadeHandler data.FacadeHandler) (*actionsGroup, error) {
 	}
 
 	baseRoutesHandlers := []*data.EndpointHandlerData{
-		{Path: "/reload-observers", Handler: ng.updateObservers, Method: http.MethodPost},
-		{Path: "/reload-full-history-observers", Handler: ng.updateFullHistoryObservers, Method: http.MethodPost},
+		{Path: "/reload-observers", Handler: ng.updateObservers, Method: http.MethodPost, Response: apiResponse("")},
+		{Path: "/reload-full-history-observers", Handler: ng.updateFullHistoryObservers, Method: http.MethodPost, Response: apiResponse("")},
 	}
 	ng.baseGroup.endpoints = baseRoutesHandlers
 
@@ -27,7 +27,7 @@ func NewBlockAtlasGroup(facadeHandler data.FacadeHandler) (*blockAtlasGroup, err
 	}
 
 	baseRoutesHandlers := []*data.EndpointHandlerData{
-		{Path: "/:shard/:nonce", Handler: bag.getBlockByShardIDAndNonceFromElastic, Method: http.MethodGet},
+		{Path: "/:shard/:nonce", Handler: bag.getBlockByShardIDAndNonceFromElastic, Method: http.MethodGet, Response: apiResponse(gin.H{"block": data.AtlasBlock{}})},
 	}
 	bag.baseGroup.endpoints = baseRoutesHandlers
 
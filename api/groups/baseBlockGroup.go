@@ -13,7 +13,8 @@ import (
 )
 
 type blockGroup struct {
-	facade BlocksFacadeHandler
+	facade  BlocksFacadeHandler
+	decoder TransactionDecoderFacadeHandler
 	*baseGroup
 }
 
@@ -23,15 +24,20 @@ func NewBlockGroup(facadeHandler data.FacadeHandler) (*blockGroup, error) {
 	if !ok {
 		return nil, ErrWrongTypeAssertion
 	}
+	decoder, ok := facadeHandler.(TransactionDecoderFacadeHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
 
 	bg := &blockGroup{
 		facade:    facade,
+		decoder:   decoder,
 		baseGroup: &baseGroup{},
 	}
 
 	baseRoutesHandlers := []*data.EndpointHandlerData{
-		{Path: "/:shard/by-nonce/:nonce", Handler: bg.byNonceHandler, Method: http.MethodGet},
-		{Path: "/:shard/by-hash/:hash", Handler: bg.byHashHandler, Method: http.MethodGet},
+		{Path: "/:shard/by-nonce/:nonce", Handler: bg.byNonceHandler, Method: http.MethodGet, Response: data.BlockApiResponse{}, QueryParameters: joinQueryParameters(withTxsQueryParameter, decodeQueryParameter)},
+		{Path: "/:shard/by-hash/:hash", Handler: bg.byHashHandler, Method: http.MethodGet, Response: data.BlockApiResponse{}, QueryParameters: joinQueryParameters(withTxsQueryParameter, decodeQueryParameter)},
 	}
 	bg.baseGroup.endpoints = baseRoutesHandlers
 
@@ -77,11 +83,26 @@ func (group *blockGroup) byHashHandler(c *gin.Context) {
 		return
 	}
 
+	decode, err := getQueryParamDecode(c)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			apiErrors.ErrValidationQueryParameterDecode.Error(),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
 	blockByHashResponse, err := group.facade.GetBlockByHash(shardID, hash, withTxs)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
+	if decode {
+		decodeBlockTransactions(group.decoder, &blockByHashResponse.Data.Block)
+	}
 
 	c.JSON(http.StatusOK, blockByHashResponse)
 }
@@ -124,15 +145,49 @@ func (group *blockGroup) byNonceHandler(c *gin.Context) {
 		return
 	}
 
+	decode, err := getQueryParamDecode(c)
+	if err != nil {
+		shared.RespondWith(
+			c,
+			http.StatusBadRequest,
+			nil,
+			apiErrors.ErrValidationQueryParameterDecode.Error(),
+			data.ReturnCodeRequestError,
+		)
+		return
+	}
+
 	blockByNonceResponse, err := group.facade.GetBlockByNonce(shardID, nonce, withTxs)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
+	if decode {
+		decodeBlockTransactions(group.decoder, &blockByNonceResponse.Data.Block)
+	}
 
 	c.JSON(http.StatusOK, blockByNonceResponse)
 }
 
+// decodeBlockTransactions decodes the contract calls of the transactions from the miniblocks of the block
+func decodeBlockTransactions(decoder TransactionDecoderFacadeHandler, block *data.Block) {
+	for _, miniBlock := range block.MiniBlocks {
+		decodeTransactions(decoder, miniBlock.Transactions)
+	}
+}
+
+// decodeTransactions decodes the contract calls of the transactions, along with the values returned by the contracts
+func decodeTransactions(decoder TransactionDecoderFacadeHandler, txs []*data.FullTransaction) {
+	for _, tx := range txs {
+		decoder.DecodeTransaction(tx)
+	}
+}
+
+// withTxsQueryParameter describes the query parameter requesting the transactions of a block
+var withTxsQueryParameter = []data.QueryParameter{
+	{Name: "withTxs", Type: "boolean", Description: "if true, the transactions of the block are returned as well"},
+}
+
 func getQueryParamWithTxs(c *gin.Context) (bool, error) {
 	withTxsStr := c.Request.URL.Query().Get("withTxs")
 	if withTxsStr == "" {
@@ -143,6 +143,60 @@ func TestGetBlockByNonce_ReturnsSuccessfully(t *testing.T) {
 	assert.Empty(t, apiResp.Error)
 }
 
+func TestGetBlockByNonce_FailWhenDecodeParamIsInvalid(t *testing.T) {
+	t.Parallel()
+
+	blockGroup, err := groups.NewBlockGroup(&mock.Facade{})
+	require.NoError(t, err)
+
+	ws := startProxyServer(blockGroup, blockPath)
+
+	req, _ := http.NewRequest("GET", "/block/0/by-nonce/1?decode=not-a-bool", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	apiResp := data.GenericAPIResponse{}
+	loadResponse(resp.Body, &apiResp)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Equal(t, apiErrors.ErrValidationQueryParameterDecode.Error(), apiResp.Error)
+}
+
+func TestGetBlockByNonce_ShouldDecodeTheTransactions(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetBlockByNonceCalled: func(_ uint32, _ uint64, _ bool) (*data.BlockApiResponse, error) {
+			return &data.BlockApiResponse{
+				Data: data.BlockApiResponsePayload{Block: data.Block{MiniBlocks: []*data.MiniBlock{
+					{Transactions: []*data.FullTransaction{{Hash: "tx0"}, {Hash: "tx1"}}},
+					{Transactions: []*data.FullTransaction{{Hash: "tx2"}}},
+				}}},
+			}, nil
+		},
+		DecodeTransactionHandler: func(tx *data.FullTransaction) {
+			tx.DecodedData = &data.DecodedCall{Function: "call-" + tx.Hash}
+		},
+	}
+
+	blockGroup, err := groups.NewBlockGroup(facade)
+	require.NoError(t, err)
+
+	ws := startProxyServer(blockGroup, blockPath)
+
+	req, _ := http.NewRequest("GET", "/block/0/by-nonce/1?withTxs=true&decode=true", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	apiResp := data.BlockApiResponse{}
+	loadResponse(resp.Body, &apiResp)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "call-tx0", apiResp.Data.Block.MiniBlocks[0].Transactions[0].DecodedData.Function)
+	assert.Equal(t, "call-tx1", apiResp.Data.Block.MiniBlocks[0].Transactions[1].DecodedData.Function)
+	assert.Equal(t, "call-tx2", apiResp.Data.Block.MiniBlocks[1].Transactions[0].DecodedData.Function)
+}
+
 func TestGetBlockByHash_FailWhenShardParamIsInvalid(t *testing.T) {
 	t.Parallel()
 
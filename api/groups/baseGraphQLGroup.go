@@ -0,0 +1,69 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+
+	apiErrors "github.com/ElrondNetwork/elrond-proxy-go/api/errors"
+	"github.com/ElrondNetwork/elrond-proxy-go/api/graphql"
+	"github.com/ElrondNetwork/elrond-proxy-go/api/shared"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/gin-gonic/gin"
+	gql "github.com/graphql-go/graphql"
+)
+
+const (
+	maxGraphQLComplexity      = 5000
+	maxGraphQLDepth           = 10
+	maxGraphQLConcurrentLoads = 10
+)
+
+type graphQLGroup struct {
+	executor GraphQLExecutor
+	*baseGroup
+}
+
+// NewGraphQLGroup returns a new instance of graphQLGroup. It exposes accounts, transactions, blocks and hyperblocks
+// through a GraphQL schema
+func NewGraphQLGroup(facadeHandler data.FacadeHandler) (*graphQLGroup, error) {
+	facade, ok := facadeHandler.(GraphQLFacadeHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	executor, err := graphql.NewGraphQLHandler(facade, maxGraphQLComplexity, maxGraphQLDepth, maxGraphQLConcurrentLoads)
+	if err != nil {
+		return nil, err
+	}
+
+	gg := &graphQLGroup{
+		executor:  executor,
+		baseGroup: &baseGroup{},
+	}
+
+	baseRoutesHandlers := []*data.EndpointHandlerData{
+		{Path: "", Handler: gg.executeQuery, Method: http.MethodPost, Request: graphql.Request{}, Response: gql.Result{}},
+	}
+	gg.baseGroup.endpoints = baseRoutesHandlers
+
+	return gg, nil
+}
+
+// executeQuery executes a GraphQL query. Rejected queries are answered with a bad request status, while the field
+// errors occurred during execution are returned, as GraphQL mandates, next to the partial data
+func (group *graphQLGroup) executeQuery(c *gin.Context) {
+	request := &graphql.Request{}
+	err := c.ShouldBindJSON(request)
+	if err != nil {
+		shared.RespondWithBadRequest(c, fmt.Sprintf("%s: %s", apiErrors.ErrInvalidJSONRequest.Error(), err.Error()))
+		return
+	}
+
+	result, isExecuted := group.executor.Execute(c.Request.Context(), request)
+	if !isExecuted {
+		c.JSON(http.StatusBadRequest, result)
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,84 @@
+package groups_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/api/groups"
+	"github.com/ElrondNetwork/elrond-proxy-go/api/mock"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const graphQLPath = "/graphql"
+
+type graphQLTestResponse struct {
+	Data   map[string]interface{}   `json:"data"`
+	Errors []map[string]interface{} `json:"errors"`
+}
+
+func doGraphQLRequest(t *testing.T, facade interface{}, body string) (int, *graphQLTestResponse) {
+	graphQLGroup, err := groups.NewGraphQLGroup(facade)
+	require.NoError(t, err)
+
+	ws := startProxyServer(graphQLGroup, graphQLPath)
+	req, _ := http.NewRequest(http.MethodPost, graphQLPath, bytes.NewBufferString(body))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &graphQLTestResponse{}
+	_ = json.Unmarshal(resp.Body.Bytes(), response)
+
+	return resp.Code, response
+}
+
+func TestNewGraphQLGroup_WrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	wrongFacade := &mock.WrongFacade{}
+	group, err := groups.NewGraphQLGroup(wrongFacade)
+	require.Nil(t, group)
+	require.Equal(t, groups.ErrWrongTypeAssertion, err)
+}
+
+func TestGraphQLGroup_InvalidJSONShouldReturnBadRequest(t *testing.T) {
+	t.Parallel()
+
+	code, _ := doGraphQLRequest(t, &mock.Facade{}, `{"query":`)
+	assert.Equal(t, http.StatusBadRequest, code)
+}
+
+func TestGraphQLGroup_InvalidQueryShouldReturnBadRequest(t *testing.T) {
+	t.Parallel()
+
+	code, response := doGraphQLRequest(t, &mock.Facade{}, `{"query":"{ account { address } }"}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+	assert.NotEmpty(t, response.Errors)
+}
+
+func TestGraphQLGroup_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetTransactionHandler: func(txHash string, withResults bool) (*data.FullTransaction, error) {
+			return &data.FullTransaction{Hash: txHash, Sender: "erd1a", Nonce: 5}, nil
+		},
+		GetAccountHandler: func(address string) (*data.Account, error) {
+			return &data.Account{Address: address, Balance: "10"}, nil
+		},
+	}
+
+	body := `{"query":"query tx($hash: String!) { transaction(hash: $hash) { hash nonce senderAccount { balance } } }","variables":{"hash":"aabb"}}`
+	code, response := doGraphQLRequest(t, facade, body)
+	require.Equal(t, http.StatusOK, code)
+	require.Empty(t, response.Errors)
+
+	tx := response.Data["transaction"].(map[string]interface{})
+	assert.Equal(t, "aabb", tx["hash"])
+	assert.Equal(t, float64(5), tx["nonce"])
+	assert.Equal(t, "10", tx["senderAccount"].(map[string]interface{})["balance"])
+}
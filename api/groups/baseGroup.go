@@ -11,6 +11,9 @@ import (
 
 var log = logger.GetOrCreate("api/groups")
 
+// maxBatchSizeContextKey is the key under which a route's batch size limit from the API config is passed to its handler
+const maxBatchSizeContextKey = "maxBatchSize"
+
 type baseGroup struct {
 	endpoints []*data.EndpointHandlerData
 	sync.RWMutex
@@ -21,6 +24,7 @@ type endpointProperties struct {
 	isSecured        bool
 	isFoundInConfig  bool
 	rateLimiterPerIP uint64
+	maxBatchSize     uint64
 }
 
 // AddEndpoint will add the handler data for the given path inside the map
@@ -78,6 +82,19 @@ func (bg *baseGroup) RemoveEndpoint(path string) error {
 	return nil
 }
 
+// GetEndpoints returns a copy of the endpoints handler data registered in the group
+func (bg *baseGroup) GetEndpoints() []data.EndpointHandlerData {
+	bg.RLock()
+	defer bg.RUnlock()
+
+	endpoints := make([]data.EndpointHandlerData, 0, len(bg.endpoints))
+	for _, handlerData := range bg.endpoints {
+		endpoints = append(endpoints, *handlerData)
+	}
+
+	return endpoints
+}
+
 // RegisterRoutes will register all the endpoints to the given web server
 func (bg *baseGroup) RegisterRoutes(
 	ws *gin.RouterGroup,
@@ -110,6 +127,10 @@ func (bg *baseGroup) RegisterRoutes(
 			middlewares = append(middlewares, rateLimiter)
 		}
 
+		if properties.maxBatchSize > 0 {
+			middlewares = append(middlewares, batchSizeLimitSetter(properties.maxBatchSize))
+		}
+
 		middlewares = append(middlewares, handlerData.Handler)
 
 		ws.Handle(handlerData.Method, handlerData.Path, middlewares...)
@@ -117,35 +138,70 @@ func (bg *baseGroup) RegisterRoutes(
 }
 
 func getEndpointProperties(ws *gin.RouterGroup, path string, apiConfig data.ApiRoutesConfig) endpointProperties {
-	basePath := ws.BasePath()
-
-	// ws.BasePath will return paths like /group or /v1.0/group so we need the last token after splitting by /
-	splitPath := strings.Split(basePath, "/")
-	basePath = splitPath[len(splitPath)-1]
+	basePath := getGroupName(ws)
 
-	group, ok := apiConfig.APIPackages[basePath]
+	route, ok := findRouteConfig(apiConfig, basePath, path)
 	if !ok {
 		return endpointProperties{
 			isFoundInConfig: false,
 		}
 	}
 
+	return endpointProperties{
+		isOpen:           route.Open,
+		isSecured:        route.Secured,
+		isFoundInConfig:  true,
+		rateLimiterPerIP: route.RateLimit,
+		maxBatchSize:     route.MaxBatchSize,
+	}
+}
+
+// getGroupName returns the name of the group's package in the API config. ws.BasePath will return paths like /group or
+// /v1.0/group so the last token after splitting by / is needed
+func getGroupName(ws *gin.RouterGroup) string {
+	splitPath := strings.Split(ws.BasePath(), "/")
+
+	return splitPath[len(splitPath)-1]
+}
+
+// findRouteConfig returns the config of the given route of a package, if any
+func findRouteConfig(apiConfig data.ApiRoutesConfig, packageName string, routeName string) (data.RouteConfig, bool) {
+	group, ok := apiConfig.APIPackages[packageName]
+	if !ok {
+		return data.RouteConfig{}, false
+	}
+
 	for _, route := range group.Routes {
-		if route.Name == path {
-			return endpointProperties{
-				isOpen:           route.Open,
-				isSecured:        route.Secured,
-				isFoundInConfig:  true,
-				rateLimiterPerIP: route.RateLimit,
-			}
+		if route.Name == routeName {
+			return route, true
 		}
 	}
 
-	return endpointProperties{
-		isFoundInConfig: false,
+	return data.RouteConfig{}, false
+}
+
+// batchSizeLimitSetter makes the batch size limit configured for a route available to its handler
+func batchSizeLimitSetter(maxBatchSize uint64) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(maxBatchSizeContextKey, maxBatchSize)
 	}
 }
 
+// getMaxBatchSize returns the batch size limit configured for the current route, or the given default one
+func getMaxBatchSize(c *gin.Context, defaultMaxBatchSize uint64) uint64 {
+	maxBatchSize, ok := c.Value(maxBatchSizeContextKey).(uint64)
+	if !ok || maxBatchSize == 0 {
+		return defaultMaxBatchSize
+	}
+
+	return maxBatchSize
+}
+
+// apiResponse returns a sample of a generic API response holding the given data, used for describing the endpoints
+func apiResponse(responseData interface{}) data.GenericAPIResponse {
+	return data.GenericAPIResponse{Data: responseData}
+}
+
 func (bg *baseGroup) isEndpointRegistered(endpoint string) bool {
 	bg.RLock()
 	defer bg.RUnlock()
@@ -163,3 +219,13 @@ func (bg *baseGroup) isEndpointRegistered(endpoint string) bool {
 func (bg *baseGroup) IsInterfaceNil() bool {
 	return bg == nil
 }
+
+// joinQueryParameters returns the query parameters of all the given lists
+func joinQueryParameters(queryParametersLists ...[]data.QueryParameter) []data.QueryParameter {
+	queryParameters := make([]data.QueryParameter, 0)
+	for _, list := range queryParametersLists {
+		queryParameters = append(queryParameters, list...)
+	}
+
+	return queryParameters
+}
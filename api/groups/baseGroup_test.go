@@ -73,4 +73,10 @@ func TestCrudOperationsBaseGroup(t *testing.T) {
 	assert.Equal(t, hd3.Path, bg.endpoints[0].Path)
 	assert.Equal(t, hd1.Path, bg.endpoints[1].Path)
 	assert.Equal(t, hd4.Path, bg.endpoints[2].Path)
+
+	endpoints := bg.GetEndpoints()
+	assert.Equal(t, 3, len(endpoints))
+	assert.Equal(t, hd3.Path, endpoints[0].Path)
+	assert.Equal(t, hd1.Path, endpoints[1].Path)
+	assert.Equal(t, hd4.Path, endpoints[2].Path)
 }
@@ -11,7 +11,8 @@ import (
 )
 
 type hyperBlockGroup struct {
-	facade HyperBlockFacadeHandler
+	facade  HyperBlockFacadeHandler
+	decoder TransactionDecoderFacadeHandler
 	*baseGroup
 }
 
@@ -21,15 +22,20 @@ func NewHyperBlockGroup(facadeHandler data.FacadeHandler) (*hyperBlockGroup, err
 	if !ok {
 		return nil, ErrWrongTypeAssertion
 	}
+	decoder, ok := facadeHandler.(TransactionDecoderFacadeHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
 
 	hbg := &hyperBlockGroup{
 		facade:    facade,
+		decoder:   decoder,
 		baseGroup: &baseGroup{},
 	}
 
 	baseRoutesHandlers := []*data.EndpointHandlerData{
-		{Path: "/by-hash/:hash", Handler: hbg.hyperBlockByHashHandler, Method: http.MethodGet},
-		{Path: "/by-nonce/:nonce", Handler: hbg.hyperBlockByNonceHandler, Method: http.MethodGet},
+		{Path: "/by-hash/:hash", Handler: hbg.hyperBlockByHashHandler, Method: http.MethodGet, Response: data.HyperblockApiResponse{}, QueryParameters: decodeQueryParameter},
+		{Path: "/by-nonce/:nonce", Handler: hbg.hyperBlockByNonceHandler, Method: http.MethodGet, Response: data.HyperblockApiResponse{}, QueryParameters: decodeQueryParameter},
 	}
 	hbg.baseGroup.endpoints = baseRoutesHandlers
 
@@ -45,11 +51,20 @@ func (group *hyperBlockGroup) hyperBlockByHashHandler(c *gin.Context) {
 		return
 	}
 
+	decode, err := getQueryParamDecode(c)
+	if err != nil {
+		shared.RespondWithBadRequest(c, apiErrors.ErrValidationQueryParameterDecode.Error())
+		return
+	}
+
 	blockByHashResponse, err := group.facade.GetHyperBlockByHash(hash)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
+	if decode {
+		decodeTransactions(group.decoder, blockByHashResponse.Data.Hyperblock.Transactions)
+	}
 
 	c.JSON(http.StatusOK, blockByHashResponse)
 }
@@ -62,11 +77,20 @@ func (group *hyperBlockGroup) hyperBlockByNonceHandler(c *gin.Context) {
 		return
 	}
 
+	decode, err := getQueryParamDecode(c)
+	if err != nil {
+		shared.RespondWithBadRequest(c, apiErrors.ErrValidationQueryParameterDecode.Error())
+		return
+	}
+
 	blockByNonceResponse, err := group.facade.GetHyperBlockByNonce(nonce)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
+	if decode {
+		decodeTransactions(group.decoder, blockByNonceResponse.Data.Hyperblock.Transactions)
+	}
 
 	c.JSON(http.StatusOK, blockByNonceResponse)
 }
@@ -93,6 +93,35 @@ func TestGetHyperblockByNonce(t *testing.T) {
 	require.Equal(t, "invalid block hash parameter", response.Error)
 }
 
+func TestGetHyperblockByNonce_ShouldDecodeTheTransactions(t *testing.T) {
+	decoded := make([]string, 0)
+	facade := &mock.Facade{
+		GetHyperBlockByNonceCalled: func(nonce uint64) (*data.HyperblockApiResponse, error) {
+			return data.NewHyperblockApiResponse(data.Hyperblock{
+				Nonce:        nonce,
+				Transactions: []*data.FullTransaction{{Hash: "tx0"}, {Hash: "tx1"}},
+			}), nil
+		},
+		DecodeTransactionHandler: func(tx *data.FullTransaction) {
+			decoded = append(decoded, tx.Hash)
+		},
+	}
+
+	response := data.HyperblockApiResponse{}
+	statusCode := doGet(t, facade, "/hyperblock/by-nonce/42", &response)
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Empty(t, decoded)
+
+	statusCode = doGet(t, facade, "/hyperblock/by-nonce/42?decode=true", &response)
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Equal(t, []string{"tx0", "tx1"}, decoded)
+
+	response = data.HyperblockApiResponse{}
+	statusCode = doGet(t, facade, "/hyperblock/by-nonce/42?decode=not-a-bool", &response)
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Equal(t, "invalid query parameter decode", response.Error)
+}
+
 func doGet(t *testing.T, facade interface{}, url string, response interface{}) int {
 	hyperBlockGroup, err := groups.NewHyperBlockGroup(facade)
 	require.NoError(t, err)
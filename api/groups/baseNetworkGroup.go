@@ -1,8 +1,10 @@
 package groups
 
 import (
+	"fmt"
 	"net/http"
 
+	"github.com/ElrondNetwork/elrond-proxy-go/api/errors"
 	"github.com/ElrondNetwork/elrond-proxy-go/api/shared"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 	"github.com/ElrondNetwork/elrond-proxy-go/process"
@@ -10,7 +12,8 @@ import (
 )
 
 type networkGroup struct {
-	facade NetworkFacadeHandler
+	facade               NetworkFacadeHandler
+	tokenTransfersFacade TokenTransfersFacadeHandler
 	*baseGroup
 }
 
@@ -20,23 +23,29 @@ func NewNetworkGroup(facadeHandler data.FacadeHandler) (*networkGroup, error) {
 	if !ok {
 		return nil, ErrWrongTypeAssertion
 	}
+	tokenTransfersFacade, ok := facadeHandler.(TokenTransfersFacadeHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
 
 	ng := &networkGroup{
-		facade:    facade,
-		baseGroup: &baseGroup{},
+		facade:               facade,
+		tokenTransfersFacade: tokenTransfersFacade,
+		baseGroup:            &baseGroup{},
 	}
 
 	baseRoutesHandlers := []*data.EndpointHandlerData{
-		{Path: "/status/:shard", Handler: ng.getNetworkStatusData, Method: http.MethodGet},
-		{Path: "/config", Handler: ng.getNetworkConfigData, Method: http.MethodGet},
-		{Path: "/economics", Handler: ng.getEconomicsData, Method: http.MethodGet},
-		{Path: "/esdts", Handler: ng.getEsdts, Method: http.MethodGet},
-		{Path: "/esdt/fungible-tokens", Handler: ng.getEsdtHandlerFunc(data.FungibleTokens), Method: http.MethodGet},
-		{Path: "/esdt/semi-fungible-tokens", Handler: ng.getEsdtHandlerFunc(data.SemiFungibleTokens), Method: http.MethodGet},
-		{Path: "/esdt/non-fungible-tokens", Handler: ng.getEsdtHandlerFunc(data.NonFungibleTokens), Method: http.MethodGet},
-		{Path: "/enable-epochs", Handler: ng.getEnableEpochs, Method: http.MethodGet},
-		{Path: "/direct-staked-info", Handler: ng.getDirectStakedInfo, Method: http.MethodGet},
-		{Path: "/delegated-info", Handler: ng.getDelegatedInfo, Method: http.MethodGet},
+		{Path: "/status/:shard", Handler: ng.getNetworkStatusData, Method: http.MethodGet, Response: apiResponse(gin.H{"status": map[string]interface{}{}})},
+		{Path: "/config", Handler: ng.getNetworkConfigData, Method: http.MethodGet, Response: apiResponse(gin.H{"config": map[string]interface{}{}})},
+		{Path: "/economics", Handler: ng.getEconomicsData, Method: http.MethodGet, Response: apiResponse(gin.H{"metrics": map[string]interface{}{}})},
+		{Path: "/esdts", Handler: ng.getEsdts, Method: http.MethodGet, Response: apiResponse(gin.H{"tokens": []string{}})},
+		{Path: "/esdt/fungible-tokens", Handler: ng.getEsdtHandlerFunc(data.FungibleTokens), Method: http.MethodGet, Response: apiResponse(gin.H{"tokens": []string{}})},
+		{Path: "/esdt/semi-fungible-tokens", Handler: ng.getEsdtHandlerFunc(data.SemiFungibleTokens), Method: http.MethodGet, Response: apiResponse(gin.H{"tokens": []string{}})},
+		{Path: "/esdt/non-fungible-tokens", Handler: ng.getEsdtHandlerFunc(data.NonFungibleTokens), Method: http.MethodGet, Response: apiResponse(gin.H{"tokens": []string{}})},
+		{Path: "/token-transfers/:tokenIdentifier", Handler: ng.getTokenTransfers, Method: http.MethodGet, Response: apiResponse(gin.H{"transfers": []data.DatabaseTransaction{}, "cursor": ""}), QueryParameters: joinQueryParameters(historyPagingQueryParameters, historyFiltersQueryParameters)},
+		{Path: "/enable-epochs", Handler: ng.getEnableEpochs, Method: http.MethodGet, Response: apiResponse(gin.H{"enableEpochs": map[string]interface{}{}})},
+		{Path: "/direct-staked-info", Handler: ng.getDirectStakedInfo, Method: http.MethodGet, Response: apiResponse(gin.H{"list": []interface{}{}})},
+		{Path: "/delegated-info", Handler: ng.getDelegatedInfo, Method: http.MethodGet, Response: apiResponse(gin.H{"list": []interface{}{}})},
 	}
 	ng.baseGroup.endpoints = baseRoutesHandlers
 
@@ -127,6 +136,24 @@ func (group *networkGroup) getEsdts(c *gin.Context) {
 	c.JSON(http.StatusOK, allIssuedESDTs)
 }
 
+// getTokenTransfers will expose the ESDT and NFT transfers of a token
+func (group *networkGroup) getTokenTransfers(c *gin.Context) {
+	options, err := getTransactionsHistoryOptions(c)
+	if err != nil {
+		respondWithHistoryError(c, http.StatusBadRequest, fmt.Errorf("%w: %v", errors.ErrInvalidTransactionsHistoryParams, err))
+		return
+	}
+	options.Token = c.Param("tokenIdentifier")
+
+	transfers, err := group.tokenTransfersFacade.GetTokenTransfers("", options)
+	if err != nil && !isHistoryScanLimitError(err) {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"transfers": transfers, "cursor": getHistoryCursor(transfers, err)}, "", data.ReturnCodeSuccess)
+}
+
 func (group *networkGroup) getEnableEpochs(c *gin.Context) {
 	enableEpochsMetrics, err := group.facade.GetEnableEpochsMetrics()
 	if err != nil {
@@ -451,3 +451,41 @@ func TestGetEnableEpochsMetrics_OkRequestShouldWork(t *testing.T) {
 	assert.True(t, ok)
 	assert.Equal(t, value, res)
 }
+
+func TestGetTokenTransfers_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	var providedAddress string
+	var providedOptions data.TransactionsHistoryOptions
+	facade := &mock.Facade{
+		GetTokenTransfersHandler: func(address string, options data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error) {
+			providedAddress = address
+			providedOptions = options
+			return []data.DatabaseTransaction{{Hash: "hash"}}, nil
+		},
+	}
+	networkGroup, err := groups.NewNetworkGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(networkGroup, networkPath)
+
+	req, _ := http.NewRequest("GET", "/network/token-transfers/TKN-123456?order=asc", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	assert.Equal(t, "", providedAddress)
+	assert.Equal(t, data.TransactionsHistoryOptions{Token: "TKN-123456", Order: data.SortOrderAscending}, providedOptions)
+}
+
+func TestGetTokenTransfers_InvalidParamsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	networkGroup, err := groups.NewNetworkGroup(&mock.Facade{})
+	require.NoError(t, err)
+	ws := startProxyServer(networkGroup, networkPath)
+
+	req, _ := http.NewRequest("GET", "/network/token-transfers/TKN-123456?from=first", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
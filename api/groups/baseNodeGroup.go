@@ -26,7 +26,7 @@ func NewNodeGroup(facadeHandler data.FacadeHandler) (*nodeGroup, error) {
 	}
 
 	baseRoutesHandlers := []*data.EndpointHandlerData{
-		{Path: "/heartbeatstatus", Handler: ng.getHeartbeatData, Method: http.MethodGet},
+		{Path: "/heartbeatstatus", Handler: ng.getHeartbeatData, Method: http.MethodGet, Response: apiResponse(gin.H{"heartbeats": []data.PubKeyHeartbeat{}})},
 	}
 	ng.baseGroup.endpoints = baseRoutesHandlers
 
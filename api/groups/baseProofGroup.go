@@ -27,9 +27,9 @@ func NewProofGroup(facadeHandler data.FacadeHandler) (*proofGroup, error) {
 	}
 
 	baseRoutesHandlers := []*data.EndpointHandlerData{
-		{Path: "/root-hash/:roothash/address/:address", Handler: pg.getProof, Method: http.MethodGet},
-		{Path: "/address/:address", Handler: pg.getProofCurrentRootHash, Method: http.MethodGet},
-		{Path: "/verify", Handler: pg.verifyProof, Method: http.MethodPost},
+		{Path: "/root-hash/:roothash/address/:address", Handler: pg.getProof, Method: http.MethodGet, Response: apiResponse(gin.H{"proof": []string{}})},
+		{Path: "/address/:address", Handler: pg.getProofCurrentRootHash, Method: http.MethodGet, Response: apiResponse(gin.H{"proof": []string{}, "rootHash": ""})},
+		{Path: "/verify", Handler: pg.verifyProof, Method: http.MethodPost, Request: data.VerifyProofRequest{}, Response: apiResponse(gin.H{"ok": false})},
 	}
 	pg.baseGroup.endpoints = baseRoutesHandlers
 
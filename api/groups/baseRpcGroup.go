@@ -0,0 +1,602 @@
+package groups
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	apiErrors "github.com/ElrondNetwork/elrond-proxy-go/api/errors"
+	"github.com/ElrondNetwork/elrond-proxy-go/api/jsonrpc"
+	"github.com/ElrondNetwork/elrond-proxy-go/api/shared"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	defaultMaxRpcBatchSize       = 100
+	defaultMaxRpcConcurrentCalls = 10
+)
+
+// rpcMethodRoute is the REST route mirrored by a JSON-RPC method. The config of the route also applies to the method
+type rpcMethodRoute struct {
+	packageName string
+	routeName   string
+}
+
+var rpcMethodsRoutes = map[string]rpcMethodRoute{
+	"account_get":           {packageName: "address", routeName: "/:address"},
+	"account_getShard":      {packageName: "address", routeName: "/:address/shard"},
+	"account_getESDTTokens": {packageName: "address", routeName: "/:address/esdt"},
+	"account_getESDTToken":  {packageName: "address", routeName: "/:address/esdt/:tokenIdentifier"},
+	"tx_send":               {packageName: "transaction", routeName: "/send"},
+	"tx_sendMultiple":       {packageName: "transaction", routeName: "/send-multiple"},
+	"tx_simulate":           {packageName: "transaction", routeName: "/simulate"},
+	"tx_cost":               {packageName: "transaction", routeName: "/cost"},
+	"tx_get":                {packageName: "transaction", routeName: "/:txhash"},
+	"tx_getStatus":          {packageName: "transaction", routeName: "/:txhash/status"},
+	"vm_query":              {packageName: "vm-values", routeName: "/query"},
+	"block_byNonce":         {packageName: "block", routeName: "/:shard/by-nonce/:nonce"},
+	"block_byHash":          {packageName: "block", routeName: "/:shard/by-hash/:hash"},
+	"hyperblock_byNonce":    {packageName: "hyperblock", routeName: "/by-nonce/:nonce"},
+	"hyperblock_byHash":     {packageName: "hyperblock", routeName: "/by-hash/:hash"},
+	"network_getConfig":     {packageName: "network", routeName: "/config"},
+	"network_getStatus":     {packageName: "network", routeName: "/status/:shard"},
+	"network_getEconomics":  {packageName: "network", routeName: "/economics"},
+}
+
+// rpcMethodProperties holds the properties of the route mirrored by a JSON-RPC method
+type rpcMethodProperties struct {
+	isOpen              bool
+	isSecured           bool
+	rateLimitedEndpoint string
+}
+
+type rpcAddressParams struct {
+	Address string `json:"address"`
+}
+
+type rpcESDTTokenParams struct {
+	Address         string `json:"address"`
+	TokenIdentifier string `json:"tokenIdentifier"`
+}
+
+type rpcTransactionParams struct {
+	Transaction    *data.Transaction `json:"transaction"`
+	CheckSignature *bool             `json:"checkSignature,omitempty"`
+}
+
+type rpcMultipleTransactionsParams struct {
+	Transactions []*data.Transaction `json:"transactions"`
+}
+
+type rpcGetTransactionParams struct {
+	Hash        string `json:"hash"`
+	Sender      string `json:"sender"`
+	WithResults bool   `json:"withResults"`
+}
+
+type rpcBlockParams struct {
+	Shard   uint32 `json:"shard"`
+	Nonce   uint64 `json:"nonce"`
+	Hash    string `json:"hash"`
+	WithTxs bool   `json:"withTxs"`
+}
+
+type rpcHyperblockParams struct {
+	Nonce uint64 `json:"nonce"`
+	Hash  string `json:"hash"`
+}
+
+type rpcShardParams struct {
+	Shard uint32 `json:"shard"`
+}
+
+type rpcGroup struct {
+	facade     RpcFacadeHandler
+	dispatcher RpcDispatcher
+	*baseGroup
+
+	mutRoutesConfig    sync.RWMutex
+	methodsProperties  map[string]rpcMethodProperties
+	rpcEndpoint        string
+	authenticationFunc gin.HandlerFunc
+	rateLimiter        gin.HandlerFunc
+}
+
+// NewRpcGroup returns a new instance of rpcGroup. It exposes the facade's operations as JSON-RPC 2.0 methods
+// using named parameters
+func NewRpcGroup(facadeHandler data.FacadeHandler) (*rpcGroup, error) {
+	facade, ok := facadeHandler.(RpcFacadeHandler)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	dispatcher, err := jsonrpc.NewDispatcher(defaultMaxRpcBatchSize, defaultMaxRpcConcurrentCalls)
+	if err != nil {
+		return nil, err
+	}
+
+	rg := &rpcGroup{
+		facade:            facade,
+		dispatcher:        dispatcher,
+		baseGroup:         &baseGroup{},
+		methodsProperties: make(map[string]rpcMethodProperties),
+	}
+
+	err = rg.registerMethods()
+	if err != nil {
+		return nil, err
+	}
+
+	baseRoutesHandlers := []*data.EndpointHandlerData{
+		{Path: "", Handler: rg.handleRpcMessage, Method: http.MethodPost, Request: jsonrpc.Request{}, Response: jsonrpc.Response{}},
+	}
+	rg.baseGroup.endpoints = baseRoutesHandlers
+
+	return rg, nil
+}
+
+func (group *rpcGroup) registerMethods() error {
+	methods := map[string]jsonrpc.MethodHandler{
+		"account_get":           group.getAccount,
+		"account_getShard":      group.getShard,
+		"account_getESDTTokens": group.getESDTTokens,
+		"account_getESDTToken":  group.getESDTToken,
+		"tx_send":               group.sendTransaction,
+		"tx_sendMultiple":       group.sendMultipleTransactions,
+		"tx_simulate":           group.simulateTransaction,
+		"tx_cost":               group.transactionCost,
+		"tx_get":                group.getTransaction,
+		"tx_getStatus":          group.getTransactionStatus,
+		"vm_query":              group.executeSCQuery,
+		"block_byNonce":         group.getBlockByNonce,
+		"block_byHash":          group.getBlockByHash,
+		"hyperblock_byNonce":    group.getHyperBlockByNonce,
+		"hyperblock_byHash":     group.getHyperBlockByHash,
+		"network_getConfig":     group.getNetworkConfig,
+		"network_getStatus":     group.getNetworkStatus,
+		"network_getEconomics":  group.getEconomicsData,
+		"rpc_methods":           group.getMethods,
+	}
+
+	for name, handler := range methods {
+		err := group.dispatcher.RegisterMethod(name, group.createOpenMethodHandler(name, handler))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// createOpenMethodHandler wraps the handler of a method, which is reported as not found if its mirrored route is closed
+func (group *rpcGroup) createOpenMethodHandler(name string, handler jsonrpc.MethodHandler) jsonrpc.MethodHandler {
+	return func(params json.RawMessage) (interface{}, error) {
+		group.mutRoutesConfig.RLock()
+		properties, ok := group.methodsProperties[name]
+		group.mutRoutesConfig.RUnlock()
+		if ok && !properties.isOpen {
+			return nil, jsonrpc.NewMethodNotFoundError(name)
+		}
+
+		return handler(params)
+	}
+}
+
+// RegisterRoutes registers the JSON-RPC endpoint. The config of the REST routes mirrored by the methods is applied to
+// each call: the methods of the closed routes are not available, the ones of the secured routes require authentication
+// and each call is counted against the rate limits of the JSON-RPC endpoint and of the mirrored route
+func (group *rpcGroup) RegisterRoutes(
+	ws *gin.RouterGroup,
+	apiConfig data.ApiRoutesConfig,
+	authenticationFunc gin.HandlerFunc,
+	rateLimiter gin.HandlerFunc,
+) {
+	group.applyRoutesConfig(getGroupName(ws), apiConfig, authenticationFunc, rateLimiter)
+
+	// the calls are counted by the handler, after reading the message
+	noRateLimiter := func(_ *gin.Context) {}
+	group.baseGroup.RegisterRoutes(ws, apiConfig, authenticationFunc, noRateLimiter)
+}
+
+func (group *rpcGroup) applyRoutesConfig(
+	packageName string,
+	apiConfig data.ApiRoutesConfig,
+	authenticationFunc gin.HandlerFunc,
+	rateLimiter gin.HandlerFunc,
+) {
+	maxBatchSize, maxConcurrentCalls := uint64(defaultMaxRpcBatchSize), uint64(defaultMaxRpcConcurrentCalls)
+	rpcRoute, ok := findRouteConfig(apiConfig, packageName, "")
+	if ok && rpcRoute.MaxBatchSize > 0 {
+		maxBatchSize = rpcRoute.MaxBatchSize
+	}
+	if ok && rpcRoute.MaxConcurrentCalls > 0 {
+		maxConcurrentCalls = rpcRoute.MaxConcurrentCalls
+	}
+	err := group.dispatcher.SetLimits(int(maxBatchSize), int(maxConcurrentCalls))
+	log.LogIfError(err)
+
+	methodsProperties := make(map[string]rpcMethodProperties, len(rpcMethodsRoutes))
+	for method, methodRoute := range rpcMethodsRoutes {
+		route, found := findRouteConfig(apiConfig, methodRoute.packageName, methodRoute.routeName)
+		if !found {
+			methodsProperties[method] = rpcMethodProperties{isOpen: true}
+			continue
+		}
+
+		methodsProperties[method] = rpcMethodProperties{
+			isOpen:              route.Open,
+			isSecured:           route.Secured,
+			rateLimitedEndpoint: fmt.Sprintf("/%s%s", methodRoute.packageName, methodRoute.routeName),
+		}
+	}
+
+	group.mutRoutesConfig.Lock()
+	group.methodsProperties = methodsProperties
+	group.rpcEndpoint = "/" + packageName
+	group.authenticationFunc = authenticationFunc
+	group.rateLimiter = rateLimiter
+	group.mutRoutesConfig.Unlock()
+}
+
+// handleRpcMessage handles a JSON-RPC request or a batch of requests
+func (group *rpcGroup) handleRpcMessage(c *gin.Context) {
+	message, err := c.GetRawData()
+	if err != nil {
+		shared.RespondWithBadRequest(c, fmt.Sprintf("%s: %s", apiErrors.ErrInvalidJSONRequest.Error(), err.Error()))
+		return
+	}
+
+	isAllowed := group.applyCallsPolicies(c, message)
+	if !isAllowed {
+		return
+	}
+
+	response, hasResponse := group.dispatcher.HandleMessage(message)
+	if !hasResponse {
+		c.Status(http.StatusNoContent)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// applyCallsPolicies authenticates the client if one of the called methods mirrors a secured route and counts each call
+// against the rate limits. It returns false if the request has been aborted
+func (group *rpcGroup) applyCallsPolicies(c *gin.Context, message []byte) bool {
+	group.mutRoutesConfig.RLock()
+	defer group.mutRoutesConfig.RUnlock()
+
+	methods := jsonrpc.GetCalledMethods(message)
+	rateLimitedEndpoints := make([]string, 0, 2*len(methods))
+	needsAuthentication := false
+	for _, method := range methods {
+		rateLimitedEndpoints = append(rateLimitedEndpoints, group.rpcEndpoint)
+
+		properties, ok := group.methodsProperties[method]
+		if !ok || !properties.isOpen {
+			continue
+		}
+		needsAuthentication = needsAuthentication || properties.isSecured
+		if len(properties.rateLimitedEndpoint) > 0 {
+			rateLimitedEndpoints = append(rateLimitedEndpoints, properties.rateLimitedEndpoint)
+		}
+	}
+
+	if needsAuthentication && group.authenticationFunc != nil {
+		group.authenticationFunc(c)
+		if c.IsAborted() {
+			return false
+		}
+	}
+
+	if group.rateLimiter != nil {
+		c.Set(shared.RateLimitedEndpointsContextKey, rateLimitedEndpoints)
+		group.rateLimiter(c)
+	}
+
+	return !c.IsAborted()
+}
+
+func unmarshalRpcParams(params json.RawMessage, destination interface{}) error {
+	if len(params) == 0 {
+		return jsonrpc.NewInvalidParamsError(apiErrors.ErrInvalidJSONRequest)
+	}
+
+	err := json.Unmarshal(params, destination)
+	if err != nil {
+		return jsonrpc.NewInvalidParamsError(fmt.Errorf("%w: %s", apiErrors.ErrInvalidJSONRequest, err.Error()))
+	}
+
+	return nil
+}
+
+func (group *rpcGroup) fetchAddressParam(params json.RawMessage) (string, error) {
+	addressParams := rpcAddressParams{}
+	err := unmarshalRpcParams(params, &addressParams)
+	if err != nil {
+		return "", err
+	}
+	if addressParams.Address == "" {
+		return "", jsonrpc.NewInvalidParamsError(apiErrors.ErrEmptyAddress)
+	}
+
+	return addressParams.Address, nil
+}
+
+func (group *rpcGroup) fetchTransactionParams(params json.RawMessage) (*rpcTransactionParams, error) {
+	txParams := &rpcTransactionParams{}
+	err := unmarshalRpcParams(params, txParams)
+	if err != nil {
+		return nil, err
+	}
+	if txParams.Transaction == nil {
+		return nil, jsonrpc.NewInvalidParamsError(fmt.Errorf("%w: missing transaction", apiErrors.ErrValidation))
+	}
+
+	return txParams, nil
+}
+
+func genericResponseToRpcResult(response *data.GenericAPIResponse, err error) (interface{}, error) {
+	if err != nil {
+		return nil, err
+	}
+	if response.Error != "" {
+		return nil, jsonrpc.NewInternalError(fmt.Errorf("%s", response.Error))
+	}
+
+	return response.Data, nil
+}
+
+func (group *rpcGroup) getAccount(params json.RawMessage) (interface{}, error) {
+	address, err := group.fetchAddressParam(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return group.facade.GetAccount(address)
+}
+
+func (group *rpcGroup) getShard(params json.RawMessage) (interface{}, error) {
+	address, err := group.fetchAddressParam(params)
+	if err != nil {
+		return nil, err
+	}
+
+	shardID, err := group.facade.GetShardIDForAddress(address)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", apiErrors.ErrComputeShardForAddress.Error(), err.Error())
+	}
+
+	return gin.H{"shardID": shardID}, nil
+}
+
+func (group *rpcGroup) getESDTTokens(params json.RawMessage) (interface{}, error) {
+	address, err := group.fetchAddressParam(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return genericResponseToRpcResult(group.facade.GetAllESDTTokens(address))
+}
+
+func (group *rpcGroup) getESDTToken(params json.RawMessage) (interface{}, error) {
+	tokenParams := rpcESDTTokenParams{}
+	err := unmarshalRpcParams(params, &tokenParams)
+	if err != nil {
+		return nil, err
+	}
+	if tokenParams.Address == "" {
+		return nil, jsonrpc.NewInvalidParamsError(apiErrors.ErrEmptyAddress)
+	}
+	if tokenParams.TokenIdentifier == "" {
+		return nil, jsonrpc.NewInvalidParamsError(apiErrors.ErrEmptyTokenIdentifier)
+	}
+
+	return genericResponseToRpcResult(group.facade.GetESDTTokenData(tokenParams.Address, tokenParams.TokenIdentifier))
+}
+
+func (group *rpcGroup) sendTransaction(params json.RawMessage) (interface{}, error) {
+	txParams, err := group.fetchTransactionParams(params)
+	if err != nil {
+		return nil, err
+	}
+
+	statusCode, txHash, err := group.facade.SendTransaction(txParams.Transaction)
+	if err != nil {
+		if statusCode == http.StatusBadRequest {
+			return nil, jsonrpc.NewInvalidParamsError(err)
+		}
+		return nil, err
+	}
+
+	return gin.H{"txHash": txHash}, nil
+}
+
+func (group *rpcGroup) sendMultipleTransactions(params json.RawMessage) (interface{}, error) {
+	txsParams := rpcMultipleTransactionsParams{}
+	err := unmarshalRpcParams(params, &txsParams)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := group.facade.SendMultipleTransactions(txsParams.Transactions)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", apiErrors.ErrTxGenerationFailed.Error(), err.Error())
+	}
+
+	return gin.H{
+		"numOfSentTxs": response.NumOfTxs,
+		"txsHashes":    response.TxsHashes,
+	}, nil
+}
+
+func (group *rpcGroup) simulateTransaction(params json.RawMessage) (interface{}, error) {
+	txParams, err := group.fetchTransactionParams(params)
+	if err != nil {
+		return nil, err
+	}
+
+	checkSignature := true
+	if txParams.CheckSignature != nil {
+		checkSignature = *txParams.CheckSignature
+	}
+
+	return genericResponseToRpcResult(group.facade.SimulateTransaction(txParams.Transaction, checkSignature))
+}
+
+func (group *rpcGroup) transactionCost(params json.RawMessage) (interface{}, error) {
+	txParams, err := group.fetchTransactionParams(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return group.facade.TransactionCostRequest(txParams.Transaction)
+}
+
+func (group *rpcGroup) getTransaction(params json.RawMessage) (interface{}, error) {
+	txParams := rpcGetTransactionParams{}
+	err := unmarshalRpcParams(params, &txParams)
+	if err != nil {
+		return nil, err
+	}
+	if txParams.Hash == "" {
+		return nil, jsonrpc.NewInvalidParamsError(apiErrors.ErrTransactionHashMissing)
+	}
+
+	if txParams.Sender != "" {
+		tx, statusCode, errGet := group.facade.GetTransactionByHashAndSenderAddress(txParams.Hash, txParams.Sender, txParams.WithResults)
+		if errGet != nil && statusCode == http.StatusBadRequest {
+			return nil, jsonrpc.NewInvalidParamsError(errGet)
+		}
+
+		return tx, errGet
+	}
+
+	return group.facade.GetTransaction(txParams.Hash, txParams.WithResults)
+}
+
+func (group *rpcGroup) getTransactionStatus(params json.RawMessage) (interface{}, error) {
+	txParams := rpcGetTransactionParams{}
+	err := unmarshalRpcParams(params, &txParams)
+	if err != nil {
+		return nil, err
+	}
+	if txParams.Hash == "" {
+		return nil, jsonrpc.NewInvalidParamsError(apiErrors.ErrTransactionHashMissing)
+	}
+
+	status, err := group.facade.GetTransactionStatus(txParams.Hash, txParams.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	return gin.H{"status": status}, nil
+}
+
+func (group *rpcGroup) executeSCQuery(params json.RawMessage) (interface{}, error) {
+	request := VMValueRequest{}
+	err := unmarshalRpcParams(params, &request)
+	if err != nil {
+		return nil, err
+	}
+
+	command, err := createSCQuery(&request)
+	if err != nil {
+		return nil, jsonrpc.NewInvalidParamsError(err)
+	}
+
+	return group.facade.ExecuteSCQuery(command)
+}
+
+func (group *rpcGroup) getBlockByNonce(params json.RawMessage) (interface{}, error) {
+	blockParams := rpcBlockParams{}
+	err := unmarshalRpcParams(params, &blockParams)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := group.facade.GetBlockByNonce(blockParams.Shard, blockParams.Nonce, blockParams.WithTxs)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data.Block, nil
+}
+
+func (group *rpcGroup) getBlockByHash(params json.RawMessage) (interface{}, error) {
+	blockParams := rpcBlockParams{}
+	err := unmarshalRpcParams(params, &blockParams)
+	if err != nil {
+		return nil, err
+	}
+	_, err = hex.DecodeString(blockParams.Hash)
+	if err != nil || blockParams.Hash == "" {
+		return nil, jsonrpc.NewInvalidParamsError(apiErrors.ErrInvalidBlockHashParam)
+	}
+
+	response, err := group.facade.GetBlockByHash(blockParams.Shard, blockParams.Hash, blockParams.WithTxs)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data.Block, nil
+}
+
+func (group *rpcGroup) getHyperBlockByNonce(params json.RawMessage) (interface{}, error) {
+	hyperblockParams := rpcHyperblockParams{}
+	err := unmarshalRpcParams(params, &hyperblockParams)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := group.facade.GetHyperBlockByNonce(hyperblockParams.Nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data.Hyperblock, nil
+}
+
+func (group *rpcGroup) getHyperBlockByHash(params json.RawMessage) (interface{}, error) {
+	hyperblockParams := rpcHyperblockParams{}
+	err := unmarshalRpcParams(params, &hyperblockParams)
+	if err != nil {
+		return nil, err
+	}
+	_, err = hex.DecodeString(hyperblockParams.Hash)
+	if err != nil || hyperblockParams.Hash == "" {
+		return nil, jsonrpc.NewInvalidParamsError(apiErrors.ErrInvalidBlockHashParam)
+	}
+
+	response, err := group.facade.GetHyperBlockByHash(hyperblockParams.Hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Data.Hyperblock, nil
+}
+
+func (group *rpcGroup) getNetworkConfig(_ json.RawMessage) (interface{}, error) {
+	return genericResponseToRpcResult(group.facade.GetNetworkConfigMetrics())
+}
+
+func (group *rpcGroup) getNetworkStatus(params json.RawMessage) (interface{}, error) {
+	shardParams := rpcShardParams{}
+	err := unmarshalRpcParams(params, &shardParams)
+	if err != nil {
+		return nil, err
+	}
+
+	return genericResponseToRpcResult(group.facade.GetNetworkStatusMetrics(shardParams.Shard))
+}
+
+func (group *rpcGroup) getEconomicsData(_ json.RawMessage) (interface{}, error) {
+	return genericResponseToRpcResult(group.facade.GetEconomicsDataMetrics())
+}
+
+func (group *rpcGroup) getMethods(_ json.RawMessage) (interface{}, error) {
+	return gin.H{"methods": group.dispatcher.Methods()}, nil
+}
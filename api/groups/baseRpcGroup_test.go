@@ -0,0 +1,264 @@
+package groups_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/vm"
+	"github.com/ElrondNetwork/elrond-proxy-go/api/groups"
+	"github.com/ElrondNetwork/elrond-proxy-go/api/jsonrpc"
+	"github.com/ElrondNetwork/elrond-proxy-go/api/mock"
+	"github.com/ElrondNetwork/elrond-proxy-go/api/shared"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const rpcPath = "/rpc"
+
+type rpcTestResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Result  json.RawMessage `json:"result"`
+	Error   *jsonrpc.Error  `json:"error"`
+	ID      json.RawMessage `json:"id"`
+}
+
+func doRpcRequest(t *testing.T, facade interface{}, message string) (int, *bytes.Buffer) {
+	rpcGroup, err := groups.NewRpcGroup(facade)
+	require.NoError(t, err)
+
+	ws := startProxyServer(rpcGroup, rpcPath)
+	req, _ := http.NewRequest(http.MethodPost, rpcPath, bytes.NewBufferString(message))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp.Code, resp.Body
+}
+
+func TestNewRpcGroup_WrongFacadeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	wrongFacade := &mock.WrongFacade{}
+	group, err := groups.NewRpcGroup(wrongFacade)
+	require.Nil(t, group)
+	require.Equal(t, groups.ErrWrongTypeAssertion, err)
+}
+
+func TestRpcGroup_AccountGet(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetAccountHandler: func(address string) (*data.Account, error) {
+			return &data.Account{Address: address, Nonce: 37, Balance: "100"}, nil
+		},
+	}
+
+	code, body := doRpcRequest(t, facade, `{"jsonrpc":"2.0","method":"account_get","params":{"address":"erd1a"},"id":1}`)
+	require.Equal(t, http.StatusOK, code)
+
+	response := rpcTestResponse{}
+	loadResponse(body, &response)
+	require.Nil(t, response.Error)
+
+	account := data.Account{}
+	require.Nil(t, json.Unmarshal(response.Result, &account))
+	assert.Equal(t, "erd1a", account.Address)
+	assert.Equal(t, uint64(37), account.Nonce)
+}
+
+func TestRpcGroup_AccountGetMissingAddressShouldReturnBadRequestCode(t *testing.T) {
+	t.Parallel()
+
+	code, body := doRpcRequest(t, &mock.Facade{}, `{"jsonrpc":"2.0","method":"account_get","params":{},"id":1}`)
+	require.Equal(t, http.StatusOK, code)
+
+	response := rpcTestResponse{}
+	loadResponse(body, &response)
+	require.NotNil(t, response.Error)
+	assert.Equal(t, jsonrpc.ErrorCodeInvalidParams, response.Error.Code)
+	assert.Equal(t, data.ReturnCodeRequestError, response.Error.Data)
+}
+
+func TestRpcGroup_FacadeErrorShouldReturnInternalIssueCode(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetHyperBlockByNonceCalled: func(nonce uint64) (*data.HyperblockApiResponse, error) {
+			return nil, errors.New("observer down")
+		},
+	}
+
+	_, body := doRpcRequest(t, facade, `{"jsonrpc":"2.0","method":"hyperblock_byNonce","params":{"nonce":5},"id":"a"}`)
+
+	response := rpcTestResponse{}
+	loadResponse(body, &response)
+	require.NotNil(t, response.Error)
+	assert.Equal(t, jsonrpc.ErrorCodeInternal, response.Error.Code)
+	assert.Equal(t, data.ReturnCodeInternalError, response.Error.Data)
+	assert.Equal(t, "observer down", response.Error.Message)
+	assert.Equal(t, json.RawMessage(`"a"`), response.ID)
+}
+
+func TestRpcGroup_Batch(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		SendTransactionHandler: func(tx *data.Transaction) (int, string, error) {
+			return http.StatusOK, "txHash", nil
+		},
+		ExecuteSCQueryHandler: func(query *data.SCQuery) (*vm.VMOutputApi, error) {
+			return &vm.VMOutputApi{ReturnData: query.Arguments}, nil
+		},
+		GetHyperBlockByHashCalled: func(hash string) (*data.HyperblockApiResponse, error) {
+			return data.NewHyperblockApiResponse(data.Hyperblock{Hash: hash, Nonce: 42}), nil
+		},
+	}
+
+	message := `[
+		{"jsonrpc":"2.0","method":"tx_send","params":{"transaction":{"nonce":1,"value":"1"}},"id":1},
+		{"jsonrpc":"2.0","method":"vm_query","params":{"scAddress":"erd1sc","funcName":"get","args":["zz"]},"id":2},
+		{"jsonrpc":"2.0","method":"hyperblock_byHash","params":{"hash":"abcd"},"id":3}
+	]`
+	code, body := doRpcRequest(t, facade, message)
+	require.Equal(t, http.StatusOK, code)
+
+	responses := make([]rpcTestResponse, 0)
+	loadResponse(body, &responses)
+	require.Equal(t, 3, len(responses))
+
+	assert.Nil(t, responses[0].Error)
+	assert.JSONEq(t, `{"txHash":"txHash"}`, string(responses[0].Result))
+
+	require.NotNil(t, responses[1].Error)
+	assert.Equal(t, jsonrpc.ErrorCodeInvalidParams, responses[1].Error.Code)
+
+	hyperblock := data.Hyperblock{}
+	require.Nil(t, json.Unmarshal(responses[2].Result, &hyperblock))
+	assert.Equal(t, uint64(42), hyperblock.Nonce)
+	assert.Equal(t, "abcd", hyperblock.Hash)
+}
+
+func TestRpcGroup_NotificationShouldReturnNoContent(t *testing.T) {
+	t.Parallel()
+
+	code, body := doRpcRequest(t, &mock.Facade{}, `{"jsonrpc":"2.0","method":"rpc_methods"}`)
+	assert.Equal(t, http.StatusNoContent, code)
+	assert.Equal(t, 0, body.Len())
+}
+
+func doRpcRequestWithConfig(
+	t *testing.T,
+	apiConfig data.ApiRoutesConfig,
+	authenticationFunc gin.HandlerFunc,
+	rateLimiter gin.HandlerFunc,
+	message string,
+) (int, *bytes.Buffer) {
+	facade := &mock.Facade{
+		SendTransactionHandler: func(tx *data.Transaction) (int, string, error) {
+			return http.StatusOK, "txHash", nil
+		},
+		GetConfigMetricsHandler: func() (*data.GenericAPIResponse, error) {
+			return &data.GenericAPIResponse{Data: "config"}, nil
+		},
+	}
+	rpcGroup, err := groups.NewRpcGroup(facade)
+	require.NoError(t, err)
+
+	ws := gin.New()
+	rpcGroup.RegisterRoutes(ws.Group(rpcPath), apiConfig, authenticationFunc, rateLimiter)
+	req, _ := http.NewRequest(http.MethodPost, rpcPath, bytes.NewBufferString(message))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp.Code, resp.Body
+}
+
+func createRpcApiConfig(rpcRoute data.RouteConfig, sendRoute data.RouteConfig) data.ApiRoutesConfig {
+	return data.ApiRoutesConfig{
+		APIPackages: map[string]data.APIPackageConfig{
+			"rpc":         {Routes: []data.RouteConfig{rpcRoute}},
+			"transaction": {Routes: []data.RouteConfig{sendRoute}},
+		},
+	}
+}
+
+func TestRpcGroup_MethodOfClosedRouteShouldNotBeFound(t *testing.T) {
+	t.Parallel()
+
+	apiConfig := createRpcApiConfig(
+		data.RouteConfig{Name: "", Open: true},
+		data.RouteConfig{Name: "/send", Open: false},
+	)
+	_, body := doRpcRequestWithConfig(t, apiConfig, nil, nil, `{"jsonrpc":"2.0","method":"tx_send","params":{"transaction":{"nonce":1}},"id":1}`)
+
+	response := rpcTestResponse{}
+	loadResponse(body, &response)
+	require.NotNil(t, response.Error)
+	assert.Equal(t, jsonrpc.ErrorCodeMethodNotFound, response.Error.Code)
+}
+
+func TestRpcGroup_MethodOfSecuredRouteShouldAuthenticate(t *testing.T) {
+	t.Parallel()
+
+	apiConfig := createRpcApiConfig(
+		data.RouteConfig{Name: "", Open: true},
+		data.RouteConfig{Name: "/send", Open: true, Secured: true},
+	)
+	numAuthentications := 0
+	authenticationFunc := func(c *gin.Context) {
+		numAuthentications++
+		c.AbortWithStatus(http.StatusUnauthorized)
+	}
+
+	code, _ := doRpcRequestWithConfig(t, apiConfig, authenticationFunc, nil, `{"jsonrpc":"2.0","method":"network_getConfig","id":1}`)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, 0, numAuthentications)
+
+	code, _ = doRpcRequestWithConfig(t, apiConfig, authenticationFunc, nil, `{"jsonrpc":"2.0","method":"tx_send","params":{"transaction":{"nonce":1}},"id":1}`)
+	assert.Equal(t, http.StatusUnauthorized, code)
+	assert.Equal(t, 1, numAuthentications)
+}
+
+func TestRpcGroup_EachCallShouldBeCountedByTheRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	apiConfig := createRpcApiConfig(
+		data.RouteConfig{Name: "", Open: true, RateLimit: 10},
+		data.RouteConfig{Name: "/send", Open: true, RateLimit: 2},
+	)
+	var rateLimitedEndpoints interface{}
+	rateLimiter := func(c *gin.Context) {
+		rateLimitedEndpoints, _ = c.Get(shared.RateLimitedEndpointsContextKey)
+		c.AbortWithStatus(http.StatusTooManyRequests)
+	}
+
+	message := `[
+		{"jsonrpc":"2.0","method":"tx_send","params":{"transaction":{"nonce":1}},"id":1},
+		{"jsonrpc":"2.0","method":"network_getConfig","id":2},
+		{"jsonrpc":"2.0","method":"tx_send","params":{"transaction":{"nonce":2}},"id":3}
+	]`
+	code, _ := doRpcRequestWithConfig(t, apiConfig, nil, rateLimiter, message)
+	assert.Equal(t, http.StatusTooManyRequests, code)
+	assert.Equal(t, []string{"/rpc", "/transaction/send", "/rpc", "/rpc", "/transaction/send"}, rateLimitedEndpoints)
+}
+
+func TestRpcGroup_BatchSizeShouldBeReadFromConfig(t *testing.T) {
+	t.Parallel()
+
+	apiConfig := createRpcApiConfig(
+		data.RouteConfig{Name: "", Open: true, MaxBatchSize: 1},
+		data.RouteConfig{Name: "/send", Open: true},
+	)
+	message := `[{"jsonrpc":"2.0","method":"network_getConfig","id":1},{"jsonrpc":"2.0","method":"network_getConfig","id":2}]`
+	_, body := doRpcRequestWithConfig(t, apiConfig, nil, nil, message)
+
+	response := rpcTestResponse{}
+	loadResponse(body, &response)
+	require.NotNil(t, response.Error)
+	assert.Equal(t, jsonrpc.ErrorCodeInvalidRequest, response.Error.Code)
+}
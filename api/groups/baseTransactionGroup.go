@@ -14,6 +14,23 @@ import (
 const (
 	paramCheckSignature = "checkSignature"
 	paramWithResults    = "withResults"
+	paramDecode         = "decode"
+	paramSender         = "sender"
+)
+
+var (
+	checkSignatureQueryParameter = []data.QueryParameter{
+		{Name: paramCheckSignature, Type: "boolean", Description: "if false, the signature is not checked (true by default)"},
+	}
+	withResultsQueryParameter = []data.QueryParameter{
+		{Name: paramWithResults, Type: "boolean", Description: "if true, the smart contract results are returned as well"},
+	}
+	decodeQueryParameter = []data.QueryParameter{
+		{Name: paramDecode, Type: "boolean", Description: "if true, the data is decoded with the ABI of the contract, if registered"},
+	}
+	senderQueryParameter = []data.QueryParameter{
+		{Name: paramSender, Type: "string", Description: "the sender address, used to select the observers of its shard"},
+	}
 )
 
 type transactionGroup struct {
@@ -34,13 +51,14 @@ func NewTransactionGroup(facadeHandler data.FacadeHandler) (*transactionGroup, e
 	}
 
 	baseRoutesHandlers := []*data.EndpointHandlerData{
-		{Path: "/send", Handler: tg.sendTransaction, Method: http.MethodPost},
-		{Path: "/simulate", Handler: tg.simulateTransaction, Method: http.MethodPost},
-		{Path: "/send-multiple", Handler: tg.sendMultipleTransactions, Method: http.MethodPost},
-		{Path: "/send-user-funds", Handler: tg.sendUserFunds, Method: http.MethodPost},
-		{Path: "/cost", Handler: tg.requestTransactionCost, Method: http.MethodPost},
-		{Path: "/:txhash/status", Handler: tg.getTransactionStatus, Method: http.MethodGet},
-		{Path: "/:txhash", Handler: tg.getTransaction, Method: http.MethodGet},
+		{Path: "/send", Handler: tg.sendTransaction, Method: http.MethodPost, Request: data.Transaction{}, Response: apiResponse(gin.H{"txHash": ""})},
+		{Path: "/simulate", Handler: tg.simulateTransaction, Method: http.MethodPost, Request: data.Transaction{}, Response: data.ResponseTransactionSimulation{}, QueryParameters: checkSignatureQueryParameter},
+		{Path: "/send-multiple", Handler: tg.sendMultipleTransactions, Method: http.MethodPost, Request: []data.Transaction{}, Response: apiResponse(gin.H{"numOfSentTxs": uint64(0), "txsHashes": map[int]string{}})},
+		{Path: "/send-user-funds", Handler: tg.sendUserFunds, Method: http.MethodPost, Request: data.FundsRequest{}, Response: apiResponse(gin.H{"message": ""})},
+		{Path: "/cost", Handler: tg.requestTransactionCost, Method: http.MethodPost, Request: data.Transaction{}, Response: apiResponse(data.TxCostResponseData{})},
+		{Path: "/:txhash/status", Handler: tg.getTransactionStatus, Method: http.MethodGet, Response: apiResponse(gin.H{"status": ""}), QueryParameters: senderQueryParameter},
+		{Path: "/:txhash/scresults", Handler: tg.getSCResults, Method: http.MethodGet, Response: apiResponse(gin.H{"scResults": []data.DatabaseSCResult{}})},
+		{Path: "/:txhash", Handler: tg.getTransaction, Method: http.MethodGet, Response: apiResponse(gin.H{"transaction": data.FullTransaction{}}), QueryParameters: joinQueryParameters(senderQueryParameter, withResultsQueryParameter, decodeQueryParameter)},
 	}
 	tg.baseGroup.endpoints = baseRoutesHandlers
 
@@ -211,7 +229,7 @@ func (group *transactionGroup) requestTransactionCost(c *gin.Context) {
 // getTransactionStatus will return the transaction's status
 func (group *transactionGroup) getTransactionStatus(c *gin.Context) {
 	txHash := c.Param("txhash")
-	sender := c.Request.URL.Query().Get("sender")
+	sender := c.Request.URL.Query().Get(paramSender)
 	txStatus, err := group.facade.GetTransactionStatus(txHash, sender)
 	if err != nil {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
@@ -235,9 +253,15 @@ func (group *transactionGroup) getTransaction(c *gin.Context) {
 		return
 	}
 
-	sndAddr := c.Request.URL.Query().Get("sender")
+	decode, err := getQueryParamDecode(c)
+	if err != nil {
+		shared.RespondWith(c, http.StatusBadRequest, nil, errors.ErrValidationQueryParameterDecode.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	sndAddr := c.Request.URL.Query().Get(paramSender)
 	if sndAddr != "" {
-		getTransactionByHashAndSenderAddress(c, group.facade, txHash, sndAddr, withResults)
+		getTransactionByHashAndSenderAddress(c, group.facade, txHash, sndAddr, withResults, decode)
 		return
 	}
 
@@ -246,11 +270,14 @@ func (group *transactionGroup) getTransaction(c *gin.Context) {
 		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
 		return
 	}
+	if decode {
+		group.facade.DecodeTransaction(tx)
+	}
 
 	shared.RespondWith(c, http.StatusOK, gin.H{"transaction": tx}, "", data.ReturnCodeSuccess)
 }
 
-func getTransactionByHashAndSenderAddress(c *gin.Context, ef TransactionFacadeHandler, txHash string, sndAddr string, withEvents bool) {
+func getTransactionByHashAndSenderAddress(c *gin.Context, ef TransactionFacadeHandler, txHash string, sndAddr string, withEvents bool, decode bool) {
 	tx, statusCode, err := ef.GetTransactionByHashAndSenderAddress(txHash, sndAddr, withEvents)
 	if err != nil {
 		internalCode := data.ReturnCodeInternalError
@@ -260,6 +287,9 @@ func getTransactionByHashAndSenderAddress(c *gin.Context, ef TransactionFacadeHa
 		shared.RespondWith(c, statusCode, nil, err.Error(), internalCode)
 		return
 	}
+	if decode {
+		ef.DecodeTransaction(tx)
+	}
 
 	shared.RespondWith(c, http.StatusOK, gin.H{"transaction": tx}, "", data.ReturnCodeSuccess)
 }
@@ -273,6 +303,15 @@ func getQueryParamWithResults(c *gin.Context) (bool, error) {
 	return strconv.ParseBool(withResultsStr)
 }
 
+func getQueryParamDecode(c *gin.Context) (bool, error) {
+	decodeStr := c.Request.URL.Query().Get(paramDecode)
+	if decodeStr == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(decodeStr)
+}
+
 func getQueryParameterCheckSignature(c *gin.Context) (bool, error) {
 	bypassSignatureStr := c.Request.URL.Query().Get(paramCheckSignature)
 	if bypassSignatureStr == "" {
@@ -281,3 +320,20 @@ func getQueryParameterCheckSignature(c *gin.Context) (bool, error) {
 
 	return strconv.ParseBool(bypassSignatureStr)
 }
+
+// getSCResults returns the smart contract results generated by a transaction, as stored in the external storage
+func (group *transactionGroup) getSCResults(c *gin.Context) {
+	txHash := c.Param("txhash")
+	if txHash == "" {
+		shared.RespondWith(c, http.StatusBadRequest, nil, errors.ErrTransactionHashMissing.Error(), data.ReturnCodeRequestError)
+		return
+	}
+
+	scResults, err := group.facade.GetSCResultsByTxHash(txHash)
+	if err != nil {
+		shared.RespondWith(c, http.StatusInternalServerError, nil, err.Error(), data.ReturnCodeInternalError)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"scResults": scResults}, "", data.ReturnCodeSuccess)
+}
@@ -511,3 +511,107 @@ func TestSendUserFunds_FaucetNotEnabled(t *testing.T) {
 
 	assert.Equal(t, apiErrors.ErrFaucetNotEnabled.Error(), response.Error)
 }
+
+func TestGetSCResults_FailsWhenFacadeErrors(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("cannot find transaction")
+	facade := &mock.Facade{
+		GetSCResultsByTxHashHandler: func(txHash string) ([]data.DatabaseSCResult, error) {
+			return nil, expectedErr
+		},
+	}
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	req, _ := http.NewRequest("GET", "/transaction/hash/scresults", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := GeneralResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, expectedErr.Error(), response.Error)
+}
+
+func TestGetSCResults_ReturnsSuccessfully(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetSCResultsByTxHashHandler: func(txHash string) ([]data.DatabaseSCResult, error) {
+			return []data.DatabaseSCResult{{Hash: "scr", OriginalTxHash: txHash}}, nil
+		},
+	}
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	req, _ := http.NewRequest("GET", "/transaction/hash/scresults", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		GeneralResponse
+		Data struct {
+			SCResults []data.DatabaseSCResult `json:"scResults"`
+		}
+	}{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	require.Len(t, response.Data.SCResults, 1)
+	assert.Equal(t, "hash", response.Data.SCResults[0].OriginalTxHash)
+}
+
+func TestGetTransaction_InvalidDecodeParameterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	transactionsGroup, err := groups.NewTransactionGroup(&mock.Facade{})
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	req, _ := http.NewRequest("GET", "/transaction/hash?decode=maybe", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := GeneralResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Equal(t, apiErrors.ErrValidationQueryParameterDecode.Error(), response.Error)
+}
+
+func TestGetTransaction_WithDecodeShouldReturnTheDecodedCall(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetTransactionHandler: func(txHash string, withResults bool) (*data.FullTransaction, error) {
+			return &data.FullTransaction{Hash: txHash, Data: []byte("stake@01")}, nil
+		},
+		DecodeTransactionHandler: func(tx *data.FullTransaction) {
+			tx.DecodedData = &data.DecodedCall{Function: "stake", Arguments: []interface{}{"1"}}
+		},
+	}
+	transactionsGroup, err := groups.NewTransactionGroup(facade)
+	require.NoError(t, err)
+	ws := startProxyServer(transactionsGroup, transactionsPath)
+
+	req, _ := http.NewRequest("GET", "/transaction/hash?decode=true", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := struct {
+		GeneralResponse
+		Data struct {
+			Transaction data.FullTransaction `json:"transaction"`
+		}
+	}{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	require.NotNil(t, response.Data.Transaction.DecodedData)
+	assert.Equal(t, "stake", response.Data.Transaction.DecodedData.Function)
+	assert.Equal(t, []interface{}{"1"}, response.Data.Transaction.DecodedData.Arguments)
+}
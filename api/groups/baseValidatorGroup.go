@@ -26,7 +26,7 @@ func NewValidatorGroup(facadeHandler data.FacadeHandler) (*validatorGroup, error
 	}
 
 	baseRoutesHandlers := []*data.EndpointHandlerData{
-		{Path: "/statistics", Handler: vg.statistics, Method: http.MethodGet},
+		{Path: "/statistics", Handler: vg.statistics, Method: http.MethodGet, Response: apiResponse(gin.H{"statistics": map[string]*data.ValidatorApiResponse{}})},
 	}
 	vg.baseGroup.endpoints = baseRoutesHandlers
 
@@ -22,6 +22,36 @@ type VMValueRequest struct {
 	Args       []string `form:"args"  json:"args"`
 }
 
+// VMValueBatchQuery is a query from a batch, along with the kind of output it expects: hex, string, int or raw.
+// The raw output, which is the default one, is the full output of the VM
+type VMValueBatchQuery struct {
+	VMValueRequest
+	OutputKind string `json:"outputKind"`
+}
+
+// VMValuesBatchRequest represents the structure of a batch of VM queries
+type VMValuesBatchRequest struct {
+	Queries []VMValueBatchQuery `json:"queries"`
+}
+
+// VMValueBatchResult holds the output of a query from a batch, or the error which prevented its execution. The decoded
+// output, or the error which prevented its decoding, is only set when the decode=true query parameter is provided
+type VMValueBatchResult struct {
+	Data        interface{}   `json:"data"`
+	Decoded     []interface{} `json:"decoded,omitempty"`
+	DecodeError string        `json:"decodeError,omitempty"`
+	Error       string        `json:"error,omitempty"`
+}
+
+const (
+	outputKindHex    = "hex"
+	outputKindString = "string"
+	outputKindInt    = "int"
+	outputKindRaw    = "raw"
+
+	defaultMaxVmQueriesBatchSize = 50
+)
+
 type vmValuesGroup struct {
 	facade VmValuesFacadeHandler
 	*baseGroup
@@ -40,10 +70,11 @@ func NewVmValuesGroup(facadeHandler data.FacadeHandler) (*vmValuesGroup, error)
 	}
 
 	baseRoutesHandlers := []*data.EndpointHandlerData{
-		{Path: "/hex", Handler: vvg.getHex, Method: http.MethodPost},
-		{Path: "/string", Handler: vvg.getString, Method: http.MethodPost},
-		{Path: "/int", Handler: vvg.getInt, Method: http.MethodPost},
-		{Path: "/query", Handler: vvg.executeQuery, Method: http.MethodPost},
+		{Path: "/hex", Handler: vvg.getHex, Method: http.MethodPost, Request: VMValueRequest{}, Response: apiResponse(gin.H{"data": ""})},
+		{Path: "/string", Handler: vvg.getString, Method: http.MethodPost, Request: VMValueRequest{}, Response: apiResponse(gin.H{"data": ""})},
+		{Path: "/int", Handler: vvg.getInt, Method: http.MethodPost, Request: VMValueRequest{}, Response: apiResponse(gin.H{"data": ""})},
+		{Path: "/query", Handler: vvg.executeQuery, Method: http.MethodPost, Request: VMValueRequest{}, Response: apiResponse(gin.H{"data": vm.VMOutputApi{}}), QueryParameters: decodeQueryParameter},
+		{Path: "/batch", Handler: vvg.executeBatch, Method: http.MethodPost, Request: VMValuesBatchRequest{}, Response: apiResponse(gin.H{"results": []VMValueBatchResult{}}), QueryParameters: decodeQueryParameter},
 	}
 	vvg.baseGroup.endpoints = baseRoutesHandlers
 
@@ -82,35 +113,161 @@ func (group *vmValuesGroup) doGetVMValue(context *gin.Context, asType vmcommon.R
 	returnOkResponse(context, returnData)
 }
 
-// executeQuery returns the data as string
+// executeQuery returns the full output of the VM. If the decode=true query parameter is provided, the values returned
+// by the contract are also decoded using its ABI
 func (group *vmValuesGroup) executeQuery(context *gin.Context) {
-	vmOutput, err := group.doExecuteQuery(context)
+	decode, err := getQueryParamDecode(context)
+	if err != nil {
+		returnBadRequest(context, "executeQuery", apiErrors.ErrValidationQueryParameterDecode)
+		return
+	}
+
+	command, vmOutput, err := group.doExecuteQueryCommand(context)
 	if err != nil {
 		returnBadRequest(context, "executeQuery", err)
 		return
 	}
+	if !decode {
+		returnOkResponse(context, vmOutput)
+		return
+	}
 
-	returnOkResponse(context, vmOutput)
+	response := gin.H{"data": vmOutput}
+	decoded, err := group.facade.DecodeSCQueryOutput(command, vmOutput)
+	if err != nil {
+		response["decodeError"] = err.Error()
+	} else {
+		response["decoded"] = decoded
+	}
+
+	shared.RespondWith(context, http.StatusOK, response, "", data.ReturnCodeSuccess)
 }
 
 func (group *vmValuesGroup) doExecuteQuery(context *gin.Context) (*vm.VMOutputApi, error) {
+	_, vmOutput, err := group.doExecuteQueryCommand(context)
+
+	return vmOutput, err
+}
+
+func (group *vmValuesGroup) doExecuteQueryCommand(context *gin.Context) (*data.SCQuery, *vm.VMOutputApi, error) {
 	request := VMValueRequest{}
 	err := context.ShouldBindJSON(&request)
 	if err != nil {
-		return nil, apiErrors.ErrInvalidJSONRequest
+		return nil, nil, apiErrors.ErrInvalidJSONRequest
 	}
 
 	command, err := createSCQuery(&request)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	vmOutput, err := group.facade.ExecuteSCQuery(command)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	return command, vmOutput, nil
+}
+
+// executeBatch executes the queries from the request body and returns their results in the same order. A query which
+// fails gets an error in its result, without failing the others
+func (group *vmValuesGroup) executeBatch(context *gin.Context) {
+	decode, err := getQueryParamDecode(context)
+	if err != nil {
+		returnBadRequest(context, "executeBatch", apiErrors.ErrValidationQueryParameterDecode)
+		return
+	}
+
+	request := VMValuesBatchRequest{}
+	err = context.ShouldBindJSON(&request)
+	if err != nil {
+		returnBadRequest(context, "executeBatch", apiErrors.ErrInvalidJSONRequest)
+		return
+	}
+
+	maxBatchSize := getMaxBatchSize(context, defaultMaxVmQueriesBatchSize)
+	if len(request.Queries) == 0 || uint64(len(request.Queries)) > maxBatchSize {
+		err = fmt.Errorf("%w: the batch must hold between 1 and %d queries", apiErrors.ErrInvalidVmQueriesBatch, maxBatchSize)
+		returnBadRequest(context, "executeBatch", err)
+		return
+	}
+
+	results := make([]VMValueBatchResult, len(request.Queries))
+	commands := make([]*data.SCQuery, 0, len(request.Queries))
+	commandsIndexes := make([]int, 0, len(request.Queries))
+	for idx := range request.Queries {
+		query := &request.Queries[idx]
+		if !isValidOutputKind(query.OutputKind) {
+			results[idx].Error = fmt.Sprintf("invalid output kind '%s'", query.OutputKind)
+			continue
+		}
+
+		command, errCreate := createSCQuery(&query.VMValueRequest)
+		if errCreate != nil {
+			results[idx].Error = errCreate.Error()
+			continue
+		}
+
+		commands = append(commands, command)
+		commandsIndexes = append(commandsIndexes, idx)
+	}
+
+	if len(commands) > 0 {
+		queriesResults := group.facade.ExecuteSCQueries(commands)
+		for i, queryResult := range queriesResults {
+			idx := commandsIndexes[i]
+			results[idx] = createBatchResult(queryResult, request.Queries[idx].OutputKind)
+			if decode && queryResult.Err == nil {
+				group.decodeBatchResult(&results[idx], commands[i], queryResult.VMOutput)
+			}
+		}
+	}
+
+	shared.RespondWith(context, http.StatusOK, gin.H{"results": results}, "", data.ReturnCodeSuccess)
+}
+
+func (group *vmValuesGroup) decodeBatchResult(result *VMValueBatchResult, command *data.SCQuery, vmOutput *vm.VMOutputApi) {
+	decoded, err := group.facade.DecodeSCQueryOutput(command, vmOutput)
+	if err != nil {
+		result.DecodeError = err.Error()
+		return
+	}
+
+	result.Decoded = decoded
+}
+
+func isValidOutputKind(outputKind string) bool {
+	switch outputKind {
+	case "", outputKindRaw, outputKindHex, outputKindString, outputKindInt:
+		return true
+	default:
+		return false
+	}
+}
+
+func createBatchResult(queryResult *data.SCQueryResult, outputKind string) VMValueBatchResult {
+	if queryResult.Err != nil {
+		return VMValueBatchResult{Error: queryResult.Err.Error()}
+	}
+
+	var returnDataKind vmcommon.ReturnDataKind
+	switch outputKind {
+	case outputKindHex:
+		returnDataKind = vmcommon.AsHex
+	case outputKindString:
+		returnDataKind = vmcommon.AsString
+	case outputKindInt:
+		returnDataKind = vmcommon.AsBigIntString
+	default:
+		return VMValueBatchResult{Data: queryResult.VMOutput}
+	}
+
+	returnData, err := queryResult.VMOutput.GetFirstReturnData(returnDataKind)
+	if err != nil {
+		return VMValueBatchResult{Error: err.Error()}
 	}
 
-	return vmOutput, nil
+	return VMValueBatchResult{Data: returnData}
 }
 
 func createSCQuery(request *VMValueRequest) (*data.SCQuery, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/ElrondNetwork/elrond-proxy-go/api/groups"
 	"github.com/ElrondNetwork/elrond-proxy-go/api/mock"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/require"
 )
 
@@ -301,3 +302,202 @@ func createSCQuery(request *groups.VMValueRequest) (*data.SCQuery, error) {
 		Arguments:  arguments,
 	}, nil
 }
+
+type vmValuesBatchResponseData struct {
+	Results []groups.VMValueBatchResult `json:"results"`
+}
+
+type vmValuesBatchResponse struct {
+	Data  vmValuesBatchResponseData `json:"data"`
+	Error string                    `json:"error"`
+}
+
+func TestExecuteBatch_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		ExecuteSCQueriesHandler: func(queries []*data.SCQuery) []*data.SCQueryResult {
+			results := make([]*data.SCQueryResult, 0, len(queries))
+			for _, query := range queries {
+				if query.FuncName == "fails" {
+					results = append(results, &data.SCQueryResult{Err: errors.New("execution failed")})
+					continue
+				}
+
+				results = append(results, &data.SCQueryResult{VMOutput: &vm.VMOutputApi{ReturnData: [][]byte{big.NewInt(42).Bytes()}}})
+			}
+
+			return results
+		},
+	}
+
+	request := groups.VMValuesBatchRequest{
+		Queries: []groups.VMValueBatchQuery{
+			{VMValueRequest: groups.VMValueRequest{ScAddress: DummyScAddress, FuncName: "function"}, OutputKind: "int"},
+			{VMValueRequest: groups.VMValueRequest{ScAddress: DummyScAddress, FuncName: "function"}, OutputKind: "hex"},
+			{VMValueRequest: groups.VMValueRequest{ScAddress: DummyScAddress, FuncName: "fails"}},
+			{VMValueRequest: groups.VMValueRequest{ScAddress: DummyScAddress, FuncName: "function", Args: []string{"not hex"}}},
+			{VMValueRequest: groups.VMValueRequest{ScAddress: DummyScAddress, FuncName: "function"}, OutputKind: "unknown"},
+			{VMValueRequest: groups.VMValueRequest{ScAddress: DummyScAddress, FuncName: "function"}},
+		},
+	}
+
+	response := vmValuesBatchResponse{}
+	statusCode := doPost(t, facade, "/vm-values/batch", request, &response)
+
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Empty(t, response.Error)
+	results := response.Data.Results
+	require.Len(t, results, 6)
+	require.Equal(t, groups.VMValueBatchResult{Data: "42"}, results[0])
+	require.Equal(t, groups.VMValueBatchResult{Data: "2a"}, results[1])
+	require.Equal(t, groups.VMValueBatchResult{Error: "execution failed"}, results[2])
+	require.Contains(t, results[3].Error, "is not a valid hex string")
+	require.Contains(t, results[4].Error, "invalid output kind")
+	rawOutput, ok := results[5].Data.(map[string]interface{})
+	require.True(t, ok)
+	require.Len(t, rawOutput["returnData"], 1)
+}
+
+func TestExecuteBatch_InvalidBatchShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		ExecuteSCQueriesHandler: func(queries []*data.SCQuery) []*data.SCQueryResult {
+			require.Fail(t, "should not have been called")
+			return nil
+		},
+	}
+
+	response := vmValuesBatchResponse{}
+	statusCode := doPost(t, facade, "/vm-values/batch", []byte("dummy"), &response)
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Contains(t, response.Error, apiErrors.ErrInvalidJSONRequest.Error())
+
+	response = vmValuesBatchResponse{}
+	statusCode = doPost(t, facade, "/vm-values/batch", groups.VMValuesBatchRequest{}, &response)
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Contains(t, response.Error, apiErrors.ErrInvalidVmQueriesBatch.Error())
+}
+
+func TestExecuteBatch_ShouldApplyTheMaxBatchSizeFromConfig(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		ExecuteSCQueriesHandler: func(queries []*data.SCQuery) []*data.SCQueryResult {
+			return make([]*data.SCQueryResult, len(queries))
+		},
+	}
+	group, _ := groups.NewVmValuesGroup(facade)
+	ws := gin.New()
+	apiConfig := data.ApiRoutesConfig{
+		APIPackages: map[string]data.APIPackageConfig{
+			"vm-values": {Routes: []data.RouteConfig{{Name: "/batch", Open: true, MaxBatchSize: 2}}},
+		},
+	}
+	group.RegisterRoutes(ws.Group(vmValuesPath), apiConfig, func(_ *gin.Context) {}, func(_ *gin.Context) {})
+
+	query := groups.VMValueBatchQuery{VMValueRequest: groups.VMValueRequest{ScAddress: DummyScAddress, FuncName: "function"}}
+	request := groups.VMValuesBatchRequest{Queries: []groups.VMValueBatchQuery{query, query, query}}
+	requestAsBytes, _ := json.Marshal(request)
+	httpRequest, _ := http.NewRequest("POST", "/vm-values/batch", bytes.NewBuffer(requestAsBytes))
+	responseRecorder := httptest.NewRecorder()
+	ws.ServeHTTP(responseRecorder, httpRequest)
+
+	response := vmValuesBatchResponse{}
+	loadResponse(responseRecorder.Body, &response)
+	require.Equal(t, http.StatusBadRequest, responseRecorder.Code)
+	require.Contains(t, response.Error, "between 1 and 2 queries")
+}
+
+func TestQuery_WithDecodeShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		ExecuteSCQueryHandler: func(query *data.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
+			return &vm.VMOutputApi{
+				ReturnData: [][]byte{big.NewInt(42).Bytes()},
+			}, nil
+		},
+		DecodeSCQueryOutputHandler: func(query *data.SCQuery, vmOutput *vm.VMOutputApi) ([]interface{}, error) {
+			if query.FuncName == "unknown" {
+				return nil, errors.New("no ABI")
+			}
+
+			return []interface{}{big.NewInt(0).SetBytes(vmOutput.ReturnData[0]).String()}, nil
+		},
+	}
+
+	response := struct {
+		Data struct {
+			Data        *vm.VMOutputApi `json:"data"`
+			Decoded     []interface{}   `json:"decoded"`
+			DecodeError string          `json:"decodeError"`
+		} `json:"data"`
+		Error string `json:"error"`
+	}{}
+	request := groups.VMValueRequest{ScAddress: DummyScAddress, FuncName: "function"}
+	statusCode := doPost(t, facade, "/vm-values/query?decode=true", request, &response)
+
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Empty(t, response.Error)
+	require.Len(t, response.Data.Data.ReturnData, 1)
+	require.Equal(t, []interface{}{"42"}, response.Data.Decoded)
+
+	request.FuncName = "unknown"
+	statusCode = doPost(t, facade, "/vm-values/query?decode=true", request, &response)
+
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Equal(t, "no ABI", response.Data.DecodeError)
+
+	statusCode = doPost(t, facade, "/vm-values/query?decode=maybe", request, &response)
+
+	require.Equal(t, http.StatusBadRequest, statusCode)
+	require.Contains(t, response.Error, apiErrors.ErrValidationQueryParameterDecode.Error())
+}
+
+func TestExecuteBatch_WithDecodeShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		ExecuteSCQueriesHandler: func(queries []*data.SCQuery) []*data.SCQueryResult {
+			results := make([]*data.SCQueryResult, 0, len(queries))
+			for _, query := range queries {
+				if query.FuncName == "fails" {
+					results = append(results, &data.SCQueryResult{Err: errors.New("execution failed")})
+					continue
+				}
+
+				results = append(results, &data.SCQueryResult{VMOutput: &vm.VMOutputApi{ReturnData: [][]byte{big.NewInt(42).Bytes()}}})
+			}
+
+			return results
+		},
+		DecodeSCQueryOutputHandler: func(query *data.SCQuery, vmOutput *vm.VMOutputApi) ([]interface{}, error) {
+			if query.FuncName == "unknown" {
+				return nil, errors.New("no ABI")
+			}
+
+			return []interface{}{big.NewInt(0).SetBytes(vmOutput.ReturnData[0]).String()}, nil
+		},
+	}
+
+	request := groups.VMValuesBatchRequest{
+		Queries: []groups.VMValueBatchQuery{
+			{VMValueRequest: groups.VMValueRequest{ScAddress: DummyScAddress, FuncName: "function"}, OutputKind: "hex"},
+			{VMValueRequest: groups.VMValueRequest{ScAddress: DummyScAddress, FuncName: "unknown"}, OutputKind: "hex"},
+			{VMValueRequest: groups.VMValueRequest{ScAddress: DummyScAddress, FuncName: "fails"}},
+		},
+	}
+
+	response := vmValuesBatchResponse{}
+	statusCode := doPost(t, facade, "/vm-values/batch?decode=true", request, &response)
+
+	require.Equal(t, http.StatusOK, statusCode)
+	require.Empty(t, response.Error)
+	results := response.Data.Results
+	require.Len(t, results, 3)
+	require.Equal(t, groups.VMValueBatchResult{Data: "2a", Decoded: []interface{}{"42"}}, results[0])
+	require.Equal(t, groups.VMValueBatchResult{Data: "2a", DecodeError: "no ABI"}, results[1])
+	require.Equal(t, groups.VMValueBatchResult{Error: "execution failed"}, results[2])
+}
@@ -1,16 +1,22 @@
 package groups
 
 import (
+	"context"
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go/data/vm"
+	"github.com/ElrondNetwork/elrond-proxy-go/api/graphql"
+	"github.com/ElrondNetwork/elrond-proxy-go/api/jsonrpc"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	gql "github.com/graphql-go/graphql"
 )
 
 // AccountsFacadeHandler interface defines methods that can be used from facade context variable
 type AccountsFacadeHandler interface {
 	GetAccount(address string) (*data.Account, error)
-	GetTransactions(address string) ([]data.DatabaseTransaction, error)
+	GetTransactions(address string, options data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error)
+	GetTokenTransfers(address string, options data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error)
+	GetEvents(address string, identifier string, options data.TransactionsHistoryOptions) ([]data.DatabaseEvent, error)
 	GetShardIDForAddress(address string) (uint32, error)
 	GetValueForKey(address string, key string) (string, error)
 	GetAllESDTTokens(address string) (*data.GenericAPIResponse, error)
@@ -19,6 +25,13 @@ type AccountsFacadeHandler interface {
 	GetESDTsWithRole(address string, role string) (*data.GenericAPIResponse, error)
 	GetESDTNftTokenData(address string, key string, nonce uint64) (*data.GenericAPIResponse, error)
 	GetNFTTokenIDsRegisteredByAddress(address string) (*data.GenericAPIResponse, error)
+	GetAccountAtBlock(address string, options data.AccountQueryOptions) (*data.ResponseAccount, error)
+	GetValueForKeyAtBlock(address string, key string, options data.AccountQueryOptions) (*data.AccountKeyValueResponseData, error)
+	GetKeyValuePairsAtBlock(address string, options data.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetAllESDTTokensAtBlock(address string, options data.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetESDTTokenDataAtBlock(address string, key string, options data.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetESDTNftTokenDataAtBlock(address string, key string, nonce uint64, options data.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetBulkAccounts(addresses []string, fields []string) (map[string]*data.BulkAccountResult, error)
 }
 
 // BlocksFacadeHandler interface defines methods that can be used from facade context variable
@@ -38,6 +51,12 @@ type HyperBlockFacadeHandler interface {
 	GetHyperBlockByHash(hash string) (*data.HyperblockApiResponse, error)
 }
 
+// TransactionDecoderFacadeHandler defines the action needed for decoding the contract calls of the transactions from
+// the blocks and the hyperblocks
+type TransactionDecoderFacadeHandler interface {
+	DecodeTransaction(tx *data.FullTransaction)
+}
+
 // NetworkFacadeHandler interface defines methods that can be used from facade context variable
 type NetworkFacadeHandler interface {
 	GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error)
@@ -49,6 +68,11 @@ type NetworkFacadeHandler interface {
 	GetEnableEpochsMetrics() (*data.GenericAPIResponse, error)
 }
 
+// TokenTransfersFacadeHandler interface defines the method used by the network group for fetching a token's transfers
+type TokenTransfersFacadeHandler interface {
+	GetTokenTransfers(address string, options data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error)
+}
+
 // NodeFacadeHandler interface defines methods that can be used from facade context variable
 type NodeFacadeHandler interface {
 	GetHeartbeatData() (*data.HeartbeatResponse, error)
@@ -65,6 +89,8 @@ type TransactionFacadeHandler interface {
 	GetTransactionStatus(txHash string, sender string) (string, error)
 	GetTransaction(txHash string, withResults bool) (*data.FullTransaction, error)
 	GetTransactionByHashAndSenderAddress(txHash string, sndAddr string, withEvents bool) (*data.FullTransaction, int, error)
+	GetSCResultsByTxHash(txHash string) ([]data.DatabaseSCResult, error)
+	DecodeTransaction(tx *data.FullTransaction)
 }
 
 // ProofFacadeHandler interface defines methods that can be used from facade context variable
@@ -82,6 +108,8 @@ type ValidatorFacadeHandler interface {
 // VmValuesFacadeHandler interface defines methods that can be used from `elrondFacade` context variable
 type VmValuesFacadeHandler interface {
 	ExecuteSCQuery(*data.SCQuery) (*vm.VMOutputApi, error)
+	ExecuteSCQueries([]*data.SCQuery) []*data.SCQueryResult
+	DecodeSCQueryOutput(query *data.SCQuery, vmOutput *vm.VMOutputApi) ([]interface{}, error)
 }
 
 // ActionsFacadeHandler interface defines methods that can be used from facade context variable
@@ -89,3 +117,36 @@ type ActionsFacadeHandler interface {
 	ReloadObservers() data.NodesReloadResponse
 	ReloadFullHistoryObservers() data.NodesReloadResponse
 }
+
+// RpcFacadeHandler interface defines the facade methods exposed through the JSON-RPC endpoint
+type RpcFacadeHandler interface {
+	AccountsFacadeHandler
+	TransactionFacadeHandler
+	VmValuesFacadeHandler
+	BlocksFacadeHandler
+	HyperBlockFacadeHandler
+	NetworkFacadeHandler
+}
+
+// GraphQLFacadeHandler interface defines the facade methods used for resolving the GraphQL queries
+type GraphQLFacadeHandler interface {
+	AccountsFacadeHandler
+	TransactionFacadeHandler
+	BlocksFacadeHandler
+	HyperBlockFacadeHandler
+}
+
+// GraphQLExecutor defines what a GraphQL queries executor should be able to do
+type GraphQLExecutor interface {
+	Execute(ctx context.Context, request *graphql.Request) (*gql.Result, bool)
+	IsInterfaceNil() bool
+}
+
+// RpcDispatcher defines what a JSON-RPC methods dispatcher should be able to do
+type RpcDispatcher interface {
+	RegisterMethod(name string, handler jsonrpc.MethodHandler) error
+	SetLimits(maxBatchSize int, maxConcurrentCalls int) error
+	Methods() []string
+	HandleMessage(message []byte) (interface{}, bool)
+	IsInterfaceNil() bool
+}
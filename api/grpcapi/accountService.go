@@ -0,0 +1,193 @@
+package grpcapi
+
+import (
+	"context"
+
+	apiErrors "github.com/ElrondNetwork/elrond-proxy-go/api/errors"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+type accountService struct {
+	facade AccountFacadeHandler
+}
+
+// GetAccount returns the account's data
+func (as *accountService) GetAccount(_ context.Context, request *AddressRequest) (*Account, error) {
+	if len(request.Address) == 0 {
+		return nil, invalidArgumentError(apiErrors.ErrEmptyAddress)
+	}
+
+	account, err := as.facade.GetAccount(request.Address)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return fromAccount(account), nil
+}
+
+// GetShardIDForAddress returns the shard of the given address
+func (as *accountService) GetShardIDForAddress(_ context.Context, request *AddressRequest) (*ShardIDResponse, error) {
+	if len(request.Address) == 0 {
+		return nil, invalidArgumentError(apiErrors.ErrEmptyAddress)
+	}
+
+	shardID, err := as.facade.GetShardIDForAddress(request.Address)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &ShardIDResponse{ShardID: shardID}, nil
+}
+
+// GetValueForKey returns the value stored under the given key of the account's storage
+func (as *accountService) GetValueForKey(_ context.Context, request *AccountKeyRequest) (*ValueResponse, error) {
+	if len(request.Address) == 0 {
+		return nil, invalidArgumentError(apiErrors.ErrEmptyAddress)
+	}
+	if len(request.Key) == 0 {
+		return nil, invalidArgumentError(apiErrors.ErrEmptyKey)
+	}
+
+	value, err := as.facade.GetValueForKey(request.Address, request.Key)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &ValueResponse{Value: value}, nil
+}
+
+// GetKeyValuePairs returns all the key-value pairs of the account's storage
+func (as *accountService) GetKeyValuePairs(_ context.Context, request *AddressRequest) (*KeyValuePairsResponse, error) {
+	if len(request.Address) == 0 {
+		return nil, invalidArgumentError(apiErrors.ErrEmptyAddress)
+	}
+
+	response, err := as.facade.GetKeyValuePairs(request.Address)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	pairs := &KeyValuePairsResponse{}
+	err = convertResponseData(response, pairs)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return pairs, nil
+}
+
+// GetTransactions returns the account's transactions, as stored in the external storage
+func (as *accountService) GetTransactions(_ context.Context, request *AddressRequest) (*DatabaseTransactionsResponse, error) {
+	if len(request.Address) == 0 {
+		return nil, invalidArgumentError(apiErrors.ErrEmptyAddress)
+	}
+
+	txs, err := as.facade.GetTransactions(request.Address, data.TransactionsHistoryOptions{})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &DatabaseTransactionsResponse{Transactions: fromDatabaseTransactions(txs)}, nil
+}
+
+// GetAllESDTTokens returns all the ESDT tokens owned by the account, sorted by their identifier
+func (as *accountService) GetAllESDTTokens(_ context.Context, request *AddressRequest) (*ESDTTokensResponse, error) {
+	if len(request.Address) == 0 {
+		return nil, invalidArgumentError(apiErrors.ErrEmptyAddress)
+	}
+
+	response, err := as.facade.GetAllESDTTokens(request.Address)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	tokens := &data.ESDTTokensResponseData{}
+	err = convertResponseData(response, tokens)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &ESDTTokensResponse{Tokens: fromESDTTokens(tokens.ESDTs)}, nil
+}
+
+// GetESDTTokenData returns the account's data for the given ESDT token
+func (as *accountService) GetESDTTokenData(_ context.Context, request *ESDTTokenRequest) (*ESDTToken, error) {
+	if len(request.Address) == 0 {
+		return nil, invalidArgumentError(apiErrors.ErrEmptyAddress)
+	}
+	if len(request.TokenIdentifier) == 0 {
+		return nil, invalidArgumentError(apiErrors.ErrEmptyTokenIdentifier)
+	}
+
+	response, err := as.facade.GetESDTTokenData(request.Address, request.TokenIdentifier)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return esdtTokenFromResponse(response)
+}
+
+// GetESDTNftTokenData returns the account's data for the given NFT
+func (as *accountService) GetESDTNftTokenData(_ context.Context, request *ESDTNftTokenRequest) (*ESDTToken, error) {
+	if len(request.Address) == 0 {
+		return nil, invalidArgumentError(apiErrors.ErrEmptyAddress)
+	}
+	if len(request.TokenIdentifier) == 0 {
+		return nil, invalidArgumentError(apiErrors.ErrEmptyTokenIdentifier)
+	}
+
+	response, err := as.facade.GetESDTNftTokenData(request.Address, request.TokenIdentifier, request.Nonce)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return esdtTokenFromResponse(response)
+}
+
+// GetESDTsWithRole returns the identifiers of the tokens for which the account has the given role
+func (as *accountService) GetESDTsWithRole(_ context.Context, request *ESDTsWithRoleRequest) (*TokenIdentifiersResponse, error) {
+	if len(request.Address) == 0 {
+		return nil, invalidArgumentError(apiErrors.ErrEmptyAddress)
+	}
+
+	response, err := as.facade.GetESDTsWithRole(request.Address, request.Role)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return tokenIdentifiersFromResponse(response)
+}
+
+// GetNFTTokenIDsRegisteredByAddress returns the identifiers of the NFTs registered by the account
+func (as *accountService) GetNFTTokenIDsRegisteredByAddress(_ context.Context, request *AddressRequest) (*TokenIdentifiersResponse, error) {
+	if len(request.Address) == 0 {
+		return nil, invalidArgumentError(apiErrors.ErrEmptyAddress)
+	}
+
+	response, err := as.facade.GetNFTTokenIDsRegisteredByAddress(request.Address)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return tokenIdentifiersFromResponse(response)
+}
+
+func esdtTokenFromResponse(response *data.GenericAPIResponse) (*ESDTToken, error) {
+	tokenData := &data.ESDTTokenResponseData{}
+	err := convertResponseData(response, tokenData)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return fromESDTToken(tokenData.TokenData), nil
+}
+
+func tokenIdentifiersFromResponse(response *data.GenericAPIResponse) (*TokenIdentifiersResponse, error) {
+	tokens := &TokenIdentifiersResponse{}
+	err := convertResponseData(response, tokens)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return tokens, nil
+}
@@ -0,0 +1,141 @@
+package grpcapi
+
+import (
+	"context"
+	"time"
+
+	apiErrors "github.com/ElrondNetwork/elrond-proxy-go/api/errors"
+)
+
+type blockService struct {
+	facade          BlockFacadeHandler
+	pollingInterval time.Duration
+}
+
+// GetBlockByNonce returns the block with the given nonce from the given shard
+func (bs *blockService) GetBlockByNonce(_ context.Context, request *BlockByNonceRequest) (*Block, error) {
+	response, err := bs.facade.GetBlockByNonce(request.Shard, request.Nonce, request.WithTxs)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return fromBlock(&response.Data.Block), nil
+}
+
+// GetBlockByHash returns the block with the given hash from the given shard
+func (bs *blockService) GetBlockByHash(_ context.Context, request *BlockByHashRequest) (*Block, error) {
+	if len(request.Hash) == 0 {
+		return nil, invalidArgumentError(apiErrors.ErrInvalidBlockHashParam)
+	}
+
+	response, err := bs.facade.GetBlockByHash(request.Shard, request.Hash, request.WithTxs)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return fromBlock(&response.Data.Block), nil
+}
+
+// GetAtlasBlock returns the block with the given nonce from the given shard, as stored in the external storage
+func (bs *blockService) GetAtlasBlock(_ context.Context, request *AtlasBlockRequest) (*AtlasBlock, error) {
+	block, err := bs.facade.GetAtlasBlockByShardIDAndNonce(request.Shard, request.Nonce)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &AtlasBlock{
+		Nonce:        block.Nonce,
+		Hash:         block.Hash,
+		Transactions: fromDatabaseTransactions(block.Transactions),
+	}, nil
+}
+
+// GetHyperBlockByNonce returns the hyperblock with the given nonce
+func (bs *blockService) GetHyperBlockByNonce(_ context.Context, request *HyperBlockByNonceRequest) (*Hyperblock, error) {
+	response, err := bs.facade.GetHyperBlockByNonce(request.Nonce)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return fromHyperblock(&response.Data.Hyperblock), nil
+}
+
+// GetHyperBlockByHash returns the hyperblock with the given hash
+func (bs *blockService) GetHyperBlockByHash(_ context.Context, request *HyperBlockByHashRequest) (*Hyperblock, error) {
+	if len(request.Hash) == 0 {
+		return nil, invalidArgumentError(apiErrors.ErrInvalidBlockHashParam)
+	}
+
+	response, err := bs.facade.GetHyperBlockByHash(request.Hash)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return fromHyperblock(&response.Data.Hyperblock), nil
+}
+
+// StreamHyperBlocks sends the hyperblocks in order, starting with the requested nonce or, if FromLatest is set, with the
+// latest fully synchronized one. The latest fully synchronized hyperblock nonce is polled and each new hyperblock is sent
+// as soon as it becomes available. Observer errors do not end the stream, the hyperblock being retried at the next
+// polling round
+func (bs *blockService) StreamHyperBlocks(request *StreamHyperBlocksRequest, stream BlockService_StreamHyperBlocksServer) error {
+	ctx := stream.Context()
+	nextNonce := request.StartNonce
+	if request.FromLatest {
+		latestNonce, err := bs.facade.GetLatestFullySynchronizedHyperblockNonce()
+		if err != nil {
+			return toStatusError(err)
+		}
+		nextNonce = latestNonce
+	}
+
+	ticker := time.NewTicker(bs.pollingInterval)
+	defer ticker.Stop()
+
+	for {
+		var err error
+		nextNonce, err = bs.sendAvailableHyperblocks(ctx, stream, nextNonce)
+		if err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// sendAvailableHyperblocks sends the hyperblocks up to the latest fully synchronized one and returns the nonce of
+// the next hyperblock to be sent. Only the errors occurred while writing on the stream are returned
+func (bs *blockService) sendAvailableHyperblocks(
+	ctx context.Context,
+	stream BlockService_StreamHyperBlocksServer,
+	nextNonce uint64,
+) (uint64, error) {
+	latestNonce, err := bs.facade.GetLatestFullySynchronizedHyperblockNonce()
+	if err != nil {
+		log.Debug("hyperblocks stream: cannot get the latest nonce", "error", err.Error())
+		return nextNonce, nil
+	}
+
+	for ; nextNonce <= latestNonce; nextNonce++ {
+		if ctx.Err() != nil {
+			return nextNonce, nil
+		}
+
+		response, errGet := bs.facade.GetHyperBlockByNonce(nextNonce)
+		if errGet != nil {
+			log.Debug("hyperblocks stream: cannot get hyperblock", "nonce", nextNonce, "error", errGet.Error())
+			return nextNonce, nil
+		}
+
+		err = stream.Send(fromHyperblock(&response.Data.Hyperblock))
+		if err != nil {
+			return nextNonce, err
+		}
+	}
+
+	return nextNonce, nil
+}
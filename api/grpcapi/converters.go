@@ -0,0 +1,386 @@
+package grpcapi
+
+import (
+	"encoding/json"
+	"math/big"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/vm"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// convertResponseData converts the generic data field of a node's response into the provided structure
+func convertResponseData(response *data.GenericAPIResponse, destination interface{}) error {
+	if response == nil || response.Data == nil {
+		return ErrEmptyResponseData
+	}
+
+	buff, err := json.Marshal(response.Data)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(buff, destination)
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return ""
+	}
+
+	return value.String()
+}
+
+func toDataTransaction(tx *Transaction) *data.Transaction {
+	return &data.Transaction{
+		Nonce:            tx.Nonce,
+		Value:            tx.Value,
+		Receiver:         tx.Receiver,
+		Sender:           tx.Sender,
+		SenderUsername:   tx.SenderUsername,
+		ReceiverUsername: tx.ReceiverUsername,
+		GasPrice:         tx.GasPrice,
+		GasLimit:         tx.GasLimit,
+		Data:             tx.Data,
+		Signature:        tx.Signature,
+		ChainID:          tx.ChainID,
+		Version:          tx.Version,
+		Options:          tx.Options,
+	}
+}
+
+func fromAccount(account *data.Account) *Account {
+	return &Account{
+		Address:         account.Address,
+		Nonce:           account.Nonce,
+		Balance:         account.Balance,
+		Username:        account.Username,
+		Code:            account.Code,
+		CodeHash:        account.CodeHash,
+		RootHash:        account.RootHash,
+		CodeMetadata:    account.CodeMetadata,
+		DeveloperReward: account.DeveloperReward,
+		OwnerAddress:    account.OwnerAddress,
+	}
+}
+
+func fromESDTToken(token *data.ESDTToken) *ESDTToken {
+	if token == nil {
+		return &ESDTToken{}
+	}
+
+	return &ESDTToken{
+		TokenIdentifier: token.TokenIdentifier,
+		Balance:         token.Balance,
+		Properties:      token.Properties,
+		Nonce:           token.Nonce,
+		Name:            token.Name,
+		Creator:         token.Creator,
+		Royalties:       token.Royalties,
+		Hash:            token.Hash,
+		URIs:            token.URIs,
+		Attributes:      token.Attributes,
+	}
+}
+
+func fromESDTTokens(tokens map[string]*data.ESDTToken) []*ESDTToken {
+	result := make([]*ESDTToken, 0, len(tokens))
+	for _, token := range tokens {
+		result = append(result, fromESDTToken(token))
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].TokenIdentifier < result[j].TokenIdentifier
+	})
+
+	return result
+}
+
+func fromDatabaseTransaction(tx *data.DatabaseTransaction) *DatabaseTransaction {
+	return &DatabaseTransaction{
+		Hash:          tx.Hash,
+		Fee:           tx.Fee,
+		MiniBlockHash: tx.MBHash,
+		Nonce:         tx.Nonce,
+		Round:         tx.Round,
+		Value:         tx.Value,
+		Receiver:      tx.Receiver,
+		Sender:        tx.Sender,
+		ReceiverShard: tx.ReceiverShard,
+		SenderShard:   tx.SenderShard,
+		GasPrice:      tx.GasPrice,
+		GasLimit:      tx.GasLimit,
+		GasUsed:       tx.GasUsed,
+		Data:          tx.Data,
+		Signature:     tx.Signature,
+		Timestamp:     int64(tx.Timestamp),
+		Status:        tx.Status,
+	}
+}
+
+func fromDatabaseTransactions(txs []data.DatabaseTransaction) []*DatabaseTransaction {
+	result := make([]*DatabaseTransaction, 0, len(txs))
+	for i := range txs {
+		result = append(result, fromDatabaseTransaction(&txs[i]))
+	}
+
+	return result
+}
+
+func fromSmartContractResult(scr *transaction.ApiSmartContractResult) *SmartContractResult {
+	return &SmartContractResult{
+		Hash:           scr.Hash,
+		Nonce:          scr.Nonce,
+		Value:          bigIntToString(scr.Value),
+		Receiver:       scr.RcvAddr,
+		Sender:         scr.SndAddr,
+		RelayerAddress: scr.RelayerAddr,
+		RelayedValue:   bigIntToString(scr.RelayedValue),
+		Code:           scr.Code,
+		Data:           scr.Data,
+		PrevTxHash:     scr.PrevTxHash,
+		OriginalTxHash: scr.OriginalTxHash,
+		GasLimit:       scr.GasLimit,
+		GasPrice:       scr.GasPrice,
+		CallType:       int32(scr.CallType),
+		CodeMetadata:   scr.CodeMetadata,
+		ReturnMessage:  scr.ReturnMessage,
+		OriginalSender: scr.OriginalSender,
+	}
+}
+
+func fromReceipt(receipt *transaction.ReceiptApi) *Receipt {
+	if receipt == nil {
+		return nil
+	}
+
+	return &Receipt{
+		Value:  bigIntToString(receipt.Value),
+		Sender: receipt.SndAddr,
+		Data:   receipt.Data,
+		TxHash: receipt.TxHash,
+	}
+}
+
+func fromFullTransaction(tx *data.FullTransaction) *FullTransaction {
+	scResults := make([]*SmartContractResult, 0, len(tx.ScResults))
+	for _, scr := range tx.ScResults {
+		scResults = append(scResults, fromSmartContractResult(scr))
+	}
+
+	return &FullTransaction{
+		Type:                              tx.Type,
+		Hash:                              tx.Hash,
+		Nonce:                             tx.Nonce,
+		Round:                             tx.Round,
+		Epoch:                             tx.Epoch,
+		Value:                             tx.Value,
+		Receiver:                          tx.Receiver,
+		Sender:                            tx.Sender,
+		SenderUsername:                    tx.SenderUsername,
+		ReceiverUsername:                  tx.ReceiverUsername,
+		GasPrice:                          tx.GasPrice,
+		GasLimit:                          tx.GasLimit,
+		Data:                              tx.Data,
+		CodeMetadata:                      tx.CodeMetadata,
+		Code:                              tx.Code,
+		PreviousTransactionHash:           tx.PreviousTransactionHash,
+		OriginalTransactionHash:           tx.OriginalTransactionHash,
+		ReturnMessage:                     tx.ReturnMessage,
+		OriginalSender:                    tx.OriginalSender,
+		Signature:                         tx.Signature,
+		SourceShard:                       tx.SourceShard,
+		DestinationShard:                  tx.DestinationShard,
+		BlockNonce:                        tx.BlockNonce,
+		BlockHash:                         tx.BlockHash,
+		NotarizedAtSourceInMetaNonce:      tx.NotarizedAtSourceInMetaNonce,
+		NotarizedAtSourceInMetaHash:       tx.NotarizedAtSourceInMetaHash,
+		NotarizedAtDestinationInMetaNonce: tx.NotarizedAtDestinationInMetaNonce,
+		NotarizedAtDestinationInMetaHash:  tx.NotarizedAtDestinationInMetaHash,
+		MiniBlockType:                     tx.MiniBlockType,
+		MiniBlockHash:                     tx.MiniBlockHash,
+		Status:                            string(tx.Status),
+		HyperblockNonce:                   tx.HyperblockNonce,
+		HyperblockHash:                    tx.HyperblockHash,
+		Receipt:                           fromReceipt(tx.Receipt),
+		SmartContractResults:              scResults,
+	}
+}
+
+func fromFullTransactions(txs []*data.FullTransaction) []*FullTransaction {
+	result := make([]*FullTransaction, 0, len(txs))
+	for _, tx := range txs {
+		result = append(result, fromFullTransaction(tx))
+	}
+
+	return result
+}
+
+func fromSimulationResults(results *data.TransactionSimulationResults) *SimulationResults {
+	scResults := make(map[string]*SmartContractResult, len(results.ScResults))
+	for hash, scr := range results.ScResults {
+		scResults[hash] = fromSmartContractResult(scr)
+	}
+
+	receipts := make(map[string]*Receipt, len(results.Receipts))
+	for hash, receipt := range results.Receipts {
+		receipts[hash] = fromReceipt(receipt)
+	}
+
+	return &SimulationResults{
+		Status:     string(results.Status),
+		FailReason: results.FailReason,
+		Hash:       results.Hash,
+		ScResults:  scResults,
+		Receipts:   receipts,
+	}
+}
+
+func fromNotarizedBlocks(blocks []*data.NotarizedBlock) []*NotarizedBlock {
+	result := make([]*NotarizedBlock, 0, len(blocks))
+	for _, block := range blocks {
+		result = append(result, &NotarizedBlock{
+			Hash:  block.Hash,
+			Nonce: block.Nonce,
+			Round: block.Round,
+			Shard: block.Shard,
+		})
+	}
+
+	return result
+}
+
+func fromEpochStartInfo(info *data.EpochStartInfo) *EpochStartInfo {
+	if info == nil {
+		return nil
+	}
+
+	return &EpochStartInfo{
+		TotalSupply:                      info.TotalSupply,
+		TotalToDistribute:                info.TotalToDistribute,
+		TotalNewlyMinted:                 info.TotalNewlyMinted,
+		RewardsPerBlock:                  info.RewardsPerBlock,
+		RewardsForProtocolSustainability: info.RewardsForProtocolSustainability,
+		NodePrice:                        info.NodePrice,
+		PrevEpochStartRound:              info.PrevEpochStartRound,
+		PrevEpochStartHash:               info.PrevEpochStartHash,
+	}
+}
+
+func fromBlock(block *data.Block) *Block {
+	miniBlocks := make([]*MiniBlock, 0, len(block.MiniBlocks))
+	for _, miniBlock := range block.MiniBlocks {
+		miniBlocks = append(miniBlocks, &MiniBlock{
+			Hash:             miniBlock.Hash,
+			Type:             miniBlock.Type,
+			SourceShard:      miniBlock.SourceShard,
+			DestinationShard: miniBlock.DestinationShard,
+			Transactions:     fromFullTransactions(miniBlock.Transactions),
+		})
+	}
+
+	return &Block{
+		Nonce:                  block.Nonce,
+		Round:                  block.Round,
+		Hash:                   block.Hash,
+		PrevBlockHash:          block.PrevBlockHash,
+		Epoch:                  block.Epoch,
+		Shard:                  block.Shard,
+		NumTxs:                 block.NumTxs,
+		NotarizedBlocks:        fromNotarizedBlocks(block.NotarizedBlocks),
+		MiniBlocks:             miniBlocks,
+		Timestamp:              int64(block.Timestamp),
+		AccumulatedFees:        block.AccumulatedFees,
+		DeveloperFees:          block.DeveloperFees,
+		AccumulatedFeesInEpoch: block.AccumulatedFeesInEpoch,
+		DeveloperFeesInEpoch:   block.DeveloperFeesInEpoch,
+		EpochStartInfo:         fromEpochStartInfo(block.EpochStartInfo),
+		Status:                 block.Status,
+	}
+}
+
+func fromHyperblock(hyperblock *data.Hyperblock) *Hyperblock {
+	return &Hyperblock{
+		Nonce:                  hyperblock.Nonce,
+		Round:                  hyperblock.Round,
+		Hash:                   hyperblock.Hash,
+		PrevBlockHash:          hyperblock.PrevBlockHash,
+		Epoch:                  hyperblock.Epoch,
+		NumTxs:                 hyperblock.NumTxs,
+		ShardBlocks:            fromNotarizedBlocks(hyperblock.ShardBlocks),
+		Transactions:           fromFullTransactions(hyperblock.Transactions),
+		AccumulatedFees:        hyperblock.AccumulatedFees,
+		DeveloperFees:          hyperblock.DeveloperFees,
+		AccumulatedFeesInEpoch: hyperblock.AccumulatedFeesInEpoch,
+		DeveloperFeesInEpoch:   hyperblock.DeveloperFeesInEpoch,
+		EpochStartInfo:         fromEpochStartInfo(hyperblock.EpochStartInfo),
+		Status:                 hyperblock.Status,
+	}
+}
+
+func fromVMOutput(vmOutput *vm.VMOutputApi) *VMOutput {
+	outputAccounts := make(map[string]*OutputAccount, len(vmOutput.OutputAccounts))
+	for key, account := range vmOutput.OutputAccounts {
+		if account == nil {
+			continue
+		}
+
+		storageUpdates := make(map[string]*StorageUpdate, len(account.StorageUpdates))
+		for storageKey, update := range account.StorageUpdates {
+			if update == nil {
+				continue
+			}
+			storageUpdates[storageKey] = &StorageUpdate{
+				Offset: update.Offset,
+				Data:   update.Data,
+			}
+		}
+
+		outputTransfers := make([]*OutputTransfer, 0, len(account.OutputTransfers))
+		for _, transfer := range account.OutputTransfers {
+			outputTransfers = append(outputTransfers, &OutputTransfer{
+				Value:    bigIntToString(transfer.Value),
+				GasLimit: transfer.GasLimit,
+				Data:     transfer.Data,
+				CallType: int32(transfer.CallType),
+			})
+		}
+
+		outputAccounts[key] = &OutputAccount{
+			Address:         account.Address,
+			Nonce:           account.Nonce,
+			Balance:         bigIntToString(account.Balance),
+			BalanceDelta:    bigIntToString(account.BalanceDelta),
+			StorageUpdates:  storageUpdates,
+			Code:            account.Code,
+			CodeMetadata:    account.CodeMetadata,
+			OutputTransfers: outputTransfers,
+			CallType:        int32(account.CallType),
+		}
+	}
+
+	logs := make([]*LogEntry, 0, len(vmOutput.Logs))
+	for _, entry := range vmOutput.Logs {
+		if entry == nil {
+			continue
+		}
+		logs = append(logs, &LogEntry{
+			Identifier: entry.Identifier,
+			Address:    entry.Address,
+			Topics:     entry.Topics,
+			Data:       entry.Data,
+		})
+	}
+
+	return &VMOutput{
+		ReturnData:      vmOutput.ReturnData,
+		ReturnCode:      vmOutput.ReturnCode,
+		ReturnMessage:   vmOutput.ReturnMessage,
+		GasRemaining:    vmOutput.GasRemaining,
+		GasRefund:       bigIntToString(vmOutput.GasRefund),
+		OutputAccounts:  outputAccounts,
+		DeletedAccounts: vmOutput.DeletedAccounts,
+		TouchedAccounts: vmOutput.TouchedAccounts,
+		Logs:            logs,
+	}
+}
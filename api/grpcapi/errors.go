@@ -0,0 +1,40 @@
+package grpcapi
+
+import (
+	"errors"
+
+	apiErrors "github.com/ElrondNetwork/elrond-proxy-go/api/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrNilFacade signals that a nil facade has been provided
+var ErrNilFacade = errors.New("nil facade")
+
+// ErrInvalidPollingInterval signals that an invalid hyperblocks polling interval has been provided
+var ErrInvalidPollingInterval = errors.New("invalid hyperblocks polling interval")
+
+// ErrNilCredentialsVerifier signals that a nil credentials verifier has been provided
+var ErrNilCredentialsVerifier = errors.New("nil credentials verifier")
+
+// ErrInvalidRateLimitWindow signals that an invalid rate limit window has been provided
+var ErrInvalidRateLimitWindow = errors.New("invalid rate limit window")
+
+// ErrEmptyResponseData signals that the observers' response does not contain any data
+var ErrEmptyResponseData = errors.New("empty response data")
+
+// ErrNilTransaction signals that a request does not contain a transaction
+var ErrNilTransaction = errors.New("nil transaction")
+
+func invalidArgumentError(err error) error {
+	return status.Error(codes.InvalidArgument, err.Error())
+}
+
+// toStatusError wraps an error returned by the facade into a gRPC status error
+func toStatusError(err error) error {
+	if errors.Is(err, apiErrors.ErrTransactionNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
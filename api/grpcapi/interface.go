@@ -0,0 +1,79 @@
+package grpcapi
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/vm"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// AccountFacadeHandler defines the facade methods used by the account service
+type AccountFacadeHandler interface {
+	GetAccount(address string) (*data.Account, error)
+	GetShardIDForAddress(address string) (uint32, error)
+	GetValueForKey(address string, key string) (string, error)
+	GetKeyValuePairs(address string) (*data.GenericAPIResponse, error)
+	GetTransactions(address string, options data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error)
+	GetAllESDTTokens(address string) (*data.GenericAPIResponse, error)
+	GetESDTTokenData(address string, key string) (*data.GenericAPIResponse, error)
+	GetESDTNftTokenData(address string, key string, nonce uint64) (*data.GenericAPIResponse, error)
+	GetESDTsWithRole(address string, role string) (*data.GenericAPIResponse, error)
+	GetNFTTokenIDsRegisteredByAddress(address string) (*data.GenericAPIResponse, error)
+}
+
+// TransactionFacadeHandler defines the facade methods used by the transaction service
+type TransactionFacadeHandler interface {
+	SendTransaction(tx *data.Transaction) (int, string, error)
+	SendMultipleTransactions(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error)
+	SimulateTransaction(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error)
+	TransactionCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error)
+	ComputeTransactionHash(tx *data.Transaction) (string, error)
+	GetTransaction(txHash string, withResults bool) (*data.FullTransaction, error)
+	GetTransactionByHashAndSenderAddress(txHash string, sndAddr string, withResults bool) (*data.FullTransaction, int, error)
+	GetTransactionStatus(txHash string, sender string) (string, error)
+}
+
+// BlockFacadeHandler defines the facade methods used by the block service
+type BlockFacadeHandler interface {
+	GetBlockByNonce(shardID uint32, nonce uint64, withTxs bool) (*data.BlockApiResponse, error)
+	GetBlockByHash(shardID uint32, hash string, withTxs bool) (*data.BlockApiResponse, error)
+	GetAtlasBlockByShardIDAndNonce(shardID uint32, nonce uint64) (data.AtlasBlock, error)
+	GetHyperBlockByNonce(nonce uint64) (*data.HyperblockApiResponse, error)
+	GetHyperBlockByHash(hash string) (*data.HyperblockApiResponse, error)
+	GetLatestFullySynchronizedHyperblockNonce() (uint64, error)
+}
+
+// NodeStatusFacadeHandler defines the facade methods used by the node status service
+type NodeStatusFacadeHandler interface {
+	GetNetworkConfigMetrics() (*data.GenericAPIResponse, error)
+	GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error)
+	GetEconomicsDataMetrics() (*data.GenericAPIResponse, error)
+	GetEnableEpochsMetrics() (*data.GenericAPIResponse, error)
+	GetLatestFullySynchronizedHyperblockNonce() (uint64, error)
+	GetAllIssuedESDTs(tokenType string) (*data.GenericAPIResponse, error)
+	GetDirectStakedInfo() (*data.GenericAPIResponse, error)
+	GetDelegatedInfo() (*data.GenericAPIResponse, error)
+}
+
+// SCQueryFacadeHandler defines the facade methods used by the SC query service
+type SCQueryFacadeHandler interface {
+	ExecuteSCQuery(query *data.SCQuery) (*vm.VMOutputApi, error)
+}
+
+// FacadeHandler defines all the facade methods exposed through the gRPC API. The block methods are listed explicitly
+// since GetLatestFullySynchronizedHyperblockNonce is already part of the NodeStatusFacadeHandler
+type FacadeHandler interface {
+	AccountFacadeHandler
+	TransactionFacadeHandler
+	NodeStatusFacadeHandler
+	SCQueryFacadeHandler
+	GetBlockByNonce(shardID uint32, nonce uint64, withTxs bool) (*data.BlockApiResponse, error)
+	GetBlockByHash(shardID uint32, hash string, withTxs bool) (*data.BlockApiResponse, error)
+	GetAtlasBlockByShardIDAndNonce(shardID uint32, nonce uint64) (data.AtlasBlock, error)
+	GetHyperBlockByNonce(nonce uint64) (*data.HyperblockApiResponse, error)
+	GetHyperBlockByHash(hash string) (*data.HyperblockApiResponse, error)
+}
+
+// CredentialsVerifier defines the component able to check the credentials of the secured calls
+type CredentialsVerifier interface {
+	Verify(username string, password string) error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,265 @@
+package grpcapi
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authorizationMetadataKey  = "authorization"
+	basicAuthenticationPrefix = "Basic "
+	rateLimiterVersion        = "grpc"
+)
+
+// grpcMethodRoute is the REST route mirrored by a gRPC method. The config of the route also applies to the method
+type grpcMethodRoute struct {
+	packageName string
+	routeName   string
+}
+
+// grpcMethodsRoutes holds the REST routes mirrored by the gRPC methods. The methods which are not listed, such as
+// ComputeTransactionHash, do not have a REST counterpart and are always available
+var grpcMethodsRoutes = map[string]grpcMethodRoute{
+	"/grpcapi.AccountService/GetAccount":                        {packageName: "address", routeName: "/:address"},
+	"/grpcapi.AccountService/GetShardIDForAddress":              {packageName: "address", routeName: "/:address/shard"},
+	"/grpcapi.AccountService/GetValueForKey":                    {packageName: "address", routeName: "/:address/key/:key"},
+	"/grpcapi.AccountService/GetKeyValuePairs":                  {packageName: "address", routeName: "/:address/keys"},
+	"/grpcapi.AccountService/GetTransactions":                   {packageName: "address", routeName: "/:address/transactions"},
+	"/grpcapi.AccountService/GetAllESDTTokens":                  {packageName: "address", routeName: "/:address/esdt"},
+	"/grpcapi.AccountService/GetESDTTokenData":                  {packageName: "address", routeName: "/:address/esdt/:tokenIdentifier"},
+	"/grpcapi.AccountService/GetESDTNftTokenData":               {packageName: "address", routeName: "/:address/nft/:tokenIdentifier/nonce/:nonce"},
+	"/grpcapi.AccountService/GetESDTsWithRole":                  {packageName: "address", routeName: "/:address/esdts-with-role/:role"},
+	"/grpcapi.AccountService/GetNFTTokenIDsRegisteredByAddress": {packageName: "address", routeName: "/:address/registered-nfts"},
+	"/grpcapi.TransactionService/SendTransaction":               {packageName: "transaction", routeName: "/send"},
+	"/grpcapi.TransactionService/SendMultipleTransactions":      {packageName: "transaction", routeName: "/send-multiple"},
+	"/grpcapi.TransactionService/SimulateTransaction":           {packageName: "transaction", routeName: "/simulate"},
+	"/grpcapi.TransactionService/TransactionCost":               {packageName: "transaction", routeName: "/cost"},
+	"/grpcapi.TransactionService/GetTransaction":                {packageName: "transaction", routeName: "/:txhash"},
+	"/grpcapi.TransactionService/GetTransactionStatus":          {packageName: "transaction", routeName: "/:txhash/status"},
+	"/grpcapi.BlockService/GetBlockByNonce":                     {packageName: "block", routeName: "/:shard/by-nonce/:nonce"},
+	"/grpcapi.BlockService/GetBlockByHash":                      {packageName: "block", routeName: "/:shard/by-hash/:hash"},
+	"/grpcapi.BlockService/GetAtlasBlock":                       {packageName: "block-atlas", routeName: "/:shard/:nonce"},
+	"/grpcapi.BlockService/GetHyperBlockByNonce":                {packageName: "hyperblock", routeName: "/by-nonce/:nonce"},
+	"/grpcapi.BlockService/GetHyperBlockByHash":                 {packageName: "hyperblock", routeName: "/by-hash/:hash"},
+	"/grpcapi.BlockService/StreamHyperBlocks":                   {packageName: "hyperblock", routeName: "/by-nonce/:nonce"},
+	"/grpcapi.NodeStatusService/GetNetworkConfig":               {packageName: "network", routeName: "/config"},
+	"/grpcapi.NodeStatusService/GetNetworkStatus":               {packageName: "network", routeName: "/status/:shard"},
+	"/grpcapi.NodeStatusService/GetEconomicsData":               {packageName: "network", routeName: "/economics"},
+	"/grpcapi.NodeStatusService/GetEnableEpochs":                {packageName: "network", routeName: "/enable-epochs"},
+	"/grpcapi.NodeStatusService/GetAllIssuedESDTs":              {packageName: "network", routeName: "/esdts"},
+	"/grpcapi.NodeStatusService/GetDirectStakedInfo":            {packageName: "network", routeName: "/direct-staked-info"},
+	"/grpcapi.NodeStatusService/GetDelegatedInfo":               {packageName: "network", routeName: "/delegated-info"},
+	"/grpcapi.SCQueryService/ExecuteQuery":                      {packageName: "vm-values", routeName: "/query"},
+}
+
+// grpcMethodProperties holds the properties of the route mirrored by a gRPC method
+type grpcMethodProperties struct {
+	isOpen                    bool
+	isSecured                 bool
+	requiresClientCertificate bool
+	rateLimitedEndpoint       string
+}
+
+// methodsPolicy applies the config of the mirrored REST routes to the gRPC calls: the methods of the closed routes are
+// not available, the ones of the secured routes require Basic Authentication through the authorization metadata and
+// the calls are counted against the rate limits of the routes
+type methodsPolicy struct {
+	methodsProperties   map[string]grpcMethodProperties
+	credentialsVerifier CredentialsVerifier
+	rateLimiter         middleware.RateLimiterHandler
+	closeOnce           sync.Once
+	closeChan           chan struct{}
+}
+
+func newMethodsPolicy(
+	apiConfig data.ApiRoutesConfig,
+	credentialsVerifier CredentialsVerifier,
+	rateLimitWindow time.Duration,
+) (*methodsPolicy, error) {
+	limits := make(map[string]uint64)
+	methodsProperties := make(map[string]grpcMethodProperties, len(grpcMethodsRoutes))
+	for method, methodRoute := range grpcMethodsRoutes {
+		packageConfig, ok := apiConfig.APIPackages[methodRoute.packageName]
+		if !ok {
+			methodsProperties[method] = grpcMethodProperties{isOpen: true}
+			continue
+		}
+
+		properties := grpcMethodProperties{
+			isOpen:                    true,
+			requiresClientCertificate: packageConfig.RequireClientCertificate,
+		}
+		for _, route := range packageConfig.Routes {
+			if route.Name != methodRoute.routeName {
+				continue
+			}
+
+			properties.isOpen = route.Open
+			properties.isSecured = route.Secured
+			if route.RateLimit > 0 {
+				properties.rateLimitedEndpoint = fmt.Sprintf("/%s%s", methodRoute.packageName, methodRoute.routeName)
+				limits[properties.rateLimitedEndpoint] = route.RateLimit
+			}
+		}
+		methodsProperties[method] = properties
+	}
+
+	rateLimiter, err := middleware.NewRateLimiter(limits, rateLimitWindow)
+	if err != nil {
+		return nil, err
+	}
+
+	mp := &methodsPolicy{
+		methodsProperties:   methodsProperties,
+		credentialsVerifier: credentialsVerifier,
+		rateLimiter:         rateLimiter,
+		closeChan:           make(chan struct{}),
+	}
+	go mp.resetRateLimiter(rateLimitWindow)
+
+	return mp, nil
+}
+
+func (mp *methodsPolicy) resetRateLimiter(rateLimitWindow time.Duration) {
+	ticker := time.NewTicker(rateLimitWindow)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-mp.closeChan:
+			return
+		case <-ticker.C:
+			mp.rateLimiter.ResetMap(rateLimiterVersion)
+		}
+	}
+}
+
+// unaryInterceptor checks a unary call against the config of the mirrored route before handling it
+func (mp *methodsPolicy) unaryInterceptor(
+	ctx context.Context,
+	request interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	err := mp.checkCall(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, request)
+}
+
+// streamInterceptor checks a streaming call against the config of the mirrored route before handling it
+func (mp *methodsPolicy) streamInterceptor(
+	server interface{},
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	err := mp.checkCall(stream.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+
+	return handler(server, stream)
+}
+
+func (mp *methodsPolicy) checkCall(ctx context.Context, fullMethod string) error {
+	properties, ok := mp.methodsProperties[fullMethod]
+	if !ok {
+		return nil
+	}
+	if !properties.isOpen {
+		return status.Errorf(codes.Unimplemented, "method %s is not enabled", fullMethod)
+	}
+	if properties.requiresClientCertificate && !hasVerifiedClientCertificate(ctx) {
+		return status.Error(codes.Unauthenticated, "this method requires a valid client certificate")
+	}
+	if properties.isSecured {
+		err := mp.authenticate(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	if len(properties.rateLimitedEndpoint) == 0 {
+		return nil
+	}
+
+	limit, isAllowed := mp.rateLimiter.AddRequests(properties.rateLimitedEndpoint, getClientIP(ctx), 1)
+	if !isAllowed {
+		return status.Errorf(codes.ResourceExhausted, "your IP exceeded the limit of %d requests for this method", limit)
+	}
+
+	return nil
+}
+
+func (mp *methodsPolicy) authenticate(ctx context.Context) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(authorizationMetadataKey)
+	if len(values) == 0 || !strings.HasPrefix(values[0], basicAuthenticationPrefix) {
+		return status.Error(codes.Unauthenticated, "this method requires Basic Authentication")
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(values[0], basicAuthenticationPrefix))
+	if err != nil {
+		return status.Error(codes.Unauthenticated, "this method requires Basic Authentication")
+	}
+	credentialsPair := strings.SplitN(string(decoded), ":", 2)
+	if len(credentialsPair) != 2 {
+		return status.Error(codes.Unauthenticated, "this method requires Basic Authentication")
+	}
+
+	err = mp.credentialsVerifier.Verify(credentialsPair[0], credentialsPair[1])
+	if err != nil {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	return nil
+}
+
+func hasVerifiedClientCertificate(ctx context.Context) bool {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return false
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+
+	return ok && len(tlsInfo.State.VerifiedChains) > 0
+}
+
+func getClientIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+
+	return host
+}
+
+// Close stops resetting the rate limiter
+func (mp *methodsPolicy) Close() error {
+	mp.closeOnce.Do(func() {
+		close(mp.closeChan)
+	})
+
+	return nil
+}
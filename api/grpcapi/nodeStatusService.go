@@ -0,0 +1,138 @@
+package grpcapi
+
+import (
+	"context"
+)
+
+type networkConfigResponseData struct {
+	Config *NetworkConfig `json:"config"`
+}
+
+type networkStatusResponseData struct {
+	Status *NetworkStatus `json:"status"`
+}
+
+type economicsResponseData struct {
+	Metrics *EconomicsData `json:"metrics"`
+}
+
+type nodeStatusService struct {
+	facade NodeStatusFacadeHandler
+}
+
+// GetNetworkConfig returns the network's configuration
+func (nss *nodeStatusService) GetNetworkConfig(_ context.Context, _ *Empty) (*NetworkConfig, error) {
+	response, err := nss.facade.GetNetworkConfigMetrics()
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	responseData := &networkConfigResponseData{Config: &NetworkConfig{}}
+	err = convertResponseData(response, responseData)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return responseData.Config, nil
+}
+
+// GetNetworkStatus returns the status of the given shard
+func (nss *nodeStatusService) GetNetworkStatus(_ context.Context, request *ShardRequest) (*NetworkStatus, error) {
+	response, err := nss.facade.GetNetworkStatusMetrics(request.Shard)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	responseData := &networkStatusResponseData{Status: &NetworkStatus{}}
+	err = convertResponseData(response, responseData)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return responseData.Status, nil
+}
+
+// GetEconomicsData returns the network's economics metrics
+func (nss *nodeStatusService) GetEconomicsData(_ context.Context, _ *Empty) (*EconomicsData, error) {
+	response, err := nss.facade.GetEconomicsDataMetrics()
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	responseData := &economicsResponseData{Metrics: &EconomicsData{}}
+	err = convertResponseData(response, responseData)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return responseData.Metrics, nil
+}
+
+// GetEnableEpochs returns the activation epochs of the network's features
+func (nss *nodeStatusService) GetEnableEpochs(_ context.Context, _ *Empty) (*EnableEpochsResponse, error) {
+	response, err := nss.facade.GetEnableEpochsMetrics()
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	enableEpochs := &EnableEpochsResponse{}
+	err = convertResponseData(response, enableEpochs)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return enableEpochs, nil
+}
+
+// GetLatestFullySynchronizedHyperblockNonce returns the nonce of the latest hyperblock fully synchronized by the
+// observers of all shards
+func (nss *nodeStatusService) GetLatestFullySynchronizedHyperblockNonce(_ context.Context, _ *Empty) (*NonceResponse, error) {
+	nonce, err := nss.facade.GetLatestFullySynchronizedHyperblockNonce()
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &NonceResponse{Nonce: nonce}, nil
+}
+
+// GetAllIssuedESDTs returns the identifiers of all the issued tokens of the given type
+func (nss *nodeStatusService) GetAllIssuedESDTs(_ context.Context, request *TokenTypeRequest) (*TokenIdentifiersResponse, error) {
+	response, err := nss.facade.GetAllIssuedESDTs(request.TokenType)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return tokenIdentifiersFromResponse(response)
+}
+
+// GetDirectStakedInfo returns the list of direct staked values
+func (nss *nodeStatusService) GetDirectStakedInfo(_ context.Context, _ *Empty) (*DirectStakedInfoResponse, error) {
+	response, err := nss.facade.GetDirectStakedInfo()
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	stakedInfo := &DirectStakedInfoResponse{}
+	err = convertResponseData(response, stakedInfo)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return stakedInfo, nil
+}
+
+// GetDelegatedInfo returns the list of delegated values
+func (nss *nodeStatusService) GetDelegatedInfo(_ context.Context, _ *Empty) (*DelegatedInfoResponse, error) {
+	response, err := nss.facade.GetDelegatedInfo()
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	delegatedInfo := &DelegatedInfoResponse{}
+	err = convertResponseData(response, delegatedInfo)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return delegatedInfo, nil
+}
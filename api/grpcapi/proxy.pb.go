@@ -0,0 +1,27225 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: proxy.proto
+
+package grpcapi
+
+import (
+	bytes "bytes"
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type Empty struct {
+}
+
+func (m *Empty) Reset()      { *m = Empty{} }
+func (*Empty) ProtoMessage() {}
+func (*Empty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{0}
+}
+func (m *Empty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Empty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Empty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Empty.Merge(m, src)
+}
+func (m *Empty) XXX_Size() int {
+	return m.Size()
+}
+func (m *Empty) XXX_DiscardUnknown() {
+	xxx_messageInfo_Empty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Empty proto.InternalMessageInfo
+
+type AddressRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+}
+
+func (m *AddressRequest) Reset()      { *m = AddressRequest{} }
+func (*AddressRequest) ProtoMessage() {}
+func (*AddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{1}
+}
+func (m *AddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressRequest.Merge(m, src)
+}
+func (m *AddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressRequest proto.InternalMessageInfo
+
+func (m *AddressRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type AccountKeyRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+	Key     string `protobuf:"bytes,2,opt,name=Key,proto3" json:"Key,omitempty"`
+}
+
+func (m *AccountKeyRequest) Reset()      { *m = AccountKeyRequest{} }
+func (*AccountKeyRequest) ProtoMessage() {}
+func (*AccountKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{2}
+}
+func (m *AccountKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AccountKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountKeyRequest.Merge(m, src)
+}
+func (m *AccountKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountKeyRequest proto.InternalMessageInfo
+
+func (m *AccountKeyRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AccountKeyRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type ESDTTokenRequest struct {
+	Address         string `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+	TokenIdentifier string `protobuf:"bytes,2,opt,name=TokenIdentifier,proto3" json:"TokenIdentifier,omitempty"`
+}
+
+func (m *ESDTTokenRequest) Reset()      { *m = ESDTTokenRequest{} }
+func (*ESDTTokenRequest) ProtoMessage() {}
+func (*ESDTTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{3}
+}
+func (m *ESDTTokenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ESDTTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ESDTTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ESDTTokenRequest.Merge(m, src)
+}
+func (m *ESDTTokenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ESDTTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ESDTTokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ESDTTokenRequest proto.InternalMessageInfo
+
+func (m *ESDTTokenRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ESDTTokenRequest) GetTokenIdentifier() string {
+	if m != nil {
+		return m.TokenIdentifier
+	}
+	return ""
+}
+
+type ESDTNftTokenRequest struct {
+	Address         string `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+	TokenIdentifier string `protobuf:"bytes,2,opt,name=TokenIdentifier,proto3" json:"TokenIdentifier,omitempty"`
+	Nonce           uint64 `protobuf:"varint,3,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+}
+
+func (m *ESDTNftTokenRequest) Reset()      { *m = ESDTNftTokenRequest{} }
+func (*ESDTNftTokenRequest) ProtoMessage() {}
+func (*ESDTNftTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{4}
+}
+func (m *ESDTNftTokenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ESDTNftTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ESDTNftTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ESDTNftTokenRequest.Merge(m, src)
+}
+func (m *ESDTNftTokenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ESDTNftTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ESDTNftTokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ESDTNftTokenRequest proto.InternalMessageInfo
+
+func (m *ESDTNftTokenRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ESDTNftTokenRequest) GetTokenIdentifier() string {
+	if m != nil {
+		return m.TokenIdentifier
+	}
+	return ""
+}
+
+func (m *ESDTNftTokenRequest) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+type ESDTsWithRoleRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+	Role    string `protobuf:"bytes,2,opt,name=Role,proto3" json:"Role,omitempty"`
+}
+
+func (m *ESDTsWithRoleRequest) Reset()      { *m = ESDTsWithRoleRequest{} }
+func (*ESDTsWithRoleRequest) ProtoMessage() {}
+func (*ESDTsWithRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{5}
+}
+func (m *ESDTsWithRoleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ESDTsWithRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ESDTsWithRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ESDTsWithRoleRequest.Merge(m, src)
+}
+func (m *ESDTsWithRoleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ESDTsWithRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ESDTsWithRoleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ESDTsWithRoleRequest proto.InternalMessageInfo
+
+func (m *ESDTsWithRoleRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ESDTsWithRoleRequest) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+type ShardRequest struct {
+	Shard uint32 `protobuf:"varint,1,opt,name=Shard,proto3" json:"Shard,omitempty"`
+}
+
+func (m *ShardRequest) Reset()      { *m = ShardRequest{} }
+func (*ShardRequest) ProtoMessage() {}
+func (*ShardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{6}
+}
+func (m *ShardRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ShardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardRequest.Merge(m, src)
+}
+func (m *ShardRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardRequest proto.InternalMessageInfo
+
+func (m *ShardRequest) GetShard() uint32 {
+	if m != nil {
+		return m.Shard
+	}
+	return 0
+}
+
+type TokenTypeRequest struct {
+	TokenType string `protobuf:"bytes,1,opt,name=TokenType,proto3" json:"TokenType,omitempty"`
+}
+
+func (m *TokenTypeRequest) Reset()      { *m = TokenTypeRequest{} }
+func (*TokenTypeRequest) ProtoMessage() {}
+func (*TokenTypeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{7}
+}
+func (m *TokenTypeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenTypeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TokenTypeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenTypeRequest.Merge(m, src)
+}
+func (m *TokenTypeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenTypeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenTypeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenTypeRequest proto.InternalMessageInfo
+
+func (m *TokenTypeRequest) GetTokenType() string {
+	if m != nil {
+		return m.TokenType
+	}
+	return ""
+}
+
+type Account struct {
+	Address         string `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+	Nonce           uint64 `protobuf:"varint,2,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Balance         string `protobuf:"bytes,3,opt,name=Balance,proto3" json:"Balance,omitempty"`
+	Username        string `protobuf:"bytes,4,opt,name=Username,proto3" json:"Username,omitempty"`
+	Code            string `protobuf:"bytes,5,opt,name=Code,proto3" json:"Code,omitempty"`
+	CodeHash        []byte `protobuf:"bytes,6,opt,name=CodeHash,proto3" json:"CodeHash,omitempty"`
+	RootHash        []byte `protobuf:"bytes,7,opt,name=RootHash,proto3" json:"RootHash,omitempty"`
+	CodeMetadata    []byte `protobuf:"bytes,8,opt,name=CodeMetadata,proto3" json:"CodeMetadata,omitempty"`
+	DeveloperReward string `protobuf:"bytes,9,opt,name=DeveloperReward,proto3" json:"DeveloperReward,omitempty"`
+	OwnerAddress    string `protobuf:"bytes,10,opt,name=OwnerAddress,proto3" json:"OwnerAddress,omitempty"`
+}
+
+func (m *Account) Reset()      { *m = Account{} }
+func (*Account) ProtoMessage() {}
+func (*Account) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{8}
+}
+func (m *Account) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Account) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Account) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Account.Merge(m, src)
+}
+func (m *Account) XXX_Size() int {
+	return m.Size()
+}
+func (m *Account) XXX_DiscardUnknown() {
+	xxx_messageInfo_Account.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Account proto.InternalMessageInfo
+
+func (m *Account) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Account) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *Account) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+func (m *Account) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *Account) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *Account) GetCodeHash() []byte {
+	if m != nil {
+		return m.CodeHash
+	}
+	return nil
+}
+
+func (m *Account) GetRootHash() []byte {
+	if m != nil {
+		return m.RootHash
+	}
+	return nil
+}
+
+func (m *Account) GetCodeMetadata() []byte {
+	if m != nil {
+		return m.CodeMetadata
+	}
+	return nil
+}
+
+func (m *Account) GetDeveloperReward() string {
+	if m != nil {
+		return m.DeveloperReward
+	}
+	return ""
+}
+
+func (m *Account) GetOwnerAddress() string {
+	if m != nil {
+		return m.OwnerAddress
+	}
+	return ""
+}
+
+type ShardIDResponse struct {
+	ShardID uint32 `protobuf:"varint,1,opt,name=ShardID,proto3" json:"ShardID,omitempty"`
+}
+
+func (m *ShardIDResponse) Reset()      { *m = ShardIDResponse{} }
+func (*ShardIDResponse) ProtoMessage() {}
+func (*ShardIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{9}
+}
+func (m *ShardIDResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShardIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ShardIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardIDResponse.Merge(m, src)
+}
+func (m *ShardIDResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShardIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardIDResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardIDResponse proto.InternalMessageInfo
+
+func (m *ShardIDResponse) GetShardID() uint32 {
+	if m != nil {
+		return m.ShardID
+	}
+	return 0
+}
+
+type ValueResponse struct {
+	Value string `protobuf:"bytes,1,opt,name=Value,proto3" json:"Value,omitempty"`
+}
+
+func (m *ValueResponse) Reset()      { *m = ValueResponse{} }
+func (*ValueResponse) ProtoMessage() {}
+func (*ValueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{10}
+}
+func (m *ValueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ValueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValueResponse.Merge(m, src)
+}
+func (m *ValueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValueResponse proto.InternalMessageInfo
+
+func (m *ValueResponse) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type KeyValuePairsResponse struct {
+	Pairs map[string]string `protobuf:"bytes,1,rep,name=Pairs,proto3" json:"pairs" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *KeyValuePairsResponse) Reset()      { *m = KeyValuePairsResponse{} }
+func (*KeyValuePairsResponse) ProtoMessage() {}
+func (*KeyValuePairsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{11}
+}
+func (m *KeyValuePairsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyValuePairsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *KeyValuePairsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyValuePairsResponse.Merge(m, src)
+}
+func (m *KeyValuePairsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyValuePairsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyValuePairsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyValuePairsResponse proto.InternalMessageInfo
+
+func (m *KeyValuePairsResponse) GetPairs() map[string]string {
+	if m != nil {
+		return m.Pairs
+	}
+	return nil
+}
+
+type ESDTToken struct {
+	TokenIdentifier string   `protobuf:"bytes,1,opt,name=TokenIdentifier,proto3" json:"TokenIdentifier,omitempty"`
+	Balance         string   `protobuf:"bytes,2,opt,name=Balance,proto3" json:"Balance,omitempty"`
+	Properties      string   `protobuf:"bytes,3,opt,name=Properties,proto3" json:"Properties,omitempty"`
+	Nonce           uint64   `protobuf:"varint,4,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Name            string   `protobuf:"bytes,5,opt,name=Name,proto3" json:"Name,omitempty"`
+	Creator         string   `protobuf:"bytes,6,opt,name=Creator,proto3" json:"Creator,omitempty"`
+	Royalties       string   `protobuf:"bytes,7,opt,name=Royalties,proto3" json:"Royalties,omitempty"`
+	Hash            []byte   `protobuf:"bytes,8,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	URIs            [][]byte `protobuf:"bytes,9,rep,name=URIs,proto3" json:"URIs,omitempty"`
+	Attributes      []byte   `protobuf:"bytes,10,opt,name=Attributes,proto3" json:"Attributes,omitempty"`
+}
+
+func (m *ESDTToken) Reset()      { *m = ESDTToken{} }
+func (*ESDTToken) ProtoMessage() {}
+func (*ESDTToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{12}
+}
+func (m *ESDTToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ESDTToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ESDTToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ESDTToken.Merge(m, src)
+}
+func (m *ESDTToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *ESDTToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_ESDTToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ESDTToken proto.InternalMessageInfo
+
+func (m *ESDTToken) GetTokenIdentifier() string {
+	if m != nil {
+		return m.TokenIdentifier
+	}
+	return ""
+}
+
+func (m *ESDTToken) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+func (m *ESDTToken) GetProperties() string {
+	if m != nil {
+		return m.Properties
+	}
+	return ""
+}
+
+func (m *ESDTToken) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *ESDTToken) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ESDTToken) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *ESDTToken) GetRoyalties() string {
+	if m != nil {
+		return m.Royalties
+	}
+	return ""
+}
+
+func (m *ESDTToken) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *ESDTToken) GetURIs() [][]byte {
+	if m != nil {
+		return m.URIs
+	}
+	return nil
+}
+
+func (m *ESDTToken) GetAttributes() []byte {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+type ESDTTokensResponse struct {
+	Tokens []*ESDTToken `protobuf:"bytes,1,rep,name=Tokens,proto3" json:"Tokens,omitempty"`
+}
+
+func (m *ESDTTokensResponse) Reset()      { *m = ESDTTokensResponse{} }
+func (*ESDTTokensResponse) ProtoMessage() {}
+func (*ESDTTokensResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{13}
+}
+func (m *ESDTTokensResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ESDTTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ESDTTokensResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ESDTTokensResponse.Merge(m, src)
+}
+func (m *ESDTTokensResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ESDTTokensResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ESDTTokensResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ESDTTokensResponse proto.InternalMessageInfo
+
+func (m *ESDTTokensResponse) GetTokens() []*ESDTToken {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+type TokenIdentifiersResponse struct {
+	TokenIdentifiers []string `protobuf:"bytes,1,rep,name=TokenIdentifiers,proto3" json:"tokens"`
+}
+
+func (m *TokenIdentifiersResponse) Reset()      { *m = TokenIdentifiersResponse{} }
+func (*TokenIdentifiersResponse) ProtoMessage() {}
+func (*TokenIdentifiersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{14}
+}
+func (m *TokenIdentifiersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenIdentifiersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TokenIdentifiersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenIdentifiersResponse.Merge(m, src)
+}
+func (m *TokenIdentifiersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenIdentifiersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenIdentifiersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenIdentifiersResponse proto.InternalMessageInfo
+
+func (m *TokenIdentifiersResponse) GetTokenIdentifiers() []string {
+	if m != nil {
+		return m.TokenIdentifiers
+	}
+	return nil
+}
+
+type DatabaseTransaction struct {
+	Hash          string `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	Fee           string `protobuf:"bytes,2,opt,name=Fee,proto3" json:"Fee,omitempty"`
+	MiniBlockHash string `protobuf:"bytes,3,opt,name=MiniBlockHash,proto3" json:"MiniBlockHash,omitempty"`
+	Nonce         uint64 `protobuf:"varint,4,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Round         uint64 `protobuf:"varint,5,opt,name=Round,proto3" json:"Round,omitempty"`
+	Value         string `protobuf:"bytes,6,opt,name=Value,proto3" json:"Value,omitempty"`
+	Receiver      string `protobuf:"bytes,7,opt,name=Receiver,proto3" json:"Receiver,omitempty"`
+	Sender        string `protobuf:"bytes,8,opt,name=Sender,proto3" json:"Sender,omitempty"`
+	ReceiverShard uint32 `protobuf:"varint,9,opt,name=ReceiverShard,proto3" json:"ReceiverShard,omitempty"`
+	SenderShard   uint32 `protobuf:"varint,10,opt,name=SenderShard,proto3" json:"SenderShard,omitempty"`
+	GasPrice      uint64 `protobuf:"varint,11,opt,name=GasPrice,proto3" json:"GasPrice,omitempty"`
+	GasLimit      uint64 `protobuf:"varint,12,opt,name=GasLimit,proto3" json:"GasLimit,omitempty"`
+	GasUsed       uint64 `protobuf:"varint,13,opt,name=GasUsed,proto3" json:"GasUsed,omitempty"`
+	Data          []byte `protobuf:"bytes,14,opt,name=Data,proto3" json:"Data,omitempty"`
+	Signature     string `protobuf:"bytes,15,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	Timestamp     int64  `protobuf:"varint,16,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Status        string `protobuf:"bytes,17,opt,name=Status,proto3" json:"Status,omitempty"`
+}
+
+func (m *DatabaseTransaction) Reset()      { *m = DatabaseTransaction{} }
+func (*DatabaseTransaction) ProtoMessage() {}
+func (*DatabaseTransaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{15}
+}
+func (m *DatabaseTransaction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DatabaseTransaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DatabaseTransaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatabaseTransaction.Merge(m, src)
+}
+func (m *DatabaseTransaction) XXX_Size() int {
+	return m.Size()
+}
+func (m *DatabaseTransaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatabaseTransaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DatabaseTransaction proto.InternalMessageInfo
+
+func (m *DatabaseTransaction) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *DatabaseTransaction) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+func (m *DatabaseTransaction) GetMiniBlockHash() string {
+	if m != nil {
+		return m.MiniBlockHash
+	}
+	return ""
+}
+
+func (m *DatabaseTransaction) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *DatabaseTransaction) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *DatabaseTransaction) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *DatabaseTransaction) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *DatabaseTransaction) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *DatabaseTransaction) GetReceiverShard() uint32 {
+	if m != nil {
+		return m.ReceiverShard
+	}
+	return 0
+}
+
+func (m *DatabaseTransaction) GetSenderShard() uint32 {
+	if m != nil {
+		return m.SenderShard
+	}
+	return 0
+}
+
+func (m *DatabaseTransaction) GetGasPrice() uint64 {
+	if m != nil {
+		return m.GasPrice
+	}
+	return 0
+}
+
+func (m *DatabaseTransaction) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *DatabaseTransaction) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *DatabaseTransaction) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *DatabaseTransaction) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+func (m *DatabaseTransaction) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *DatabaseTransaction) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+type DatabaseTransactionsResponse struct {
+	Transactions []*DatabaseTransaction `protobuf:"bytes,1,rep,name=Transactions,proto3" json:"Transactions,omitempty"`
+}
+
+func (m *DatabaseTransactionsResponse) Reset()      { *m = DatabaseTransactionsResponse{} }
+func (*DatabaseTransactionsResponse) ProtoMessage() {}
+func (*DatabaseTransactionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{16}
+}
+func (m *DatabaseTransactionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DatabaseTransactionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DatabaseTransactionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatabaseTransactionsResponse.Merge(m, src)
+}
+func (m *DatabaseTransactionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DatabaseTransactionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatabaseTransactionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DatabaseTransactionsResponse proto.InternalMessageInfo
+
+func (m *DatabaseTransactionsResponse) GetTransactions() []*DatabaseTransaction {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+type Transaction struct {
+	Nonce            uint64 `protobuf:"varint,1,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Value            string `protobuf:"bytes,2,opt,name=Value,proto3" json:"Value,omitempty"`
+	Receiver         string `protobuf:"bytes,3,opt,name=Receiver,proto3" json:"Receiver,omitempty"`
+	Sender           string `protobuf:"bytes,4,opt,name=Sender,proto3" json:"Sender,omitempty"`
+	SenderUsername   []byte `protobuf:"bytes,5,opt,name=SenderUsername,proto3" json:"SenderUsername,omitempty"`
+	ReceiverUsername []byte `protobuf:"bytes,6,opt,name=ReceiverUsername,proto3" json:"ReceiverUsername,omitempty"`
+	GasPrice         uint64 `protobuf:"varint,7,opt,name=GasPrice,proto3" json:"GasPrice,omitempty"`
+	GasLimit         uint64 `protobuf:"varint,8,opt,name=GasLimit,proto3" json:"GasLimit,omitempty"`
+	Data             []byte `protobuf:"bytes,9,opt,name=Data,proto3" json:"Data,omitempty"`
+	Signature        string `protobuf:"bytes,10,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	ChainID          string `protobuf:"bytes,11,opt,name=ChainID,proto3" json:"ChainID,omitempty"`
+	Version          uint32 `protobuf:"varint,12,opt,name=Version,proto3" json:"Version,omitempty"`
+	Options          uint32 `protobuf:"varint,13,opt,name=Options,proto3" json:"Options,omitempty"`
+}
+
+func (m *Transaction) Reset()      { *m = Transaction{} }
+func (*Transaction) ProtoMessage() {}
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{17}
+}
+func (m *Transaction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Transaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Transaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Transaction.Merge(m, src)
+}
+func (m *Transaction) XXX_Size() int {
+	return m.Size()
+}
+func (m *Transaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_Transaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Transaction proto.InternalMessageInfo
+
+func (m *Transaction) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *Transaction) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *Transaction) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *Transaction) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *Transaction) GetSenderUsername() []byte {
+	if m != nil {
+		return m.SenderUsername
+	}
+	return nil
+}
+
+func (m *Transaction) GetReceiverUsername() []byte {
+	if m != nil {
+		return m.ReceiverUsername
+	}
+	return nil
+}
+
+func (m *Transaction) GetGasPrice() uint64 {
+	if m != nil {
+		return m.GasPrice
+	}
+	return 0
+}
+
+func (m *Transaction) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *Transaction) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *Transaction) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+func (m *Transaction) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *Transaction) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *Transaction) GetOptions() uint32 {
+	if m != nil {
+		return m.Options
+	}
+	return 0
+}
+
+type MultipleTransactionsRequest struct {
+	Transactions []*Transaction `protobuf:"bytes,1,rep,name=Transactions,proto3" json:"Transactions,omitempty"`
+}
+
+func (m *MultipleTransactionsRequest) Reset()      { *m = MultipleTransactionsRequest{} }
+func (*MultipleTransactionsRequest) ProtoMessage() {}
+func (*MultipleTransactionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{18}
+}
+func (m *MultipleTransactionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultipleTransactionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MultipleTransactionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultipleTransactionsRequest.Merge(m, src)
+}
+func (m *MultipleTransactionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultipleTransactionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultipleTransactionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultipleTransactionsRequest proto.InternalMessageInfo
+
+func (m *MultipleTransactionsRequest) GetTransactions() []*Transaction {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+type MultipleTransactionsResponse struct {
+	NumOfSentTxs uint64            `protobuf:"varint,1,opt,name=NumOfSentTxs,proto3" json:"NumOfSentTxs,omitempty"`
+	TxsHashes    map[uint32]string `protobuf:"bytes,2,rep,name=TxsHashes,proto3" json:"TxsHashes,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *MultipleTransactionsResponse) Reset()      { *m = MultipleTransactionsResponse{} }
+func (*MultipleTransactionsResponse) ProtoMessage() {}
+func (*MultipleTransactionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{19}
+}
+func (m *MultipleTransactionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultipleTransactionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MultipleTransactionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultipleTransactionsResponse.Merge(m, src)
+}
+func (m *MultipleTransactionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultipleTransactionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultipleTransactionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultipleTransactionsResponse proto.InternalMessageInfo
+
+func (m *MultipleTransactionsResponse) GetNumOfSentTxs() uint64 {
+	if m != nil {
+		return m.NumOfSentTxs
+	}
+	return 0
+}
+
+func (m *MultipleTransactionsResponse) GetTxsHashes() map[uint32]string {
+	if m != nil {
+		return m.TxsHashes
+	}
+	return nil
+}
+
+type SimulateTransactionRequest struct {
+	Transaction        *Transaction `protobuf:"bytes,1,opt,name=Transaction,proto3" json:"Transaction,omitempty"`
+	SkipSignatureCheck bool         `protobuf:"varint,2,opt,name=SkipSignatureCheck,proto3" json:"SkipSignatureCheck,omitempty"`
+}
+
+func (m *SimulateTransactionRequest) Reset()      { *m = SimulateTransactionRequest{} }
+func (*SimulateTransactionRequest) ProtoMessage() {}
+func (*SimulateTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{20}
+}
+func (m *SimulateTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulateTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SimulateTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulateTransactionRequest.Merge(m, src)
+}
+func (m *SimulateTransactionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulateTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulateTransactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulateTransactionRequest proto.InternalMessageInfo
+
+func (m *SimulateTransactionRequest) GetTransaction() *Transaction {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+func (m *SimulateTransactionRequest) GetSkipSignatureCheck() bool {
+	if m != nil {
+		return m.SkipSignatureCheck
+	}
+	return false
+}
+
+type SmartContractResult struct {
+	Hash           string `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	Nonce          uint64 `protobuf:"varint,2,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Value          string `protobuf:"bytes,3,opt,name=Value,proto3" json:"Value,omitempty"`
+	Receiver       string `protobuf:"bytes,4,opt,name=Receiver,proto3" json:"Receiver,omitempty"`
+	Sender         string `protobuf:"bytes,5,opt,name=Sender,proto3" json:"Sender,omitempty"`
+	RelayerAddress string `protobuf:"bytes,6,opt,name=RelayerAddress,proto3" json:"RelayerAddress,omitempty"`
+	RelayedValue   string `protobuf:"bytes,7,opt,name=RelayedValue,proto3" json:"RelayedValue,omitempty"`
+	Code           string `protobuf:"bytes,8,opt,name=Code,proto3" json:"Code,omitempty"`
+	Data           string `protobuf:"bytes,9,opt,name=Data,proto3" json:"Data,omitempty"`
+	PrevTxHash     string `protobuf:"bytes,10,opt,name=PrevTxHash,proto3" json:"PrevTxHash,omitempty"`
+	OriginalTxHash string `protobuf:"bytes,11,opt,name=OriginalTxHash,proto3" json:"OriginalTxHash,omitempty"`
+	GasLimit       uint64 `protobuf:"varint,12,opt,name=GasLimit,proto3" json:"GasLimit,omitempty"`
+	GasPrice       uint64 `protobuf:"varint,13,opt,name=GasPrice,proto3" json:"GasPrice,omitempty"`
+	CallType       int32  `protobuf:"varint,14,opt,name=CallType,proto3" json:"CallType,omitempty"`
+	CodeMetadata   string `protobuf:"bytes,15,opt,name=CodeMetadata,proto3" json:"CodeMetadata,omitempty"`
+	ReturnMessage  string `protobuf:"bytes,16,opt,name=ReturnMessage,proto3" json:"ReturnMessage,omitempty"`
+	OriginalSender string `protobuf:"bytes,17,opt,name=OriginalSender,proto3" json:"OriginalSender,omitempty"`
+}
+
+func (m *SmartContractResult) Reset()      { *m = SmartContractResult{} }
+func (*SmartContractResult) ProtoMessage() {}
+func (*SmartContractResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{21}
+}
+func (m *SmartContractResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SmartContractResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SmartContractResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SmartContractResult.Merge(m, src)
+}
+func (m *SmartContractResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *SmartContractResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_SmartContractResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SmartContractResult proto.InternalMessageInfo
+
+func (m *SmartContractResult) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *SmartContractResult) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *SmartContractResult) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *SmartContractResult) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *SmartContractResult) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *SmartContractResult) GetRelayerAddress() string {
+	if m != nil {
+		return m.RelayerAddress
+	}
+	return ""
+}
+
+func (m *SmartContractResult) GetRelayedValue() string {
+	if m != nil {
+		return m.RelayedValue
+	}
+	return ""
+}
+
+func (m *SmartContractResult) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *SmartContractResult) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *SmartContractResult) GetPrevTxHash() string {
+	if m != nil {
+		return m.PrevTxHash
+	}
+	return ""
+}
+
+func (m *SmartContractResult) GetOriginalTxHash() string {
+	if m != nil {
+		return m.OriginalTxHash
+	}
+	return ""
+}
+
+func (m *SmartContractResult) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *SmartContractResult) GetGasPrice() uint64 {
+	if m != nil {
+		return m.GasPrice
+	}
+	return 0
+}
+
+func (m *SmartContractResult) GetCallType() int32 {
+	if m != nil {
+		return m.CallType
+	}
+	return 0
+}
+
+func (m *SmartContractResult) GetCodeMetadata() string {
+	if m != nil {
+		return m.CodeMetadata
+	}
+	return ""
+}
+
+func (m *SmartContractResult) GetReturnMessage() string {
+	if m != nil {
+		return m.ReturnMessage
+	}
+	return ""
+}
+
+func (m *SmartContractResult) GetOriginalSender() string {
+	if m != nil {
+		return m.OriginalSender
+	}
+	return ""
+}
+
+type Receipt struct {
+	Value  string `protobuf:"bytes,1,opt,name=Value,proto3" json:"Value,omitempty"`
+	Sender string `protobuf:"bytes,2,opt,name=Sender,proto3" json:"Sender,omitempty"`
+	Data   string `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+	TxHash string `protobuf:"bytes,4,opt,name=TxHash,proto3" json:"TxHash,omitempty"`
+}
+
+func (m *Receipt) Reset()      { *m = Receipt{} }
+func (*Receipt) ProtoMessage() {}
+func (*Receipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{22}
+}
+func (m *Receipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Receipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Receipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Receipt.Merge(m, src)
+}
+func (m *Receipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *Receipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_Receipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Receipt proto.InternalMessageInfo
+
+func (m *Receipt) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *Receipt) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *Receipt) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func (m *Receipt) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+type SimulationResults struct {
+	Status     string                          `protobuf:"bytes,1,opt,name=Status,proto3" json:"Status,omitempty"`
+	FailReason string                          `protobuf:"bytes,2,opt,name=FailReason,proto3" json:"FailReason,omitempty"`
+	Hash       string                          `protobuf:"bytes,3,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	ScResults  map[string]*SmartContractResult `protobuf:"bytes,4,rep,name=ScResults,proto3" json:"ScResults,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Receipts   map[string]*Receipt             `protobuf:"bytes,5,rep,name=Receipts,proto3" json:"Receipts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *SimulationResults) Reset()      { *m = SimulationResults{} }
+func (*SimulationResults) ProtoMessage() {}
+func (*SimulationResults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{23}
+}
+func (m *SimulationResults) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulationResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SimulationResults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulationResults.Merge(m, src)
+}
+func (m *SimulationResults) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulationResults) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulationResults.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulationResults proto.InternalMessageInfo
+
+func (m *SimulationResults) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *SimulationResults) GetFailReason() string {
+	if m != nil {
+		return m.FailReason
+	}
+	return ""
+}
+
+func (m *SimulationResults) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *SimulationResults) GetScResults() map[string]*SmartContractResult {
+	if m != nil {
+		return m.ScResults
+	}
+	return nil
+}
+
+func (m *SimulationResults) GetReceipts() map[string]*Receipt {
+	if m != nil {
+		return m.Receipts
+	}
+	return nil
+}
+
+type SimulationResponse struct {
+	SenderShard   *SimulationResults `protobuf:"bytes,1,opt,name=SenderShard,proto3" json:"SenderShard,omitempty"`
+	ReceiverShard *SimulationResults `protobuf:"bytes,2,opt,name=ReceiverShard,proto3" json:"ReceiverShard,omitempty"`
+	IsCrossShard  bool               `protobuf:"varint,3,opt,name=IsCrossShard,proto3" json:"IsCrossShard,omitempty"`
+}
+
+func (m *SimulationResponse) Reset()      { *m = SimulationResponse{} }
+func (*SimulationResponse) ProtoMessage() {}
+func (*SimulationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{24}
+}
+func (m *SimulationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SimulationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SimulationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SimulationResponse.Merge(m, src)
+}
+func (m *SimulationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SimulationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SimulationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SimulationResponse proto.InternalMessageInfo
+
+func (m *SimulationResponse) GetSenderShard() *SimulationResults {
+	if m != nil {
+		return m.SenderShard
+	}
+	return nil
+}
+
+func (m *SimulationResponse) GetReceiverShard() *SimulationResults {
+	if m != nil {
+		return m.ReceiverShard
+	}
+	return nil
+}
+
+func (m *SimulationResponse) GetIsCrossShard() bool {
+	if m != nil {
+		return m.IsCrossShard
+	}
+	return false
+}
+
+type TransactionCostResponse struct {
+	GasUnits      uint64 `protobuf:"varint,1,opt,name=GasUnits,proto3" json:"GasUnits,omitempty"`
+	ReturnMessage string `protobuf:"bytes,2,opt,name=ReturnMessage,proto3" json:"ReturnMessage,omitempty"`
+}
+
+func (m *TransactionCostResponse) Reset()      { *m = TransactionCostResponse{} }
+func (*TransactionCostResponse) ProtoMessage() {}
+func (*TransactionCostResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{25}
+}
+func (m *TransactionCostResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransactionCostResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TransactionCostResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionCostResponse.Merge(m, src)
+}
+func (m *TransactionCostResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransactionCostResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionCostResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionCostResponse proto.InternalMessageInfo
+
+func (m *TransactionCostResponse) GetGasUnits() uint64 {
+	if m != nil {
+		return m.GasUnits
+	}
+	return 0
+}
+
+func (m *TransactionCostResponse) GetReturnMessage() string {
+	if m != nil {
+		return m.ReturnMessage
+	}
+	return ""
+}
+
+type TransactionHashResponse struct {
+	Hash string `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+}
+
+func (m *TransactionHashResponse) Reset()      { *m = TransactionHashResponse{} }
+func (*TransactionHashResponse) ProtoMessage() {}
+func (*TransactionHashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{26}
+}
+func (m *TransactionHashResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransactionHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TransactionHashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionHashResponse.Merge(m, src)
+}
+func (m *TransactionHashResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransactionHashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionHashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionHashResponse proto.InternalMessageInfo
+
+func (m *TransactionHashResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+type GetTransactionRequest struct {
+	Hash        string `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	Sender      string `protobuf:"bytes,2,opt,name=Sender,proto3" json:"Sender,omitempty"`
+	WithResults bool   `protobuf:"varint,3,opt,name=WithResults,proto3" json:"WithResults,omitempty"`
+}
+
+func (m *GetTransactionRequest) Reset()      { *m = GetTransactionRequest{} }
+func (*GetTransactionRequest) ProtoMessage() {}
+func (*GetTransactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{27}
+}
+func (m *GetTransactionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTransactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GetTransactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTransactionRequest.Merge(m, src)
+}
+func (m *GetTransactionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTransactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTransactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTransactionRequest proto.InternalMessageInfo
+
+func (m *GetTransactionRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *GetTransactionRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *GetTransactionRequest) GetWithResults() bool {
+	if m != nil {
+		return m.WithResults
+	}
+	return false
+}
+
+type TransactionStatusRequest struct {
+	Hash   string `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	Sender string `protobuf:"bytes,2,opt,name=Sender,proto3" json:"Sender,omitempty"`
+}
+
+func (m *TransactionStatusRequest) Reset()      { *m = TransactionStatusRequest{} }
+func (*TransactionStatusRequest) ProtoMessage() {}
+func (*TransactionStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{28}
+}
+func (m *TransactionStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransactionStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TransactionStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionStatusRequest.Merge(m, src)
+}
+func (m *TransactionStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransactionStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionStatusRequest proto.InternalMessageInfo
+
+func (m *TransactionStatusRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *TransactionStatusRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+type TransactionStatusResponse struct {
+	Status string `protobuf:"bytes,1,opt,name=Status,proto3" json:"Status,omitempty"`
+}
+
+func (m *TransactionStatusResponse) Reset()      { *m = TransactionStatusResponse{} }
+func (*TransactionStatusResponse) ProtoMessage() {}
+func (*TransactionStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{29}
+}
+func (m *TransactionStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransactionStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TransactionStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransactionStatusResponse.Merge(m, src)
+}
+func (m *TransactionStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransactionStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransactionStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransactionStatusResponse proto.InternalMessageInfo
+
+func (m *TransactionStatusResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+type FullTransaction struct {
+	Type                              string                 `protobuf:"bytes,1,opt,name=Type,proto3" json:"Type,omitempty"`
+	Hash                              string                 `protobuf:"bytes,2,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	Nonce                             uint64                 `protobuf:"varint,3,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Round                             uint64                 `protobuf:"varint,4,opt,name=Round,proto3" json:"Round,omitempty"`
+	Epoch                             uint32                 `protobuf:"varint,5,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	Value                             string                 `protobuf:"bytes,6,opt,name=Value,proto3" json:"Value,omitempty"`
+	Receiver                          string                 `protobuf:"bytes,7,opt,name=Receiver,proto3" json:"Receiver,omitempty"`
+	Sender                            string                 `protobuf:"bytes,8,opt,name=Sender,proto3" json:"Sender,omitempty"`
+	SenderUsername                    []byte                 `protobuf:"bytes,9,opt,name=SenderUsername,proto3" json:"SenderUsername,omitempty"`
+	ReceiverUsername                  []byte                 `protobuf:"bytes,10,opt,name=ReceiverUsername,proto3" json:"ReceiverUsername,omitempty"`
+	GasPrice                          uint64                 `protobuf:"varint,11,opt,name=GasPrice,proto3" json:"GasPrice,omitempty"`
+	GasLimit                          uint64                 `protobuf:"varint,12,opt,name=GasLimit,proto3" json:"GasLimit,omitempty"`
+	Data                              []byte                 `protobuf:"bytes,13,opt,name=Data,proto3" json:"Data,omitempty"`
+	CodeMetadata                      []byte                 `protobuf:"bytes,14,opt,name=CodeMetadata,proto3" json:"CodeMetadata,omitempty"`
+	Code                              string                 `protobuf:"bytes,15,opt,name=Code,proto3" json:"Code,omitempty"`
+	PreviousTransactionHash           string                 `protobuf:"bytes,16,opt,name=PreviousTransactionHash,proto3" json:"PreviousTransactionHash,omitempty"`
+	OriginalTransactionHash           string                 `protobuf:"bytes,17,opt,name=OriginalTransactionHash,proto3" json:"OriginalTransactionHash,omitempty"`
+	ReturnMessage                     string                 `protobuf:"bytes,18,opt,name=ReturnMessage,proto3" json:"ReturnMessage,omitempty"`
+	OriginalSender                    string                 `protobuf:"bytes,19,opt,name=OriginalSender,proto3" json:"OriginalSender,omitempty"`
+	Signature                         string                 `protobuf:"bytes,20,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	SourceShard                       uint32                 `protobuf:"varint,21,opt,name=SourceShard,proto3" json:"SourceShard,omitempty"`
+	DestinationShard                  uint32                 `protobuf:"varint,22,opt,name=DestinationShard,proto3" json:"DestinationShard,omitempty"`
+	BlockNonce                        uint64                 `protobuf:"varint,23,opt,name=BlockNonce,proto3" json:"BlockNonce,omitempty"`
+	BlockHash                         string                 `protobuf:"bytes,24,opt,name=BlockHash,proto3" json:"BlockHash,omitempty"`
+	NotarizedAtSourceInMetaNonce      uint64                 `protobuf:"varint,25,opt,name=NotarizedAtSourceInMetaNonce,proto3" json:"NotarizedAtSourceInMetaNonce,omitempty"`
+	NotarizedAtSourceInMetaHash       string                 `protobuf:"bytes,26,opt,name=NotarizedAtSourceInMetaHash,proto3" json:"NotarizedAtSourceInMetaHash,omitempty"`
+	NotarizedAtDestinationInMetaNonce uint64                 `protobuf:"varint,27,opt,name=NotarizedAtDestinationInMetaNonce,proto3" json:"NotarizedAtDestinationInMetaNonce,omitempty"`
+	NotarizedAtDestinationInMetaHash  string                 `protobuf:"bytes,28,opt,name=NotarizedAtDestinationInMetaHash,proto3" json:"NotarizedAtDestinationInMetaHash,omitempty"`
+	MiniBlockType                     string                 `protobuf:"bytes,29,opt,name=MiniBlockType,proto3" json:"MiniBlockType,omitempty"`
+	MiniBlockHash                     string                 `protobuf:"bytes,30,opt,name=MiniBlockHash,proto3" json:"MiniBlockHash,omitempty"`
+	Status                            string                 `protobuf:"bytes,31,opt,name=Status,proto3" json:"Status,omitempty"`
+	HyperblockNonce                   uint64                 `protobuf:"varint,32,opt,name=HyperblockNonce,proto3" json:"HyperblockNonce,omitempty"`
+	HyperblockHash                    string                 `protobuf:"bytes,33,opt,name=HyperblockHash,proto3" json:"HyperblockHash,omitempty"`
+	Receipt                           *Receipt               `protobuf:"bytes,34,opt,name=Receipt,proto3" json:"Receipt,omitempty"`
+	SmartContractResults              []*SmartContractResult `protobuf:"bytes,35,rep,name=SmartContractResults,proto3" json:"SmartContractResults,omitempty"`
+}
+
+func (m *FullTransaction) Reset()      { *m = FullTransaction{} }
+func (*FullTransaction) ProtoMessage() {}
+func (*FullTransaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{30}
+}
+func (m *FullTransaction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FullTransaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *FullTransaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FullTransaction.Merge(m, src)
+}
+func (m *FullTransaction) XXX_Size() int {
+	return m.Size()
+}
+func (m *FullTransaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_FullTransaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FullTransaction proto.InternalMessageInfo
+
+func (m *FullTransaction) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *FullTransaction) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *FullTransaction) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *FullTransaction) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *FullTransaction) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *FullTransaction) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *FullTransaction) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *FullTransaction) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *FullTransaction) GetSenderUsername() []byte {
+	if m != nil {
+		return m.SenderUsername
+	}
+	return nil
+}
+
+func (m *FullTransaction) GetReceiverUsername() []byte {
+	if m != nil {
+		return m.ReceiverUsername
+	}
+	return nil
+}
+
+func (m *FullTransaction) GetGasPrice() uint64 {
+	if m != nil {
+		return m.GasPrice
+	}
+	return 0
+}
+
+func (m *FullTransaction) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *FullTransaction) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *FullTransaction) GetCodeMetadata() []byte {
+	if m != nil {
+		return m.CodeMetadata
+	}
+	return nil
+}
+
+func (m *FullTransaction) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *FullTransaction) GetPreviousTransactionHash() string {
+	if m != nil {
+		return m.PreviousTransactionHash
+	}
+	return ""
+}
+
+func (m *FullTransaction) GetOriginalTransactionHash() string {
+	if m != nil {
+		return m.OriginalTransactionHash
+	}
+	return ""
+}
+
+func (m *FullTransaction) GetReturnMessage() string {
+	if m != nil {
+		return m.ReturnMessage
+	}
+	return ""
+}
+
+func (m *FullTransaction) GetOriginalSender() string {
+	if m != nil {
+		return m.OriginalSender
+	}
+	return ""
+}
+
+func (m *FullTransaction) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+func (m *FullTransaction) GetSourceShard() uint32 {
+	if m != nil {
+		return m.SourceShard
+	}
+	return 0
+}
+
+func (m *FullTransaction) GetDestinationShard() uint32 {
+	if m != nil {
+		return m.DestinationShard
+	}
+	return 0
+}
+
+func (m *FullTransaction) GetBlockNonce() uint64 {
+	if m != nil {
+		return m.BlockNonce
+	}
+	return 0
+}
+
+func (m *FullTransaction) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *FullTransaction) GetNotarizedAtSourceInMetaNonce() uint64 {
+	if m != nil {
+		return m.NotarizedAtSourceInMetaNonce
+	}
+	return 0
+}
+
+func (m *FullTransaction) GetNotarizedAtSourceInMetaHash() string {
+	if m != nil {
+		return m.NotarizedAtSourceInMetaHash
+	}
+	return ""
+}
+
+func (m *FullTransaction) GetNotarizedAtDestinationInMetaNonce() uint64 {
+	if m != nil {
+		return m.NotarizedAtDestinationInMetaNonce
+	}
+	return 0
+}
+
+func (m *FullTransaction) GetNotarizedAtDestinationInMetaHash() string {
+	if m != nil {
+		return m.NotarizedAtDestinationInMetaHash
+	}
+	return ""
+}
+
+func (m *FullTransaction) GetMiniBlockType() string {
+	if m != nil {
+		return m.MiniBlockType
+	}
+	return ""
+}
+
+func (m *FullTransaction) GetMiniBlockHash() string {
+	if m != nil {
+		return m.MiniBlockHash
+	}
+	return ""
+}
+
+func (m *FullTransaction) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *FullTransaction) GetHyperblockNonce() uint64 {
+	if m != nil {
+		return m.HyperblockNonce
+	}
+	return 0
+}
+
+func (m *FullTransaction) GetHyperblockHash() string {
+	if m != nil {
+		return m.HyperblockHash
+	}
+	return ""
+}
+
+func (m *FullTransaction) GetReceipt() *Receipt {
+	if m != nil {
+		return m.Receipt
+	}
+	return nil
+}
+
+func (m *FullTransaction) GetSmartContractResults() []*SmartContractResult {
+	if m != nil {
+		return m.SmartContractResults
+	}
+	return nil
+}
+
+type BlockByNonceRequest struct {
+	Shard   uint32 `protobuf:"varint,1,opt,name=Shard,proto3" json:"Shard,omitempty"`
+	Nonce   uint64 `protobuf:"varint,2,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	WithTxs bool   `protobuf:"varint,3,opt,name=WithTxs,proto3" json:"WithTxs,omitempty"`
+}
+
+func (m *BlockByNonceRequest) Reset()      { *m = BlockByNonceRequest{} }
+func (*BlockByNonceRequest) ProtoMessage() {}
+func (*BlockByNonceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{31}
+}
+func (m *BlockByNonceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockByNonceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BlockByNonceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockByNonceRequest.Merge(m, src)
+}
+func (m *BlockByNonceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockByNonceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockByNonceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockByNonceRequest proto.InternalMessageInfo
+
+func (m *BlockByNonceRequest) GetShard() uint32 {
+	if m != nil {
+		return m.Shard
+	}
+	return 0
+}
+
+func (m *BlockByNonceRequest) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *BlockByNonceRequest) GetWithTxs() bool {
+	if m != nil {
+		return m.WithTxs
+	}
+	return false
+}
+
+type BlockByHashRequest struct {
+	Shard   uint32 `protobuf:"varint,1,opt,name=Shard,proto3" json:"Shard,omitempty"`
+	Hash    string `protobuf:"bytes,2,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	WithTxs bool   `protobuf:"varint,3,opt,name=WithTxs,proto3" json:"WithTxs,omitempty"`
+}
+
+func (m *BlockByHashRequest) Reset()      { *m = BlockByHashRequest{} }
+func (*BlockByHashRequest) ProtoMessage() {}
+func (*BlockByHashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{32}
+}
+func (m *BlockByHashRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockByHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *BlockByHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockByHashRequest.Merge(m, src)
+}
+func (m *BlockByHashRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockByHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockByHashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockByHashRequest proto.InternalMessageInfo
+
+func (m *BlockByHashRequest) GetShard() uint32 {
+	if m != nil {
+		return m.Shard
+	}
+	return 0
+}
+
+func (m *BlockByHashRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *BlockByHashRequest) GetWithTxs() bool {
+	if m != nil {
+		return m.WithTxs
+	}
+	return false
+}
+
+type AtlasBlockRequest struct {
+	Shard uint32 `protobuf:"varint,1,opt,name=Shard,proto3" json:"Shard,omitempty"`
+	Nonce uint64 `protobuf:"varint,2,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+}
+
+func (m *AtlasBlockRequest) Reset()      { *m = AtlasBlockRequest{} }
+func (*AtlasBlockRequest) ProtoMessage() {}
+func (*AtlasBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{33}
+}
+func (m *AtlasBlockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AtlasBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AtlasBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AtlasBlockRequest.Merge(m, src)
+}
+func (m *AtlasBlockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AtlasBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AtlasBlockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AtlasBlockRequest proto.InternalMessageInfo
+
+func (m *AtlasBlockRequest) GetShard() uint32 {
+	if m != nil {
+		return m.Shard
+	}
+	return 0
+}
+
+func (m *AtlasBlockRequest) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+type HyperBlockByNonceRequest struct {
+	Nonce uint64 `protobuf:"varint,1,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+}
+
+func (m *HyperBlockByNonceRequest) Reset()      { *m = HyperBlockByNonceRequest{} }
+func (*HyperBlockByNonceRequest) ProtoMessage() {}
+func (*HyperBlockByNonceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{34}
+}
+func (m *HyperBlockByNonceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HyperBlockByNonceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HyperBlockByNonceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HyperBlockByNonceRequest.Merge(m, src)
+}
+func (m *HyperBlockByNonceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *HyperBlockByNonceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HyperBlockByNonceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HyperBlockByNonceRequest proto.InternalMessageInfo
+
+func (m *HyperBlockByNonceRequest) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+type HyperBlockByHashRequest struct {
+	Hash string `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+}
+
+func (m *HyperBlockByHashRequest) Reset()      { *m = HyperBlockByHashRequest{} }
+func (*HyperBlockByHashRequest) ProtoMessage() {}
+func (*HyperBlockByHashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{35}
+}
+func (m *HyperBlockByHashRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HyperBlockByHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HyperBlockByHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HyperBlockByHashRequest.Merge(m, src)
+}
+func (m *HyperBlockByHashRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *HyperBlockByHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HyperBlockByHashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HyperBlockByHashRequest proto.InternalMessageInfo
+
+func (m *HyperBlockByHashRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+type StreamHyperBlocksRequest struct {
+	// StartNonce is the nonce of the first streamed hyperblock. It is ignored if FromLatest is set
+	StartNonce uint64 `protobuf:"varint,1,opt,name=StartNonce,proto3" json:"StartNonce,omitempty"`
+	// FromLatest starts the stream with the latest fully synchronized hyperblock
+	FromLatest bool `protobuf:"varint,2,opt,name=FromLatest,proto3" json:"FromLatest,omitempty"`
+}
+
+func (m *StreamHyperBlocksRequest) Reset()      { *m = StreamHyperBlocksRequest{} }
+func (*StreamHyperBlocksRequest) ProtoMessage() {}
+func (*StreamHyperBlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{36}
+}
+func (m *StreamHyperBlocksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamHyperBlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StreamHyperBlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamHyperBlocksRequest.Merge(m, src)
+}
+func (m *StreamHyperBlocksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamHyperBlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamHyperBlocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamHyperBlocksRequest proto.InternalMessageInfo
+
+func (m *StreamHyperBlocksRequest) GetStartNonce() uint64 {
+	if m != nil {
+		return m.StartNonce
+	}
+	return 0
+}
+
+func (m *StreamHyperBlocksRequest) GetFromLatest() bool {
+	if m != nil {
+		return m.FromLatest
+	}
+	return false
+}
+
+type NotarizedBlock struct {
+	Hash  string `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	Nonce uint64 `protobuf:"varint,2,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Round uint64 `protobuf:"varint,3,opt,name=Round,proto3" json:"Round,omitempty"`
+	Shard uint32 `protobuf:"varint,4,opt,name=Shard,proto3" json:"Shard,omitempty"`
+}
+
+func (m *NotarizedBlock) Reset()      { *m = NotarizedBlock{} }
+func (*NotarizedBlock) ProtoMessage() {}
+func (*NotarizedBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{37}
+}
+func (m *NotarizedBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NotarizedBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NotarizedBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotarizedBlock.Merge(m, src)
+}
+func (m *NotarizedBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *NotarizedBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotarizedBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NotarizedBlock proto.InternalMessageInfo
+
+func (m *NotarizedBlock) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *NotarizedBlock) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *NotarizedBlock) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *NotarizedBlock) GetShard() uint32 {
+	if m != nil {
+		return m.Shard
+	}
+	return 0
+}
+
+type MiniBlock struct {
+	Hash             string             `protobuf:"bytes,1,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	Type             string             `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	SourceShard      uint32             `protobuf:"varint,3,opt,name=SourceShard,proto3" json:"SourceShard,omitempty"`
+	DestinationShard uint32             `protobuf:"varint,4,opt,name=DestinationShard,proto3" json:"DestinationShard,omitempty"`
+	Transactions     []*FullTransaction `protobuf:"bytes,5,rep,name=Transactions,proto3" json:"Transactions,omitempty"`
+}
+
+func (m *MiniBlock) Reset()      { *m = MiniBlock{} }
+func (*MiniBlock) ProtoMessage() {}
+func (*MiniBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{38}
+}
+func (m *MiniBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MiniBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MiniBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MiniBlock.Merge(m, src)
+}
+func (m *MiniBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *MiniBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_MiniBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MiniBlock proto.InternalMessageInfo
+
+func (m *MiniBlock) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *MiniBlock) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *MiniBlock) GetSourceShard() uint32 {
+	if m != nil {
+		return m.SourceShard
+	}
+	return 0
+}
+
+func (m *MiniBlock) GetDestinationShard() uint32 {
+	if m != nil {
+		return m.DestinationShard
+	}
+	return 0
+}
+
+func (m *MiniBlock) GetTransactions() []*FullTransaction {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+type EpochStartInfo struct {
+	TotalSupply                      string `protobuf:"bytes,1,opt,name=TotalSupply,proto3" json:"TotalSupply,omitempty"`
+	TotalToDistribute                string `protobuf:"bytes,2,opt,name=TotalToDistribute,proto3" json:"TotalToDistribute,omitempty"`
+	TotalNewlyMinted                 string `protobuf:"bytes,3,opt,name=TotalNewlyMinted,proto3" json:"TotalNewlyMinted,omitempty"`
+	RewardsPerBlock                  string `protobuf:"bytes,4,opt,name=RewardsPerBlock,proto3" json:"RewardsPerBlock,omitempty"`
+	RewardsForProtocolSustainability string `protobuf:"bytes,5,opt,name=RewardsForProtocolSustainability,proto3" json:"RewardsForProtocolSustainability,omitempty"`
+	NodePrice                        string `protobuf:"bytes,6,opt,name=NodePrice,proto3" json:"NodePrice,omitempty"`
+	PrevEpochStartRound              uint64 `protobuf:"varint,7,opt,name=PrevEpochStartRound,proto3" json:"PrevEpochStartRound,omitempty"`
+	PrevEpochStartHash               string `protobuf:"bytes,8,opt,name=PrevEpochStartHash,proto3" json:"PrevEpochStartHash,omitempty"`
+}
+
+func (m *EpochStartInfo) Reset()      { *m = EpochStartInfo{} }
+func (*EpochStartInfo) ProtoMessage() {}
+func (*EpochStartInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{39}
+}
+func (m *EpochStartInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochStartInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EpochStartInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochStartInfo.Merge(m, src)
+}
+func (m *EpochStartInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochStartInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochStartInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochStartInfo proto.InternalMessageInfo
+
+func (m *EpochStartInfo) GetTotalSupply() string {
+	if m != nil {
+		return m.TotalSupply
+	}
+	return ""
+}
+
+func (m *EpochStartInfo) GetTotalToDistribute() string {
+	if m != nil {
+		return m.TotalToDistribute
+	}
+	return ""
+}
+
+func (m *EpochStartInfo) GetTotalNewlyMinted() string {
+	if m != nil {
+		return m.TotalNewlyMinted
+	}
+	return ""
+}
+
+func (m *EpochStartInfo) GetRewardsPerBlock() string {
+	if m != nil {
+		return m.RewardsPerBlock
+	}
+	return ""
+}
+
+func (m *EpochStartInfo) GetRewardsForProtocolSustainability() string {
+	if m != nil {
+		return m.RewardsForProtocolSustainability
+	}
+	return ""
+}
+
+func (m *EpochStartInfo) GetNodePrice() string {
+	if m != nil {
+		return m.NodePrice
+	}
+	return ""
+}
+
+func (m *EpochStartInfo) GetPrevEpochStartRound() uint64 {
+	if m != nil {
+		return m.PrevEpochStartRound
+	}
+	return 0
+}
+
+func (m *EpochStartInfo) GetPrevEpochStartHash() string {
+	if m != nil {
+		return m.PrevEpochStartHash
+	}
+	return ""
+}
+
+type Block struct {
+	Nonce                  uint64            `protobuf:"varint,1,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Round                  uint64            `protobuf:"varint,2,opt,name=Round,proto3" json:"Round,omitempty"`
+	Hash                   string            `protobuf:"bytes,3,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	PrevBlockHash          string            `protobuf:"bytes,4,opt,name=PrevBlockHash,proto3" json:"PrevBlockHash,omitempty"`
+	Epoch                  uint32            `protobuf:"varint,5,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	Shard                  uint32            `protobuf:"varint,6,opt,name=Shard,proto3" json:"Shard,omitempty"`
+	NumTxs                 uint32            `protobuf:"varint,7,opt,name=NumTxs,proto3" json:"NumTxs,omitempty"`
+	NotarizedBlocks        []*NotarizedBlock `protobuf:"bytes,8,rep,name=NotarizedBlocks,proto3" json:"NotarizedBlocks,omitempty"`
+	MiniBlocks             []*MiniBlock      `protobuf:"bytes,9,rep,name=MiniBlocks,proto3" json:"MiniBlocks,omitempty"`
+	Timestamp              int64             `protobuf:"varint,10,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	AccumulatedFees        string            `protobuf:"bytes,11,opt,name=AccumulatedFees,proto3" json:"AccumulatedFees,omitempty"`
+	DeveloperFees          string            `protobuf:"bytes,12,opt,name=DeveloperFees,proto3" json:"DeveloperFees,omitempty"`
+	AccumulatedFeesInEpoch string            `protobuf:"bytes,13,opt,name=AccumulatedFeesInEpoch,proto3" json:"AccumulatedFeesInEpoch,omitempty"`
+	DeveloperFeesInEpoch   string            `protobuf:"bytes,14,opt,name=DeveloperFeesInEpoch,proto3" json:"DeveloperFeesInEpoch,omitempty"`
+	EpochStartInfo         *EpochStartInfo   `protobuf:"bytes,15,opt,name=EpochStartInfo,proto3" json:"EpochStartInfo,omitempty"`
+	Status                 string            `protobuf:"bytes,16,opt,name=Status,proto3" json:"Status,omitempty"`
+}
+
+func (m *Block) Reset()      { *m = Block{} }
+func (*Block) ProtoMessage() {}
+func (*Block) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{40}
+}
+func (m *Block) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Block) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Block) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Block.Merge(m, src)
+}
+func (m *Block) XXX_Size() int {
+	return m.Size()
+}
+func (m *Block) XXX_DiscardUnknown() {
+	xxx_messageInfo_Block.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Block proto.InternalMessageInfo
+
+func (m *Block) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *Block) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *Block) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *Block) GetPrevBlockHash() string {
+	if m != nil {
+		return m.PrevBlockHash
+	}
+	return ""
+}
+
+func (m *Block) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *Block) GetShard() uint32 {
+	if m != nil {
+		return m.Shard
+	}
+	return 0
+}
+
+func (m *Block) GetNumTxs() uint32 {
+	if m != nil {
+		return m.NumTxs
+	}
+	return 0
+}
+
+func (m *Block) GetNotarizedBlocks() []*NotarizedBlock {
+	if m != nil {
+		return m.NotarizedBlocks
+	}
+	return nil
+}
+
+func (m *Block) GetMiniBlocks() []*MiniBlock {
+	if m != nil {
+		return m.MiniBlocks
+	}
+	return nil
+}
+
+func (m *Block) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *Block) GetAccumulatedFees() string {
+	if m != nil {
+		return m.AccumulatedFees
+	}
+	return ""
+}
+
+func (m *Block) GetDeveloperFees() string {
+	if m != nil {
+		return m.DeveloperFees
+	}
+	return ""
+}
+
+func (m *Block) GetAccumulatedFeesInEpoch() string {
+	if m != nil {
+		return m.AccumulatedFeesInEpoch
+	}
+	return ""
+}
+
+func (m *Block) GetDeveloperFeesInEpoch() string {
+	if m != nil {
+		return m.DeveloperFeesInEpoch
+	}
+	return ""
+}
+
+func (m *Block) GetEpochStartInfo() *EpochStartInfo {
+	if m != nil {
+		return m.EpochStartInfo
+	}
+	return nil
+}
+
+func (m *Block) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+type Hyperblock struct {
+	Nonce                  uint64             `protobuf:"varint,1,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Round                  uint64             `protobuf:"varint,2,opt,name=Round,proto3" json:"Round,omitempty"`
+	Hash                   string             `protobuf:"bytes,3,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	PrevBlockHash          string             `protobuf:"bytes,4,opt,name=PrevBlockHash,proto3" json:"PrevBlockHash,omitempty"`
+	Epoch                  uint32             `protobuf:"varint,5,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	NumTxs                 uint32             `protobuf:"varint,6,opt,name=NumTxs,proto3" json:"NumTxs,omitempty"`
+	ShardBlocks            []*NotarizedBlock  `protobuf:"bytes,7,rep,name=ShardBlocks,proto3" json:"ShardBlocks,omitempty"`
+	Transactions           []*FullTransaction `protobuf:"bytes,8,rep,name=Transactions,proto3" json:"Transactions,omitempty"`
+	AccumulatedFees        string             `protobuf:"bytes,9,opt,name=AccumulatedFees,proto3" json:"AccumulatedFees,omitempty"`
+	DeveloperFees          string             `protobuf:"bytes,10,opt,name=DeveloperFees,proto3" json:"DeveloperFees,omitempty"`
+	AccumulatedFeesInEpoch string             `protobuf:"bytes,11,opt,name=AccumulatedFeesInEpoch,proto3" json:"AccumulatedFeesInEpoch,omitempty"`
+	DeveloperFeesInEpoch   string             `protobuf:"bytes,12,opt,name=DeveloperFeesInEpoch,proto3" json:"DeveloperFeesInEpoch,omitempty"`
+	EpochStartInfo         *EpochStartInfo    `protobuf:"bytes,13,opt,name=EpochStartInfo,proto3" json:"EpochStartInfo,omitempty"`
+	Status                 string             `protobuf:"bytes,14,opt,name=Status,proto3" json:"Status,omitempty"`
+}
+
+func (m *Hyperblock) Reset()      { *m = Hyperblock{} }
+func (*Hyperblock) ProtoMessage() {}
+func (*Hyperblock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{41}
+}
+func (m *Hyperblock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Hyperblock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Hyperblock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Hyperblock.Merge(m, src)
+}
+func (m *Hyperblock) XXX_Size() int {
+	return m.Size()
+}
+func (m *Hyperblock) XXX_DiscardUnknown() {
+	xxx_messageInfo_Hyperblock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Hyperblock proto.InternalMessageInfo
+
+func (m *Hyperblock) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *Hyperblock) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *Hyperblock) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *Hyperblock) GetPrevBlockHash() string {
+	if m != nil {
+		return m.PrevBlockHash
+	}
+	return ""
+}
+
+func (m *Hyperblock) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *Hyperblock) GetNumTxs() uint32 {
+	if m != nil {
+		return m.NumTxs
+	}
+	return 0
+}
+
+func (m *Hyperblock) GetShardBlocks() []*NotarizedBlock {
+	if m != nil {
+		return m.ShardBlocks
+	}
+	return nil
+}
+
+func (m *Hyperblock) GetTransactions() []*FullTransaction {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+func (m *Hyperblock) GetAccumulatedFees() string {
+	if m != nil {
+		return m.AccumulatedFees
+	}
+	return ""
+}
+
+func (m *Hyperblock) GetDeveloperFees() string {
+	if m != nil {
+		return m.DeveloperFees
+	}
+	return ""
+}
+
+func (m *Hyperblock) GetAccumulatedFeesInEpoch() string {
+	if m != nil {
+		return m.AccumulatedFeesInEpoch
+	}
+	return ""
+}
+
+func (m *Hyperblock) GetDeveloperFeesInEpoch() string {
+	if m != nil {
+		return m.DeveloperFeesInEpoch
+	}
+	return ""
+}
+
+func (m *Hyperblock) GetEpochStartInfo() *EpochStartInfo {
+	if m != nil {
+		return m.EpochStartInfo
+	}
+	return nil
+}
+
+func (m *Hyperblock) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+type AtlasBlock struct {
+	Nonce        uint64                 `protobuf:"varint,1,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Hash         string                 `protobuf:"bytes,2,opt,name=Hash,proto3" json:"Hash,omitempty"`
+	Transactions []*DatabaseTransaction `protobuf:"bytes,3,rep,name=Transactions,proto3" json:"Transactions,omitempty"`
+}
+
+func (m *AtlasBlock) Reset()      { *m = AtlasBlock{} }
+func (*AtlasBlock) ProtoMessage() {}
+func (*AtlasBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{42}
+}
+func (m *AtlasBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AtlasBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AtlasBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AtlasBlock.Merge(m, src)
+}
+func (m *AtlasBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *AtlasBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_AtlasBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AtlasBlock proto.InternalMessageInfo
+
+func (m *AtlasBlock) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *AtlasBlock) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *AtlasBlock) GetTransactions() []*DatabaseTransaction {
+	if m != nil {
+		return m.Transactions
+	}
+	return nil
+}
+
+type NetworkConfig struct {
+	ChainID                   string `protobuf:"bytes,1,opt,name=ChainID,proto3" json:"erd_chain_id"`
+	Denomination              uint32 `protobuf:"varint,2,opt,name=Denomination,proto3" json:"erd_denomination"`
+	GasPerDataByte            uint64 `protobuf:"varint,3,opt,name=GasPerDataByte,proto3" json:"erd_gas_per_data_byte"`
+	LatestTagSoftwareVersion  string `protobuf:"bytes,4,opt,name=LatestTagSoftwareVersion,proto3" json:"erd_latest_tag_software_version"`
+	MetaConsensusGroupSize    uint32 `protobuf:"varint,5,opt,name=MetaConsensusGroupSize,proto3" json:"erd_meta_consensus_group_size"`
+	MinGasLimit               uint64 `protobuf:"varint,6,opt,name=MinGasLimit,proto3" json:"erd_min_gas_limit"`
+	MinGasPrice               uint64 `protobuf:"varint,7,opt,name=MinGasPrice,proto3" json:"erd_min_gas_price"`
+	MinTransactionVersion     uint32 `protobuf:"varint,8,opt,name=MinTransactionVersion,proto3" json:"erd_min_transaction_version"`
+	NumMetachainNodes         uint32 `protobuf:"varint,9,opt,name=NumMetachainNodes,proto3" json:"erd_num_metachain_nodes"`
+	NumNodesInShard           uint32 `protobuf:"varint,10,opt,name=NumNodesInShard,proto3" json:"erd_num_nodes_in_shard"`
+	NumShardsWithoutMeta      uint32 `protobuf:"varint,11,opt,name=NumShardsWithoutMeta,proto3" json:"erd_num_shards_without_meta"`
+	RewardsTopUpGradientPoint string `protobuf:"bytes,12,opt,name=RewardsTopUpGradientPoint,proto3" json:"erd_rewards_top_up_gradient_point"`
+	RoundDuration             uint64 `protobuf:"varint,13,opt,name=RoundDuration,proto3" json:"erd_round_duration"`
+	ShardConsensusGroupSize   uint32 `protobuf:"varint,14,opt,name=ShardConsensusGroupSize,proto3" json:"erd_shard_consensus_group_size"`
+	StartTime                 uint64 `protobuf:"varint,15,opt,name=StartTime,proto3" json:"erd_start_time"`
+	TopUpFactor               string `protobuf:"bytes,16,opt,name=TopUpFactor,proto3" json:"erd_top_up_factor"`
+}
+
+func (m *NetworkConfig) Reset()      { *m = NetworkConfig{} }
+func (*NetworkConfig) ProtoMessage() {}
+func (*NetworkConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{43}
+}
+func (m *NetworkConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NetworkConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NetworkConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkConfig.Merge(m, src)
+}
+func (m *NetworkConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *NetworkConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetworkConfig proto.InternalMessageInfo
+
+func (m *NetworkConfig) GetChainID() string {
+	if m != nil {
+		return m.ChainID
+	}
+	return ""
+}
+
+func (m *NetworkConfig) GetDenomination() uint32 {
+	if m != nil {
+		return m.Denomination
+	}
+	return 0
+}
+
+func (m *NetworkConfig) GetGasPerDataByte() uint64 {
+	if m != nil {
+		return m.GasPerDataByte
+	}
+	return 0
+}
+
+func (m *NetworkConfig) GetLatestTagSoftwareVersion() string {
+	if m != nil {
+		return m.LatestTagSoftwareVersion
+	}
+	return ""
+}
+
+func (m *NetworkConfig) GetMetaConsensusGroupSize() uint32 {
+	if m != nil {
+		return m.MetaConsensusGroupSize
+	}
+	return 0
+}
+
+func (m *NetworkConfig) GetMinGasLimit() uint64 {
+	if m != nil {
+		return m.MinGasLimit
+	}
+	return 0
+}
+
+func (m *NetworkConfig) GetMinGasPrice() uint64 {
+	if m != nil {
+		return m.MinGasPrice
+	}
+	return 0
+}
+
+func (m *NetworkConfig) GetMinTransactionVersion() uint32 {
+	if m != nil {
+		return m.MinTransactionVersion
+	}
+	return 0
+}
+
+func (m *NetworkConfig) GetNumMetachainNodes() uint32 {
+	if m != nil {
+		return m.NumMetachainNodes
+	}
+	return 0
+}
+
+func (m *NetworkConfig) GetNumNodesInShard() uint32 {
+	if m != nil {
+		return m.NumNodesInShard
+	}
+	return 0
+}
+
+func (m *NetworkConfig) GetNumShardsWithoutMeta() uint32 {
+	if m != nil {
+		return m.NumShardsWithoutMeta
+	}
+	return 0
+}
+
+func (m *NetworkConfig) GetRewardsTopUpGradientPoint() string {
+	if m != nil {
+		return m.RewardsTopUpGradientPoint
+	}
+	return ""
+}
+
+func (m *NetworkConfig) GetRoundDuration() uint64 {
+	if m != nil {
+		return m.RoundDuration
+	}
+	return 0
+}
+
+func (m *NetworkConfig) GetShardConsensusGroupSize() uint32 {
+	if m != nil {
+		return m.ShardConsensusGroupSize
+	}
+	return 0
+}
+
+func (m *NetworkConfig) GetStartTime() uint64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *NetworkConfig) GetTopUpFactor() string {
+	if m != nil {
+		return m.TopUpFactor
+	}
+	return ""
+}
+
+type NetworkStatus struct {
+	CurrentRound               uint64 `protobuf:"varint,1,opt,name=CurrentRound,proto3" json:"erd_current_round"`
+	EpochNumber                uint32 `protobuf:"varint,2,opt,name=EpochNumber,proto3" json:"erd_epoch_number"`
+	HighestFinalNonce          uint64 `protobuf:"varint,3,opt,name=HighestFinalNonce,proto3" json:"erd_highest_final_nonce"`
+	Nonce                      uint64 `protobuf:"varint,4,opt,name=Nonce,proto3" json:"erd_nonce"`
+	NonceAtEpochStart          uint64 `protobuf:"varint,5,opt,name=NonceAtEpochStart,proto3" json:"erd_nonce_at_epoch_start"`
+	NoncesPassedInCurrentEpoch uint64 `protobuf:"varint,6,opt,name=NoncesPassedInCurrentEpoch,proto3" json:"erd_nonces_passed_in_current_epoch"`
+	RoundAtEpochStart          uint64 `protobuf:"varint,7,opt,name=RoundAtEpochStart,proto3" json:"erd_round_at_epoch_start"`
+	RoundsPassedInCurrentEpoch uint64 `protobuf:"varint,8,opt,name=RoundsPassedInCurrentEpoch,proto3" json:"erd_rounds_passed_in_current_epoch"`
+	RoundsPerEpoch             uint64 `protobuf:"varint,9,opt,name=RoundsPerEpoch,proto3" json:"erd_rounds_per_epoch"`
+	CrossCheckBlockHeight      string `protobuf:"bytes,10,opt,name=CrossCheckBlockHeight,proto3" json:"erd_cross_check_block_height"`
+}
+
+func (m *NetworkStatus) Reset()      { *m = NetworkStatus{} }
+func (*NetworkStatus) ProtoMessage() {}
+func (*NetworkStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{44}
+}
+func (m *NetworkStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NetworkStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NetworkStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkStatus.Merge(m, src)
+}
+func (m *NetworkStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *NetworkStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetworkStatus proto.InternalMessageInfo
+
+func (m *NetworkStatus) GetCurrentRound() uint64 {
+	if m != nil {
+		return m.CurrentRound
+	}
+	return 0
+}
+
+func (m *NetworkStatus) GetEpochNumber() uint32 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *NetworkStatus) GetHighestFinalNonce() uint64 {
+	if m != nil {
+		return m.HighestFinalNonce
+	}
+	return 0
+}
+
+func (m *NetworkStatus) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *NetworkStatus) GetNonceAtEpochStart() uint64 {
+	if m != nil {
+		return m.NonceAtEpochStart
+	}
+	return 0
+}
+
+func (m *NetworkStatus) GetNoncesPassedInCurrentEpoch() uint64 {
+	if m != nil {
+		return m.NoncesPassedInCurrentEpoch
+	}
+	return 0
+}
+
+func (m *NetworkStatus) GetRoundAtEpochStart() uint64 {
+	if m != nil {
+		return m.RoundAtEpochStart
+	}
+	return 0
+}
+
+func (m *NetworkStatus) GetRoundsPassedInCurrentEpoch() uint64 {
+	if m != nil {
+		return m.RoundsPassedInCurrentEpoch
+	}
+	return 0
+}
+
+func (m *NetworkStatus) GetRoundsPerEpoch() uint64 {
+	if m != nil {
+		return m.RoundsPerEpoch
+	}
+	return 0
+}
+
+func (m *NetworkStatus) GetCrossCheckBlockHeight() string {
+	if m != nil {
+		return m.CrossCheckBlockHeight
+	}
+	return ""
+}
+
+type EconomicsData struct {
+	TotalSupply           string `protobuf:"bytes,1,opt,name=TotalSupply,proto3" json:"erd_total_supply"`
+	CirculatingSupply     string `protobuf:"bytes,2,opt,name=CirculatingSupply,proto3" json:"erd_circulating_supply"`
+	StakedValue           string `protobuf:"bytes,3,opt,name=StakedValue,proto3" json:"erd_staked_value"`
+	TotalBaseStakedValue  string `protobuf:"bytes,4,opt,name=TotalBaseStakedValue,proto3" json:"erd_total_base_staked_value"`
+	TotalTopUpValue       string `protobuf:"bytes,5,opt,name=TotalTopUpValue,proto3" json:"erd_total_top_up_value"`
+	DevRewards            string `protobuf:"bytes,6,opt,name=DevRewards,proto3" json:"erd_dev_rewards"`
+	Inflation             string `protobuf:"bytes,7,opt,name=Inflation,proto3" json:"erd_inflation"`
+	TotalFees             string `protobuf:"bytes,8,opt,name=TotalFees,proto3" json:"erd_total_fees"`
+	EpochNumber           uint32 `protobuf:"varint,9,opt,name=EpochNumber,proto3" json:"erd_epoch_number"`
+	EpochForEconomicsData uint32 `protobuf:"varint,10,opt,name=EpochForEconomicsData,proto3" json:"erd_epoch_for_economics_data"`
+}
+
+func (m *EconomicsData) Reset()      { *m = EconomicsData{} }
+func (*EconomicsData) ProtoMessage() {}
+func (*EconomicsData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{45}
+}
+func (m *EconomicsData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EconomicsData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EconomicsData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EconomicsData.Merge(m, src)
+}
+func (m *EconomicsData) XXX_Size() int {
+	return m.Size()
+}
+func (m *EconomicsData) XXX_DiscardUnknown() {
+	xxx_messageInfo_EconomicsData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EconomicsData proto.InternalMessageInfo
+
+func (m *EconomicsData) GetTotalSupply() string {
+	if m != nil {
+		return m.TotalSupply
+	}
+	return ""
+}
+
+func (m *EconomicsData) GetCirculatingSupply() string {
+	if m != nil {
+		return m.CirculatingSupply
+	}
+	return ""
+}
+
+func (m *EconomicsData) GetStakedValue() string {
+	if m != nil {
+		return m.StakedValue
+	}
+	return ""
+}
+
+func (m *EconomicsData) GetTotalBaseStakedValue() string {
+	if m != nil {
+		return m.TotalBaseStakedValue
+	}
+	return ""
+}
+
+func (m *EconomicsData) GetTotalTopUpValue() string {
+	if m != nil {
+		return m.TotalTopUpValue
+	}
+	return ""
+}
+
+func (m *EconomicsData) GetDevRewards() string {
+	if m != nil {
+		return m.DevRewards
+	}
+	return ""
+}
+
+func (m *EconomicsData) GetInflation() string {
+	if m != nil {
+		return m.Inflation
+	}
+	return ""
+}
+
+func (m *EconomicsData) GetTotalFees() string {
+	if m != nil {
+		return m.TotalFees
+	}
+	return ""
+}
+
+func (m *EconomicsData) GetEpochNumber() uint32 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *EconomicsData) GetEpochForEconomicsData() uint32 {
+	if m != nil {
+		return m.EpochForEconomicsData
+	}
+	return 0
+}
+
+type EnableEpochsResponse struct {
+	EnableEpochs map[string]uint32 `protobuf:"bytes,1,rep,name=EnableEpochs,proto3" json:"enableEpochs" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (m *EnableEpochsResponse) Reset()      { *m = EnableEpochsResponse{} }
+func (*EnableEpochsResponse) ProtoMessage() {}
+func (*EnableEpochsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{46}
+}
+func (m *EnableEpochsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnableEpochsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *EnableEpochsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnableEpochsResponse.Merge(m, src)
+}
+func (m *EnableEpochsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnableEpochsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnableEpochsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnableEpochsResponse proto.InternalMessageInfo
+
+func (m *EnableEpochsResponse) GetEnableEpochs() map[string]uint32 {
+	if m != nil {
+		return m.EnableEpochs
+	}
+	return nil
+}
+
+type NonceResponse struct {
+	Nonce uint64 `protobuf:"varint,1,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+}
+
+func (m *NonceResponse) Reset()      { *m = NonceResponse{} }
+func (*NonceResponse) ProtoMessage() {}
+func (*NonceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{47}
+}
+func (m *NonceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NonceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NonceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NonceResponse.Merge(m, src)
+}
+func (m *NonceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *NonceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NonceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NonceResponse proto.InternalMessageInfo
+
+func (m *NonceResponse) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+type StakedInfo struct {
+	Address    string `protobuf:"bytes,1,opt,name=Address,proto3" json:"address"`
+	BaseStaked string `protobuf:"bytes,2,opt,name=BaseStaked,proto3" json:"baseStaked"`
+	TopUp      string `protobuf:"bytes,3,opt,name=TopUp,proto3" json:"topUp"`
+	Total      string `protobuf:"bytes,4,opt,name=Total,proto3" json:"total"`
+}
+
+func (m *StakedInfo) Reset()      { *m = StakedInfo{} }
+func (*StakedInfo) ProtoMessage() {}
+func (*StakedInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{48}
+}
+func (m *StakedInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakedInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StakedInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakedInfo.Merge(m, src)
+}
+func (m *StakedInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakedInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakedInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakedInfo proto.InternalMessageInfo
+
+func (m *StakedInfo) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *StakedInfo) GetBaseStaked() string {
+	if m != nil {
+		return m.BaseStaked
+	}
+	return ""
+}
+
+func (m *StakedInfo) GetTopUp() string {
+	if m != nil {
+		return m.TopUp
+	}
+	return ""
+}
+
+func (m *StakedInfo) GetTotal() string {
+	if m != nil {
+		return m.Total
+	}
+	return ""
+}
+
+type DirectStakedInfoResponse struct {
+	List []*StakedInfo `protobuf:"bytes,1,rep,name=List,proto3" json:"list"`
+}
+
+func (m *DirectStakedInfoResponse) Reset()      { *m = DirectStakedInfoResponse{} }
+func (*DirectStakedInfoResponse) ProtoMessage() {}
+func (*DirectStakedInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{49}
+}
+func (m *DirectStakedInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DirectStakedInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DirectStakedInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DirectStakedInfoResponse.Merge(m, src)
+}
+func (m *DirectStakedInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DirectStakedInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DirectStakedInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DirectStakedInfoResponse proto.InternalMessageInfo
+
+func (m *DirectStakedInfoResponse) GetList() []*StakedInfo {
+	if m != nil {
+		return m.List
+	}
+	return nil
+}
+
+type DelegatedValue struct {
+	DelegationScAddress string `protobuf:"bytes,1,opt,name=DelegationScAddress,proto3" json:"delegationScAddress"`
+	Value               string `protobuf:"bytes,2,opt,name=Value,proto3" json:"value"`
+}
+
+func (m *DelegatedValue) Reset()      { *m = DelegatedValue{} }
+func (*DelegatedValue) ProtoMessage() {}
+func (*DelegatedValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{50}
+}
+func (m *DelegatedValue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatedValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DelegatedValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatedValue.Merge(m, src)
+}
+func (m *DelegatedValue) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatedValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatedValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatedValue proto.InternalMessageInfo
+
+func (m *DelegatedValue) GetDelegationScAddress() string {
+	if m != nil {
+		return m.DelegationScAddress
+	}
+	return ""
+}
+
+func (m *DelegatedValue) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type DelegatorInfo struct {
+	DelegatorAddress string            `protobuf:"bytes,1,opt,name=DelegatorAddress,proto3" json:"delegatorAddress"`
+	DelegatedTo      []*DelegatedValue `protobuf:"bytes,2,rep,name=DelegatedTo,proto3" json:"delegatedTo"`
+	Total            string            `protobuf:"bytes,3,opt,name=Total,proto3" json:"total"`
+}
+
+func (m *DelegatorInfo) Reset()      { *m = DelegatorInfo{} }
+func (*DelegatorInfo) ProtoMessage() {}
+func (*DelegatorInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{51}
+}
+func (m *DelegatorInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DelegatorInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorInfo.Merge(m, src)
+}
+func (m *DelegatorInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorInfo proto.InternalMessageInfo
+
+func (m *DelegatorInfo) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+func (m *DelegatorInfo) GetDelegatedTo() []*DelegatedValue {
+	if m != nil {
+		return m.DelegatedTo
+	}
+	return nil
+}
+
+func (m *DelegatorInfo) GetTotal() string {
+	if m != nil {
+		return m.Total
+	}
+	return ""
+}
+
+type DelegatedInfoResponse struct {
+	List []*DelegatorInfo `protobuf:"bytes,1,rep,name=List,proto3" json:"list"`
+}
+
+func (m *DelegatedInfoResponse) Reset()      { *m = DelegatedInfoResponse{} }
+func (*DelegatedInfoResponse) ProtoMessage() {}
+func (*DelegatedInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{52}
+}
+func (m *DelegatedInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatedInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DelegatedInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatedInfoResponse.Merge(m, src)
+}
+func (m *DelegatedInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatedInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatedInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatedInfoResponse proto.InternalMessageInfo
+
+func (m *DelegatedInfoResponse) GetList() []*DelegatorInfo {
+	if m != nil {
+		return m.List
+	}
+	return nil
+}
+
+type SCQuery struct {
+	ScAddress  string   `protobuf:"bytes,1,opt,name=ScAddress,proto3" json:"ScAddress,omitempty"`
+	FuncName   string   `protobuf:"bytes,2,opt,name=FuncName,proto3" json:"FuncName,omitempty"`
+	CallerAddr string   `protobuf:"bytes,3,opt,name=CallerAddr,proto3" json:"CallerAddr,omitempty"`
+	CallValue  string   `protobuf:"bytes,4,opt,name=CallValue,proto3" json:"CallValue,omitempty"`
+	Arguments  [][]byte `protobuf:"bytes,5,rep,name=Arguments,proto3" json:"Arguments,omitempty"`
+}
+
+func (m *SCQuery) Reset()      { *m = SCQuery{} }
+func (*SCQuery) ProtoMessage() {}
+func (*SCQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{53}
+}
+func (m *SCQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SCQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SCQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SCQuery.Merge(m, src)
+}
+func (m *SCQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *SCQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_SCQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SCQuery proto.InternalMessageInfo
+
+func (m *SCQuery) GetScAddress() string {
+	if m != nil {
+		return m.ScAddress
+	}
+	return ""
+}
+
+func (m *SCQuery) GetFuncName() string {
+	if m != nil {
+		return m.FuncName
+	}
+	return ""
+}
+
+func (m *SCQuery) GetCallerAddr() string {
+	if m != nil {
+		return m.CallerAddr
+	}
+	return ""
+}
+
+func (m *SCQuery) GetCallValue() string {
+	if m != nil {
+		return m.CallValue
+	}
+	return ""
+}
+
+func (m *SCQuery) GetArguments() [][]byte {
+	if m != nil {
+		return m.Arguments
+	}
+	return nil
+}
+
+type StorageUpdate struct {
+	Offset []byte `protobuf:"bytes,1,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	Data   []byte `protobuf:"bytes,2,opt,name=Data,proto3" json:"Data,omitempty"`
+}
+
+func (m *StorageUpdate) Reset()      { *m = StorageUpdate{} }
+func (*StorageUpdate) ProtoMessage() {}
+func (*StorageUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{54}
+}
+func (m *StorageUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StorageUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StorageUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageUpdate.Merge(m, src)
+}
+func (m *StorageUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageUpdate proto.InternalMessageInfo
+
+func (m *StorageUpdate) GetOffset() []byte {
+	if m != nil {
+		return m.Offset
+	}
+	return nil
+}
+
+func (m *StorageUpdate) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type OutputTransfer struct {
+	Value    string `protobuf:"bytes,1,opt,name=Value,proto3" json:"Value,omitempty"`
+	GasLimit uint64 `protobuf:"varint,2,opt,name=GasLimit,proto3" json:"GasLimit,omitempty"`
+	Data     []byte `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+	CallType int32  `protobuf:"varint,4,opt,name=CallType,proto3" json:"CallType,omitempty"`
+}
+
+func (m *OutputTransfer) Reset()      { *m = OutputTransfer{} }
+func (*OutputTransfer) ProtoMessage() {}
+func (*OutputTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{55}
+}
+func (m *OutputTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutputTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OutputTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutputTransfer.Merge(m, src)
+}
+func (m *OutputTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutputTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutputTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutputTransfer proto.InternalMessageInfo
+
+func (m *OutputTransfer) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *OutputTransfer) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *OutputTransfer) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *OutputTransfer) GetCallType() int32 {
+	if m != nil {
+		return m.CallType
+	}
+	return 0
+}
+
+type OutputAccount struct {
+	Address         string                    `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+	Nonce           uint64                    `protobuf:"varint,2,opt,name=Nonce,proto3" json:"Nonce,omitempty"`
+	Balance         string                    `protobuf:"bytes,3,opt,name=Balance,proto3" json:"Balance,omitempty"`
+	BalanceDelta    string                    `protobuf:"bytes,4,opt,name=BalanceDelta,proto3" json:"BalanceDelta,omitempty"`
+	StorageUpdates  map[string]*StorageUpdate `protobuf:"bytes,5,rep,name=StorageUpdates,proto3" json:"StorageUpdates,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Code            []byte                    `protobuf:"bytes,6,opt,name=Code,proto3" json:"Code,omitempty"`
+	CodeMetadata    []byte                    `protobuf:"bytes,7,opt,name=CodeMetadata,proto3" json:"CodeMetadata,omitempty"`
+	OutputTransfers []*OutputTransfer         `protobuf:"bytes,8,rep,name=OutputTransfers,proto3" json:"OutputTransfers,omitempty"`
+	CallType        int32                     `protobuf:"varint,9,opt,name=CallType,proto3" json:"CallType,omitempty"`
+}
+
+func (m *OutputAccount) Reset()      { *m = OutputAccount{} }
+func (*OutputAccount) ProtoMessage() {}
+func (*OutputAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{56}
+}
+func (m *OutputAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutputAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OutputAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutputAccount.Merge(m, src)
+}
+func (m *OutputAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutputAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutputAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutputAccount proto.InternalMessageInfo
+
+func (m *OutputAccount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *OutputAccount) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *OutputAccount) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+func (m *OutputAccount) GetBalanceDelta() string {
+	if m != nil {
+		return m.BalanceDelta
+	}
+	return ""
+}
+
+func (m *OutputAccount) GetStorageUpdates() map[string]*StorageUpdate {
+	if m != nil {
+		return m.StorageUpdates
+	}
+	return nil
+}
+
+func (m *OutputAccount) GetCode() []byte {
+	if m != nil {
+		return m.Code
+	}
+	return nil
+}
+
+func (m *OutputAccount) GetCodeMetadata() []byte {
+	if m != nil {
+		return m.CodeMetadata
+	}
+	return nil
+}
+
+func (m *OutputAccount) GetOutputTransfers() []*OutputTransfer {
+	if m != nil {
+		return m.OutputTransfers
+	}
+	return nil
+}
+
+func (m *OutputAccount) GetCallType() int32 {
+	if m != nil {
+		return m.CallType
+	}
+	return 0
+}
+
+type LogEntry struct {
+	Identifier []byte   `protobuf:"bytes,1,opt,name=Identifier,proto3" json:"Identifier,omitempty"`
+	Address    string   `protobuf:"bytes,2,opt,name=Address,proto3" json:"Address,omitempty"`
+	Topics     [][]byte `protobuf:"bytes,3,rep,name=Topics,proto3" json:"Topics,omitempty"`
+	Data       []byte   `protobuf:"bytes,4,opt,name=Data,proto3" json:"Data,omitempty"`
+}
+
+func (m *LogEntry) Reset()      { *m = LogEntry{} }
+func (*LogEntry) ProtoMessage() {}
+func (*LogEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{57}
+}
+func (m *LogEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LogEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *LogEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogEntry.Merge(m, src)
+}
+func (m *LogEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *LogEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogEntry proto.InternalMessageInfo
+
+func (m *LogEntry) GetIdentifier() []byte {
+	if m != nil {
+		return m.Identifier
+	}
+	return nil
+}
+
+func (m *LogEntry) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *LogEntry) GetTopics() [][]byte {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *LogEntry) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type VMOutput struct {
+	ReturnData      [][]byte                  `protobuf:"bytes,1,rep,name=ReturnData,proto3" json:"ReturnData,omitempty"`
+	ReturnCode      string                    `protobuf:"bytes,2,opt,name=ReturnCode,proto3" json:"ReturnCode,omitempty"`
+	ReturnMessage   string                    `protobuf:"bytes,3,opt,name=ReturnMessage,proto3" json:"ReturnMessage,omitempty"`
+	GasRemaining    uint64                    `protobuf:"varint,4,opt,name=GasRemaining,proto3" json:"GasRemaining,omitempty"`
+	GasRefund       string                    `protobuf:"bytes,5,opt,name=GasRefund,proto3" json:"GasRefund,omitempty"`
+	OutputAccounts  map[string]*OutputAccount `protobuf:"bytes,6,rep,name=OutputAccounts,proto3" json:"OutputAccounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	DeletedAccounts [][]byte                  `protobuf:"bytes,7,rep,name=DeletedAccounts,proto3" json:"DeletedAccounts,omitempty"`
+	TouchedAccounts [][]byte                  `protobuf:"bytes,8,rep,name=TouchedAccounts,proto3" json:"TouchedAccounts,omitempty"`
+	Logs            []*LogEntry               `protobuf:"bytes,9,rep,name=Logs,proto3" json:"Logs,omitempty"`
+}
+
+func (m *VMOutput) Reset()      { *m = VMOutput{} }
+func (*VMOutput) ProtoMessage() {}
+func (*VMOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{58}
+}
+func (m *VMOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VMOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *VMOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VMOutput.Merge(m, src)
+}
+func (m *VMOutput) XXX_Size() int {
+	return m.Size()
+}
+func (m *VMOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_VMOutput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VMOutput proto.InternalMessageInfo
+
+func (m *VMOutput) GetReturnData() [][]byte {
+	if m != nil {
+		return m.ReturnData
+	}
+	return nil
+}
+
+func (m *VMOutput) GetReturnCode() string {
+	if m != nil {
+		return m.ReturnCode
+	}
+	return ""
+}
+
+func (m *VMOutput) GetReturnMessage() string {
+	if m != nil {
+		return m.ReturnMessage
+	}
+	return ""
+}
+
+func (m *VMOutput) GetGasRemaining() uint64 {
+	if m != nil {
+		return m.GasRemaining
+	}
+	return 0
+}
+
+func (m *VMOutput) GetGasRefund() string {
+	if m != nil {
+		return m.GasRefund
+	}
+	return ""
+}
+
+func (m *VMOutput) GetOutputAccounts() map[string]*OutputAccount {
+	if m != nil {
+		return m.OutputAccounts
+	}
+	return nil
+}
+
+func (m *VMOutput) GetDeletedAccounts() [][]byte {
+	if m != nil {
+		return m.DeletedAccounts
+	}
+	return nil
+}
+
+func (m *VMOutput) GetTouchedAccounts() [][]byte {
+	if m != nil {
+		return m.TouchedAccounts
+	}
+	return nil
+}
+
+func (m *VMOutput) GetLogs() []*LogEntry {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Empty)(nil), "grpcapi.Empty")
+	proto.RegisterType((*AddressRequest)(nil), "grpcapi.AddressRequest")
+	proto.RegisterType((*AccountKeyRequest)(nil), "grpcapi.AccountKeyRequest")
+	proto.RegisterType((*ESDTTokenRequest)(nil), "grpcapi.ESDTTokenRequest")
+	proto.RegisterType((*ESDTNftTokenRequest)(nil), "grpcapi.ESDTNftTokenRequest")
+	proto.RegisterType((*ESDTsWithRoleRequest)(nil), "grpcapi.ESDTsWithRoleRequest")
+	proto.RegisterType((*ShardRequest)(nil), "grpcapi.ShardRequest")
+	proto.RegisterType((*TokenTypeRequest)(nil), "grpcapi.TokenTypeRequest")
+	proto.RegisterType((*Account)(nil), "grpcapi.Account")
+	proto.RegisterType((*ShardIDResponse)(nil), "grpcapi.ShardIDResponse")
+	proto.RegisterType((*ValueResponse)(nil), "grpcapi.ValueResponse")
+	proto.RegisterType((*KeyValuePairsResponse)(nil), "grpcapi.KeyValuePairsResponse")
+	proto.RegisterMapType((map[string]string)(nil), "grpcapi.KeyValuePairsResponse.PairsEntry")
+	proto.RegisterType((*ESDTToken)(nil), "grpcapi.ESDTToken")
+	proto.RegisterType((*ESDTTokensResponse)(nil), "grpcapi.ESDTTokensResponse")
+	proto.RegisterType((*TokenIdentifiersResponse)(nil), "grpcapi.TokenIdentifiersResponse")
+	proto.RegisterType((*DatabaseTransaction)(nil), "grpcapi.DatabaseTransaction")
+	proto.RegisterType((*DatabaseTransactionsResponse)(nil), "grpcapi.DatabaseTransactionsResponse")
+	proto.RegisterType((*Transaction)(nil), "grpcapi.Transaction")
+	proto.RegisterType((*MultipleTransactionsRequest)(nil), "grpcapi.MultipleTransactionsRequest")
+	proto.RegisterType((*MultipleTransactionsResponse)(nil), "grpcapi.MultipleTransactionsResponse")
+	proto.RegisterMapType((map[uint32]string)(nil), "grpcapi.MultipleTransactionsResponse.TxsHashesEntry")
+	proto.RegisterType((*SimulateTransactionRequest)(nil), "grpcapi.SimulateTransactionRequest")
+	proto.RegisterType((*SmartContractResult)(nil), "grpcapi.SmartContractResult")
+	proto.RegisterType((*Receipt)(nil), "grpcapi.Receipt")
+	proto.RegisterType((*SimulationResults)(nil), "grpcapi.SimulationResults")
+	proto.RegisterMapType((map[string]*Receipt)(nil), "grpcapi.SimulationResults.ReceiptsEntry")
+	proto.RegisterMapType((map[string]*SmartContractResult)(nil), "grpcapi.SimulationResults.ScResultsEntry")
+	proto.RegisterType((*SimulationResponse)(nil), "grpcapi.SimulationResponse")
+	proto.RegisterType((*TransactionCostResponse)(nil), "grpcapi.TransactionCostResponse")
+	proto.RegisterType((*TransactionHashResponse)(nil), "grpcapi.TransactionHashResponse")
+	proto.RegisterType((*GetTransactionRequest)(nil), "grpcapi.GetTransactionRequest")
+	proto.RegisterType((*TransactionStatusRequest)(nil), "grpcapi.TransactionStatusRequest")
+	proto.RegisterType((*TransactionStatusResponse)(nil), "grpcapi.TransactionStatusResponse")
+	proto.RegisterType((*FullTransaction)(nil), "grpcapi.FullTransaction")
+	proto.RegisterType((*BlockByNonceRequest)(nil), "grpcapi.BlockByNonceRequest")
+	proto.RegisterType((*BlockByHashRequest)(nil), "grpcapi.BlockByHashRequest")
+	proto.RegisterType((*AtlasBlockRequest)(nil), "grpcapi.AtlasBlockRequest")
+	proto.RegisterType((*HyperBlockByNonceRequest)(nil), "grpcapi.HyperBlockByNonceRequest")
+	proto.RegisterType((*HyperBlockByHashRequest)(nil), "grpcapi.HyperBlockByHashRequest")
+	proto.RegisterType((*StreamHyperBlocksRequest)(nil), "grpcapi.StreamHyperBlocksRequest")
+	proto.RegisterType((*NotarizedBlock)(nil), "grpcapi.NotarizedBlock")
+	proto.RegisterType((*MiniBlock)(nil), "grpcapi.MiniBlock")
+	proto.RegisterType((*EpochStartInfo)(nil), "grpcapi.EpochStartInfo")
+	proto.RegisterType((*Block)(nil), "grpcapi.Block")
+	proto.RegisterType((*Hyperblock)(nil), "grpcapi.Hyperblock")
+	proto.RegisterType((*AtlasBlock)(nil), "grpcapi.AtlasBlock")
+	proto.RegisterType((*NetworkConfig)(nil), "grpcapi.NetworkConfig")
+	proto.RegisterType((*NetworkStatus)(nil), "grpcapi.NetworkStatus")
+	proto.RegisterType((*EconomicsData)(nil), "grpcapi.EconomicsData")
+	proto.RegisterType((*EnableEpochsResponse)(nil), "grpcapi.EnableEpochsResponse")
+	proto.RegisterMapType((map[string]uint32)(nil), "grpcapi.EnableEpochsResponse.EnableEpochsEntry")
+	proto.RegisterType((*NonceResponse)(nil), "grpcapi.NonceResponse")
+	proto.RegisterType((*StakedInfo)(nil), "grpcapi.StakedInfo")
+	proto.RegisterType((*DirectStakedInfoResponse)(nil), "grpcapi.DirectStakedInfoResponse")
+	proto.RegisterType((*DelegatedValue)(nil), "grpcapi.DelegatedValue")
+	proto.RegisterType((*DelegatorInfo)(nil), "grpcapi.DelegatorInfo")
+	proto.RegisterType((*DelegatedInfoResponse)(nil), "grpcapi.DelegatedInfoResponse")
+	proto.RegisterType((*SCQuery)(nil), "grpcapi.SCQuery")
+	proto.RegisterType((*StorageUpdate)(nil), "grpcapi.StorageUpdate")
+	proto.RegisterType((*OutputTransfer)(nil), "grpcapi.OutputTransfer")
+	proto.RegisterType((*OutputAccount)(nil), "grpcapi.OutputAccount")
+	proto.RegisterMapType((map[string]*StorageUpdate)(nil), "grpcapi.OutputAccount.StorageUpdatesEntry")
+	proto.RegisterType((*LogEntry)(nil), "grpcapi.LogEntry")
+	proto.RegisterType((*VMOutput)(nil), "grpcapi.VMOutput")
+	proto.RegisterMapType((map[string]*OutputAccount)(nil), "grpcapi.VMOutput.OutputAccountsEntry")
+}
+
+func init() { proto.RegisterFile("proxy.proto", fileDescriptor_700b50b08ed8dbaf) }
+
+var fileDescriptor_700b50b08ed8dbaf = []byte{
+	// 4702 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x7b, 0x4d, 0x8c, 0x1b, 0x47,
+	0x76, 0xb0, 0x38, 0xe4, 0xfc, 0xf0, 0x0d, 0xc9, 0x99, 0xe9, 0xf9, 0x11, 0x45, 0xc9, 0xe4, 0xa8,
+	0x6d, 0x19, 0x5a, 0x7f, 0xbb, 0x63, 0x7f, 0xf2, 0xc2, 0xeb, 0xdd, 0x38, 0xb0, 0x86, 0x33, 0x9a,
+	0xd1, 0xd8, 0x9a, 0x91, 0x5c, 0x1c, 0xc9, 0x59, 0x6f, 0x02, 0xa6, 0x87, 0xac, 0xe1, 0x34, 0x44,
+	0x76, 0x33, 0xdd, 0xd5, 0x92, 0x68, 0xe4, 0x10, 0x04, 0x01, 0x72, 0x48, 0x80, 0xe4, 0xba, 0x87,
+	0x20, 0xa7, 0x00, 0xb9, 0x24, 0xe7, 0x5c, 0x92, 0x1c, 0x02, 0x04, 0x39, 0xfa, 0x10, 0x20, 0x0e,
+	0x82, 0x10, 0xb1, 0x7c, 0x49, 0x78, 0xda, 0x43, 0x2e, 0xb9, 0x05, 0xf5, 0xaa, 0xba, 0xbb, 0xaa,
+	0xbb, 0xc9, 0x91, 0x0c, 0x03, 0x39, 0xb1, 0xeb, 0xfd, 0xd5, 0xcf, 0x7b, 0xf5, 0xde, 0xab, 0xaa,
+	0x47, 0x58, 0x1e, 0x7a, 0xee, 0x8b, 0xd1, 0xce, 0xd0, 0x73, 0x99, 0x6b, 0x2c, 0xf6, 0xbc, 0x61,
+	0xc7, 0x1a, 0xda, 0xb5, 0x1f, 0xf5, 0x6c, 0x76, 0x11, 0x9c, 0xed, 0x74, 0xdc, 0xc1, 0xbb, 0x3d,
+	0xb7, 0xe7, 0xbe, 0x8b, 0xf8, 0xb3, 0xe0, 0x1c, 0x5b, 0xd8, 0xc0, 0x2f, 0xc1, 0x67, 0x2e, 0xc2,
+	0xfc, 0xbd, 0xc1, 0x90, 0x8d, 0xcc, 0x77, 0xa0, 0xb2, 0xdb, 0xed, 0x7a, 0xd4, 0xf7, 0x09, 0xfd,
+	0x9d, 0x80, 0xfa, 0xcc, 0xa8, 0xc2, 0xa2, 0x84, 0x54, 0x73, 0xdb, 0xb9, 0xdb, 0x45, 0x12, 0x36,
+	0xcd, 0x8f, 0x61, 0x6d, 0xb7, 0xd3, 0x71, 0x03, 0x87, 0x7d, 0x4a, 0x47, 0x97, 0x92, 0x1b, 0xab,
+	0x90, 0xff, 0x94, 0x8e, 0xaa, 0x73, 0x08, 0xe5, 0x9f, 0xe6, 0x13, 0x58, 0xbd, 0xd7, 0xda, 0x3f,
+	0x3d, 0x75, 0x9f, 0x52, 0xe7, 0x72, 0xfe, 0xdb, 0xb0, 0x82, 0x94, 0x47, 0x5d, 0xea, 0x30, 0xfb,
+	0xdc, 0xa6, 0x9e, 0x94, 0x95, 0x04, 0x9b, 0x2e, 0xac, 0x73, 0xb9, 0x27, 0xe7, 0xec, 0xfb, 0x16,
+	0x6d, 0x6c, 0xc0, 0xfc, 0x89, 0xeb, 0x74, 0x68, 0x35, 0xbf, 0x9d, 0xbb, 0x5d, 0x20, 0xa2, 0x61,
+	0xee, 0xc3, 0x06, 0xef, 0xd0, 0xff, 0xdc, 0x66, 0x17, 0xc4, 0xed, 0xd3, 0xcb, 0x7b, 0x34, 0xa0,
+	0xc0, 0x09, 0x65, 0x37, 0xf8, 0x6d, 0xbe, 0x05, 0xa5, 0xd6, 0x85, 0xe5, 0x75, 0x43, 0xee, 0x0d,
+	0x98, 0xc7, 0x36, 0xf2, 0x96, 0x89, 0x68, 0x98, 0xef, 0xc1, 0x2a, 0x0e, 0xea, 0x74, 0x34, 0x8c,
+	0xfa, 0xb9, 0x01, 0xc5, 0x08, 0x26, 0x7b, 0x8a, 0x01, 0xe6, 0x5f, 0xcd, 0xc1, 0xa2, 0x54, 0xd4,
+	0x8c, 0x11, 0x45, 0x33, 0x9b, 0x53, 0x66, 0xc6, 0xe9, 0x9b, 0x56, 0xdf, 0x0a, 0x67, 0x5c, 0x24,
+	0x61, 0xd3, 0xa8, 0xc1, 0xd2, 0x63, 0x9f, 0x7a, 0x8e, 0x35, 0xa0, 0xd5, 0x02, 0xa2, 0xa2, 0x36,
+	0x9f, 0xdd, 0x9e, 0xdb, 0xa5, 0xd5, 0x79, 0x31, 0x3b, 0xfe, 0xcd, 0xe9, 0xf9, 0xef, 0x7d, 0xcb,
+	0xbf, 0xa8, 0x2e, 0x6c, 0xe7, 0x6e, 0x97, 0x48, 0xd4, 0xe6, 0x38, 0xe2, 0xba, 0x0c, 0x71, 0x8b,
+	0x02, 0x17, 0xb6, 0x0d, 0x13, 0x4a, 0x9c, 0xee, 0x98, 0x32, 0xab, 0x6b, 0x31, 0xab, 0xba, 0x84,
+	0x78, 0x0d, 0xc6, 0xf5, 0xb7, 0x4f, 0x9f, 0xd1, 0xbe, 0x3b, 0xa4, 0x1e, 0xa1, 0xcf, 0xf9, 0x9a,
+	0x15, 0x85, 0xfe, 0x12, 0x60, 0x2e, 0xed, 0xe1, 0x73, 0x87, 0x7a, 0xe1, 0x22, 0x00, 0x92, 0x69,
+	0x30, 0xf3, 0xff, 0xc1, 0x0a, 0x2e, 0xf5, 0xd1, 0x3e, 0xa1, 0xfe, 0xd0, 0x75, 0x7c, 0x5c, 0x06,
+	0x09, 0x92, 0xca, 0x08, 0x9b, 0xe6, 0x2d, 0x28, 0x3f, 0xb1, 0xfa, 0x01, 0x8d, 0x48, 0x37, 0x60,
+	0x1e, 0x01, 0x72, 0x7d, 0x45, 0xc3, 0xfc, 0xb3, 0x1c, 0x6c, 0x7e, 0x4a, 0x47, 0xd8, 0x78, 0x64,
+	0xd9, 0x9e, 0x1f, 0xd1, 0x7f, 0x02, 0xf3, 0x08, 0xa8, 0xe6, 0xb6, 0xf3, 0xb7, 0x97, 0xef, 0xfc,
+	0x60, 0x47, 0x6e, 0xe1, 0x9d, 0x4c, 0xf2, 0x1d, 0x6c, 0xdd, 0x73, 0x98, 0x37, 0x6a, 0x16, 0x27,
+	0xe3, 0xc6, 0xfc, 0x10, 0xb1, 0x42, 0x44, 0xed, 0x43, 0x80, 0x18, 0xcf, 0x37, 0xdc, 0x53, 0x3a,
+	0x92, 0xe3, 0xe0, 0x9f, 0x7c, 0x6c, 0xcf, 0x70, 0x6c, 0xc2, 0xec, 0x44, 0xe3, 0x67, 0x73, 0x1f,
+	0xe6, 0xcc, 0x5f, 0xce, 0x41, 0x31, 0xda, 0x8b, 0x59, 0xfb, 0x21, 0x97, 0xbd, 0x1f, 0x14, 0xfb,
+	0x98, 0xd3, 0xed, 0xa3, 0x0e, 0xf0, 0xc8, 0xe3, 0x2b, 0xcf, 0x6c, 0xea, 0x4b, 0xe3, 0x51, 0x20,
+	0xb1, 0xbd, 0x15, 0x54, 0x7b, 0x33, 0xa0, 0x70, 0x62, 0x0d, 0x22, 0xcb, 0xe1, 0xdf, 0xbc, 0x8f,
+	0x3d, 0x8f, 0x5a, 0xcc, 0xf5, 0xd0, 0x70, 0x8a, 0x24, 0x6c, 0x72, 0xbb, 0x27, 0xee, 0xc8, 0xea,
+	0x63, 0x17, 0x8b, 0xc2, 0xee, 0x23, 0x00, 0x97, 0x85, 0x16, 0x25, 0x2c, 0x06, 0xbf, 0x39, 0xec,
+	0x31, 0x39, 0xf2, 0xab, 0xc5, 0xed, 0x3c, 0x87, 0xf1, 0x6f, 0x3e, 0xd2, 0x5d, 0xc6, 0x3c, 0xfb,
+	0x2c, 0x60, 0x54, 0x58, 0x44, 0x89, 0x28, 0x10, 0xf3, 0x2e, 0x18, 0xd1, 0xd2, 0xc4, 0x7a, 0x7b,
+	0x07, 0x16, 0x04, 0x44, 0x2a, 0xce, 0x88, 0x14, 0x17, 0x11, 0x13, 0x49, 0x61, 0x12, 0xa8, 0x26,
+	0x16, 0x2e, 0x96, 0xf3, 0x01, 0xac, 0x26, 0x71, 0x28, 0xb1, 0xd8, 0x84, 0xc9, 0xb8, 0xb1, 0xc0,
+	0x44, 0xaf, 0x29, 0x1a, 0xf3, 0x5f, 0xf3, 0xb0, 0xbe, 0x6f, 0x31, 0xeb, 0xcc, 0xf2, 0xe9, 0xa9,
+	0x67, 0x39, 0xbe, 0xd5, 0x61, 0xb6, 0xeb, 0x44, 0xb3, 0x16, 0x0a, 0x13, 0xb3, 0x5e, 0x85, 0xfc,
+	0x01, 0x0d, 0x35, 0xc4, 0x3f, 0x8d, 0xb7, 0xa0, 0x7c, 0x6c, 0x3b, 0x76, 0xb3, 0xef, 0x76, 0x9e,
+	0x22, 0xb9, 0x50, 0x90, 0x0e, 0x9c, 0xa2, 0xa3, 0x0d, 0x98, 0x27, 0x6e, 0xe0, 0x74, 0x51, 0x49,
+	0x05, 0x22, 0x1a, 0xb1, 0xdd, 0x2f, 0x28, 0x76, 0x8f, 0x3b, 0x9b, 0x76, 0xa8, 0xfd, 0x8c, 0x7a,
+	0x52, 0x41, 0x51, 0xdb, 0xd8, 0x82, 0x85, 0x16, 0x75, 0xba, 0xd4, 0x43, 0x0d, 0x15, 0x89, 0x6c,
+	0xf1, 0xb1, 0x85, 0x34, 0xad, 0x8b, 0x70, 0x2f, 0x97, 0x89, 0x0e, 0x34, 0xb6, 0x61, 0x59, 0xd0,
+	0x0b, 0x1a, 0x40, 0x1a, 0x15, 0xc4, 0xfb, 0x3e, 0xb4, 0xfc, 0x47, 0x9e, 0xdd, 0xa1, 0xd5, 0x65,
+	0x1c, 0x6a, 0xd4, 0x96, 0xb8, 0x07, 0xf6, 0xc0, 0x66, 0xd5, 0x52, 0x84, 0xc3, 0x36, 0xb7, 0xb7,
+	0x43, 0xcb, 0x7f, 0xec, 0xd3, 0x6e, 0xb5, 0x8c, 0xa8, 0xb0, 0xc9, 0xd7, 0x96, 0x2f, 0x79, 0xb5,
+	0x22, 0x2c, 0x8a, 0x7f, 0x73, 0x1b, 0x6c, 0xd9, 0x3d, 0xc7, 0x62, 0x81, 0x47, 0xab, 0x2b, 0xc2,
+	0x06, 0x23, 0x00, 0xc7, 0x9e, 0xda, 0x03, 0xea, 0x33, 0x6b, 0x30, 0xac, 0xae, 0x6e, 0xe7, 0x6e,
+	0xe7, 0x49, 0x0c, 0xc0, 0x15, 0x60, 0x16, 0x0b, 0xfc, 0xea, 0x9a, 0x5c, 0x01, 0x6c, 0x99, 0xbf,
+	0x0d, 0x37, 0x32, 0x54, 0x1b, 0xdb, 0xcc, 0x5d, 0x28, 0xa9, 0x70, 0x69, 0x81, 0x37, 0x22, 0x0b,
+	0xcc, 0x60, 0x26, 0x1a, 0x87, 0xf9, 0x3f, 0x73, 0xb0, 0xac, 0x00, 0x62, 0x4d, 0xe7, 0x12, 0x9a,
+	0x7e, 0xa2, 0xfa, 0x8b, 0xb4, 0x4e, 0xf3, 0x53, 0x75, 0x5a, 0xd0, 0x74, 0xfa, 0x36, 0x54, 0xc4,
+	0x57, 0x14, 0x33, 0xe6, 0x71, 0x0d, 0x13, 0x50, 0xe3, 0x1d, 0x58, 0x0d, 0x65, 0x45, 0x94, 0x22,
+	0x5a, 0xa4, 0xe0, 0x9a, 0x7e, 0x17, 0x67, 0xe8, 0x77, 0x29, 0xa1, 0xdf, 0x50, 0x8b, 0xc5, 0x69,
+	0x5a, 0x84, 0xa4, 0x16, 0xb9, 0x07, 0xba, 0xb0, 0x6c, 0xe7, 0x68, 0xbf, 0xba, 0x2c, 0x3d, 0x90,
+	0x68, 0x72, 0xcc, 0x13, 0xea, 0xf9, 0xb6, 0xeb, 0xa0, 0x19, 0x95, 0x49, 0xd8, 0xe4, 0x98, 0x87,
+	0x43, 0xa1, 0x9e, 0xb2, 0xc0, 0xc8, 0xa6, 0xf9, 0x39, 0x5c, 0x3f, 0x0e, 0xfa, 0xcc, 0x1e, 0xf6,
+	0x13, 0xda, 0x15, 0xc1, 0xfc, 0xc3, 0x4c, 0xe5, 0x6e, 0x44, 0xca, 0x9d, 0xae, 0xd4, 0x7f, 0xce,
+	0xc1, 0x8d, 0x6c, 0xc9, 0xd2, 0x6e, 0x4c, 0x28, 0x9d, 0x04, 0x83, 0x87, 0xe7, 0x2d, 0xea, 0xb0,
+	0xd3, 0x17, 0xbe, 0x54, 0xb6, 0x06, 0x33, 0x08, 0x14, 0x4f, 0x5f, 0xf8, 0x7c, 0xfb, 0x53, 0xbf,
+	0x3a, 0x87, 0x7d, 0xff, 0x38, 0xea, 0x7b, 0x96, 0xf4, 0x9d, 0x88, 0x0d, 0xc3, 0x0f, 0x89, 0xc5,
+	0xd4, 0x3e, 0x82, 0x8a, 0x8e, 0x54, 0x63, 0x53, 0xf9, 0xb2, 0xd8, 0xf4, 0x07, 0x39, 0xa8, 0xb5,
+	0xec, 0x41, 0xd0, 0xb7, 0x98, 0x66, 0xd1, 0x72, 0xbd, 0x3e, 0xd0, 0x2c, 0x19, 0x45, 0x4e, 0x5b,
+	0x2e, 0xcd, 0xe4, 0x77, 0xc0, 0x68, 0x3d, 0xb5, 0x87, 0x91, 0x96, 0xf7, 0x2e, 0x68, 0xe7, 0x29,
+	0xf6, 0xbe, 0x44, 0x32, 0x30, 0xe6, 0x1f, 0x15, 0x60, 0xbd, 0x35, 0xb0, 0x3c, 0xb6, 0xe7, 0x3a,
+	0xcc, 0xb3, 0x3a, 0x8c, 0x50, 0x3f, 0xe8, 0xb3, 0x4c, 0x87, 0x9b, 0x9d, 0x4c, 0x45, 0xdb, 0x29,
+	0x3f, 0x6d, 0x3b, 0x15, 0xa6, 0x6e, 0xa7, 0xf9, 0xe4, 0x76, 0x22, 0xb4, 0x6f, 0x8d, 0xe2, 0x44,
+	0x46, 0x78, 0xdd, 0x04, 0x94, 0x2b, 0x5c, 0x40, 0xba, 0xa2, 0x63, 0xe1, 0x82, 0x35, 0x58, 0x94,
+	0xac, 0x2d, 0x29, 0xc9, 0x9a, 0xba, 0x45, 0x8a, 0x72, 0x8b, 0x60, 0x40, 0xa7, 0xcf, 0x4e, 0x5f,
+	0xe0, 0x6c, 0x21, 0x0c, 0xe8, 0x21, 0x84, 0x8f, 0xe9, 0xa1, 0x67, 0xf7, 0x6c, 0xc7, 0xea, 0x4b,
+	0x1a, 0xb1, 0x57, 0x12, 0xd0, 0x99, 0xae, 0x57, 0xdd, 0xd2, 0xe5, 0xf4, 0x96, 0xde, 0xb3, 0xfa,
+	0x7d, 0xcc, 0x71, 0xb9, 0x03, 0x9e, 0x27, 0x51, 0x3b, 0x95, 0x24, 0x0a, 0x3f, 0xac, 0xc1, 0x44,
+	0x58, 0x61, 0x81, 0xe7, 0x1c, 0x53, 0xdf, 0xb7, 0x7a, 0x14, 0xdd, 0x71, 0x91, 0xe8, 0x40, 0x75,
+	0x16, 0x72, 0xe5, 0xd7, 0xf4, 0x59, 0x08, 0xa8, 0xd9, 0x81, 0x45, 0xd4, 0xd2, 0x90, 0x65, 0x67,
+	0x7c, 0x8a, 0xea, 0xe6, 0x34, 0xd5, 0x85, 0x4b, 0x9b, 0x57, 0x96, 0x76, 0x0b, 0x16, 0xe4, 0x92,
+	0x49, 0xaf, 0x29, 0x5a, 0xe6, 0x2f, 0xf3, 0xb0, 0x26, 0x2d, 0x1f, 0x0d, 0x9e, 0xdb, 0x9b, 0xaf,
+	0x44, 0x8d, 0x9c, 0x1a, 0x35, 0xb8, 0x82, 0x0e, 0x2c, 0xbb, 0x4f, 0xa8, 0xe5, 0xbb, 0x8e, 0xec,
+	0x55, 0x81, 0x44, 0x86, 0x9a, 0x57, 0x0c, 0xf5, 0x10, 0x8a, 0xad, 0x8e, 0x14, 0x5c, 0x2d, 0x24,
+	0x32, 0xd0, 0x54, 0xd7, 0x3b, 0x11, 0xad, 0xdc, 0xe2, 0x51, 0xdb, 0xd8, 0x97, 0x56, 0x3c, 0x64,
+	0x7e, 0x75, 0x1e, 0xe5, 0xdc, 0x9e, 0x21, 0x27, 0x24, 0x15, 0x62, 0x22, 0xce, 0xda, 0x17, 0x50,
+	0xd1, 0xbb, 0xc8, 0x48, 0x62, 0xef, 0xa8, 0x8e, 0x42, 0x8d, 0x7a, 0x19, 0x9b, 0x53, 0x71, 0x23,
+	0xb5, 0x63, 0x28, 0x6b, 0xdd, 0x66, 0x88, 0x7e, 0x5b, 0x17, 0xbd, 0x1a, 0x89, 0x96, 0x8c, 0xaa,
+	0x57, 0xfa, 0x9b, 0x1c, 0x18, 0xda, 0xc4, 0x84, 0x8b, 0xfd, 0x48, 0x4f, 0x4b, 0x84, 0x37, 0xaa,
+	0x4d, 0x5f, 0x0a, 0x3d, 0x65, 0xb9, 0x9b, 0x4c, 0x7d, 0xe6, 0x2e, 0xe5, 0xd7, 0x19, 0xf8, 0x4e,
+	0x38, 0xf2, 0xf7, 0x3c, 0xd7, 0xf7, 0x85, 0x80, 0x3c, 0xfa, 0x33, 0x0d, 0x66, 0xfe, 0x02, 0xae,
+	0x2a, 0x8e, 0x70, 0xcf, 0xf5, 0x59, 0x34, 0x7c, 0xb1, 0x01, 0x1f, 0x3b, 0x36, 0x0b, 0xa3, 0x43,
+	0xd4, 0x4e, 0x6f, 0xa0, 0xb9, 0x8c, 0x0d, 0x64, 0xfe, 0x48, 0x13, 0xce, 0x8d, 0x2c, 0x12, 0x9e,
+	0xe1, 0x29, 0x4d, 0x0a, 0x9b, 0x87, 0x94, 0x65, 0xb8, 0xf5, 0x0c, 0xe2, 0xa9, 0x7b, 0x6a, 0x1b,
+	0x96, 0xf1, 0xe8, 0x2d, 0xed, 0x58, 0xcc, 0x59, 0x05, 0x99, 0x07, 0x50, 0x55, 0xfa, 0x10, 0x1b,
+	0xe6, 0x3b, 0xf4, 0x64, 0xbe, 0x0f, 0xd7, 0x32, 0xe4, 0xc8, 0xf9, 0x4d, 0xd9, 0x98, 0xe6, 0xbf,
+	0x03, 0xac, 0x1c, 0x04, 0xfd, 0x7e, 0x22, 0x4d, 0x57, 0x4e, 0xeb, 0xf8, 0x1d, 0x0d, 0x64, 0x2e,
+	0x2b, 0x92, 0xe4, 0x33, 0x53, 0xf0, 0x42, 0x22, 0x05, 0xbf, 0x37, 0x74, 0x3b, 0x17, 0x18, 0x2c,
+	0xca, 0x44, 0x34, 0xbe, 0xc7, 0xc4, 0x3c, 0x9d, 0xc4, 0x15, 0x5f, 0x39, 0x89, 0x83, 0x57, 0x48,
+	0xe2, 0x5e, 0x27, 0x49, 0x0f, 0xdd, 0x68, 0x59, 0x49, 0xe2, 0x92, 0x51, 0xa0, 0x92, 0x71, 0x55,
+	0x10, 0x46, 0xbb, 0x15, 0x25, 0xda, 0x7d, 0x08, 0x57, 0x79, 0x1c, 0xb3, 0xdd, 0xc0, 0x4f, 0x98,
+	0xae, 0x8c, 0x11, 0xd3, 0xd0, 0x9c, 0x33, 0x8a, 0x6e, 0x09, 0x4e, 0x11, 0x36, 0xa6, 0xa1, 0xd3,
+	0x9b, 0xc9, 0x78, 0xb5, 0x68, 0xb4, 0x9e, 0x15, 0x8d, 0xf4, 0xf4, 0x75, 0x23, 0x99, 0xbe, 0xf2,
+	0xa3, 0x92, 0x1b, 0x78, 0x1d, 0x2a, 0x5c, 0xc2, 0xa6, 0x3c, 0x2a, 0xc5, 0x20, 0xae, 0xb1, 0x7d,
+	0xea, 0x33, 0xdb, 0x41, 0xd7, 0x22, 0xc8, 0xb6, 0x90, 0x2c, 0x05, 0xe7, 0x61, 0x06, 0x4f, 0x88,
+	0xc2, 0x2c, 0xaf, 0xa2, 0x5e, 0x14, 0x08, 0x1f, 0x4b, 0x7c, 0xac, 0xac, 0x8a, 0xb1, 0x44, 0x00,
+	0xa3, 0x09, 0x37, 0x4e, 0x5c, 0x66, 0x79, 0xf6, 0x97, 0xb4, 0xbb, 0xcb, 0xc4, 0x18, 0x8e, 0x1c,
+	0xae, 0x1e, 0x21, 0xef, 0x1a, 0xca, 0x9b, 0x49, 0x63, 0xdc, 0x85, 0xeb, 0x53, 0xf0, 0xd8, 0x67,
+	0x0d, 0xfb, 0x9c, 0x45, 0x62, 0x3c, 0x80, 0x9b, 0x0a, 0x5a, 0x99, 0xa2, 0x3a, 0x94, 0xeb, 0x38,
+	0x94, 0xcb, 0x09, 0x8d, 0x4f, 0x60, 0x7b, 0x16, 0x11, 0x0e, 0xea, 0x06, 0x0e, 0xea, 0x52, 0x3a,
+	0xed, 0x60, 0x8e, 0x0e, 0xe2, 0x8d, 0xc4, 0xc1, 0x9c, 0x03, 0xd3, 0xc7, 0xf7, 0x7a, 0xd6, 0xf1,
+	0x3d, 0xf6, 0x47, 0x0d, 0x2d, 0x51, 0xb8, 0x0d, 0x2b, 0xf7, 0x47, 0x43, 0xea, 0x9d, 0xc5, 0x6a,
+	0xdc, 0xc6, 0xb9, 0x26, 0xc1, 0xdc, 0xfe, 0x62, 0x10, 0x76, 0x74, 0x53, 0xd8, 0x9f, 0x0e, 0x35,
+	0xde, 0x89, 0xb2, 0xa1, 0xaa, 0x39, 0x25, 0x74, 0x86, 0x04, 0xc6, 0x23, 0xd8, 0xc8, 0x88, 0xd4,
+	0x7e, 0xf5, 0xcd, 0xc4, 0x21, 0x36, 0x83, 0x88, 0x64, 0x72, 0x9a, 0xbf, 0x80, 0x75, 0x9c, 0x74,
+	0x73, 0x84, 0xa3, 0x9e, 0x79, 0x7f, 0x3a, 0xfd, 0x9e, 0x93, 0x87, 0x0b, 0x7e, 0x28, 0x12, 0xd1,
+	0x23, 0x6c, 0x9a, 0xbf, 0x01, 0x86, 0x14, 0x2e, 0x62, 0xd9, 0x2c, 0xd9, 0x59, 0x0e, 0x7c, 0xba,
+	0x64, 0x7e, 0x7f, 0xce, 0xfa, 0x96, 0x8f, 0xe2, 0xbf, 0xc3, 0xa0, 0xcd, 0xf7, 0xa0, 0x8a, 0x7a,
+	0x98, 0x32, 0xf9, 0xf4, 0x81, 0x9e, 0x07, 0x67, 0x95, 0x43, 0x9d, 0x51, 0x56, 0x70, 0xfe, 0x02,
+	0xaa, 0x2d, 0xe6, 0x51, 0x6b, 0x10, 0x33, 0x45, 0x51, 0xb3, 0x0e, 0xd0, 0x62, 0x96, 0xc7, 0xd4,
+	0x5e, 0x14, 0x08, 0x66, 0xa3, 0x9e, 0x3b, 0x78, 0x60, 0x31, 0xea, 0x33, 0x79, 0xac, 0x52, 0x20,
+	0xe6, 0x39, 0x54, 0xa2, 0xcd, 0x80, 0x92, 0x5f, 0xef, 0x20, 0x25, 0xc2, 0x5f, 0x3e, 0x11, 0xfe,
+	0xc4, 0xd2, 0x15, 0xd4, 0xfb, 0xf2, 0xbf, 0xcf, 0x41, 0x31, 0xda, 0x16, 0x99, 0x7d, 0x84, 0xa1,
+	0x78, 0x4e, 0x09, 0xc5, 0x09, 0x97, 0x99, 0x7f, 0x35, 0x97, 0x59, 0x98, 0xe2, 0x32, 0x3f, 0x4a,
+	0x1c, 0xe9, 0x45, 0x82, 0x5c, 0x8d, 0x4c, 0x3d, 0x91, 0x1c, 0x24, 0x8e, 0xf5, 0xbf, 0x9f, 0x87,
+	0x0a, 0x86, 0x72, 0x5c, 0xdd, 0x23, 0xe7, 0xdc, 0xe5, 0xc3, 0x3b, 0x75, 0x99, 0xd5, 0x6f, 0x05,
+	0xc3, 0x61, 0x3f, 0x4c, 0x61, 0x55, 0x90, 0xf1, 0x43, 0x58, 0xc3, 0xe6, 0xa9, 0xbb, 0x6f, 0xfb,
+	0xf2, 0x2a, 0x53, 0xce, 0x30, 0x8d, 0xe0, 0x93, 0x41, 0xe0, 0x09, 0x7d, 0xde, 0x1f, 0x1d, 0xdb,
+	0x0e, 0xa3, 0x5d, 0x79, 0x4c, 0x48, 0xc1, 0xb9, 0xf7, 0x10, 0x97, 0xe9, 0xfe, 0x23, 0x69, 0x13,
+	0xf2, 0xd4, 0x92, 0x04, 0x73, 0xbf, 0x28, 0x41, 0x07, 0xae, 0xf7, 0x88, 0x3f, 0x34, 0x75, 0xdc,
+	0x7e, 0x2b, 0xf0, 0x99, 0x65, 0x3b, 0xd6, 0x99, 0xdd, 0xb7, 0xd9, 0x48, 0x9e, 0x6b, 0x2f, 0xa5,
+	0xe3, 0x51, 0xe5, 0xc4, 0xed, 0x52, 0x91, 0x28, 0x88, 0x4c, 0x26, 0x06, 0x18, 0xef, 0xc1, 0x3a,
+	0x0f, 0xd1, 0xf1, 0x2a, 0x09, 0xf3, 0x10, 0xb7, 0x42, 0x59, 0x28, 0x7e, 0xfa, 0xd7, 0xc1, 0xd1,
+	0x55, 0x71, 0x91, 0x64, 0x60, 0xcc, 0x7f, 0x2b, 0xc0, 0xbc, 0x98, 0xd5, 0xd4, 0xab, 0x32, 0xd1,
+	0xe7, 0x9c, 0x6a, 0x92, 0x59, 0x47, 0xae, 0xb7, 0xa0, 0xcc, 0xe5, 0xc7, 0xbe, 0x5b, 0xac, 0x9e,
+	0x0e, 0x9c, 0x9e, 0xcb, 0x09, 0x4b, 0x5b, 0x50, 0xbd, 0xc3, 0x16, 0x2c, 0x9c, 0x04, 0x03, 0xee,
+	0x61, 0x16, 0x11, 0x2c, 0x5b, 0xc6, 0x2e, 0xac, 0xe8, 0x5b, 0xcc, 0xaf, 0x2e, 0xa1, 0xe5, 0x5d,
+	0x8d, 0x2c, 0x4f, 0xc7, 0x93, 0x24, 0xbd, 0x71, 0x07, 0x20, 0xda, 0x3c, 0xe2, 0xd6, 0x5c, 0xbd,
+	0xe9, 0x8e, 0x50, 0x44, 0xa1, 0xd2, 0xef, 0x3c, 0x21, 0x79, 0xe7, 0x79, 0x1b, 0x56, 0x76, 0x3b,
+	0x9d, 0x40, 0x5c, 0xe7, 0x74, 0x0f, 0x28, 0xf5, 0xe5, 0x3d, 0x41, 0x12, 0xcc, 0x17, 0x2a, 0x7a,
+	0xbe, 0x41, 0xba, 0x92, 0x58, 0x28, 0x0d, 0x68, 0x7c, 0x00, 0x5b, 0x09, 0xc6, 0x23, 0x47, 0xac,
+	0x5c, 0x19, 0xc9, 0xa7, 0x60, 0x8d, 0x3b, 0xb0, 0xa1, 0x09, 0x0a, 0xb9, 0x2a, 0xc8, 0x95, 0x89,
+	0x33, 0x3e, 0x4e, 0x6e, 0x44, 0x4c, 0x23, 0xd5, 0xf5, 0xd4, 0xd1, 0x24, 0xb9, 0x6f, 0xe3, 0x88,
+	0xbc, 0xaa, 0x9d, 0x10, 0xfe, 0xba, 0x00, 0x10, 0x87, 0xd4, 0xff, 0x53, 0x13, 0x8b, 0x8d, 0x69,
+	0x41, 0x33, 0xa6, 0x9f, 0xc2, 0x32, 0x5a, 0x9b, 0x34, 0x85, 0xc5, 0xd9, 0x86, 0xa4, 0xd2, 0xa6,
+	0xdc, 0xdf, 0xd2, 0xeb, 0xb8, 0xbf, 0x2c, 0x83, 0x29, 0xbe, 0xa2, 0xc1, 0xc0, 0xeb, 0x19, 0xcc,
+	0xf2, 0x77, 0x32, 0x98, 0xd2, 0x6b, 0x19, 0x4c, 0xf9, 0xbb, 0x1a, 0x4c, 0x45, 0x33, 0x98, 0x17,
+	0x00, 0x71, 0xee, 0x30, 0xc5, 0x5e, 0xb2, 0xb2, 0x91, 0xe4, 0xcb, 0x41, 0xfe, 0xb5, 0x5f, 0x0e,
+	0xfe, 0xa4, 0x08, 0xe5, 0x13, 0xca, 0x9e, 0xbb, 0xde, 0xd3, 0x3d, 0xd7, 0x39, 0xb7, 0x7b, 0x3c,
+	0xf9, 0x0b, 0x6f, 0xc7, 0x31, 0x10, 0x35, 0x57, 0x27, 0xe3, 0x46, 0x89, 0x7a, 0xdd, 0x76, 0x87,
+	0x83, 0xdb, 0x76, 0x37, 0xbe, 0x2f, 0xff, 0x10, 0x4a, 0xfb, 0xd4, 0x71, 0x07, 0x32, 0x3c, 0xe2,
+	0xd8, 0xca, 0xcd, 0x8d, 0xc9, 0xb8, 0xb1, 0xca, 0x19, 0xba, 0x0a, 0x8e, 0x68, 0x94, 0xc6, 0x2e,
+	0x54, 0xf8, 0xc1, 0x90, 0x7a, 0x7c, 0x88, 0xcd, 0x11, 0x93, 0x27, 0xe2, 0xe6, 0xb5, 0xc9, 0xb8,
+	0xb1, 0xc9, 0x79, 0x7b, 0x96, 0xdf, 0x1e, 0x52, 0xaf, 0xdd, 0xb5, 0x98, 0xd5, 0x3e, 0x1b, 0x31,
+	0x4a, 0x12, 0x0c, 0x46, 0x1b, 0xaa, 0x22, 0xf9, 0x38, 0xb5, 0x7a, 0x2d, 0xf7, 0x9c, 0x3d, 0xb7,
+	0x3c, 0x1a, 0xde, 0xde, 0xe3, 0x0e, 0x69, 0xbe, 0x39, 0x19, 0x37, 0x1a, 0x5c, 0x58, 0x1f, 0xe9,
+	0xda, 0xcc, 0xea, 0xb5, 0x7d, 0x49, 0xd9, 0x7e, 0x26, 0x48, 0xc9, 0x54, 0x21, 0xc6, 0xcf, 0x61,
+	0x8b, 0x27, 0xf2, 0x7b, 0xae, 0xe3, 0x53, 0xc7, 0x0f, 0xfc, 0x43, 0xcf, 0x0d, 0x86, 0x2d, 0xfb,
+	0x4b, 0xf1, 0xda, 0x51, 0x6e, 0xde, 0x9c, 0x8c, 0x1b, 0x6f, 0x70, 0xf1, 0x03, 0xca, 0xac, 0x76,
+	0x27, 0x24, 0x6b, 0xf7, 0x38, 0x5d, 0xdb, 0xb7, 0xbf, 0xa4, 0x64, 0x8a, 0x00, 0xe3, 0x27, 0xb0,
+	0x7c, 0x6c, 0x3b, 0xd1, 0x71, 0x78, 0x01, 0xe7, 0xbe, 0x39, 0x19, 0x37, 0xd6, 0x50, 0x9e, 0xed,
+	0xe0, 0xfc, 0xfb, 0x1c, 0x49, 0x54, 0xca, 0x98, 0x51, 0x79, 0x28, 0x49, 0x33, 0x0e, 0x39, 0x92,
+	0xa8, 0x94, 0xc6, 0x63, 0xd8, 0x3c, 0xb6, 0x1d, 0x45, 0xf7, 0xe1, 0x52, 0x2d, 0xe1, 0x5c, 0x1a,
+	0x93, 0x71, 0xe3, 0x7a, 0x28, 0x82, 0xc5, 0x54, 0xd1, 0x32, 0x65, 0x73, 0x1b, 0x47, 0xb0, 0x76,
+	0x12, 0x0c, 0xf8, 0x2c, 0xd1, 0x3a, 0x78, 0x0c, 0x17, 0x1b, 0xba, 0xdc, 0xbc, 0x3e, 0x19, 0x37,
+	0xae, 0x72, 0x91, 0x4e, 0x30, 0x68, 0x0f, 0x42, 0x8a, 0xb6, 0xc3, 0x49, 0x48, 0x9a, 0xcb, 0xd8,
+	0x87, 0x95, 0x93, 0x60, 0x80, 0xdf, 0x47, 0x8e, 0xf2, 0x0c, 0xd8, 0xac, 0x4d, 0xc6, 0x8d, 0xad,
+	0x50, 0x10, 0xb2, 0xb7, 0x6d, 0xa7, 0xed, 0x73, 0x0a, 0x92, 0x64, 0x31, 0x5a, 0xb0, 0x71, 0x12,
+	0x0c, 0xf0, 0x1b, 0x0b, 0x38, 0xdc, 0x80, 0xf1, 0x7e, 0xaa, 0xcb, 0xfa, 0x34, 0xb9, 0x28, 0x94,
+	0xe0, 0xb7, 0x9f, 0x0b, 0x2a, 0x1c, 0x22, 0xc9, 0x64, 0x36, 0x3a, 0x70, 0x4d, 0xa6, 0x34, 0xa7,
+	0xee, 0xf0, 0xf1, 0xf0, 0xd0, 0xb3, 0xba, 0x36, 0x75, 0xd8, 0x23, 0xd7, 0x76, 0xc4, 0x5d, 0x46,
+	0xb1, 0x79, 0x6b, 0x32, 0x6e, 0xdc, 0xe4, 0x92, 0x3d, 0x41, 0xd8, 0x66, 0xee, 0xb0, 0x1d, 0x0c,
+	0xdb, 0x3d, 0x49, 0xdb, 0x1e, 0x72, 0x62, 0x32, 0x5d, 0x8e, 0xf1, 0x11, 0x94, 0x31, 0x06, 0xec,
+	0x07, 0x9e, 0xd8, 0x4d, 0x78, 0x65, 0xde, 0xdc, 0x9a, 0x8c, 0x1b, 0x06, 0x0a, 0xe6, 0xc8, 0x76,
+	0x57, 0x62, 0x89, 0x4e, 0x6c, 0xfc, 0x26, 0x5c, 0xc5, 0x71, 0x67, 0x58, 0x6b, 0x05, 0xa7, 0x6e,
+	0x4e, 0xc6, 0x8d, 0x3a, 0x97, 0x83, 0xd3, 0xce, 0x36, 0xd7, 0x69, 0x22, 0x8c, 0xf7, 0xa0, 0x88,
+	0x5e, 0x8c, 0x07, 0x7e, 0x8c, 0x92, 0x85, 0xa6, 0x31, 0x19, 0x37, 0x2a, 0x28, 0x8f, 0x23, 0xda,
+	0xcc, 0x1e, 0x50, 0x12, 0x13, 0x71, 0x43, 0xc5, 0x39, 0x1e, 0x58, 0x1d, 0xfe, 0xd4, 0x8f, 0x01,
+	0x32, 0x36, 0x54, 0xb9, 0x38, 0xe7, 0x88, 0x24, 0x2a, 0xa5, 0xf9, 0xdf, 0xf3, 0x91, 0x47, 0x92,
+	0x07, 0xdc, 0x9f, 0x42, 0x69, 0x2f, 0xf0, 0x3c, 0xea, 0xc8, 0x3c, 0x30, 0xa7, 0x1b, 0x7d, 0x47,
+	0xe0, 0xc4, 0xfa, 0x10, 0x8d, 0x94, 0xbf, 0x26, 0xa1, 0x0b, 0x3e, 0x09, 0x06, 0x67, 0xd4, 0x4b,
+	0xfa, 0x27, 0xca, 0x51, 0xdc, 0x14, 0xce, 0xa8, 0x47, 0x54, 0x42, 0x6e, 0xd6, 0xf7, 0xed, 0xde,
+	0x05, 0xf5, 0xd9, 0x01, 0xbf, 0x97, 0x51, 0xee, 0xec, 0x62, 0xb3, 0xbe, 0x10, 0x04, 0xed, 0x73,
+	0x4e, 0xd1, 0x76, 0x38, 0x09, 0x49, 0x73, 0x19, 0x6f, 0x6a, 0xaf, 0xee, 0xcd, 0xf2, 0x64, 0xdc,
+	0x28, 0xa2, 0x05, 0x22, 0xc3, 0x7c, 0x78, 0xe7, 0xb0, 0x86, 0x1f, 0xbb, 0x2c, 0x8e, 0x18, 0xe2,
+	0x41, 0xbe, 0x79, 0x63, 0x32, 0x6e, 0x54, 0x23, 0x86, 0xb6, 0xc5, 0xe4, 0xb0, 0x71, 0xd9, 0x49,
+	0x9a, 0xcd, 0x38, 0x87, 0x1a, 0x02, 0xfd, 0x47, 0x96, 0xef, 0xd3, 0xee, 0x91, 0x23, 0x57, 0x44,
+	0xc4, 0x37, 0xe1, 0x6a, 0xde, 0x9e, 0x8c, 0x1b, 0x66, 0x24, 0xd4, 0x6f, 0x0f, 0x91, 0x94, 0x6f,
+	0xab, 0x70, 0x35, 0xb1, 0x13, 0x32, 0x43, 0x12, 0x1f, 0x33, 0x2e, 0xb2, 0x36, 0xe6, 0x45, 0x7d,
+	0xcc, 0xc2, 0x66, 0x93, 0x63, 0x4e, 0xb1, 0xf1, 0x31, 0x23, 0x30, 0x7b, 0xcc, 0x4b, 0xfa, 0x98,
+	0x51, 0xe8, 0x8c, 0x31, 0x4f, 0x97, 0x64, 0xdc, 0x85, 0x8a, 0xc4, 0x52, 0x4f, 0xc8, 0x2e, 0xa2,
+	0xec, 0xea, 0x64, 0xdc, 0xd8, 0x50, 0x65, 0x53, 0x4f, 0x4a, 0x4b, 0xd0, 0x1b, 0x4f, 0x60, 0x13,
+	0xef, 0xde, 0xf1, 0x15, 0x51, 0x64, 0x5f, 0xd4, 0xee, 0x5d, 0x30, 0x91, 0x9d, 0x34, 0xb7, 0x27,
+	0xe3, 0xc6, 0x0d, 0xb4, 0x4a, 0x4e, 0xd4, 0xee, 0x70, 0xaa, 0x36, 0xa6, 0x81, 0xed, 0x0b, 0xa4,
+	0x23, 0xd9, 0xec, 0xe6, 0x1f, 0xcf, 0x43, 0xf9, 0x5e, 0xc7, 0xe5, 0x21, 0xb2, 0xe3, 0xe3, 0xfd,
+	0xe7, 0x07, 0x19, 0xa7, 0xc2, 0xd8, 0x76, 0x19, 0x47, 0xb5, 0x7d, 0xc4, 0xe9, 0x67, 0xc5, 0xfb,
+	0xb0, 0xb6, 0x67, 0x7b, 0x1d, 0x7c, 0x58, 0x70, 0x7a, 0x92, 0x1b, 0xb3, 0x86, 0xd8, 0x93, 0x76,
+	0x62, 0x82, 0x50, 0x46, 0x9a, 0x89, 0x8f, 0xa0, 0xc5, 0xac, 0xa7, 0xe1, 0x73, 0x63, 0x5e, 0x1f,
+	0x81, 0x8f, 0xa8, 0x36, 0xbe, 0xa1, 0x10, 0x95, 0x90, 0xfb, 0x60, 0x1c, 0x50, 0xd3, 0xf2, 0xa9,
+	0x2a, 0x40, 0x44, 0xe5, 0xc8, 0x07, 0x8b, 0x29, 0xf0, 0xfc, 0x44, 0x97, 0x95, 0xc9, 0xcc, 0xc3,
+	0x83, 0x3c, 0xe9, 0x0e, 0x1f, 0x0f, 0x85, 0xbc, 0x79, 0x7d, 0x52, 0x42, 0x9e, 0x74, 0x2d, 0x42,
+	0x54, 0x92, 0xc5, 0x78, 0x1f, 0x60, 0x9f, 0x3e, 0x93, 0x4e, 0x58, 0x9c, 0x3c, 0x9b, 0xeb, 0x93,
+	0x71, 0x63, 0x45, 0xe4, 0x2b, 0xcf, 0x42, 0xf7, 0x4d, 0x14, 0x32, 0xe3, 0x5d, 0x28, 0x1e, 0x39,
+	0xe7, 0xe2, 0xa1, 0x46, 0x5c, 0xaf, 0x37, 0xd7, 0x26, 0xe3, 0x46, 0x99, 0xf3, 0xd8, 0x21, 0x82,
+	0xc4, 0x34, 0xdc, 0x5d, 0x62, 0xc7, 0x98, 0xb6, 0xe2, 0x29, 0x34, 0x76, 0x97, 0x62, 0x94, 0xe7,
+	0x94, 0xfa, 0x24, 0x26, 0x4a, 0x3a, 0xaa, 0xe2, 0xab, 0x3a, 0xaa, 0x27, 0xb0, 0x89, 0xcd, 0x03,
+	0xd7, 0xd3, 0xac, 0x47, 0x86, 0xce, 0xc8, 0x1c, 0x85, 0x84, 0x73, 0xd7, 0x6b, 0xd3, 0x90, 0x0c,
+	0x53, 0x2b, 0x92, 0xcd, 0x6e, 0xfe, 0x5d, 0x0e, 0x36, 0xee, 0x39, 0xd6, 0x59, 0x9f, 0x22, 0x3e,
+	0x7e, 0x15, 0xa1, 0x50, 0x52, 0xe1, 0xb2, 0x9e, 0xe1, 0xdd, 0x38, 0x03, 0xce, 0x60, 0xd2, 0x80,
+	0xa2, 0xda, 0x4d, 0x24, 0x95, 0x2a, 0xad, 0x26, 0xb6, 0xf6, 0x31, 0xac, 0xa5, 0x98, 0x2e, 0x2b,
+	0x81, 0x2b, 0xab, 0x0f, 0x7a, 0xb7, 0xa0, 0x2c, 0x6f, 0xd0, 0xe2, 0x4a, 0xbe, 0x8c, 0x2b, 0xb4,
+	0xbf, 0xc8, 0xe1, 0xc5, 0xd7, 0x53, 0xee, 0x29, 0xce, 0x5d, 0xe3, 0x56, 0xa2, 0xa0, 0xb2, 0xb9,
+	0x3c, 0x19, 0x37, 0x16, 0x2d, 0x01, 0x8a, 0xab, 0x2b, 0x77, 0x00, 0x62, 0xf3, 0x94, 0x7b, 0xab,
+	0x32, 0x19, 0x37, 0xe0, 0x2c, 0x82, 0x12, 0x85, 0xc2, 0x68, 0xc0, 0x3c, 0xda, 0xa0, 0xdc, 0x42,
+	0x58, 0xea, 0xc7, 0x38, 0x80, 0x08, 0xb8, 0x20, 0x60, 0x56, 0xbf, 0x5a, 0x50, 0x09, 0x98, 0xd5,
+	0x27, 0x02, 0x6e, 0x1e, 0x43, 0x75, 0xdf, 0xf6, 0x68, 0x87, 0xc5, 0x83, 0x8d, 0x66, 0xf6, 0xff,
+	0xa1, 0xf0, 0xc0, 0xf6, 0x99, 0x54, 0xc5, 0x7a, 0x7c, 0xe5, 0x1a, 0x91, 0x36, 0x97, 0x26, 0xe3,
+	0x46, 0xa1, 0x6f, 0xfb, 0x8c, 0x20, 0xa9, 0xf9, 0xbb, 0x50, 0xd9, 0xa7, 0x7d, 0xda, 0xb3, 0x58,
+	0xb8, 0xbd, 0x8e, 0x60, 0x5d, 0x42, 0xf8, 0x3d, 0x57, 0x47, 0x5f, 0x85, 0xab, 0x93, 0x71, 0x63,
+	0xbd, 0x9b, 0x46, 0x93, 0x2c, 0x1e, 0x3e, 0x19, 0xa5, 0xce, 0x48, 0x4c, 0x46, 0x6c, 0x47, 0x01,
+	0xe7, 0xc6, 0x55, 0x96, 0x8c, 0xae, 0x87, 0xeb, 0x7e, 0x17, 0x56, 0x23, 0x80, 0xde, 0x35, 0xee,
+	0x81, 0x6e, 0x02, 0x47, 0x52, 0xd4, 0xc6, 0x27, 0xb0, 0x1c, 0xcd, 0xe8, 0xd4, 0x95, 0xa5, 0x2e,
+	0xf1, 0xc1, 0x4c, 0x9f, 0x6d, 0x73, 0x65, 0x32, 0x6e, 0x2c, 0x77, 0x63, 0x7a, 0xa2, 0x32, 0xc7,
+	0xda, 0xc8, 0x4f, 0xd5, 0xc6, 0x66, 0x44, 0xaf, 0xa9, 0xe2, 0xc7, 0x9a, 0x2a, 0xb6, 0x92, 0xdd,
+	0xbb, 0x5e, 0xa6, 0x36, 0xfe, 0x3c, 0x07, 0x8b, 0xad, 0xbd, 0xcf, 0x02, 0xea, 0xe1, 0xcd, 0x58,
+	0x62, 0xf5, 0x49, 0x0c, 0xe0, 0x6f, 0x68, 0x07, 0x81, 0xd3, 0xc1, 0xa2, 0x4a, 0x71, 0x10, 0x8c,
+	0xda, 0xfc, 0x8a, 0x96, 0x57, 0x50, 0x88, 0x72, 0x91, 0xb0, 0x44, 0x33, 0x86, 0x70, 0xc9, 0xbc,
+	0xa5, 0xb8, 0x62, 0x12, 0x03, 0x38, 0x76, 0xd7, 0xeb, 0x05, 0x03, 0xea, 0xc8, 0x27, 0xff, 0x12,
+	0x89, 0x01, 0xe6, 0xaf, 0x41, 0xb9, 0xc5, 0x5c, 0xcf, 0xea, 0xd1, 0xc7, 0xc3, 0xae, 0xc5, 0xf0,
+	0x71, 0xf4, 0xe1, 0xf9, 0xb9, 0x4f, 0x19, 0x8e, 0xb1, 0x44, 0x64, 0x2b, 0x7a, 0xc8, 0x9b, 0x8b,
+	0x1f, 0xf2, 0x4c, 0x0f, 0x2a, 0x0f, 0x03, 0x36, 0x0c, 0xc4, 0xbb, 0xf0, 0xb9, 0xa8, 0xbb, 0xce,
+	0xa8, 0xb1, 0x50, 0x1f, 0x08, 0xe7, 0xa6, 0x3c, 0x10, 0xe6, 0x63, 0xb9, 0x5a, 0x09, 0x49, 0x41,
+	0x2f, 0x21, 0x31, 0xff, 0x21, 0x0f, 0x65, 0xd1, 0xe9, 0xf7, 0x5f, 0x2b, 0x6d, 0x42, 0x49, 0x7e,
+	0xee, 0xd3, 0x3e, 0xb3, 0xe4, 0x5a, 0x6a, 0x30, 0x83, 0x40, 0x45, 0x5b, 0xb0, 0xf0, 0x96, 0xf8,
+	0x9d, 0xc8, 0x24, 0xb4, 0xd1, 0xed, 0xe8, 0xc4, 0xa2, 0x90, 0x22, 0x21, 0x21, 0x7a, 0xec, 0x14,
+	0x15, 0x74, 0xf8, 0x9d, 0x7a, 0x24, 0x5d, 0xcc, 0x78, 0x24, 0xdd, 0x85, 0x15, 0x7d, 0xfd, 0xd3,
+	0x17, 0x87, 0x3a, 0x9e, 0x24, 0xe9, 0xb5, 0xa5, 0x2e, 0xea, 0x4b, 0x5d, 0xfb, 0x39, 0xac, 0x67,
+	0x8c, 0x3e, 0xc3, 0x59, 0xff, 0x50, 0xaf, 0xc7, 0xd8, 0x52, 0x1c, 0x95, 0xc2, 0xae, 0x3a, 0xf1,
+	0x21, 0x2c, 0x3d, 0x70, 0x7b, 0x42, 0x5e, 0x1d, 0x20, 0x51, 0xc0, 0x5c, 0x22, 0xa0, 0xd7, 0x2e,
+	0x87, 0xfa, 0x9d, 0xd3, 0xf5, 0xcb, 0xeb, 0x71, 0xdc, 0xa1, 0xdd, 0x11, 0xf7, 0x23, 0x25, 0x22,
+	0x5b, 0x91, 0x4d, 0x15, 0x14, 0x5b, 0xfd, 0x97, 0x3c, 0x2c, 0x3d, 0x39, 0x16, 0xd3, 0xe7, 0x5d,
+	0x8a, 0x07, 0x5c, 0x24, 0xcb, 0x21, 0xb3, 0x02, 0x89, 0xf1, 0xa8, 0x16, 0xd1, 0xab, 0x02, 0x49,
+	0xbf, 0x0a, 0xe7, 0xb3, 0x5e, 0x85, 0x4d, 0x28, 0x1d, 0x5a, 0x3e, 0xa1, 0x03, 0xcb, 0x76, 0x6c,
+	0xa7, 0x27, 0x8b, 0x00, 0x34, 0x18, 0xdf, 0x9d, 0xd8, 0x3e, 0x0f, 0x0b, 0x75, 0x8b, 0x24, 0x06,
+	0x18, 0xc7, 0x50, 0xd1, 0xac, 0x89, 0x27, 0x36, 0x5c, 0xbf, 0xb7, 0xa2, 0x15, 0x0e, 0xa7, 0xa4,
+	0x5b, 0x5d, 0x68, 0x67, 0x3a, 0x50, 0xd4, 0xdf, 0xf7, 0x29, 0xa3, 0xdd, 0x48, 0xde, 0x22, 0xce,
+	0x3d, 0x09, 0x16, 0x95, 0xe5, 0x41, 0xe7, 0x42, 0xa1, 0x5c, 0x12, 0x94, 0x09, 0xb0, 0x71, 0x0b,
+	0x0a, 0x0f, 0xdc, 0x5e, 0x78, 0xe7, 0xbc, 0x16, 0x0d, 0x2c, 0x54, 0x2f, 0x41, 0x34, 0xb7, 0xa5,
+	0x8c, 0x11, 0xbe, 0x8e, 0x2d, 0x69, 0xec, 0x8a, 0x2d, 0xdd, 0xf9, 0xc3, 0x05, 0xa8, 0x48, 0x70,
+	0x8b, 0x7a, 0xcf, 0xf8, 0x9d, 0xc8, 0x4f, 0x00, 0x0e, 0x69, 0x48, 0x6b, 0xc4, 0xbb, 0x41, 0xff,
+	0xcf, 0x4c, 0x2d, 0x7e, 0xfd, 0x0c, 0x49, 0x8f, 0x60, 0xe3, 0x90, 0x32, 0xf9, 0xa7, 0x81, 0x83,
+	0x38, 0x08, 0x4d, 0x15, 0x11, 0xdf, 0x8e, 0x26, 0xff, 0x8b, 0xb0, 0x0f, 0x95, 0x43, 0xca, 0xd0,
+	0x01, 0x1e, 0xb8, 0xde, 0xa7, 0x74, 0x64, 0xd4, 0x92, 0xdd, 0xc5, 0xff, 0xc7, 0xa9, 0xc5, 0xf3,
+	0xd4, 0xff, 0xa6, 0xf0, 0x29, 0xac, 0x1e, 0x52, 0xa6, 0xfd, 0xc7, 0x60, 0xfa, 0x60, 0xea, 0xb3,
+	0xff, 0x94, 0x60, 0x7c, 0x06, 0x2b, 0x7a, 0x11, 0xcf, 0x0c, 0x59, 0xb7, 0x66, 0xdd, 0x35, 0xc6,
+	0x22, 0xef, 0xe3, 0xf8, 0x76, 0xfb, 0xfd, 0xb8, 0xf4, 0x7e, 0xba, 0xcc, 0xeb, 0xe9, 0xda, 0xfb,
+	0x58, 0xd2, 0x2e, 0x4a, 0x8a, 0x10, 0xb8, 0x0f, 0xaf, 0xa5, 0x19, 0x42, 0x59, 0x19, 0x75, 0xfc,
+	0xc6, 0x21, 0xac, 0x4b, 0x11, 0xe1, 0x7f, 0x8a, 0x44, 0xd1, 0xb0, 0x46, 0x9a, 0xf8, 0xbb, 0x51,
+	0xa6, 0xa0, 0xd3, 0x68, 0x2c, 0xd1, 0x7f, 0x85, 0x8c, 0x37, 0x34, 0xba, 0xe4, 0x7f, 0x88, 0x6a,
+	0x37, 0x23, 0xf4, 0xd4, 0xbf, 0x10, 0xb4, 0xe1, 0xe6, 0x21, 0x65, 0x27, 0x07, 0xa2, 0x93, 0xa3,
+	0x7d, 0x9f, 0xd0, 0x9e, 0xed, 0x33, 0xea, 0xd1, 0x6e, 0x73, 0x74, 0xa9, 0xa5, 0x5d, 0xde, 0xc1,
+	0x9d, 0xff, 0x2a, 0x80, 0xa1, 0x96, 0x3d, 0xc9, 0xdd, 0x70, 0x04, 0x2b, 0xbc, 0xfe, 0x44, 0xab,
+	0x23, 0xcf, 0xaa, 0xbb, 0xad, 0x6d, 0x67, 0x41, 0xb5, 0xd2, 0xb0, 0x1e, 0x54, 0xb9, 0xa8, 0xac,
+	0xfa, 0x62, 0xe3, 0xad, 0x4b, 0xca, 0x8f, 0x93, 0x76, 0x35, 0xb3, 0x04, 0xfa, 0x73, 0x58, 0xcf,
+	0xa8, 0x25, 0x36, 0xde, 0x4c, 0x56, 0xd8, 0x65, 0x54, 0x1a, 0xd7, 0xae, 0x27, 0x89, 0xd4, 0xc2,
+	0xbf, 0x23, 0x58, 0x49, 0x14, 0xd5, 0xbd, 0xce, 0x62, 0x68, 0x45, 0x78, 0x8f, 0x60, 0x6b, 0xcf,
+	0x1d, 0x0c, 0x03, 0x46, 0x13, 0xcb, 0xf5, 0x9d, 0x97, 0xf7, 0x13, 0xf4, 0x19, 0xea, 0x84, 0xe3,
+	0x2d, 0x9d, 0x59, 0x7e, 0x57, 0x9b, 0xfa, 0x3a, 0x63, 0xfc, 0x16, 0xba, 0xb2, 0x54, 0x15, 0x9c,
+	0x71, 0x33, 0x6b, 0x14, 0x5a, 0xa5, 0x5d, 0xcd, 0x9c, 0x45, 0x22, 0x6d, 0xed, 0x6f, 0xf3, 0x50,
+	0xc2, 0x6b, 0x8e, 0xd0, 0xca, 0x3e, 0x46, 0xe7, 0xa2, 0xd6, 0x38, 0x28, 0x1b, 0x2f, 0xa3, 0xf4,
+	0xa1, 0x56, 0xd1, 0xb1, 0xc6, 0xaf, 0xe3, 0xe4, 0x95, 0x92, 0x07, 0xe3, 0x7a, 0x92, 0x5f, 0x29,
+	0x84, 0x48, 0xb1, 0xdf, 0x85, 0x32, 0xf7, 0x44, 0xf1, 0x6b, 0x8b, 0xe2, 0x6e, 0x93, 0xe5, 0x1b,
+	0xb5, 0xf5, 0x0c, 0x9c, 0x71, 0x82, 0x2b, 0x96, 0x2a, 0xd5, 0x50, 0x56, 0x6c, 0x5a, 0x19, 0x47,
+	0x6d, 0x5d, 0x27, 0x11, 0xcf, 0x83, 0x0f, 0xd0, 0x1d, 0x25, 0x0b, 0x39, 0x8c, 0xed, 0x4c, 0x71,
+	0xea, 0xd4, 0x32, 0xa5, 0x1d, 0xc3, 0x5a, 0xaa, 0xc8, 0x43, 0x19, 0xda, 0xb4, 0x02, 0x90, 0x4c,
+	0x61, 0xef, 0xe5, 0xee, 0xfc, 0x63, 0x81, 0xdf, 0x4c, 0x76, 0xa9, 0x50, 0x6b, 0xa8, 0xc4, 0x9f,
+	0xa1, 0xe3, 0xd3, 0xdf, 0x8d, 0xe2, 0x85, 0xc6, 0xff, 0x9e, 0x2a, 0xa1, 0x4a, 0xa7, 0xdb, 0x55,
+	0x79, 0xa5, 0xb1, 0x6d, 0xea, 0xe1, 0x31, 0x1d, 0xed, 0x74, 0x72, 0xd1, 0xbd, 0x7e, 0x5b, 0x36,
+	0xbd, 0x7b, 0x9d, 0xee, 0x2e, 0xda, 0x9f, 0x7a, 0xb7, 0x90, 0x62, 0x7d, 0x63, 0xe6, 0x65, 0x86,
+	0xd1, 0x82, 0x1f, 0x1c, 0x52, 0x26, 0x5e, 0x8d, 0xf8, 0x6e, 0x1a, 0xb5, 0x46, 0x4e, 0xe7, 0xc2,
+	0x73, 0x1d, 0xfe, 0x5e, 0x9a, 0x2c, 0xb9, 0x9a, 0xb1, 0x2a, 0xda, 0xed, 0xc4, 0x67, 0xb0, 0x26,
+	0x02, 0xe4, 0x91, 0xef, 0x07, 0xb4, 0x8b, 0xb1, 0xc3, 0xb8, 0xa6, 0xfb, 0x72, 0xe5, 0x3f, 0xa2,
+	0xaf, 0x12, 0x47, 0xee, 0xa3, 0x5d, 0x25, 0x6f, 0x0d, 0x52, 0x23, 0x8a, 0x25, 0x4d, 0xbd, 0x60,
+	0x68, 0xe2, 0x7a, 0x6b, 0x27, 0xde, 0x94, 0x98, 0x7a, 0xfa, 0xa8, 0xad, 0xca, 0xb8, 0x73, 0x0f,
+	0x2a, 0xf2, 0x88, 0x1b, 0x1a, 0xd1, 0xfb, 0x50, 0xba, 0xf7, 0x82, 0x76, 0x02, 0x46, 0x11, 0x6c,
+	0xc4, 0x69, 0x96, 0x24, 0xac, 0xad, 0xa5, 0xf2, 0xd7, 0xe6, 0xee, 0x57, 0xdf, 0xd4, 0xaf, 0x7c,
+	0xfd, 0x4d, 0xfd, 0xca, 0xaf, 0xbe, 0xa9, 0xe7, 0x7e, 0xef, 0x65, 0x3d, 0xf7, 0x97, 0x2f, 0xeb,
+	0xb9, 0x7f, 0x7a, 0x59, 0xcf, 0x7d, 0xf5, 0xb2, 0x9e, 0xfb, 0xfa, 0x65, 0x3d, 0xf7, 0x1f, 0x2f,
+	0xeb, 0xb9, 0xff, 0x7c, 0x59, 0xbf, 0xf2, 0xab, 0x97, 0xf5, 0xdc, 0x9f, 0x7e, 0x5b, 0xbf, 0xf2,
+	0xd5, 0xb7, 0xf5, 0x2b, 0x5f, 0x7f, 0x5b, 0xbf, 0xf2, 0x45, 0xf8, 0x67, 0xea, 0xb3, 0x05, 0xfc,
+	0x93, 0xf4, 0xfb, 0xff, 0x3b, 0x00, 0x2a, 0x0a, 0xbe, 0xd7, 0x6b, 0x3d, 0x00, 0x00,
+}
+
+func (this *Empty) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Empty)
+	if !ok {
+		that2, ok := that.(Empty)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *AddressRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AddressRequest)
+	if !ok {
+		that2, ok := that.(AddressRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	return true
+}
+func (this *AccountKeyRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccountKeyRequest)
+	if !ok {
+		that2, ok := that.(AccountKeyRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	return true
+}
+func (this *ESDTTokenRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ESDTTokenRequest)
+	if !ok {
+		that2, ok := that.(ESDTTokenRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.TokenIdentifier != that1.TokenIdentifier {
+		return false
+	}
+	return true
+}
+func (this *ESDTNftTokenRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ESDTNftTokenRequest)
+	if !ok {
+		that2, ok := that.(ESDTNftTokenRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.TokenIdentifier != that1.TokenIdentifier {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	return true
+}
+func (this *ESDTsWithRoleRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ESDTsWithRoleRequest)
+	if !ok {
+		that2, ok := that.(ESDTsWithRoleRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Role != that1.Role {
+		return false
+	}
+	return true
+}
+func (this *ShardRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ShardRequest)
+	if !ok {
+		that2, ok := that.(ShardRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Shard != that1.Shard {
+		return false
+	}
+	return true
+}
+func (this *TokenTypeRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenTypeRequest)
+	if !ok {
+		that2, ok := that.(TokenTypeRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TokenType != that1.TokenType {
+		return false
+	}
+	return true
+}
+func (this *Account) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Account)
+	if !ok {
+		that2, ok := that.(Account)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if this.Balance != that1.Balance {
+		return false
+	}
+	if this.Username != that1.Username {
+		return false
+	}
+	if this.Code != that1.Code {
+		return false
+	}
+	if !bytes.Equal(this.CodeHash, that1.CodeHash) {
+		return false
+	}
+	if !bytes.Equal(this.RootHash, that1.RootHash) {
+		return false
+	}
+	if !bytes.Equal(this.CodeMetadata, that1.CodeMetadata) {
+		return false
+	}
+	if this.DeveloperReward != that1.DeveloperReward {
+		return false
+	}
+	if this.OwnerAddress != that1.OwnerAddress {
+		return false
+	}
+	return true
+}
+func (this *ShardIDResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ShardIDResponse)
+	if !ok {
+		that2, ok := that.(ShardIDResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ShardID != that1.ShardID {
+		return false
+	}
+	return true
+}
+func (this *ValueResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ValueResponse)
+	if !ok {
+		that2, ok := that.(ValueResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	return true
+}
+func (this *KeyValuePairsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*KeyValuePairsResponse)
+	if !ok {
+		that2, ok := that.(KeyValuePairsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Pairs) != len(that1.Pairs) {
+		return false
+	}
+	for i := range this.Pairs {
+		if this.Pairs[i] != that1.Pairs[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *ESDTToken) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ESDTToken)
+	if !ok {
+		that2, ok := that.(ESDTToken)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TokenIdentifier != that1.TokenIdentifier {
+		return false
+	}
+	if this.Balance != that1.Balance {
+		return false
+	}
+	if this.Properties != that1.Properties {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Creator != that1.Creator {
+		return false
+	}
+	if this.Royalties != that1.Royalties {
+		return false
+	}
+	if !bytes.Equal(this.Hash, that1.Hash) {
+		return false
+	}
+	if len(this.URIs) != len(that1.URIs) {
+		return false
+	}
+	for i := range this.URIs {
+		if !bytes.Equal(this.URIs[i], that1.URIs[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.Attributes, that1.Attributes) {
+		return false
+	}
+	return true
+}
+func (this *ESDTTokensResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ESDTTokensResponse)
+	if !ok {
+		that2, ok := that.(ESDTTokensResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Tokens) != len(that1.Tokens) {
+		return false
+	}
+	for i := range this.Tokens {
+		if !this.Tokens[i].Equal(that1.Tokens[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *TokenIdentifiersResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TokenIdentifiersResponse)
+	if !ok {
+		that2, ok := that.(TokenIdentifiersResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.TokenIdentifiers) != len(that1.TokenIdentifiers) {
+		return false
+	}
+	for i := range this.TokenIdentifiers {
+		if this.TokenIdentifiers[i] != that1.TokenIdentifiers[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *DatabaseTransaction) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DatabaseTransaction)
+	if !ok {
+		that2, ok := that.(DatabaseTransaction)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Hash != that1.Hash {
+		return false
+	}
+	if this.Fee != that1.Fee {
+		return false
+	}
+	if this.MiniBlockHash != that1.MiniBlockHash {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if this.Round != that1.Round {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	if this.Receiver != that1.Receiver {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if this.ReceiverShard != that1.ReceiverShard {
+		return false
+	}
+	if this.SenderShard != that1.SenderShard {
+		return false
+	}
+	if this.GasPrice != that1.GasPrice {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	if this.GasUsed != that1.GasUsed {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	if this.Signature != that1.Signature {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	return true
+}
+func (this *DatabaseTransactionsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DatabaseTransactionsResponse)
+	if !ok {
+		that2, ok := that.(DatabaseTransactionsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Transactions) != len(that1.Transactions) {
+		return false
+	}
+	for i := range this.Transactions {
+		if !this.Transactions[i].Equal(that1.Transactions[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *Transaction) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Transaction)
+	if !ok {
+		that2, ok := that.(Transaction)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	if this.Receiver != that1.Receiver {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if !bytes.Equal(this.SenderUsername, that1.SenderUsername) {
+		return false
+	}
+	if !bytes.Equal(this.ReceiverUsername, that1.ReceiverUsername) {
+		return false
+	}
+	if this.GasPrice != that1.GasPrice {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	if this.Signature != that1.Signature {
+		return false
+	}
+	if this.ChainID != that1.ChainID {
+		return false
+	}
+	if this.Version != that1.Version {
+		return false
+	}
+	if this.Options != that1.Options {
+		return false
+	}
+	return true
+}
+func (this *MultipleTransactionsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MultipleTransactionsRequest)
+	if !ok {
+		that2, ok := that.(MultipleTransactionsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Transactions) != len(that1.Transactions) {
+		return false
+	}
+	for i := range this.Transactions {
+		if !this.Transactions[i].Equal(that1.Transactions[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *MultipleTransactionsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MultipleTransactionsResponse)
+	if !ok {
+		that2, ok := that.(MultipleTransactionsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NumOfSentTxs != that1.NumOfSentTxs {
+		return false
+	}
+	if len(this.TxsHashes) != len(that1.TxsHashes) {
+		return false
+	}
+	for i := range this.TxsHashes {
+		if this.TxsHashes[i] != that1.TxsHashes[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *SimulateTransactionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SimulateTransactionRequest)
+	if !ok {
+		that2, ok := that.(SimulateTransactionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Transaction.Equal(that1.Transaction) {
+		return false
+	}
+	if this.SkipSignatureCheck != that1.SkipSignatureCheck {
+		return false
+	}
+	return true
+}
+func (this *SmartContractResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SmartContractResult)
+	if !ok {
+		that2, ok := that.(SmartContractResult)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Hash != that1.Hash {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	if this.Receiver != that1.Receiver {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if this.RelayerAddress != that1.RelayerAddress {
+		return false
+	}
+	if this.RelayedValue != that1.RelayedValue {
+		return false
+	}
+	if this.Code != that1.Code {
+		return false
+	}
+	if this.Data != that1.Data {
+		return false
+	}
+	if this.PrevTxHash != that1.PrevTxHash {
+		return false
+	}
+	if this.OriginalTxHash != that1.OriginalTxHash {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	if this.GasPrice != that1.GasPrice {
+		return false
+	}
+	if this.CallType != that1.CallType {
+		return false
+	}
+	if this.CodeMetadata != that1.CodeMetadata {
+		return false
+	}
+	if this.ReturnMessage != that1.ReturnMessage {
+		return false
+	}
+	if this.OriginalSender != that1.OriginalSender {
+		return false
+	}
+	return true
+}
+func (this *Receipt) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Receipt)
+	if !ok {
+		that2, ok := that.(Receipt)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if this.Data != that1.Data {
+		return false
+	}
+	if this.TxHash != that1.TxHash {
+		return false
+	}
+	return true
+}
+func (this *SimulationResults) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SimulationResults)
+	if !ok {
+		that2, ok := that.(SimulationResults)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.FailReason != that1.FailReason {
+		return false
+	}
+	if this.Hash != that1.Hash {
+		return false
+	}
+	if len(this.ScResults) != len(that1.ScResults) {
+		return false
+	}
+	for i := range this.ScResults {
+		if !this.ScResults[i].Equal(that1.ScResults[i]) {
+			return false
+		}
+	}
+	if len(this.Receipts) != len(that1.Receipts) {
+		return false
+	}
+	for i := range this.Receipts {
+		if !this.Receipts[i].Equal(that1.Receipts[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *SimulationResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SimulationResponse)
+	if !ok {
+		that2, ok := that.(SimulationResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.SenderShard.Equal(that1.SenderShard) {
+		return false
+	}
+	if !this.ReceiverShard.Equal(that1.ReceiverShard) {
+		return false
+	}
+	if this.IsCrossShard != that1.IsCrossShard {
+		return false
+	}
+	return true
+}
+func (this *TransactionCostResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TransactionCostResponse)
+	if !ok {
+		that2, ok := that.(TransactionCostResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.GasUnits != that1.GasUnits {
+		return false
+	}
+	if this.ReturnMessage != that1.ReturnMessage {
+		return false
+	}
+	return true
+}
+func (this *TransactionHashResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TransactionHashResponse)
+	if !ok {
+		that2, ok := that.(TransactionHashResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Hash != that1.Hash {
+		return false
+	}
+	return true
+}
+func (this *GetTransactionRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GetTransactionRequest)
+	if !ok {
+		that2, ok := that.(GetTransactionRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Hash != that1.Hash {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if this.WithResults != that1.WithResults {
+		return false
+	}
+	return true
+}
+func (this *TransactionStatusRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TransactionStatusRequest)
+	if !ok {
+		that2, ok := that.(TransactionStatusRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Hash != that1.Hash {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	return true
+}
+func (this *TransactionStatusResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TransactionStatusResponse)
+	if !ok {
+		that2, ok := that.(TransactionStatusResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	return true
+}
+func (this *FullTransaction) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FullTransaction)
+	if !ok {
+		that2, ok := that.(FullTransaction)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.Hash != that1.Hash {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if this.Round != that1.Round {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	if this.Receiver != that1.Receiver {
+		return false
+	}
+	if this.Sender != that1.Sender {
+		return false
+	}
+	if !bytes.Equal(this.SenderUsername, that1.SenderUsername) {
+		return false
+	}
+	if !bytes.Equal(this.ReceiverUsername, that1.ReceiverUsername) {
+		return false
+	}
+	if this.GasPrice != that1.GasPrice {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	if !bytes.Equal(this.CodeMetadata, that1.CodeMetadata) {
+		return false
+	}
+	if this.Code != that1.Code {
+		return false
+	}
+	if this.PreviousTransactionHash != that1.PreviousTransactionHash {
+		return false
+	}
+	if this.OriginalTransactionHash != that1.OriginalTransactionHash {
+		return false
+	}
+	if this.ReturnMessage != that1.ReturnMessage {
+		return false
+	}
+	if this.OriginalSender != that1.OriginalSender {
+		return false
+	}
+	if this.Signature != that1.Signature {
+		return false
+	}
+	if this.SourceShard != that1.SourceShard {
+		return false
+	}
+	if this.DestinationShard != that1.DestinationShard {
+		return false
+	}
+	if this.BlockNonce != that1.BlockNonce {
+		return false
+	}
+	if this.BlockHash != that1.BlockHash {
+		return false
+	}
+	if this.NotarizedAtSourceInMetaNonce != that1.NotarizedAtSourceInMetaNonce {
+		return false
+	}
+	if this.NotarizedAtSourceInMetaHash != that1.NotarizedAtSourceInMetaHash {
+		return false
+	}
+	if this.NotarizedAtDestinationInMetaNonce != that1.NotarizedAtDestinationInMetaNonce {
+		return false
+	}
+	if this.NotarizedAtDestinationInMetaHash != that1.NotarizedAtDestinationInMetaHash {
+		return false
+	}
+	if this.MiniBlockType != that1.MiniBlockType {
+		return false
+	}
+	if this.MiniBlockHash != that1.MiniBlockHash {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	if this.HyperblockNonce != that1.HyperblockNonce {
+		return false
+	}
+	if this.HyperblockHash != that1.HyperblockHash {
+		return false
+	}
+	if !this.Receipt.Equal(that1.Receipt) {
+		return false
+	}
+	if len(this.SmartContractResults) != len(that1.SmartContractResults) {
+		return false
+	}
+	for i := range this.SmartContractResults {
+		if !this.SmartContractResults[i].Equal(that1.SmartContractResults[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *BlockByNonceRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BlockByNonceRequest)
+	if !ok {
+		that2, ok := that.(BlockByNonceRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Shard != that1.Shard {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if this.WithTxs != that1.WithTxs {
+		return false
+	}
+	return true
+}
+func (this *BlockByHashRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BlockByHashRequest)
+	if !ok {
+		that2, ok := that.(BlockByHashRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Shard != that1.Shard {
+		return false
+	}
+	if this.Hash != that1.Hash {
+		return false
+	}
+	if this.WithTxs != that1.WithTxs {
+		return false
+	}
+	return true
+}
+func (this *AtlasBlockRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AtlasBlockRequest)
+	if !ok {
+		that2, ok := that.(AtlasBlockRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Shard != that1.Shard {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	return true
+}
+func (this *HyperBlockByNonceRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HyperBlockByNonceRequest)
+	if !ok {
+		that2, ok := that.(HyperBlockByNonceRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	return true
+}
+func (this *HyperBlockByHashRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*HyperBlockByHashRequest)
+	if !ok {
+		that2, ok := that.(HyperBlockByHashRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Hash != that1.Hash {
+		return false
+	}
+	return true
+}
+func (this *StreamHyperBlocksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StreamHyperBlocksRequest)
+	if !ok {
+		that2, ok := that.(StreamHyperBlocksRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.StartNonce != that1.StartNonce {
+		return false
+	}
+	if this.FromLatest != that1.FromLatest {
+		return false
+	}
+	return true
+}
+func (this *NotarizedBlock) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NotarizedBlock)
+	if !ok {
+		that2, ok := that.(NotarizedBlock)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Hash != that1.Hash {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if this.Round != that1.Round {
+		return false
+	}
+	if this.Shard != that1.Shard {
+		return false
+	}
+	return true
+}
+func (this *MiniBlock) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MiniBlock)
+	if !ok {
+		that2, ok := that.(MiniBlock)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Hash != that1.Hash {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.SourceShard != that1.SourceShard {
+		return false
+	}
+	if this.DestinationShard != that1.DestinationShard {
+		return false
+	}
+	if len(this.Transactions) != len(that1.Transactions) {
+		return false
+	}
+	for i := range this.Transactions {
+		if !this.Transactions[i].Equal(that1.Transactions[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *EpochStartInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EpochStartInfo)
+	if !ok {
+		that2, ok := that.(EpochStartInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TotalSupply != that1.TotalSupply {
+		return false
+	}
+	if this.TotalToDistribute != that1.TotalToDistribute {
+		return false
+	}
+	if this.TotalNewlyMinted != that1.TotalNewlyMinted {
+		return false
+	}
+	if this.RewardsPerBlock != that1.RewardsPerBlock {
+		return false
+	}
+	if this.RewardsForProtocolSustainability != that1.RewardsForProtocolSustainability {
+		return false
+	}
+	if this.NodePrice != that1.NodePrice {
+		return false
+	}
+	if this.PrevEpochStartRound != that1.PrevEpochStartRound {
+		return false
+	}
+	if this.PrevEpochStartHash != that1.PrevEpochStartHash {
+		return false
+	}
+	return true
+}
+func (this *Block) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Block)
+	if !ok {
+		that2, ok := that.(Block)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if this.Round != that1.Round {
+		return false
+	}
+	if this.Hash != that1.Hash {
+		return false
+	}
+	if this.PrevBlockHash != that1.PrevBlockHash {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if this.Shard != that1.Shard {
+		return false
+	}
+	if this.NumTxs != that1.NumTxs {
+		return false
+	}
+	if len(this.NotarizedBlocks) != len(that1.NotarizedBlocks) {
+		return false
+	}
+	for i := range this.NotarizedBlocks {
+		if !this.NotarizedBlocks[i].Equal(that1.NotarizedBlocks[i]) {
+			return false
+		}
+	}
+	if len(this.MiniBlocks) != len(that1.MiniBlocks) {
+		return false
+	}
+	for i := range this.MiniBlocks {
+		if !this.MiniBlocks[i].Equal(that1.MiniBlocks[i]) {
+			return false
+		}
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	if this.AccumulatedFees != that1.AccumulatedFees {
+		return false
+	}
+	if this.DeveloperFees != that1.DeveloperFees {
+		return false
+	}
+	if this.AccumulatedFeesInEpoch != that1.AccumulatedFeesInEpoch {
+		return false
+	}
+	if this.DeveloperFeesInEpoch != that1.DeveloperFeesInEpoch {
+		return false
+	}
+	if !this.EpochStartInfo.Equal(that1.EpochStartInfo) {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	return true
+}
+func (this *Hyperblock) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Hyperblock)
+	if !ok {
+		that2, ok := that.(Hyperblock)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if this.Round != that1.Round {
+		return false
+	}
+	if this.Hash != that1.Hash {
+		return false
+	}
+	if this.PrevBlockHash != that1.PrevBlockHash {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if this.NumTxs != that1.NumTxs {
+		return false
+	}
+	if len(this.ShardBlocks) != len(that1.ShardBlocks) {
+		return false
+	}
+	for i := range this.ShardBlocks {
+		if !this.ShardBlocks[i].Equal(that1.ShardBlocks[i]) {
+			return false
+		}
+	}
+	if len(this.Transactions) != len(that1.Transactions) {
+		return false
+	}
+	for i := range this.Transactions {
+		if !this.Transactions[i].Equal(that1.Transactions[i]) {
+			return false
+		}
+	}
+	if this.AccumulatedFees != that1.AccumulatedFees {
+		return false
+	}
+	if this.DeveloperFees != that1.DeveloperFees {
+		return false
+	}
+	if this.AccumulatedFeesInEpoch != that1.AccumulatedFeesInEpoch {
+		return false
+	}
+	if this.DeveloperFeesInEpoch != that1.DeveloperFeesInEpoch {
+		return false
+	}
+	if !this.EpochStartInfo.Equal(that1.EpochStartInfo) {
+		return false
+	}
+	if this.Status != that1.Status {
+		return false
+	}
+	return true
+}
+func (this *AtlasBlock) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AtlasBlock)
+	if !ok {
+		that2, ok := that.(AtlasBlock)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if this.Hash != that1.Hash {
+		return false
+	}
+	if len(this.Transactions) != len(that1.Transactions) {
+		return false
+	}
+	for i := range this.Transactions {
+		if !this.Transactions[i].Equal(that1.Transactions[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *NetworkConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NetworkConfig)
+	if !ok {
+		that2, ok := that.(NetworkConfig)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ChainID != that1.ChainID {
+		return false
+	}
+	if this.Denomination != that1.Denomination {
+		return false
+	}
+	if this.GasPerDataByte != that1.GasPerDataByte {
+		return false
+	}
+	if this.LatestTagSoftwareVersion != that1.LatestTagSoftwareVersion {
+		return false
+	}
+	if this.MetaConsensusGroupSize != that1.MetaConsensusGroupSize {
+		return false
+	}
+	if this.MinGasLimit != that1.MinGasLimit {
+		return false
+	}
+	if this.MinGasPrice != that1.MinGasPrice {
+		return false
+	}
+	if this.MinTransactionVersion != that1.MinTransactionVersion {
+		return false
+	}
+	if this.NumMetachainNodes != that1.NumMetachainNodes {
+		return false
+	}
+	if this.NumNodesInShard != that1.NumNodesInShard {
+		return false
+	}
+	if this.NumShardsWithoutMeta != that1.NumShardsWithoutMeta {
+		return false
+	}
+	if this.RewardsTopUpGradientPoint != that1.RewardsTopUpGradientPoint {
+		return false
+	}
+	if this.RoundDuration != that1.RoundDuration {
+		return false
+	}
+	if this.ShardConsensusGroupSize != that1.ShardConsensusGroupSize {
+		return false
+	}
+	if this.StartTime != that1.StartTime {
+		return false
+	}
+	if this.TopUpFactor != that1.TopUpFactor {
+		return false
+	}
+	return true
+}
+func (this *NetworkStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NetworkStatus)
+	if !ok {
+		that2, ok := that.(NetworkStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CurrentRound != that1.CurrentRound {
+		return false
+	}
+	if this.EpochNumber != that1.EpochNumber {
+		return false
+	}
+	if this.HighestFinalNonce != that1.HighestFinalNonce {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if this.NonceAtEpochStart != that1.NonceAtEpochStart {
+		return false
+	}
+	if this.NoncesPassedInCurrentEpoch != that1.NoncesPassedInCurrentEpoch {
+		return false
+	}
+	if this.RoundAtEpochStart != that1.RoundAtEpochStart {
+		return false
+	}
+	if this.RoundsPassedInCurrentEpoch != that1.RoundsPassedInCurrentEpoch {
+		return false
+	}
+	if this.RoundsPerEpoch != that1.RoundsPerEpoch {
+		return false
+	}
+	if this.CrossCheckBlockHeight != that1.CrossCheckBlockHeight {
+		return false
+	}
+	return true
+}
+func (this *EconomicsData) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EconomicsData)
+	if !ok {
+		that2, ok := that.(EconomicsData)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TotalSupply != that1.TotalSupply {
+		return false
+	}
+	if this.CirculatingSupply != that1.CirculatingSupply {
+		return false
+	}
+	if this.StakedValue != that1.StakedValue {
+		return false
+	}
+	if this.TotalBaseStakedValue != that1.TotalBaseStakedValue {
+		return false
+	}
+	if this.TotalTopUpValue != that1.TotalTopUpValue {
+		return false
+	}
+	if this.DevRewards != that1.DevRewards {
+		return false
+	}
+	if this.Inflation != that1.Inflation {
+		return false
+	}
+	if this.TotalFees != that1.TotalFees {
+		return false
+	}
+	if this.EpochNumber != that1.EpochNumber {
+		return false
+	}
+	if this.EpochForEconomicsData != that1.EpochForEconomicsData {
+		return false
+	}
+	return true
+}
+func (this *EnableEpochsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EnableEpochsResponse)
+	if !ok {
+		that2, ok := that.(EnableEpochsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.EnableEpochs) != len(that1.EnableEpochs) {
+		return false
+	}
+	for i := range this.EnableEpochs {
+		if this.EnableEpochs[i] != that1.EnableEpochs[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *NonceResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*NonceResponse)
+	if !ok {
+		that2, ok := that.(NonceResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	return true
+}
+func (this *StakedInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StakedInfo)
+	if !ok {
+		that2, ok := that.(StakedInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.BaseStaked != that1.BaseStaked {
+		return false
+	}
+	if this.TopUp != that1.TopUp {
+		return false
+	}
+	if this.Total != that1.Total {
+		return false
+	}
+	return true
+}
+func (this *DirectStakedInfoResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DirectStakedInfoResponse)
+	if !ok {
+		that2, ok := that.(DirectStakedInfoResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.List) != len(that1.List) {
+		return false
+	}
+	for i := range this.List {
+		if !this.List[i].Equal(that1.List[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *DelegatedValue) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegatedValue)
+	if !ok {
+		that2, ok := that.(DelegatedValue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DelegationScAddress != that1.DelegationScAddress {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	return true
+}
+func (this *DelegatorInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegatorInfo)
+	if !ok {
+		that2, ok := that.(DelegatorInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DelegatorAddress != that1.DelegatorAddress {
+		return false
+	}
+	if len(this.DelegatedTo) != len(that1.DelegatedTo) {
+		return false
+	}
+	for i := range this.DelegatedTo {
+		if !this.DelegatedTo[i].Equal(that1.DelegatedTo[i]) {
+			return false
+		}
+	}
+	if this.Total != that1.Total {
+		return false
+	}
+	return true
+}
+func (this *DelegatedInfoResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DelegatedInfoResponse)
+	if !ok {
+		that2, ok := that.(DelegatedInfoResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.List) != len(that1.List) {
+		return false
+	}
+	for i := range this.List {
+		if !this.List[i].Equal(that1.List[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *SCQuery) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SCQuery)
+	if !ok {
+		that2, ok := that.(SCQuery)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ScAddress != that1.ScAddress {
+		return false
+	}
+	if this.FuncName != that1.FuncName {
+		return false
+	}
+	if this.CallerAddr != that1.CallerAddr {
+		return false
+	}
+	if this.CallValue != that1.CallValue {
+		return false
+	}
+	if len(this.Arguments) != len(that1.Arguments) {
+		return false
+	}
+	for i := range this.Arguments {
+		if !bytes.Equal(this.Arguments[i], that1.Arguments[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *StorageUpdate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StorageUpdate)
+	if !ok {
+		that2, ok := that.(StorageUpdate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Offset, that1.Offset) {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	return true
+}
+func (this *OutputTransfer) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OutputTransfer)
+	if !ok {
+		that2, ok := that.(OutputTransfer)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	if this.CallType != that1.CallType {
+		return false
+	}
+	return true
+}
+func (this *OutputAccount) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OutputAccount)
+	if !ok {
+		that2, ok := that.(OutputAccount)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Nonce != that1.Nonce {
+		return false
+	}
+	if this.Balance != that1.Balance {
+		return false
+	}
+	if this.BalanceDelta != that1.BalanceDelta {
+		return false
+	}
+	if len(this.StorageUpdates) != len(that1.StorageUpdates) {
+		return false
+	}
+	for i := range this.StorageUpdates {
+		if !this.StorageUpdates[i].Equal(that1.StorageUpdates[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.Code, that1.Code) {
+		return false
+	}
+	if !bytes.Equal(this.CodeMetadata, that1.CodeMetadata) {
+		return false
+	}
+	if len(this.OutputTransfers) != len(that1.OutputTransfers) {
+		return false
+	}
+	for i := range this.OutputTransfers {
+		if !this.OutputTransfers[i].Equal(that1.OutputTransfers[i]) {
+			return false
+		}
+	}
+	if this.CallType != that1.CallType {
+		return false
+	}
+	return true
+}
+func (this *LogEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LogEntry)
+	if !ok {
+		that2, ok := that.(LogEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Identifier, that1.Identifier) {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if len(this.Topics) != len(that1.Topics) {
+		return false
+	}
+	for i := range this.Topics {
+		if !bytes.Equal(this.Topics[i], that1.Topics[i]) {
+			return false
+		}
+	}
+	if !bytes.Equal(this.Data, that1.Data) {
+		return false
+	}
+	return true
+}
+func (this *VMOutput) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VMOutput)
+	if !ok {
+		that2, ok := that.(VMOutput)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.ReturnData) != len(that1.ReturnData) {
+		return false
+	}
+	for i := range this.ReturnData {
+		if !bytes.Equal(this.ReturnData[i], that1.ReturnData[i]) {
+			return false
+		}
+	}
+	if this.ReturnCode != that1.ReturnCode {
+		return false
+	}
+	if this.ReturnMessage != that1.ReturnMessage {
+		return false
+	}
+	if this.GasRemaining != that1.GasRemaining {
+		return false
+	}
+	if this.GasRefund != that1.GasRefund {
+		return false
+	}
+	if len(this.OutputAccounts) != len(that1.OutputAccounts) {
+		return false
+	}
+	for i := range this.OutputAccounts {
+		if !this.OutputAccounts[i].Equal(that1.OutputAccounts[i]) {
+			return false
+		}
+	}
+	if len(this.DeletedAccounts) != len(that1.DeletedAccounts) {
+		return false
+	}
+	for i := range this.DeletedAccounts {
+		if !bytes.Equal(this.DeletedAccounts[i], that1.DeletedAccounts[i]) {
+			return false
+		}
+	}
+	if len(this.TouchedAccounts) != len(that1.TouchedAccounts) {
+		return false
+	}
+	for i := range this.TouchedAccounts {
+		if !bytes.Equal(this.TouchedAccounts[i], that1.TouchedAccounts[i]) {
+			return false
+		}
+	}
+	if len(this.Logs) != len(that1.Logs) {
+		return false
+	}
+	for i := range this.Logs {
+		if !this.Logs[i].Equal(that1.Logs[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *Empty) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&grpcapi.Empty{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AddressRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&grpcapi.AddressRequest{")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AccountKeyRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&grpcapi.AccountKeyRequest{")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ESDTTokenRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&grpcapi.ESDTTokenRequest{")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "TokenIdentifier: "+fmt.Sprintf("%#v", this.TokenIdentifier)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ESDTNftTokenRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&grpcapi.ESDTNftTokenRequest{")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "TokenIdentifier: "+fmt.Sprintf("%#v", this.TokenIdentifier)+",\n")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ESDTsWithRoleRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&grpcapi.ESDTsWithRoleRequest{")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "Role: "+fmt.Sprintf("%#v", this.Role)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ShardRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&grpcapi.ShardRequest{")
+	s = append(s, "Shard: "+fmt.Sprintf("%#v", this.Shard)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TokenTypeRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&grpcapi.TokenTypeRequest{")
+	s = append(s, "TokenType: "+fmt.Sprintf("%#v", this.TokenType)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Account) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 14)
+	s = append(s, "&grpcapi.Account{")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "Balance: "+fmt.Sprintf("%#v", this.Balance)+",\n")
+	s = append(s, "Username: "+fmt.Sprintf("%#v", this.Username)+",\n")
+	s = append(s, "Code: "+fmt.Sprintf("%#v", this.Code)+",\n")
+	s = append(s, "CodeHash: "+fmt.Sprintf("%#v", this.CodeHash)+",\n")
+	s = append(s, "RootHash: "+fmt.Sprintf("%#v", this.RootHash)+",\n")
+	s = append(s, "CodeMetadata: "+fmt.Sprintf("%#v", this.CodeMetadata)+",\n")
+	s = append(s, "DeveloperReward: "+fmt.Sprintf("%#v", this.DeveloperReward)+",\n")
+	s = append(s, "OwnerAddress: "+fmt.Sprintf("%#v", this.OwnerAddress)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ShardIDResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&grpcapi.ShardIDResponse{")
+	s = append(s, "ShardID: "+fmt.Sprintf("%#v", this.ShardID)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ValueResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&grpcapi.ValueResponse{")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *KeyValuePairsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&grpcapi.KeyValuePairsResponse{")
+	keysForPairs := make([]string, 0, len(this.Pairs))
+	for k, _ := range this.Pairs {
+		keysForPairs = append(keysForPairs, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForPairs)
+	mapStringForPairs := "map[string]string{"
+	for _, k := range keysForPairs {
+		mapStringForPairs += fmt.Sprintf("%#v: %#v,", k, this.Pairs[k])
+	}
+	mapStringForPairs += "}"
+	if this.Pairs != nil {
+		s = append(s, "Pairs: "+mapStringForPairs+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ESDTToken) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 14)
+	s = append(s, "&grpcapi.ESDTToken{")
+	s = append(s, "TokenIdentifier: "+fmt.Sprintf("%#v", this.TokenIdentifier)+",\n")
+	s = append(s, "Balance: "+fmt.Sprintf("%#v", this.Balance)+",\n")
+	s = append(s, "Properties: "+fmt.Sprintf("%#v", this.Properties)+",\n")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "Name: "+fmt.Sprintf("%#v", this.Name)+",\n")
+	s = append(s, "Creator: "+fmt.Sprintf("%#v", this.Creator)+",\n")
+	s = append(s, "Royalties: "+fmt.Sprintf("%#v", this.Royalties)+",\n")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "URIs: "+fmt.Sprintf("%#v", this.URIs)+",\n")
+	s = append(s, "Attributes: "+fmt.Sprintf("%#v", this.Attributes)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ESDTTokensResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&grpcapi.ESDTTokensResponse{")
+	if this.Tokens != nil {
+		s = append(s, "Tokens: "+fmt.Sprintf("%#v", this.Tokens)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TokenIdentifiersResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&grpcapi.TokenIdentifiersResponse{")
+	s = append(s, "TokenIdentifiers: "+fmt.Sprintf("%#v", this.TokenIdentifiers)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DatabaseTransaction) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 21)
+	s = append(s, "&grpcapi.DatabaseTransaction{")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "Fee: "+fmt.Sprintf("%#v", this.Fee)+",\n")
+	s = append(s, "MiniBlockHash: "+fmt.Sprintf("%#v", this.MiniBlockHash)+",\n")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "Round: "+fmt.Sprintf("%#v", this.Round)+",\n")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "Receiver: "+fmt.Sprintf("%#v", this.Receiver)+",\n")
+	s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
+	s = append(s, "ReceiverShard: "+fmt.Sprintf("%#v", this.ReceiverShard)+",\n")
+	s = append(s, "SenderShard: "+fmt.Sprintf("%#v", this.SenderShard)+",\n")
+	s = append(s, "GasPrice: "+fmt.Sprintf("%#v", this.GasPrice)+",\n")
+	s = append(s, "GasLimit: "+fmt.Sprintf("%#v", this.GasLimit)+",\n")
+	s = append(s, "GasUsed: "+fmt.Sprintf("%#v", this.GasUsed)+",\n")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "Signature: "+fmt.Sprintf("%#v", this.Signature)+",\n")
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DatabaseTransactionsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&grpcapi.DatabaseTransactionsResponse{")
+	if this.Transactions != nil {
+		s = append(s, "Transactions: "+fmt.Sprintf("%#v", this.Transactions)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Transaction) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 17)
+	s = append(s, "&grpcapi.Transaction{")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "Receiver: "+fmt.Sprintf("%#v", this.Receiver)+",\n")
+	s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
+	s = append(s, "SenderUsername: "+fmt.Sprintf("%#v", this.SenderUsername)+",\n")
+	s = append(s, "ReceiverUsername: "+fmt.Sprintf("%#v", this.ReceiverUsername)+",\n")
+	s = append(s, "GasPrice: "+fmt.Sprintf("%#v", this.GasPrice)+",\n")
+	s = append(s, "GasLimit: "+fmt.Sprintf("%#v", this.GasLimit)+",\n")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "Signature: "+fmt.Sprintf("%#v", this.Signature)+",\n")
+	s = append(s, "ChainID: "+fmt.Sprintf("%#v", this.ChainID)+",\n")
+	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
+	s = append(s, "Options: "+fmt.Sprintf("%#v", this.Options)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MultipleTransactionsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&grpcapi.MultipleTransactionsRequest{")
+	if this.Transactions != nil {
+		s = append(s, "Transactions: "+fmt.Sprintf("%#v", this.Transactions)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MultipleTransactionsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&grpcapi.MultipleTransactionsResponse{")
+	s = append(s, "NumOfSentTxs: "+fmt.Sprintf("%#v", this.NumOfSentTxs)+",\n")
+	keysForTxsHashes := make([]uint32, 0, len(this.TxsHashes))
+	for k, _ := range this.TxsHashes {
+		keysForTxsHashes = append(keysForTxsHashes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Uint32s(keysForTxsHashes)
+	mapStringForTxsHashes := "map[uint32]string{"
+	for _, k := range keysForTxsHashes {
+		mapStringForTxsHashes += fmt.Sprintf("%#v: %#v,", k, this.TxsHashes[k])
+	}
+	mapStringForTxsHashes += "}"
+	if this.TxsHashes != nil {
+		s = append(s, "TxsHashes: "+mapStringForTxsHashes+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SimulateTransactionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&grpcapi.SimulateTransactionRequest{")
+	if this.Transaction != nil {
+		s = append(s, "Transaction: "+fmt.Sprintf("%#v", this.Transaction)+",\n")
+	}
+	s = append(s, "SkipSignatureCheck: "+fmt.Sprintf("%#v", this.SkipSignatureCheck)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SmartContractResult) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 21)
+	s = append(s, "&grpcapi.SmartContractResult{")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "Receiver: "+fmt.Sprintf("%#v", this.Receiver)+",\n")
+	s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
+	s = append(s, "RelayerAddress: "+fmt.Sprintf("%#v", this.RelayerAddress)+",\n")
+	s = append(s, "RelayedValue: "+fmt.Sprintf("%#v", this.RelayedValue)+",\n")
+	s = append(s, "Code: "+fmt.Sprintf("%#v", this.Code)+",\n")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "PrevTxHash: "+fmt.Sprintf("%#v", this.PrevTxHash)+",\n")
+	s = append(s, "OriginalTxHash: "+fmt.Sprintf("%#v", this.OriginalTxHash)+",\n")
+	s = append(s, "GasLimit: "+fmt.Sprintf("%#v", this.GasLimit)+",\n")
+	s = append(s, "GasPrice: "+fmt.Sprintf("%#v", this.GasPrice)+",\n")
+	s = append(s, "CallType: "+fmt.Sprintf("%#v", this.CallType)+",\n")
+	s = append(s, "CodeMetadata: "+fmt.Sprintf("%#v", this.CodeMetadata)+",\n")
+	s = append(s, "ReturnMessage: "+fmt.Sprintf("%#v", this.ReturnMessage)+",\n")
+	s = append(s, "OriginalSender: "+fmt.Sprintf("%#v", this.OriginalSender)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Receipt) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&grpcapi.Receipt{")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "TxHash: "+fmt.Sprintf("%#v", this.TxHash)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SimulationResults) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&grpcapi.SimulationResults{")
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	s = append(s, "FailReason: "+fmt.Sprintf("%#v", this.FailReason)+",\n")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	keysForScResults := make([]string, 0, len(this.ScResults))
+	for k, _ := range this.ScResults {
+		keysForScResults = append(keysForScResults, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForScResults)
+	mapStringForScResults := "map[string]*SmartContractResult{"
+	for _, k := range keysForScResults {
+		mapStringForScResults += fmt.Sprintf("%#v: %#v,", k, this.ScResults[k])
+	}
+	mapStringForScResults += "}"
+	if this.ScResults != nil {
+		s = append(s, "ScResults: "+mapStringForScResults+",\n")
+	}
+	keysForReceipts := make([]string, 0, len(this.Receipts))
+	for k, _ := range this.Receipts {
+		keysForReceipts = append(keysForReceipts, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForReceipts)
+	mapStringForReceipts := "map[string]*Receipt{"
+	for _, k := range keysForReceipts {
+		mapStringForReceipts += fmt.Sprintf("%#v: %#v,", k, this.Receipts[k])
+	}
+	mapStringForReceipts += "}"
+	if this.Receipts != nil {
+		s = append(s, "Receipts: "+mapStringForReceipts+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SimulationResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&grpcapi.SimulationResponse{")
+	if this.SenderShard != nil {
+		s = append(s, "SenderShard: "+fmt.Sprintf("%#v", this.SenderShard)+",\n")
+	}
+	if this.ReceiverShard != nil {
+		s = append(s, "ReceiverShard: "+fmt.Sprintf("%#v", this.ReceiverShard)+",\n")
+	}
+	s = append(s, "IsCrossShard: "+fmt.Sprintf("%#v", this.IsCrossShard)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TransactionCostResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&grpcapi.TransactionCostResponse{")
+	s = append(s, "GasUnits: "+fmt.Sprintf("%#v", this.GasUnits)+",\n")
+	s = append(s, "ReturnMessage: "+fmt.Sprintf("%#v", this.ReturnMessage)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TransactionHashResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&grpcapi.TransactionHashResponse{")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *GetTransactionRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&grpcapi.GetTransactionRequest{")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
+	s = append(s, "WithResults: "+fmt.Sprintf("%#v", this.WithResults)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TransactionStatusRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&grpcapi.TransactionStatusRequest{")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TransactionStatusResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&grpcapi.TransactionStatusResponse{")
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *FullTransaction) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 39)
+	s = append(s, "&grpcapi.FullTransaction{")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "Round: "+fmt.Sprintf("%#v", this.Round)+",\n")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "Receiver: "+fmt.Sprintf("%#v", this.Receiver)+",\n")
+	s = append(s, "Sender: "+fmt.Sprintf("%#v", this.Sender)+",\n")
+	s = append(s, "SenderUsername: "+fmt.Sprintf("%#v", this.SenderUsername)+",\n")
+	s = append(s, "ReceiverUsername: "+fmt.Sprintf("%#v", this.ReceiverUsername)+",\n")
+	s = append(s, "GasPrice: "+fmt.Sprintf("%#v", this.GasPrice)+",\n")
+	s = append(s, "GasLimit: "+fmt.Sprintf("%#v", this.GasLimit)+",\n")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "CodeMetadata: "+fmt.Sprintf("%#v", this.CodeMetadata)+",\n")
+	s = append(s, "Code: "+fmt.Sprintf("%#v", this.Code)+",\n")
+	s = append(s, "PreviousTransactionHash: "+fmt.Sprintf("%#v", this.PreviousTransactionHash)+",\n")
+	s = append(s, "OriginalTransactionHash: "+fmt.Sprintf("%#v", this.OriginalTransactionHash)+",\n")
+	s = append(s, "ReturnMessage: "+fmt.Sprintf("%#v", this.ReturnMessage)+",\n")
+	s = append(s, "OriginalSender: "+fmt.Sprintf("%#v", this.OriginalSender)+",\n")
+	s = append(s, "Signature: "+fmt.Sprintf("%#v", this.Signature)+",\n")
+	s = append(s, "SourceShard: "+fmt.Sprintf("%#v", this.SourceShard)+",\n")
+	s = append(s, "DestinationShard: "+fmt.Sprintf("%#v", this.DestinationShard)+",\n")
+	s = append(s, "BlockNonce: "+fmt.Sprintf("%#v", this.BlockNonce)+",\n")
+	s = append(s, "BlockHash: "+fmt.Sprintf("%#v", this.BlockHash)+",\n")
+	s = append(s, "NotarizedAtSourceInMetaNonce: "+fmt.Sprintf("%#v", this.NotarizedAtSourceInMetaNonce)+",\n")
+	s = append(s, "NotarizedAtSourceInMetaHash: "+fmt.Sprintf("%#v", this.NotarizedAtSourceInMetaHash)+",\n")
+	s = append(s, "NotarizedAtDestinationInMetaNonce: "+fmt.Sprintf("%#v", this.NotarizedAtDestinationInMetaNonce)+",\n")
+	s = append(s, "NotarizedAtDestinationInMetaHash: "+fmt.Sprintf("%#v", this.NotarizedAtDestinationInMetaHash)+",\n")
+	s = append(s, "MiniBlockType: "+fmt.Sprintf("%#v", this.MiniBlockType)+",\n")
+	s = append(s, "MiniBlockHash: "+fmt.Sprintf("%#v", this.MiniBlockHash)+",\n")
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	s = append(s, "HyperblockNonce: "+fmt.Sprintf("%#v", this.HyperblockNonce)+",\n")
+	s = append(s, "HyperblockHash: "+fmt.Sprintf("%#v", this.HyperblockHash)+",\n")
+	if this.Receipt != nil {
+		s = append(s, "Receipt: "+fmt.Sprintf("%#v", this.Receipt)+",\n")
+	}
+	if this.SmartContractResults != nil {
+		s = append(s, "SmartContractResults: "+fmt.Sprintf("%#v", this.SmartContractResults)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BlockByNonceRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&grpcapi.BlockByNonceRequest{")
+	s = append(s, "Shard: "+fmt.Sprintf("%#v", this.Shard)+",\n")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "WithTxs: "+fmt.Sprintf("%#v", this.WithTxs)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *BlockByHashRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&grpcapi.BlockByHashRequest{")
+	s = append(s, "Shard: "+fmt.Sprintf("%#v", this.Shard)+",\n")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "WithTxs: "+fmt.Sprintf("%#v", this.WithTxs)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AtlasBlockRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&grpcapi.AtlasBlockRequest{")
+	s = append(s, "Shard: "+fmt.Sprintf("%#v", this.Shard)+",\n")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *HyperBlockByNonceRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&grpcapi.HyperBlockByNonceRequest{")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *HyperBlockByHashRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&grpcapi.HyperBlockByHashRequest{")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *StreamHyperBlocksRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&grpcapi.StreamHyperBlocksRequest{")
+	s = append(s, "StartNonce: "+fmt.Sprintf("%#v", this.StartNonce)+",\n")
+	s = append(s, "FromLatest: "+fmt.Sprintf("%#v", this.FromLatest)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *NotarizedBlock) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&grpcapi.NotarizedBlock{")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "Round: "+fmt.Sprintf("%#v", this.Round)+",\n")
+	s = append(s, "Shard: "+fmt.Sprintf("%#v", this.Shard)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *MiniBlock) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&grpcapi.MiniBlock{")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "SourceShard: "+fmt.Sprintf("%#v", this.SourceShard)+",\n")
+	s = append(s, "DestinationShard: "+fmt.Sprintf("%#v", this.DestinationShard)+",\n")
+	if this.Transactions != nil {
+		s = append(s, "Transactions: "+fmt.Sprintf("%#v", this.Transactions)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EpochStartInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&grpcapi.EpochStartInfo{")
+	s = append(s, "TotalSupply: "+fmt.Sprintf("%#v", this.TotalSupply)+",\n")
+	s = append(s, "TotalToDistribute: "+fmt.Sprintf("%#v", this.TotalToDistribute)+",\n")
+	s = append(s, "TotalNewlyMinted: "+fmt.Sprintf("%#v", this.TotalNewlyMinted)+",\n")
+	s = append(s, "RewardsPerBlock: "+fmt.Sprintf("%#v", this.RewardsPerBlock)+",\n")
+	s = append(s, "RewardsForProtocolSustainability: "+fmt.Sprintf("%#v", this.RewardsForProtocolSustainability)+",\n")
+	s = append(s, "NodePrice: "+fmt.Sprintf("%#v", this.NodePrice)+",\n")
+	s = append(s, "PrevEpochStartRound: "+fmt.Sprintf("%#v", this.PrevEpochStartRound)+",\n")
+	s = append(s, "PrevEpochStartHash: "+fmt.Sprintf("%#v", this.PrevEpochStartHash)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Block) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 20)
+	s = append(s, "&grpcapi.Block{")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "Round: "+fmt.Sprintf("%#v", this.Round)+",\n")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "PrevBlockHash: "+fmt.Sprintf("%#v", this.PrevBlockHash)+",\n")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "Shard: "+fmt.Sprintf("%#v", this.Shard)+",\n")
+	s = append(s, "NumTxs: "+fmt.Sprintf("%#v", this.NumTxs)+",\n")
+	if this.NotarizedBlocks != nil {
+		s = append(s, "NotarizedBlocks: "+fmt.Sprintf("%#v", this.NotarizedBlocks)+",\n")
+	}
+	if this.MiniBlocks != nil {
+		s = append(s, "MiniBlocks: "+fmt.Sprintf("%#v", this.MiniBlocks)+",\n")
+	}
+	s = append(s, "Timestamp: "+fmt.Sprintf("%#v", this.Timestamp)+",\n")
+	s = append(s, "AccumulatedFees: "+fmt.Sprintf("%#v", this.AccumulatedFees)+",\n")
+	s = append(s, "DeveloperFees: "+fmt.Sprintf("%#v", this.DeveloperFees)+",\n")
+	s = append(s, "AccumulatedFeesInEpoch: "+fmt.Sprintf("%#v", this.AccumulatedFeesInEpoch)+",\n")
+	s = append(s, "DeveloperFeesInEpoch: "+fmt.Sprintf("%#v", this.DeveloperFeesInEpoch)+",\n")
+	if this.EpochStartInfo != nil {
+		s = append(s, "EpochStartInfo: "+fmt.Sprintf("%#v", this.EpochStartInfo)+",\n")
+	}
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *Hyperblock) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 18)
+	s = append(s, "&grpcapi.Hyperblock{")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "Round: "+fmt.Sprintf("%#v", this.Round)+",\n")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	s = append(s, "PrevBlockHash: "+fmt.Sprintf("%#v", this.PrevBlockHash)+",\n")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "NumTxs: "+fmt.Sprintf("%#v", this.NumTxs)+",\n")
+	if this.ShardBlocks != nil {
+		s = append(s, "ShardBlocks: "+fmt.Sprintf("%#v", this.ShardBlocks)+",\n")
+	}
+	if this.Transactions != nil {
+		s = append(s, "Transactions: "+fmt.Sprintf("%#v", this.Transactions)+",\n")
+	}
+	s = append(s, "AccumulatedFees: "+fmt.Sprintf("%#v", this.AccumulatedFees)+",\n")
+	s = append(s, "DeveloperFees: "+fmt.Sprintf("%#v", this.DeveloperFees)+",\n")
+	s = append(s, "AccumulatedFeesInEpoch: "+fmt.Sprintf("%#v", this.AccumulatedFeesInEpoch)+",\n")
+	s = append(s, "DeveloperFeesInEpoch: "+fmt.Sprintf("%#v", this.DeveloperFeesInEpoch)+",\n")
+	if this.EpochStartInfo != nil {
+		s = append(s, "EpochStartInfo: "+fmt.Sprintf("%#v", this.EpochStartInfo)+",\n")
+	}
+	s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AtlasBlock) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&grpcapi.AtlasBlock{")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "Hash: "+fmt.Sprintf("%#v", this.Hash)+",\n")
+	if this.Transactions != nil {
+		s = append(s, "Transactions: "+fmt.Sprintf("%#v", this.Transactions)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *NetworkConfig) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 20)
+	s = append(s, "&grpcapi.NetworkConfig{")
+	s = append(s, "ChainID: "+fmt.Sprintf("%#v", this.ChainID)+",\n")
+	s = append(s, "Denomination: "+fmt.Sprintf("%#v", this.Denomination)+",\n")
+	s = append(s, "GasPerDataByte: "+fmt.Sprintf("%#v", this.GasPerDataByte)+",\n")
+	s = append(s, "LatestTagSoftwareVersion: "+fmt.Sprintf("%#v", this.LatestTagSoftwareVersion)+",\n")
+	s = append(s, "MetaConsensusGroupSize: "+fmt.Sprintf("%#v", this.MetaConsensusGroupSize)+",\n")
+	s = append(s, "MinGasLimit: "+fmt.Sprintf("%#v", this.MinGasLimit)+",\n")
+	s = append(s, "MinGasPrice: "+fmt.Sprintf("%#v", this.MinGasPrice)+",\n")
+	s = append(s, "MinTransactionVersion: "+fmt.Sprintf("%#v", this.MinTransactionVersion)+",\n")
+	s = append(s, "NumMetachainNodes: "+fmt.Sprintf("%#v", this.NumMetachainNodes)+",\n")
+	s = append(s, "NumNodesInShard: "+fmt.Sprintf("%#v", this.NumNodesInShard)+",\n")
+	s = append(s, "NumShardsWithoutMeta: "+fmt.Sprintf("%#v", this.NumShardsWithoutMeta)+",\n")
+	s = append(s, "RewardsTopUpGradientPoint: "+fmt.Sprintf("%#v", this.RewardsTopUpGradientPoint)+",\n")
+	s = append(s, "RoundDuration: "+fmt.Sprintf("%#v", this.RoundDuration)+",\n")
+	s = append(s, "ShardConsensusGroupSize: "+fmt.Sprintf("%#v", this.ShardConsensusGroupSize)+",\n")
+	s = append(s, "StartTime: "+fmt.Sprintf("%#v", this.StartTime)+",\n")
+	s = append(s, "TopUpFactor: "+fmt.Sprintf("%#v", this.TopUpFactor)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *NetworkStatus) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 14)
+	s = append(s, "&grpcapi.NetworkStatus{")
+	s = append(s, "CurrentRound: "+fmt.Sprintf("%#v", this.CurrentRound)+",\n")
+	s = append(s, "EpochNumber: "+fmt.Sprintf("%#v", this.EpochNumber)+",\n")
+	s = append(s, "HighestFinalNonce: "+fmt.Sprintf("%#v", this.HighestFinalNonce)+",\n")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "NonceAtEpochStart: "+fmt.Sprintf("%#v", this.NonceAtEpochStart)+",\n")
+	s = append(s, "NoncesPassedInCurrentEpoch: "+fmt.Sprintf("%#v", this.NoncesPassedInCurrentEpoch)+",\n")
+	s = append(s, "RoundAtEpochStart: "+fmt.Sprintf("%#v", this.RoundAtEpochStart)+",\n")
+	s = append(s, "RoundsPassedInCurrentEpoch: "+fmt.Sprintf("%#v", this.RoundsPassedInCurrentEpoch)+",\n")
+	s = append(s, "RoundsPerEpoch: "+fmt.Sprintf("%#v", this.RoundsPerEpoch)+",\n")
+	s = append(s, "CrossCheckBlockHeight: "+fmt.Sprintf("%#v", this.CrossCheckBlockHeight)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EconomicsData) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 14)
+	s = append(s, "&grpcapi.EconomicsData{")
+	s = append(s, "TotalSupply: "+fmt.Sprintf("%#v", this.TotalSupply)+",\n")
+	s = append(s, "CirculatingSupply: "+fmt.Sprintf("%#v", this.CirculatingSupply)+",\n")
+	s = append(s, "StakedValue: "+fmt.Sprintf("%#v", this.StakedValue)+",\n")
+	s = append(s, "TotalBaseStakedValue: "+fmt.Sprintf("%#v", this.TotalBaseStakedValue)+",\n")
+	s = append(s, "TotalTopUpValue: "+fmt.Sprintf("%#v", this.TotalTopUpValue)+",\n")
+	s = append(s, "DevRewards: "+fmt.Sprintf("%#v", this.DevRewards)+",\n")
+	s = append(s, "Inflation: "+fmt.Sprintf("%#v", this.Inflation)+",\n")
+	s = append(s, "TotalFees: "+fmt.Sprintf("%#v", this.TotalFees)+",\n")
+	s = append(s, "EpochNumber: "+fmt.Sprintf("%#v", this.EpochNumber)+",\n")
+	s = append(s, "EpochForEconomicsData: "+fmt.Sprintf("%#v", this.EpochForEconomicsData)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *EnableEpochsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&grpcapi.EnableEpochsResponse{")
+	keysForEnableEpochs := make([]string, 0, len(this.EnableEpochs))
+	for k, _ := range this.EnableEpochs {
+		keysForEnableEpochs = append(keysForEnableEpochs, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForEnableEpochs)
+	mapStringForEnableEpochs := "map[string]uint32{"
+	for _, k := range keysForEnableEpochs {
+		mapStringForEnableEpochs += fmt.Sprintf("%#v: %#v,", k, this.EnableEpochs[k])
+	}
+	mapStringForEnableEpochs += "}"
+	if this.EnableEpochs != nil {
+		s = append(s, "EnableEpochs: "+mapStringForEnableEpochs+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *NonceResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&grpcapi.NonceResponse{")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *StakedInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&grpcapi.StakedInfo{")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "BaseStaked: "+fmt.Sprintf("%#v", this.BaseStaked)+",\n")
+	s = append(s, "TopUp: "+fmt.Sprintf("%#v", this.TopUp)+",\n")
+	s = append(s, "Total: "+fmt.Sprintf("%#v", this.Total)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DirectStakedInfoResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&grpcapi.DirectStakedInfoResponse{")
+	if this.List != nil {
+		s = append(s, "List: "+fmt.Sprintf("%#v", this.List)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DelegatedValue) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&grpcapi.DelegatedValue{")
+	s = append(s, "DelegationScAddress: "+fmt.Sprintf("%#v", this.DelegationScAddress)+",\n")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DelegatorInfo) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&grpcapi.DelegatorInfo{")
+	s = append(s, "DelegatorAddress: "+fmt.Sprintf("%#v", this.DelegatorAddress)+",\n")
+	if this.DelegatedTo != nil {
+		s = append(s, "DelegatedTo: "+fmt.Sprintf("%#v", this.DelegatedTo)+",\n")
+	}
+	s = append(s, "Total: "+fmt.Sprintf("%#v", this.Total)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DelegatedInfoResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&grpcapi.DelegatedInfoResponse{")
+	if this.List != nil {
+		s = append(s, "List: "+fmt.Sprintf("%#v", this.List)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SCQuery) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&grpcapi.SCQuery{")
+	s = append(s, "ScAddress: "+fmt.Sprintf("%#v", this.ScAddress)+",\n")
+	s = append(s, "FuncName: "+fmt.Sprintf("%#v", this.FuncName)+",\n")
+	s = append(s, "CallerAddr: "+fmt.Sprintf("%#v", this.CallerAddr)+",\n")
+	s = append(s, "CallValue: "+fmt.Sprintf("%#v", this.CallValue)+",\n")
+	s = append(s, "Arguments: "+fmt.Sprintf("%#v", this.Arguments)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *StorageUpdate) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&grpcapi.StorageUpdate{")
+	s = append(s, "Offset: "+fmt.Sprintf("%#v", this.Offset)+",\n")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *OutputTransfer) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&grpcapi.OutputTransfer{")
+	s = append(s, "Value: "+fmt.Sprintf("%#v", this.Value)+",\n")
+	s = append(s, "GasLimit: "+fmt.Sprintf("%#v", this.GasLimit)+",\n")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "CallType: "+fmt.Sprintf("%#v", this.CallType)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *OutputAccount) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 13)
+	s = append(s, "&grpcapi.OutputAccount{")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "Nonce: "+fmt.Sprintf("%#v", this.Nonce)+",\n")
+	s = append(s, "Balance: "+fmt.Sprintf("%#v", this.Balance)+",\n")
+	s = append(s, "BalanceDelta: "+fmt.Sprintf("%#v", this.BalanceDelta)+",\n")
+	keysForStorageUpdates := make([]string, 0, len(this.StorageUpdates))
+	for k, _ := range this.StorageUpdates {
+		keysForStorageUpdates = append(keysForStorageUpdates, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForStorageUpdates)
+	mapStringForStorageUpdates := "map[string]*StorageUpdate{"
+	for _, k := range keysForStorageUpdates {
+		mapStringForStorageUpdates += fmt.Sprintf("%#v: %#v,", k, this.StorageUpdates[k])
+	}
+	mapStringForStorageUpdates += "}"
+	if this.StorageUpdates != nil {
+		s = append(s, "StorageUpdates: "+mapStringForStorageUpdates+",\n")
+	}
+	s = append(s, "Code: "+fmt.Sprintf("%#v", this.Code)+",\n")
+	s = append(s, "CodeMetadata: "+fmt.Sprintf("%#v", this.CodeMetadata)+",\n")
+	if this.OutputTransfers != nil {
+		s = append(s, "OutputTransfers: "+fmt.Sprintf("%#v", this.OutputTransfers)+",\n")
+	}
+	s = append(s, "CallType: "+fmt.Sprintf("%#v", this.CallType)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *LogEntry) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&grpcapi.LogEntry{")
+	s = append(s, "Identifier: "+fmt.Sprintf("%#v", this.Identifier)+",\n")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "Topics: "+fmt.Sprintf("%#v", this.Topics)+",\n")
+	s = append(s, "Data: "+fmt.Sprintf("%#v", this.Data)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *VMOutput) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 13)
+	s = append(s, "&grpcapi.VMOutput{")
+	s = append(s, "ReturnData: "+fmt.Sprintf("%#v", this.ReturnData)+",\n")
+	s = append(s, "ReturnCode: "+fmt.Sprintf("%#v", this.ReturnCode)+",\n")
+	s = append(s, "ReturnMessage: "+fmt.Sprintf("%#v", this.ReturnMessage)+",\n")
+	s = append(s, "GasRemaining: "+fmt.Sprintf("%#v", this.GasRemaining)+",\n")
+	s = append(s, "GasRefund: "+fmt.Sprintf("%#v", this.GasRefund)+",\n")
+	keysForOutputAccounts := make([]string, 0, len(this.OutputAccounts))
+	for k, _ := range this.OutputAccounts {
+		keysForOutputAccounts = append(keysForOutputAccounts, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForOutputAccounts)
+	mapStringForOutputAccounts := "map[string]*OutputAccount{"
+	for _, k := range keysForOutputAccounts {
+		mapStringForOutputAccounts += fmt.Sprintf("%#v: %#v,", k, this.OutputAccounts[k])
+	}
+	mapStringForOutputAccounts += "}"
+	if this.OutputAccounts != nil {
+		s = append(s, "OutputAccounts: "+mapStringForOutputAccounts+",\n")
+	}
+	s = append(s, "DeletedAccounts: "+fmt.Sprintf("%#v", this.DeletedAccounts)+",\n")
+	s = append(s, "TouchedAccounts: "+fmt.Sprintf("%#v", this.TouchedAccounts)+",\n")
+	if this.Logs != nil {
+		s = append(s, "Logs: "+fmt.Sprintf("%#v", this.Logs)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringProxy(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AccountServiceClient is the client API for AccountService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AccountServiceClient interface {
+	GetAccount(ctx context.Context, in *AddressRequest, opts ...grpc.CallOption) (*Account, error)
+	GetShardIDForAddress(ctx context.Context, in *AddressRequest, opts ...grpc.CallOption) (*ShardIDResponse, error)
+	GetValueForKey(ctx context.Context, in *AccountKeyRequest, opts ...grpc.CallOption) (*ValueResponse, error)
+	GetKeyValuePairs(ctx context.Context, in *AddressRequest, opts ...grpc.CallOption) (*KeyValuePairsResponse, error)
+	GetTransactions(ctx context.Context, in *AddressRequest, opts ...grpc.CallOption) (*DatabaseTransactionsResponse, error)
+	GetAllESDTTokens(ctx context.Context, in *AddressRequest, opts ...grpc.CallOption) (*ESDTTokensResponse, error)
+	GetESDTTokenData(ctx context.Context, in *ESDTTokenRequest, opts ...grpc.CallOption) (*ESDTToken, error)
+	GetESDTNftTokenData(ctx context.Context, in *ESDTNftTokenRequest, opts ...grpc.CallOption) (*ESDTToken, error)
+	GetESDTsWithRole(ctx context.Context, in *ESDTsWithRoleRequest, opts ...grpc.CallOption) (*TokenIdentifiersResponse, error)
+	GetNFTTokenIDsRegisteredByAddress(ctx context.Context, in *AddressRequest, opts ...grpc.CallOption) (*TokenIdentifiersResponse, error)
+}
+
+type accountServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAccountServiceClient(cc *grpc.ClientConn) AccountServiceClient {
+	return &accountServiceClient{cc}
+}
+
+func (c *accountServiceClient) GetAccount(ctx context.Context, in *AddressRequest, opts ...grpc.CallOption) (*Account, error) {
+	out := new(Account)
+	err := c.cc.Invoke(ctx, "/grpcapi.AccountService/GetAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) GetShardIDForAddress(ctx context.Context, in *AddressRequest, opts ...grpc.CallOption) (*ShardIDResponse, error) {
+	out := new(ShardIDResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.AccountService/GetShardIDForAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) GetValueForKey(ctx context.Context, in *AccountKeyRequest, opts ...grpc.CallOption) (*ValueResponse, error) {
+	out := new(ValueResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.AccountService/GetValueForKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) GetKeyValuePairs(ctx context.Context, in *AddressRequest, opts ...grpc.CallOption) (*KeyValuePairsResponse, error) {
+	out := new(KeyValuePairsResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.AccountService/GetKeyValuePairs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) GetTransactions(ctx context.Context, in *AddressRequest, opts ...grpc.CallOption) (*DatabaseTransactionsResponse, error) {
+	out := new(DatabaseTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.AccountService/GetTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) GetAllESDTTokens(ctx context.Context, in *AddressRequest, opts ...grpc.CallOption) (*ESDTTokensResponse, error) {
+	out := new(ESDTTokensResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.AccountService/GetAllESDTTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) GetESDTTokenData(ctx context.Context, in *ESDTTokenRequest, opts ...grpc.CallOption) (*ESDTToken, error) {
+	out := new(ESDTToken)
+	err := c.cc.Invoke(ctx, "/grpcapi.AccountService/GetESDTTokenData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) GetESDTNftTokenData(ctx context.Context, in *ESDTNftTokenRequest, opts ...grpc.CallOption) (*ESDTToken, error) {
+	out := new(ESDTToken)
+	err := c.cc.Invoke(ctx, "/grpcapi.AccountService/GetESDTNftTokenData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) GetESDTsWithRole(ctx context.Context, in *ESDTsWithRoleRequest, opts ...grpc.CallOption) (*TokenIdentifiersResponse, error) {
+	out := new(TokenIdentifiersResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.AccountService/GetESDTsWithRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) GetNFTTokenIDsRegisteredByAddress(ctx context.Context, in *AddressRequest, opts ...grpc.CallOption) (*TokenIdentifiersResponse, error) {
+	out := new(TokenIdentifiersResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.AccountService/GetNFTTokenIDsRegisteredByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AccountServiceServer is the server API for AccountService service.
+type AccountServiceServer interface {
+	GetAccount(context.Context, *AddressRequest) (*Account, error)
+	GetShardIDForAddress(context.Context, *AddressRequest) (*ShardIDResponse, error)
+	GetValueForKey(context.Context, *AccountKeyRequest) (*ValueResponse, error)
+	GetKeyValuePairs(context.Context, *AddressRequest) (*KeyValuePairsResponse, error)
+	GetTransactions(context.Context, *AddressRequest) (*DatabaseTransactionsResponse, error)
+	GetAllESDTTokens(context.Context, *AddressRequest) (*ESDTTokensResponse, error)
+	GetESDTTokenData(context.Context, *ESDTTokenRequest) (*ESDTToken, error)
+	GetESDTNftTokenData(context.Context, *ESDTNftTokenRequest) (*ESDTToken, error)
+	GetESDTsWithRole(context.Context, *ESDTsWithRoleRequest) (*TokenIdentifiersResponse, error)
+	GetNFTTokenIDsRegisteredByAddress(context.Context, *AddressRequest) (*TokenIdentifiersResponse, error)
+}
+
+// UnimplementedAccountServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedAccountServiceServer struct {
+}
+
+func (*UnimplementedAccountServiceServer) GetAccount(ctx context.Context, req *AddressRequest) (*Account, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccount not implemented")
+}
+func (*UnimplementedAccountServiceServer) GetShardIDForAddress(ctx context.Context, req *AddressRequest) (*ShardIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShardIDForAddress not implemented")
+}
+func (*UnimplementedAccountServiceServer) GetValueForKey(ctx context.Context, req *AccountKeyRequest) (*ValueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValueForKey not implemented")
+}
+func (*UnimplementedAccountServiceServer) GetKeyValuePairs(ctx context.Context, req *AddressRequest) (*KeyValuePairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKeyValuePairs not implemented")
+}
+func (*UnimplementedAccountServiceServer) GetTransactions(ctx context.Context, req *AddressRequest) (*DatabaseTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactions not implemented")
+}
+func (*UnimplementedAccountServiceServer) GetAllESDTTokens(ctx context.Context, req *AddressRequest) (*ESDTTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllESDTTokens not implemented")
+}
+func (*UnimplementedAccountServiceServer) GetESDTTokenData(ctx context.Context, req *ESDTTokenRequest) (*ESDTToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetESDTTokenData not implemented")
+}
+func (*UnimplementedAccountServiceServer) GetESDTNftTokenData(ctx context.Context, req *ESDTNftTokenRequest) (*ESDTToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetESDTNftTokenData not implemented")
+}
+func (*UnimplementedAccountServiceServer) GetESDTsWithRole(ctx context.Context, req *ESDTsWithRoleRequest) (*TokenIdentifiersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetESDTsWithRole not implemented")
+}
+func (*UnimplementedAccountServiceServer) GetNFTTokenIDsRegisteredByAddress(ctx context.Context, req *AddressRequest) (*TokenIdentifiersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNFTTokenIDsRegisteredByAddress not implemented")
+}
+
+func RegisterAccountServiceServer(s *grpc.Server, srv AccountServiceServer) {
+	s.RegisterService(&_AccountService_serviceDesc, srv)
+}
+
+func _AccountService_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.AccountService/GetAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetAccount(ctx, req.(*AddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GetShardIDForAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetShardIDForAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.AccountService/GetShardIDForAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetShardIDForAddress(ctx, req.(*AddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GetValueForKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetValueForKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.AccountService/GetValueForKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetValueForKey(ctx, req.(*AccountKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GetKeyValuePairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetKeyValuePairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.AccountService/GetKeyValuePairs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetKeyValuePairs(ctx, req.(*AddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GetTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.AccountService/GetTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetTransactions(ctx, req.(*AddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GetAllESDTTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetAllESDTTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.AccountService/GetAllESDTTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetAllESDTTokens(ctx, req.(*AddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GetESDTTokenData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ESDTTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetESDTTokenData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.AccountService/GetESDTTokenData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetESDTTokenData(ctx, req.(*ESDTTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GetESDTNftTokenData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ESDTNftTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetESDTNftTokenData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.AccountService/GetESDTNftTokenData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetESDTNftTokenData(ctx, req.(*ESDTNftTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GetESDTsWithRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ESDTsWithRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetESDTsWithRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.AccountService/GetESDTsWithRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetESDTsWithRole(ctx, req.(*ESDTsWithRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GetNFTTokenIDsRegisteredByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetNFTTokenIDsRegisteredByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.AccountService/GetNFTTokenIDsRegisteredByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetNFTTokenIDsRegisteredByAddress(ctx, req.(*AddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _AccountService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "grpcapi.AccountService",
+	HandlerType: (*AccountServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAccount",
+			Handler:    _AccountService_GetAccount_Handler,
+		},
+		{
+			MethodName: "GetShardIDForAddress",
+			Handler:    _AccountService_GetShardIDForAddress_Handler,
+		},
+		{
+			MethodName: "GetValueForKey",
+			Handler:    _AccountService_GetValueForKey_Handler,
+		},
+		{
+			MethodName: "GetKeyValuePairs",
+			Handler:    _AccountService_GetKeyValuePairs_Handler,
+		},
+		{
+			MethodName: "GetTransactions",
+			Handler:    _AccountService_GetTransactions_Handler,
+		},
+		{
+			MethodName: "GetAllESDTTokens",
+			Handler:    _AccountService_GetAllESDTTokens_Handler,
+		},
+		{
+			MethodName: "GetESDTTokenData",
+			Handler:    _AccountService_GetESDTTokenData_Handler,
+		},
+		{
+			MethodName: "GetESDTNftTokenData",
+			Handler:    _AccountService_GetESDTNftTokenData_Handler,
+		},
+		{
+			MethodName: "GetESDTsWithRole",
+			Handler:    _AccountService_GetESDTsWithRole_Handler,
+		},
+		{
+			MethodName: "GetNFTTokenIDsRegisteredByAddress",
+			Handler:    _AccountService_GetNFTTokenIDsRegisteredByAddress_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proxy.proto",
+}
+
+// TransactionServiceClient is the client API for TransactionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type TransactionServiceClient interface {
+	SendTransaction(ctx context.Context, in *Transaction, opts ...grpc.CallOption) (*TransactionHashResponse, error)
+	SendMultipleTransactions(ctx context.Context, in *MultipleTransactionsRequest, opts ...grpc.CallOption) (*MultipleTransactionsResponse, error)
+	SimulateTransaction(ctx context.Context, in *SimulateTransactionRequest, opts ...grpc.CallOption) (*SimulationResponse, error)
+	TransactionCost(ctx context.Context, in *Transaction, opts ...grpc.CallOption) (*TransactionCostResponse, error)
+	ComputeTransactionHash(ctx context.Context, in *Transaction, opts ...grpc.CallOption) (*TransactionHashResponse, error)
+	GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*FullTransaction, error)
+	GetTransactionStatus(ctx context.Context, in *TransactionStatusRequest, opts ...grpc.CallOption) (*TransactionStatusResponse, error)
+}
+
+type transactionServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewTransactionServiceClient(cc *grpc.ClientConn) TransactionServiceClient {
+	return &transactionServiceClient{cc}
+}
+
+func (c *transactionServiceClient) SendTransaction(ctx context.Context, in *Transaction, opts ...grpc.CallOption) (*TransactionHashResponse, error) {
+	out := new(TransactionHashResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.TransactionService/SendTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) SendMultipleTransactions(ctx context.Context, in *MultipleTransactionsRequest, opts ...grpc.CallOption) (*MultipleTransactionsResponse, error) {
+	out := new(MultipleTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.TransactionService/SendMultipleTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) SimulateTransaction(ctx context.Context, in *SimulateTransactionRequest, opts ...grpc.CallOption) (*SimulationResponse, error) {
+	out := new(SimulationResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.TransactionService/SimulateTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) TransactionCost(ctx context.Context, in *Transaction, opts ...grpc.CallOption) (*TransactionCostResponse, error) {
+	out := new(TransactionCostResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.TransactionService/TransactionCost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ComputeTransactionHash(ctx context.Context, in *Transaction, opts ...grpc.CallOption) (*TransactionHashResponse, error) {
+	out := new(TransactionHashResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.TransactionService/ComputeTransactionHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*FullTransaction, error) {
+	out := new(FullTransaction)
+	err := c.cc.Invoke(ctx, "/grpcapi.TransactionService/GetTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) GetTransactionStatus(ctx context.Context, in *TransactionStatusRequest, opts ...grpc.CallOption) (*TransactionStatusResponse, error) {
+	out := new(TransactionStatusResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.TransactionService/GetTransactionStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TransactionServiceServer is the server API for TransactionService service.
+type TransactionServiceServer interface {
+	SendTransaction(context.Context, *Transaction) (*TransactionHashResponse, error)
+	SendMultipleTransactions(context.Context, *MultipleTransactionsRequest) (*MultipleTransactionsResponse, error)
+	SimulateTransaction(context.Context, *SimulateTransactionRequest) (*SimulationResponse, error)
+	TransactionCost(context.Context, *Transaction) (*TransactionCostResponse, error)
+	ComputeTransactionHash(context.Context, *Transaction) (*TransactionHashResponse, error)
+	GetTransaction(context.Context, *GetTransactionRequest) (*FullTransaction, error)
+	GetTransactionStatus(context.Context, *TransactionStatusRequest) (*TransactionStatusResponse, error)
+}
+
+// UnimplementedTransactionServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedTransactionServiceServer struct {
+}
+
+func (*UnimplementedTransactionServiceServer) SendTransaction(ctx context.Context, req *Transaction) (*TransactionHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendTransaction not implemented")
+}
+func (*UnimplementedTransactionServiceServer) SendMultipleTransactions(ctx context.Context, req *MultipleTransactionsRequest) (*MultipleTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendMultipleTransactions not implemented")
+}
+func (*UnimplementedTransactionServiceServer) SimulateTransaction(ctx context.Context, req *SimulateTransactionRequest) (*SimulationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateTransaction not implemented")
+}
+func (*UnimplementedTransactionServiceServer) TransactionCost(ctx context.Context, req *Transaction) (*TransactionCostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransactionCost not implemented")
+}
+func (*UnimplementedTransactionServiceServer) ComputeTransactionHash(ctx context.Context, req *Transaction) (*TransactionHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ComputeTransactionHash not implemented")
+}
+func (*UnimplementedTransactionServiceServer) GetTransaction(ctx context.Context, req *GetTransactionRequest) (*FullTransaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransaction not implemented")
+}
+func (*UnimplementedTransactionServiceServer) GetTransactionStatus(ctx context.Context, req *TransactionStatusRequest) (*TransactionStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionStatus not implemented")
+}
+
+func RegisterTransactionServiceServer(s *grpc.Server, srv TransactionServiceServer) {
+	s.RegisterService(&_TransactionService_serviceDesc, srv)
+}
+
+func _TransactionService_SendTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Transaction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).SendTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.TransactionService/SendTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).SendTransaction(ctx, req.(*Transaction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_SendMultipleTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultipleTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).SendMultipleTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.TransactionService/SendMultipleTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).SendMultipleTransactions(ctx, req.(*MultipleTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_SimulateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).SimulateTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.TransactionService/SimulateTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).SimulateTransaction(ctx, req.(*SimulateTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_TransactionCost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Transaction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).TransactionCost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.TransactionService/TransactionCost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).TransactionCost(ctx, req.(*Transaction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_ComputeTransactionHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Transaction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ComputeTransactionHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.TransactionService/ComputeTransactionHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ComputeTransactionHash(ctx, req.(*Transaction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).GetTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.TransactionService/GetTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).GetTransaction(ctx, req.(*GetTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_GetTransactionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactionStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).GetTransactionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.TransactionService/GetTransactionStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).GetTransactionStatus(ctx, req.(*TransactionStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _TransactionService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "grpcapi.TransactionService",
+	HandlerType: (*TransactionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SendTransaction",
+			Handler:    _TransactionService_SendTransaction_Handler,
+		},
+		{
+			MethodName: "SendMultipleTransactions",
+			Handler:    _TransactionService_SendMultipleTransactions_Handler,
+		},
+		{
+			MethodName: "SimulateTransaction",
+			Handler:    _TransactionService_SimulateTransaction_Handler,
+		},
+		{
+			MethodName: "TransactionCost",
+			Handler:    _TransactionService_TransactionCost_Handler,
+		},
+		{
+			MethodName: "ComputeTransactionHash",
+			Handler:    _TransactionService_ComputeTransactionHash_Handler,
+		},
+		{
+			MethodName: "GetTransaction",
+			Handler:    _TransactionService_GetTransaction_Handler,
+		},
+		{
+			MethodName: "GetTransactionStatus",
+			Handler:    _TransactionService_GetTransactionStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proxy.proto",
+}
+
+// BlockServiceClient is the client API for BlockService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BlockServiceClient interface {
+	GetBlockByNonce(ctx context.Context, in *BlockByNonceRequest, opts ...grpc.CallOption) (*Block, error)
+	GetBlockByHash(ctx context.Context, in *BlockByHashRequest, opts ...grpc.CallOption) (*Block, error)
+	GetAtlasBlock(ctx context.Context, in *AtlasBlockRequest, opts ...grpc.CallOption) (*AtlasBlock, error)
+	GetHyperBlockByNonce(ctx context.Context, in *HyperBlockByNonceRequest, opts ...grpc.CallOption) (*Hyperblock, error)
+	GetHyperBlockByHash(ctx context.Context, in *HyperBlockByHashRequest, opts ...grpc.CallOption) (*Hyperblock, error)
+	// StreamHyperBlocks sends the hyperblocks starting with the given nonce, followed by each new fully synchronized
+	// hyperblock, until the client cancels the stream
+	StreamHyperBlocks(ctx context.Context, in *StreamHyperBlocksRequest, opts ...grpc.CallOption) (BlockService_StreamHyperBlocksClient, error)
+}
+
+type blockServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBlockServiceClient(cc *grpc.ClientConn) BlockServiceClient {
+	return &blockServiceClient{cc}
+}
+
+func (c *blockServiceClient) GetBlockByNonce(ctx context.Context, in *BlockByNonceRequest, opts ...grpc.CallOption) (*Block, error) {
+	out := new(Block)
+	err := c.cc.Invoke(ctx, "/grpcapi.BlockService/GetBlockByNonce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockServiceClient) GetBlockByHash(ctx context.Context, in *BlockByHashRequest, opts ...grpc.CallOption) (*Block, error) {
+	out := new(Block)
+	err := c.cc.Invoke(ctx, "/grpcapi.BlockService/GetBlockByHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockServiceClient) GetAtlasBlock(ctx context.Context, in *AtlasBlockRequest, opts ...grpc.CallOption) (*AtlasBlock, error) {
+	out := new(AtlasBlock)
+	err := c.cc.Invoke(ctx, "/grpcapi.BlockService/GetAtlasBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockServiceClient) GetHyperBlockByNonce(ctx context.Context, in *HyperBlockByNonceRequest, opts ...grpc.CallOption) (*Hyperblock, error) {
+	out := new(Hyperblock)
+	err := c.cc.Invoke(ctx, "/grpcapi.BlockService/GetHyperBlockByNonce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockServiceClient) GetHyperBlockByHash(ctx context.Context, in *HyperBlockByHashRequest, opts ...grpc.CallOption) (*Hyperblock, error) {
+	out := new(Hyperblock)
+	err := c.cc.Invoke(ctx, "/grpcapi.BlockService/GetHyperBlockByHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockServiceClient) StreamHyperBlocks(ctx context.Context, in *StreamHyperBlocksRequest, opts ...grpc.CallOption) (BlockService_StreamHyperBlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BlockService_serviceDesc.Streams[0], "/grpcapi.BlockService/StreamHyperBlocks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blockServiceStreamHyperBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BlockService_StreamHyperBlocksClient interface {
+	Recv() (*Hyperblock, error)
+	grpc.ClientStream
+}
+
+type blockServiceStreamHyperBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *blockServiceStreamHyperBlocksClient) Recv() (*Hyperblock, error) {
+	m := new(Hyperblock)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// BlockServiceServer is the server API for BlockService service.
+type BlockServiceServer interface {
+	GetBlockByNonce(context.Context, *BlockByNonceRequest) (*Block, error)
+	GetBlockByHash(context.Context, *BlockByHashRequest) (*Block, error)
+	GetAtlasBlock(context.Context, *AtlasBlockRequest) (*AtlasBlock, error)
+	GetHyperBlockByNonce(context.Context, *HyperBlockByNonceRequest) (*Hyperblock, error)
+	GetHyperBlockByHash(context.Context, *HyperBlockByHashRequest) (*Hyperblock, error)
+	// StreamHyperBlocks sends the hyperblocks starting with the given nonce, followed by each new fully synchronized
+	// hyperblock, until the client cancels the stream
+	StreamHyperBlocks(*StreamHyperBlocksRequest, BlockService_StreamHyperBlocksServer) error
+}
+
+// UnimplementedBlockServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedBlockServiceServer struct {
+}
+
+func (*UnimplementedBlockServiceServer) GetBlockByNonce(ctx context.Context, req *BlockByNonceRequest) (*Block, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockByNonce not implemented")
+}
+func (*UnimplementedBlockServiceServer) GetBlockByHash(ctx context.Context, req *BlockByHashRequest) (*Block, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockByHash not implemented")
+}
+func (*UnimplementedBlockServiceServer) GetAtlasBlock(ctx context.Context, req *AtlasBlockRequest) (*AtlasBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAtlasBlock not implemented")
+}
+func (*UnimplementedBlockServiceServer) GetHyperBlockByNonce(ctx context.Context, req *HyperBlockByNonceRequest) (*Hyperblock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHyperBlockByNonce not implemented")
+}
+func (*UnimplementedBlockServiceServer) GetHyperBlockByHash(ctx context.Context, req *HyperBlockByHashRequest) (*Hyperblock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHyperBlockByHash not implemented")
+}
+func (*UnimplementedBlockServiceServer) StreamHyperBlocks(req *StreamHyperBlocksRequest, srv BlockService_StreamHyperBlocksServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamHyperBlocks not implemented")
+}
+
+func RegisterBlockServiceServer(s *grpc.Server, srv BlockServiceServer) {
+	s.RegisterService(&_BlockService_serviceDesc, srv)
+}
+
+func _BlockService_GetBlockByNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockByNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockServiceServer).GetBlockByNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.BlockService/GetBlockByNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockServiceServer).GetBlockByNonce(ctx, req.(*BlockByNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlockService_GetBlockByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockByHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockServiceServer).GetBlockByHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.BlockService/GetBlockByHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockServiceServer).GetBlockByHash(ctx, req.(*BlockByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlockService_GetAtlasBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AtlasBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockServiceServer).GetAtlasBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.BlockService/GetAtlasBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockServiceServer).GetAtlasBlock(ctx, req.(*AtlasBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlockService_GetHyperBlockByNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HyperBlockByNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockServiceServer).GetHyperBlockByNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.BlockService/GetHyperBlockByNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockServiceServer).GetHyperBlockByNonce(ctx, req.(*HyperBlockByNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlockService_GetHyperBlockByHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HyperBlockByHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockServiceServer).GetHyperBlockByHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.BlockService/GetHyperBlockByHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockServiceServer).GetHyperBlockByHash(ctx, req.(*HyperBlockByHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlockService_StreamHyperBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamHyperBlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlockServiceServer).StreamHyperBlocks(m, &blockServiceStreamHyperBlocksServer{stream})
+}
+
+type BlockService_StreamHyperBlocksServer interface {
+	Send(*Hyperblock) error
+	grpc.ServerStream
+}
+
+type blockServiceStreamHyperBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *blockServiceStreamHyperBlocksServer) Send(m *Hyperblock) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _BlockService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "grpcapi.BlockService",
+	HandlerType: (*BlockServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBlockByNonce",
+			Handler:    _BlockService_GetBlockByNonce_Handler,
+		},
+		{
+			MethodName: "GetBlockByHash",
+			Handler:    _BlockService_GetBlockByHash_Handler,
+		},
+		{
+			MethodName: "GetAtlasBlock",
+			Handler:    _BlockService_GetAtlasBlock_Handler,
+		},
+		{
+			MethodName: "GetHyperBlockByNonce",
+			Handler:    _BlockService_GetHyperBlockByNonce_Handler,
+		},
+		{
+			MethodName: "GetHyperBlockByHash",
+			Handler:    _BlockService_GetHyperBlockByHash_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamHyperBlocks",
+			Handler:       _BlockService_StreamHyperBlocks_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proxy.proto",
+}
+
+// NodeStatusServiceClient is the client API for NodeStatusService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NodeStatusServiceClient interface {
+	GetNetworkConfig(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NetworkConfig, error)
+	GetNetworkStatus(ctx context.Context, in *ShardRequest, opts ...grpc.CallOption) (*NetworkStatus, error)
+	GetEconomicsData(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*EconomicsData, error)
+	GetEnableEpochs(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*EnableEpochsResponse, error)
+	GetLatestFullySynchronizedHyperblockNonce(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NonceResponse, error)
+	GetAllIssuedESDTs(ctx context.Context, in *TokenTypeRequest, opts ...grpc.CallOption) (*TokenIdentifiersResponse, error)
+	GetDirectStakedInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*DirectStakedInfoResponse, error)
+	GetDelegatedInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*DelegatedInfoResponse, error)
+}
+
+type nodeStatusServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNodeStatusServiceClient(cc *grpc.ClientConn) NodeStatusServiceClient {
+	return &nodeStatusServiceClient{cc}
+}
+
+func (c *nodeStatusServiceClient) GetNetworkConfig(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NetworkConfig, error) {
+	out := new(NetworkConfig)
+	err := c.cc.Invoke(ctx, "/grpcapi.NodeStatusService/GetNetworkConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeStatusServiceClient) GetNetworkStatus(ctx context.Context, in *ShardRequest, opts ...grpc.CallOption) (*NetworkStatus, error) {
+	out := new(NetworkStatus)
+	err := c.cc.Invoke(ctx, "/grpcapi.NodeStatusService/GetNetworkStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeStatusServiceClient) GetEconomicsData(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*EconomicsData, error) {
+	out := new(EconomicsData)
+	err := c.cc.Invoke(ctx, "/grpcapi.NodeStatusService/GetEconomicsData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeStatusServiceClient) GetEnableEpochs(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*EnableEpochsResponse, error) {
+	out := new(EnableEpochsResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.NodeStatusService/GetEnableEpochs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeStatusServiceClient) GetLatestFullySynchronizedHyperblockNonce(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*NonceResponse, error) {
+	out := new(NonceResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.NodeStatusService/GetLatestFullySynchronizedHyperblockNonce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeStatusServiceClient) GetAllIssuedESDTs(ctx context.Context, in *TokenTypeRequest, opts ...grpc.CallOption) (*TokenIdentifiersResponse, error) {
+	out := new(TokenIdentifiersResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.NodeStatusService/GetAllIssuedESDTs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeStatusServiceClient) GetDirectStakedInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*DirectStakedInfoResponse, error) {
+	out := new(DirectStakedInfoResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.NodeStatusService/GetDirectStakedInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeStatusServiceClient) GetDelegatedInfo(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*DelegatedInfoResponse, error) {
+	out := new(DelegatedInfoResponse)
+	err := c.cc.Invoke(ctx, "/grpcapi.NodeStatusService/GetDelegatedInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NodeStatusServiceServer is the server API for NodeStatusService service.
+type NodeStatusServiceServer interface {
+	GetNetworkConfig(context.Context, *Empty) (*NetworkConfig, error)
+	GetNetworkStatus(context.Context, *ShardRequest) (*NetworkStatus, error)
+	GetEconomicsData(context.Context, *Empty) (*EconomicsData, error)
+	GetEnableEpochs(context.Context, *Empty) (*EnableEpochsResponse, error)
+	GetLatestFullySynchronizedHyperblockNonce(context.Context, *Empty) (*NonceResponse, error)
+	GetAllIssuedESDTs(context.Context, *TokenTypeRequest) (*TokenIdentifiersResponse, error)
+	GetDirectStakedInfo(context.Context, *Empty) (*DirectStakedInfoResponse, error)
+	GetDelegatedInfo(context.Context, *Empty) (*DelegatedInfoResponse, error)
+}
+
+// UnimplementedNodeStatusServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedNodeStatusServiceServer struct {
+}
+
+func (*UnimplementedNodeStatusServiceServer) GetNetworkConfig(ctx context.Context, req *Empty) (*NetworkConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetworkConfig not implemented")
+}
+func (*UnimplementedNodeStatusServiceServer) GetNetworkStatus(ctx context.Context, req *ShardRequest) (*NetworkStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNetworkStatus not implemented")
+}
+func (*UnimplementedNodeStatusServiceServer) GetEconomicsData(ctx context.Context, req *Empty) (*EconomicsData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEconomicsData not implemented")
+}
+func (*UnimplementedNodeStatusServiceServer) GetEnableEpochs(ctx context.Context, req *Empty) (*EnableEpochsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnableEpochs not implemented")
+}
+func (*UnimplementedNodeStatusServiceServer) GetLatestFullySynchronizedHyperblockNonce(ctx context.Context, req *Empty) (*NonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLatestFullySynchronizedHyperblockNonce not implemented")
+}
+func (*UnimplementedNodeStatusServiceServer) GetAllIssuedESDTs(ctx context.Context, req *TokenTypeRequest) (*TokenIdentifiersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllIssuedESDTs not implemented")
+}
+func (*UnimplementedNodeStatusServiceServer) GetDirectStakedInfo(ctx context.Context, req *Empty) (*DirectStakedInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDirectStakedInfo not implemented")
+}
+func (*UnimplementedNodeStatusServiceServer) GetDelegatedInfo(ctx context.Context, req *Empty) (*DelegatedInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDelegatedInfo not implemented")
+}
+
+func RegisterNodeStatusServiceServer(s *grpc.Server, srv NodeStatusServiceServer) {
+	s.RegisterService(&_NodeStatusService_serviceDesc, srv)
+}
+
+func _NodeStatusService_GetNetworkConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeStatusServiceServer).GetNetworkConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.NodeStatusService/GetNetworkConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeStatusServiceServer).GetNetworkConfig(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeStatusService_GetNetworkStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeStatusServiceServer).GetNetworkStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.NodeStatusService/GetNetworkStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeStatusServiceServer).GetNetworkStatus(ctx, req.(*ShardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeStatusService_GetEconomicsData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeStatusServiceServer).GetEconomicsData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.NodeStatusService/GetEconomicsData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeStatusServiceServer).GetEconomicsData(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeStatusService_GetEnableEpochs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeStatusServiceServer).GetEnableEpochs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.NodeStatusService/GetEnableEpochs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeStatusServiceServer).GetEnableEpochs(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeStatusService_GetLatestFullySynchronizedHyperblockNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeStatusServiceServer).GetLatestFullySynchronizedHyperblockNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.NodeStatusService/GetLatestFullySynchronizedHyperblockNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeStatusServiceServer).GetLatestFullySynchronizedHyperblockNonce(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeStatusService_GetAllIssuedESDTs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeStatusServiceServer).GetAllIssuedESDTs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.NodeStatusService/GetAllIssuedESDTs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeStatusServiceServer).GetAllIssuedESDTs(ctx, req.(*TokenTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeStatusService_GetDirectStakedInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeStatusServiceServer).GetDirectStakedInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.NodeStatusService/GetDirectStakedInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeStatusServiceServer).GetDirectStakedInfo(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodeStatusService_GetDelegatedInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeStatusServiceServer).GetDelegatedInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.NodeStatusService/GetDelegatedInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeStatusServiceServer).GetDelegatedInfo(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _NodeStatusService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "grpcapi.NodeStatusService",
+	HandlerType: (*NodeStatusServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetNetworkConfig",
+			Handler:    _NodeStatusService_GetNetworkConfig_Handler,
+		},
+		{
+			MethodName: "GetNetworkStatus",
+			Handler:    _NodeStatusService_GetNetworkStatus_Handler,
+		},
+		{
+			MethodName: "GetEconomicsData",
+			Handler:    _NodeStatusService_GetEconomicsData_Handler,
+		},
+		{
+			MethodName: "GetEnableEpochs",
+			Handler:    _NodeStatusService_GetEnableEpochs_Handler,
+		},
+		{
+			MethodName: "GetLatestFullySynchronizedHyperblockNonce",
+			Handler:    _NodeStatusService_GetLatestFullySynchronizedHyperblockNonce_Handler,
+		},
+		{
+			MethodName: "GetAllIssuedESDTs",
+			Handler:    _NodeStatusService_GetAllIssuedESDTs_Handler,
+		},
+		{
+			MethodName: "GetDirectStakedInfo",
+			Handler:    _NodeStatusService_GetDirectStakedInfo_Handler,
+		},
+		{
+			MethodName: "GetDelegatedInfo",
+			Handler:    _NodeStatusService_GetDelegatedInfo_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proxy.proto",
+}
+
+// SCQueryServiceClient is the client API for SCQueryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SCQueryServiceClient interface {
+	ExecuteQuery(ctx context.Context, in *SCQuery, opts ...grpc.CallOption) (*VMOutput, error)
+}
+
+type sCQueryServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSCQueryServiceClient(cc *grpc.ClientConn) SCQueryServiceClient {
+	return &sCQueryServiceClient{cc}
+}
+
+func (c *sCQueryServiceClient) ExecuteQuery(ctx context.Context, in *SCQuery, opts ...grpc.CallOption) (*VMOutput, error) {
+	out := new(VMOutput)
+	err := c.cc.Invoke(ctx, "/grpcapi.SCQueryService/ExecuteQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SCQueryServiceServer is the server API for SCQueryService service.
+type SCQueryServiceServer interface {
+	ExecuteQuery(context.Context, *SCQuery) (*VMOutput, error)
+}
+
+// UnimplementedSCQueryServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedSCQueryServiceServer struct {
+}
+
+func (*UnimplementedSCQueryServiceServer) ExecuteQuery(ctx context.Context, req *SCQuery) (*VMOutput, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteQuery not implemented")
+}
+
+func RegisterSCQueryServiceServer(s *grpc.Server, srv SCQueryServiceServer) {
+	s.RegisterService(&_SCQueryService_serviceDesc, srv)
+}
+
+func _SCQueryService_ExecuteQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SCQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SCQueryServiceServer).ExecuteQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpcapi.SCQueryService/ExecuteQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SCQueryServiceServer).ExecuteQuery(ctx, req.(*SCQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _SCQueryService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "grpcapi.SCQueryService",
+	HandlerType: (*SCQueryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExecuteQuery",
+			Handler:    _SCQueryService_ExecuteQuery_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proxy.proto",
+}
+
+func (m *Empty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Empty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Empty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *AddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ESDTTokenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ESDTTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ESDTTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenIdentifier) > 0 {
+		i -= len(m.TokenIdentifier)
+		copy(dAtA[i:], m.TokenIdentifier)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.TokenIdentifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ESDTNftTokenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ESDTNftTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ESDTNftTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TokenIdentifier) > 0 {
+		i -= len(m.TokenIdentifier)
+		copy(dAtA[i:], m.TokenIdentifier)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.TokenIdentifier)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ESDTsWithRoleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ESDTsWithRoleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ESDTsWithRoleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ShardRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShardRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Shard != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.Shard))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenTypeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenTypeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenType) > 0 {
+		i -= len(m.TokenType)
+		copy(dAtA[i:], m.TokenType)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.TokenType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Account) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Account) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Account) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.DeveloperReward) > 0 {
+		i -= len(m.DeveloperReward)
+		copy(dAtA[i:], m.DeveloperReward)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.DeveloperReward)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.CodeMetadata) > 0 {
+		i -= len(m.CodeMetadata)
+		copy(dAtA[i:], m.CodeMetadata)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.CodeMetadata)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.RootHash) > 0 {
+		i -= len(m.RootHash)
+		copy(dAtA[i:], m.RootHash)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.RootHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Username) > 0 {
+		i -= len(m.Username)
+		copy(dAtA[i:], m.Username)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Username)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Balance) > 0 {
+		i -= len(m.Balance)
+		copy(dAtA[i:], m.Balance)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Balance)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ShardIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShardIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShardIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ShardID != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.ShardID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyValuePairsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyValuePairsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyValuePairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pairs) > 0 {
+		keysForPairs := make([]string, 0, len(m.Pairs))
+		for k := range m.Pairs {
+			keysForPairs = append(keysForPairs, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForPairs)
+		for iNdEx := len(keysForPairs) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Pairs[string(keysForPairs[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintProxy(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForPairs[iNdEx])
+			copy(dAtA[i:], keysForPairs[iNdEx])
+			i = encodeVarintProxy(dAtA, i, uint64(len(keysForPairs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintProxy(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ESDTToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ESDTToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ESDTToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attributes) > 0 {
+		i -= len(m.Attributes)
+		copy(dAtA[i:], m.Attributes)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Attributes)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.URIs) > 0 {
+		for iNdEx := len(m.URIs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.URIs[iNdEx])
+			copy(dAtA[i:], m.URIs[iNdEx])
+			i = encodeVarintProxy(dAtA, i, uint64(len(m.URIs[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Royalties) > 0 {
+		i -= len(m.Royalties)
+		copy(dAtA[i:], m.Royalties)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Royalties)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Properties) > 0 {
+		i -= len(m.Properties)
+		copy(dAtA[i:], m.Properties)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Properties)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Balance) > 0 {
+		i -= len(m.Balance)
+		copy(dAtA[i:], m.Balance)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Balance)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenIdentifier) > 0 {
+		i -= len(m.TokenIdentifier)
+		copy(dAtA[i:], m.TokenIdentifier)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.TokenIdentifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ESDTTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ESDTTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ESDTTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProxy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenIdentifiersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenIdentifiersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenIdentifiersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenIdentifiers) > 0 {
+		for iNdEx := len(m.TokenIdentifiers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TokenIdentifiers[iNdEx])
+			copy(dAtA[i:], m.TokenIdentifiers[iNdEx])
+			i = encodeVarintProxy(dAtA, i, uint64(len(m.TokenIdentifiers[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DatabaseTransaction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DatabaseTransaction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DatabaseTransaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.GasPrice != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.GasPrice))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.SenderShard != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.SenderShard))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ReceiverShard != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.ReceiverShard))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Round != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MiniBlockHash) > 0 {
+		i -= len(m.MiniBlockHash)
+		copy(dAtA[i:], m.MiniBlockHash)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.MiniBlockHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Fee) > 0 {
+		i -= len(m.Fee)
+		copy(dAtA[i:], m.Fee)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Fee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DatabaseTransactionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DatabaseTransactionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DatabaseTransactionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Transactions) > 0 {
+		for iNdEx := len(m.Transactions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProxy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Transaction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Transaction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Transaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Options != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.Options))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Version != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.GasPrice != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.GasPrice))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ReceiverUsername) > 0 {
+		i -= len(m.ReceiverUsername)
+		copy(dAtA[i:], m.ReceiverUsername)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.ReceiverUsername)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SenderUsername) > 0 {
+		i -= len(m.SenderUsername)
+		copy(dAtA[i:], m.SenderUsername)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.SenderUsername)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultipleTransactionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultipleTransactionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultipleTransactionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Transactions) > 0 {
+		for iNdEx := len(m.Transactions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProxy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultipleTransactionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultipleTransactionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultipleTransactionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxsHashes) > 0 {
+		keysForTxsHashes := make([]uint32, 0, len(m.TxsHashes))
+		for k := range m.TxsHashes {
+			keysForTxsHashes = append(keysForTxsHashes, uint32(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Uint32s(keysForTxsHashes)
+		for iNdEx := len(keysForTxsHashes) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.TxsHashes[uint32(keysForTxsHashes[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintProxy(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarintProxy(dAtA, i, uint64(keysForTxsHashes[iNdEx]))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintProxy(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.NumOfSentTxs != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.NumOfSentTxs))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulateTransactionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulateTransactionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulateTransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SkipSignatureCheck {
+		i--
+		if m.SkipSignatureCheck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Transaction != nil {
+		{
+			size, err := m.Transaction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProxy(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SmartContractResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SmartContractResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SmartContractResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OriginalSender) > 0 {
+		i -= len(m.OriginalSender)
+		copy(dAtA[i:], m.OriginalSender)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.OriginalSender)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.ReturnMessage) > 0 {
+		i -= len(m.ReturnMessage)
+		copy(dAtA[i:], m.ReturnMessage)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.ReturnMessage)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.CodeMetadata) > 0 {
+		i -= len(m.CodeMetadata)
+		copy(dAtA[i:], m.CodeMetadata)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.CodeMetadata)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.CallType != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.CallType))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.GasPrice != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.GasPrice))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.OriginalTxHash) > 0 {
+		i -= len(m.OriginalTxHash)
+		copy(dAtA[i:], m.OriginalTxHash)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.OriginalTxHash)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.PrevTxHash) > 0 {
+		i -= len(m.PrevTxHash)
+		copy(dAtA[i:], m.PrevTxHash)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.PrevTxHash)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.RelayedValue) > 0 {
+		i -= len(m.RelayedValue)
+		copy(dAtA[i:], m.RelayedValue)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.RelayedValue)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.RelayerAddress) > 0 {
+		i -= len(m.RelayerAddress)
+		copy(dAtA[i:], m.RelayerAddress)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.RelayerAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Receipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Receipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Receipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulationResults) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulationResults) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulationResults) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Receipts) > 0 {
+		keysForReceipts := make([]string, 0, len(m.Receipts))
+		for k := range m.Receipts {
+			keysForReceipts = append(keysForReceipts, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForReceipts)
+		for iNdEx := len(keysForReceipts) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Receipts[string(keysForReceipts[iNdEx])]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintProxy(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(keysForReceipts[iNdEx])
+			copy(dAtA[i:], keysForReceipts[iNdEx])
+			i = encodeVarintProxy(dAtA, i, uint64(len(keysForReceipts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintProxy(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ScResults) > 0 {
+		keysForScResults := make([]string, 0, len(m.ScResults))
+		for k := range m.ScResults {
+			keysForScResults = append(keysForScResults, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForScResults)
+		for iNdEx := len(keysForScResults) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.ScResults[string(keysForScResults[iNdEx])]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintProxy(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(keysForScResults[iNdEx])
+			copy(dAtA[i:], keysForScResults[iNdEx])
+			i = encodeVarintProxy(dAtA, i, uint64(len(keysForScResults[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintProxy(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FailReason) > 0 {
+		i -= len(m.FailReason)
+		copy(dAtA[i:], m.FailReason)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.FailReason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SimulationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SimulationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SimulationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsCrossShard {
+		i--
+		if m.IsCrossShard {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ReceiverShard != nil {
+		{
+			size, err := m.ReceiverShard.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProxy(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SenderShard != nil {
+		{
+			size, err := m.SenderShard.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProxy(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransactionCostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransactionCostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransactionCostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReturnMessage) > 0 {
+		i -= len(m.ReturnMessage)
+		copy(dAtA[i:], m.ReturnMessage)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.ReturnMessage)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GasUnits != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.GasUnits))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransactionHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransactionHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransactionHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTransactionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTransactionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTransactionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WithResults {
+		i--
+		if m.WithResults {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransactionStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransactionStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransactionStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransactionStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransactionStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransactionStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FullTransaction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FullTransaction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FullTransaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SmartContractResults) > 0 {
+		for iNdEx := len(m.SmartContractResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SmartContractResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProxy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.Receipt != nil {
+		{
+			size, err := m.Receipt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProxy(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.HyperblockHash) > 0 {
+		i -= len(m.HyperblockHash)
+		copy(dAtA[i:], m.HyperblockHash)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.HyperblockHash)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.HyperblockNonce != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.HyperblockNonce))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
+	}
+	if len(m.MiniBlockHash) > 0 {
+		i -= len(m.MiniBlockHash)
+		copy(dAtA[i:], m.MiniBlockHash)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.MiniBlockHash)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
+	if len(m.MiniBlockType) > 0 {
+		i -= len(m.MiniBlockType)
+		copy(dAtA[i:], m.MiniBlockType)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.MiniBlockType)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
+	}
+	if len(m.NotarizedAtDestinationInMetaHash) > 0 {
+		i -= len(m.NotarizedAtDestinationInMetaHash)
+		copy(dAtA[i:], m.NotarizedAtDestinationInMetaHash)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.NotarizedAtDestinationInMetaHash)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
+	if m.NotarizedAtDestinationInMetaNonce != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.NotarizedAtDestinationInMetaNonce))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if len(m.NotarizedAtSourceInMetaHash) > 0 {
+		i -= len(m.NotarizedAtSourceInMetaHash)
+		copy(dAtA[i:], m.NotarizedAtSourceInMetaHash)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.NotarizedAtSourceInMetaHash)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if m.NotarizedAtSourceInMetaNonce != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.NotarizedAtSourceInMetaNonce))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.BlockNonce != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.BlockNonce))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.DestinationShard != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.DestinationShard))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.SourceShard != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.SourceShard))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.OriginalSender) > 0 {
+		i -= len(m.OriginalSender)
+		copy(dAtA[i:], m.OriginalSender)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.OriginalSender)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.ReturnMessage) > 0 {
+		i -= len(m.ReturnMessage)
+		copy(dAtA[i:], m.ReturnMessage)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.ReturnMessage)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.OriginalTransactionHash) > 0 {
+		i -= len(m.OriginalTransactionHash)
+		copy(dAtA[i:], m.OriginalTransactionHash)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.OriginalTransactionHash)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.PreviousTransactionHash) > 0 {
+		i -= len(m.PreviousTransactionHash)
+		copy(dAtA[i:], m.PreviousTransactionHash)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.PreviousTransactionHash)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.CodeMetadata) > 0 {
+		i -= len(m.CodeMetadata)
+		copy(dAtA[i:], m.CodeMetadata)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.CodeMetadata)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.GasPrice != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.GasPrice))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.ReceiverUsername) > 0 {
+		i -= len(m.ReceiverUsername)
+		copy(dAtA[i:], m.ReceiverUsername)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.ReceiverUsername)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.SenderUsername) > 0 {
+		i -= len(m.SenderUsername)
+		copy(dAtA[i:], m.SenderUsername)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.SenderUsername)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Round != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockByNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockByNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockByNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WithTxs {
+		i--
+		if m.WithTxs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Shard != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.Shard))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockByHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockByHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockByHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WithTxs {
+		i--
+		if m.WithTxs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Shard != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.Shard))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AtlasBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AtlasBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AtlasBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Shard != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.Shard))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HyperBlockByNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HyperBlockByNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HyperBlockByNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HyperBlockByHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HyperBlockByHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HyperBlockByHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamHyperBlocksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamHyperBlocksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamHyperBlocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FromLatest {
+		i--
+		if m.FromLatest {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartNonce != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.StartNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NotarizedBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NotarizedBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NotarizedBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Shard != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.Shard))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Round != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MiniBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MiniBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MiniBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Transactions) > 0 {
+		for iNdEx := len(m.Transactions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProxy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.DestinationShard != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.DestinationShard))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SourceShard != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.SourceShard))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochStartInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochStartInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochStartInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PrevEpochStartHash) > 0 {
+		i -= len(m.PrevEpochStartHash)
+		copy(dAtA[i:], m.PrevEpochStartHash)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.PrevEpochStartHash)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.PrevEpochStartRound != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.PrevEpochStartRound))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.NodePrice) > 0 {
+		i -= len(m.NodePrice)
+		copy(dAtA[i:], m.NodePrice)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.NodePrice)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RewardsForProtocolSustainability) > 0 {
+		i -= len(m.RewardsForProtocolSustainability)
+		copy(dAtA[i:], m.RewardsForProtocolSustainability)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.RewardsForProtocolSustainability)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.RewardsPerBlock) > 0 {
+		i -= len(m.RewardsPerBlock)
+		copy(dAtA[i:], m.RewardsPerBlock)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.RewardsPerBlock)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TotalNewlyMinted) > 0 {
+		i -= len(m.TotalNewlyMinted)
+		copy(dAtA[i:], m.TotalNewlyMinted)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.TotalNewlyMinted)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TotalToDistribute) > 0 {
+		i -= len(m.TotalToDistribute)
+		copy(dAtA[i:], m.TotalToDistribute)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.TotalToDistribute)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TotalSupply) > 0 {
+		i -= len(m.TotalSupply)
+		copy(dAtA[i:], m.TotalSupply)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.TotalSupply)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Block) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Block) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Block) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.EpochStartInfo != nil {
+		{
+			size, err := m.EpochStartInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProxy(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.DeveloperFeesInEpoch) > 0 {
+		i -= len(m.DeveloperFeesInEpoch)
+		copy(dAtA[i:], m.DeveloperFeesInEpoch)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.DeveloperFeesInEpoch)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.AccumulatedFeesInEpoch) > 0 {
+		i -= len(m.AccumulatedFeesInEpoch)
+		copy(dAtA[i:], m.AccumulatedFeesInEpoch)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.AccumulatedFeesInEpoch)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.DeveloperFees) > 0 {
+		i -= len(m.DeveloperFees)
+		copy(dAtA[i:], m.DeveloperFees)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.DeveloperFees)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.AccumulatedFees) > 0 {
+		i -= len(m.AccumulatedFees)
+		copy(dAtA[i:], m.AccumulatedFees)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.AccumulatedFees)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.MiniBlocks) > 0 {
+		for iNdEx := len(m.MiniBlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MiniBlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProxy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.NotarizedBlocks) > 0 {
+		for iNdEx := len(m.NotarizedBlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NotarizedBlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProxy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.NumTxs != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.NumTxs))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Shard != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.Shard))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PrevBlockHash) > 0 {
+		i -= len(m.PrevBlockHash)
+		copy(dAtA[i:], m.PrevBlockHash)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.PrevBlockHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Round != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Hyperblock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Hyperblock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Hyperblock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.EpochStartInfo != nil {
+		{
+			size, err := m.EpochStartInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProxy(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.DeveloperFeesInEpoch) > 0 {
+		i -= len(m.DeveloperFeesInEpoch)
+		copy(dAtA[i:], m.DeveloperFeesInEpoch)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.DeveloperFeesInEpoch)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.AccumulatedFeesInEpoch) > 0 {
+		i -= len(m.AccumulatedFeesInEpoch)
+		copy(dAtA[i:], m.AccumulatedFeesInEpoch)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.AccumulatedFeesInEpoch)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.DeveloperFees) > 0 {
+		i -= len(m.DeveloperFees)
+		copy(dAtA[i:], m.DeveloperFees)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.DeveloperFees)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.AccumulatedFees) > 0 {
+		i -= len(m.AccumulatedFees)
+		copy(dAtA[i:], m.AccumulatedFees)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.AccumulatedFees)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Transactions) > 0 {
+		for iNdEx := len(m.Transactions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProxy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ShardBlocks) > 0 {
+		for iNdEx := len(m.ShardBlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ShardBlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProxy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.NumTxs != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.NumTxs))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PrevBlockHash) > 0 {
+		i -= len(m.PrevBlockHash)
+		copy(dAtA[i:], m.PrevBlockHash)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.PrevBlockHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Round != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AtlasBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AtlasBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AtlasBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Transactions) > 0 {
+		for iNdEx := len(m.Transactions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transactions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProxy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NetworkConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NetworkConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NetworkConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TopUpFactor) > 0 {
+		i -= len(m.TopUpFactor)
+		copy(dAtA[i:], m.TopUpFactor)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.TopUpFactor)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.ShardConsensusGroupSize != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.ShardConsensusGroupSize))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.RoundDuration != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.RoundDuration))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.RewardsTopUpGradientPoint) > 0 {
+		i -= len(m.RewardsTopUpGradientPoint)
+		copy(dAtA[i:], m.RewardsTopUpGradientPoint)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.RewardsTopUpGradientPoint)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.NumShardsWithoutMeta != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.NumShardsWithoutMeta))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.NumNodesInShard != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.NumNodesInShard))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.NumMetachainNodes != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.NumMetachainNodes))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MinTransactionVersion != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.MinTransactionVersion))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MinGasPrice != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.MinGasPrice))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MinGasLimit != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.MinGasLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MetaConsensusGroupSize != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.MetaConsensusGroupSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.LatestTagSoftwareVersion) > 0 {
+		i -= len(m.LatestTagSoftwareVersion)
+		copy(dAtA[i:], m.LatestTagSoftwareVersion)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.LatestTagSoftwareVersion)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.GasPerDataByte != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.GasPerDataByte))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Denomination != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.Denomination))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NetworkStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NetworkStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NetworkStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CrossCheckBlockHeight) > 0 {
+		i -= len(m.CrossCheckBlockHeight)
+		copy(dAtA[i:], m.CrossCheckBlockHeight)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.CrossCheckBlockHeight)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.RoundsPerEpoch != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.RoundsPerEpoch))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.RoundsPassedInCurrentEpoch != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.RoundsPassedInCurrentEpoch))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.RoundAtEpochStart != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.RoundAtEpochStart))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.NoncesPassedInCurrentEpoch != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.NoncesPassedInCurrentEpoch))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NonceAtEpochStart != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.NonceAtEpochStart))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.HighestFinalNonce != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.HighestFinalNonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CurrentRound != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.CurrentRound))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EconomicsData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EconomicsData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EconomicsData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochForEconomicsData != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.EpochForEconomicsData))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.TotalFees) > 0 {
+		i -= len(m.TotalFees)
+		copy(dAtA[i:], m.TotalFees)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.TotalFees)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Inflation) > 0 {
+		i -= len(m.Inflation)
+		copy(dAtA[i:], m.Inflation)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Inflation)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.DevRewards) > 0 {
+		i -= len(m.DevRewards)
+		copy(dAtA[i:], m.DevRewards)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.DevRewards)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TotalTopUpValue) > 0 {
+		i -= len(m.TotalTopUpValue)
+		copy(dAtA[i:], m.TotalTopUpValue)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.TotalTopUpValue)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TotalBaseStakedValue) > 0 {
+		i -= len(m.TotalBaseStakedValue)
+		copy(dAtA[i:], m.TotalBaseStakedValue)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.TotalBaseStakedValue)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.StakedValue) > 0 {
+		i -= len(m.StakedValue)
+		copy(dAtA[i:], m.StakedValue)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.StakedValue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CirculatingSupply) > 0 {
+		i -= len(m.CirculatingSupply)
+		copy(dAtA[i:], m.CirculatingSupply)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.CirculatingSupply)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TotalSupply) > 0 {
+		i -= len(m.TotalSupply)
+		copy(dAtA[i:], m.TotalSupply)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.TotalSupply)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnableEpochsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnableEpochsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnableEpochsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EnableEpochs) > 0 {
+		keysForEnableEpochs := make([]string, 0, len(m.EnableEpochs))
+		for k := range m.EnableEpochs {
+			keysForEnableEpochs = append(keysForEnableEpochs, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForEnableEpochs)
+		for iNdEx := len(keysForEnableEpochs) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.EnableEpochs[string(keysForEnableEpochs[iNdEx])]
+			baseI := i
+			i = encodeVarintProxy(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(keysForEnableEpochs[iNdEx])
+			copy(dAtA[i:], keysForEnableEpochs[iNdEx])
+			i = encodeVarintProxy(dAtA, i, uint64(len(keysForEnableEpochs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintProxy(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StakedInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakedInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakedInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		i -= len(m.Total)
+		copy(dAtA[i:], m.Total)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Total)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TopUp) > 0 {
+		i -= len(m.TopUp)
+		copy(dAtA[i:], m.TopUp)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.TopUp)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BaseStaked) > 0 {
+		i -= len(m.BaseStaked)
+		copy(dAtA[i:], m.BaseStaked)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.BaseStaked)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DirectStakedInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DirectStakedInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DirectStakedInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.List) > 0 {
+		for iNdEx := len(m.List) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.List[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProxy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegatedValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatedValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatedValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegationScAddress) > 0 {
+		i -= len(m.DelegationScAddress)
+		copy(dAtA[i:], m.DelegationScAddress)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.DelegationScAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegatorInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		i -= len(m.Total)
+		copy(dAtA[i:], m.Total)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Total)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DelegatedTo) > 0 {
+		for iNdEx := len(m.DelegatedTo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatedTo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProxy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegatedInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatedInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatedInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.List) > 0 {
+		for iNdEx := len(m.List) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.List[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProxy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SCQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SCQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SCQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Arguments) > 0 {
+		for iNdEx := len(m.Arguments) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Arguments[iNdEx])
+			copy(dAtA[i:], m.Arguments[iNdEx])
+			i = encodeVarintProxy(dAtA, i, uint64(len(m.Arguments[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.CallValue) > 0 {
+		i -= len(m.CallValue)
+		copy(dAtA[i:], m.CallValue)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.CallValue)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CallerAddr) > 0 {
+		i -= len(m.CallerAddr)
+		copy(dAtA[i:], m.CallerAddr)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.CallerAddr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FuncName) > 0 {
+		i -= len(m.FuncName)
+		copy(dAtA[i:], m.FuncName)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.FuncName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScAddress) > 0 {
+		i -= len(m.ScAddress)
+		copy(dAtA[i:], m.ScAddress)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.ScAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StorageUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StorageUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Offset) > 0 {
+		i -= len(m.Offset)
+		copy(dAtA[i:], m.Offset)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Offset)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutputTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutputTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutputTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CallType != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.CallType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutputAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutputAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutputAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CallType != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.CallType))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.OutputTransfers) > 0 {
+		for iNdEx := len(m.OutputTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutputTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProxy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.CodeMetadata) > 0 {
+		i -= len(m.CodeMetadata)
+		copy(dAtA[i:], m.CodeMetadata)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.CodeMetadata)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.StorageUpdates) > 0 {
+		keysForStorageUpdates := make([]string, 0, len(m.StorageUpdates))
+		for k := range m.StorageUpdates {
+			keysForStorageUpdates = append(keysForStorageUpdates, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForStorageUpdates)
+		for iNdEx := len(keysForStorageUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.StorageUpdates[string(keysForStorageUpdates[iNdEx])]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintProxy(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(keysForStorageUpdates[iNdEx])
+			copy(dAtA[i:], keysForStorageUpdates[iNdEx])
+			i = encodeVarintProxy(dAtA, i, uint64(len(keysForStorageUpdates[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintProxy(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.BalanceDelta) > 0 {
+		i -= len(m.BalanceDelta)
+		copy(dAtA[i:], m.BalanceDelta)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.BalanceDelta)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Balance) > 0 {
+		i -= len(m.Balance)
+		copy(dAtA[i:], m.Balance)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Balance)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LogEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LogEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LogEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Topics) > 0 {
+		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Topics[iNdEx])
+			copy(dAtA[i:], m.Topics[iNdEx])
+			i = encodeVarintProxy(dAtA, i, uint64(len(m.Topics[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Identifier) > 0 {
+		i -= len(m.Identifier)
+		copy(dAtA[i:], m.Identifier)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.Identifier)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VMOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VMOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VMOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProxy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.TouchedAccounts) > 0 {
+		for iNdEx := len(m.TouchedAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TouchedAccounts[iNdEx])
+			copy(dAtA[i:], m.TouchedAccounts[iNdEx])
+			i = encodeVarintProxy(dAtA, i, uint64(len(m.TouchedAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.DeletedAccounts) > 0 {
+		for iNdEx := len(m.DeletedAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeletedAccounts[iNdEx])
+			copy(dAtA[i:], m.DeletedAccounts[iNdEx])
+			i = encodeVarintProxy(dAtA, i, uint64(len(m.DeletedAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.OutputAccounts) > 0 {
+		keysForOutputAccounts := make([]string, 0, len(m.OutputAccounts))
+		for k := range m.OutputAccounts {
+			keysForOutputAccounts = append(keysForOutputAccounts, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForOutputAccounts)
+		for iNdEx := len(keysForOutputAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.OutputAccounts[string(keysForOutputAccounts[iNdEx])]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintProxy(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(keysForOutputAccounts[iNdEx])
+			copy(dAtA[i:], keysForOutputAccounts[iNdEx])
+			i = encodeVarintProxy(dAtA, i, uint64(len(keysForOutputAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintProxy(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.GasRefund) > 0 {
+		i -= len(m.GasRefund)
+		copy(dAtA[i:], m.GasRefund)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.GasRefund)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.GasRemaining != 0 {
+		i = encodeVarintProxy(dAtA, i, uint64(m.GasRemaining))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ReturnMessage) > 0 {
+		i -= len(m.ReturnMessage)
+		copy(dAtA[i:], m.ReturnMessage)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.ReturnMessage)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ReturnCode) > 0 {
+		i -= len(m.ReturnCode)
+		copy(dAtA[i:], m.ReturnCode)
+		i = encodeVarintProxy(dAtA, i, uint64(len(m.ReturnCode)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ReturnData) > 0 {
+		for iNdEx := len(m.ReturnData) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReturnData[iNdEx])
+			copy(dAtA[i:], m.ReturnData[iNdEx])
+			i = encodeVarintProxy(dAtA, i, uint64(len(m.ReturnData[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProxy(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProxy(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Empty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *AddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	return n
+}
+
+func (m *ESDTTokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.TokenIdentifier)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	return n
+}
+
+func (m *ESDTNftTokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.TokenIdentifier)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovProxy(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *ESDTsWithRoleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	return n
+}
+
+func (m *ShardRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Shard != 0 {
+		n += 1 + sovProxy(uint64(m.Shard))
+	}
+	return n
+}
+
+func (m *TokenTypeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenType)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	return n
+}
+
+func (m *Account) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovProxy(uint64(m.Nonce))
+	}
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.RootHash)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.CodeMetadata)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.DeveloperReward)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	return n
+}
+
+func (m *ShardIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ShardID != 0 {
+		n += 1 + sovProxy(uint64(m.ShardID))
+	}
+	return n
+}
+
+func (m *ValueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	return n
+}
+
+func (m *KeyValuePairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pairs) > 0 {
+		for k, v := range m.Pairs {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovProxy(uint64(len(k))) + 1 + len(v) + sovProxy(uint64(len(v)))
+			n += mapEntrySize + 1 + sovProxy(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ESDTToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenIdentifier)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Properties)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovProxy(uint64(m.Nonce))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Royalties)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if len(m.URIs) > 0 {
+		for _, b := range m.URIs {
+			l = len(b)
+			n += 1 + l + sovProxy(uint64(l))
+		}
+	}
+	l = len(m.Attributes)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	return n
+}
+
+func (m *ESDTTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovProxy(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TokenIdentifiersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TokenIdentifiers) > 0 {
+		for _, s := range m.TokenIdentifiers {
+			l = len(s)
+			n += 1 + l + sovProxy(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DatabaseTransaction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Fee)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.MiniBlockHash)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovProxy(uint64(m.Nonce))
+	}
+	if m.Round != 0 {
+		n += 1 + sovProxy(uint64(m.Round))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if m.ReceiverShard != 0 {
+		n += 1 + sovProxy(uint64(m.ReceiverShard))
+	}
+	if m.SenderShard != 0 {
+		n += 1 + sovProxy(uint64(m.SenderShard))
+	}
+	if m.GasPrice != 0 {
+		n += 1 + sovProxy(uint64(m.GasPrice))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovProxy(uint64(m.GasLimit))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovProxy(uint64(m.GasUsed))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 2 + sovProxy(uint64(m.Timestamp))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 2 + l + sovProxy(uint64(l))
+	}
+	return n
+}
+
+func (m *DatabaseTransactionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Transactions) > 0 {
+		for _, e := range m.Transactions {
+			l = e.Size()
+			n += 1 + l + sovProxy(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Transaction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovProxy(uint64(m.Nonce))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.SenderUsername)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.ReceiverUsername)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if m.GasPrice != 0 {
+		n += 1 + sovProxy(uint64(m.GasPrice))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovProxy(uint64(m.GasLimit))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovProxy(uint64(m.Version))
+	}
+	if m.Options != 0 {
+		n += 1 + sovProxy(uint64(m.Options))
+	}
+	return n
+}
+
+func (m *MultipleTransactionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Transactions) > 0 {
+		for _, e := range m.Transactions {
+			l = e.Size()
+			n += 1 + l + sovProxy(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MultipleTransactionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumOfSentTxs != 0 {
+		n += 1 + sovProxy(uint64(m.NumOfSentTxs))
+	}
+	if len(m.TxsHashes) > 0 {
+		for k, v := range m.TxsHashes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovProxy(uint64(k)) + 1 + len(v) + sovProxy(uint64(len(v)))
+			n += mapEntrySize + 1 + sovProxy(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *SimulateTransactionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Transaction != nil {
+		l = m.Transaction.Size()
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if m.SkipSignatureCheck {
+		n += 2
+	}
+	return n
+}
+
+func (m *SmartContractResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovProxy(uint64(m.Nonce))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.RelayerAddress)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.RelayedValue)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.PrevTxHash)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.OriginalTxHash)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovProxy(uint64(m.GasLimit))
+	}
+	if m.GasPrice != 0 {
+		n += 1 + sovProxy(uint64(m.GasPrice))
+	}
+	if m.CallType != 0 {
+		n += 1 + sovProxy(uint64(m.CallType))
+	}
+	l = len(m.CodeMetadata)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.ReturnMessage)
+	if l > 0 {
+		n += 2 + l + sovProxy(uint64(l))
+	}
+	l = len(m.OriginalSender)
+	if l > 0 {
+		n += 2 + l + sovProxy(uint64(l))
+	}
+	return n
+}
+
+func (m *Receipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	return n
+}
+
+func (m *SimulationResults) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.FailReason)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if len(m.ScResults) > 0 {
+		for k, v := range m.ScResults {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovProxy(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovProxy(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovProxy(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Receipts) > 0 {
+		for k, v := range m.Receipts {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovProxy(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovProxy(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovProxy(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *SimulationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SenderShard != nil {
+		l = m.SenderShard.Size()
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if m.ReceiverShard != nil {
+		l = m.ReceiverShard.Size()
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if m.IsCrossShard {
+		n += 2
+	}
+	return n
+}
+
+func (m *TransactionCostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasUnits != 0 {
+		n += 1 + sovProxy(uint64(m.GasUnits))
+	}
+	l = len(m.ReturnMessage)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	return n
+}
+
+func (m *TransactionHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	return n
+}
+
+func (m *GetTransactionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if m.WithResults {
+		n += 2
+	}
+	return n
+}
+
+func (m *TransactionStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	return n
+}
+
+func (m *TransactionStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	return n
+}
+
+func (m *FullTransaction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovProxy(uint64(m.Nonce))
+	}
+	if m.Round != 0 {
+		n += 1 + sovProxy(uint64(m.Round))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovProxy(uint64(m.Epoch))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.SenderUsername)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.ReceiverUsername)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if m.GasPrice != 0 {
+		n += 1 + sovProxy(uint64(m.GasPrice))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovProxy(uint64(m.GasLimit))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.CodeMetadata)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.PreviousTransactionHash)
+	if l > 0 {
+		n += 2 + l + sovProxy(uint64(l))
+	}
+	l = len(m.OriginalTransactionHash)
+	if l > 0 {
+		n += 2 + l + sovProxy(uint64(l))
+	}
+	l = len(m.ReturnMessage)
+	if l > 0 {
+		n += 2 + l + sovProxy(uint64(l))
+	}
+	l = len(m.OriginalSender)
+	if l > 0 {
+		n += 2 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 2 + l + sovProxy(uint64(l))
+	}
+	if m.SourceShard != 0 {
+		n += 2 + sovProxy(uint64(m.SourceShard))
+	}
+	if m.DestinationShard != 0 {
+		n += 2 + sovProxy(uint64(m.DestinationShard))
+	}
+	if m.BlockNonce != 0 {
+		n += 2 + sovProxy(uint64(m.BlockNonce))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 2 + l + sovProxy(uint64(l))
+	}
+	if m.NotarizedAtSourceInMetaNonce != 0 {
+		n += 2 + sovProxy(uint64(m.NotarizedAtSourceInMetaNonce))
+	}
+	l = len(m.NotarizedAtSourceInMetaHash)
+	if l > 0 {
+		n += 2 + l + sovProxy(uint64(l))
+	}
+	if m.NotarizedAtDestinationInMetaNonce != 0 {
+		n += 2 + sovProxy(uint64(m.NotarizedAtDestinationInMetaNonce))
+	}
+	l = len(m.NotarizedAtDestinationInMetaHash)
+	if l > 0 {
+		n += 2 + l + sovProxy(uint64(l))
+	}
+	l = len(m.MiniBlockType)
+	if l > 0 {
+		n += 2 + l + sovProxy(uint64(l))
+	}
+	l = len(m.MiniBlockHash)
+	if l > 0 {
+		n += 2 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 2 + l + sovProxy(uint64(l))
+	}
+	if m.HyperblockNonce != 0 {
+		n += 2 + sovProxy(uint64(m.HyperblockNonce))
+	}
+	l = len(m.HyperblockHash)
+	if l > 0 {
+		n += 2 + l + sovProxy(uint64(l))
+	}
+	if m.Receipt != nil {
+		l = m.Receipt.Size()
+		n += 2 + l + sovProxy(uint64(l))
+	}
+	if len(m.SmartContractResults) > 0 {
+		for _, e := range m.SmartContractResults {
+			l = e.Size()
+			n += 2 + l + sovProxy(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlockByNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Shard != 0 {
+		n += 1 + sovProxy(uint64(m.Shard))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovProxy(uint64(m.Nonce))
+	}
+	if m.WithTxs {
+		n += 2
+	}
+	return n
+}
+
+func (m *BlockByHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Shard != 0 {
+		n += 1 + sovProxy(uint64(m.Shard))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if m.WithTxs {
+		n += 2
+	}
+	return n
+}
+
+func (m *AtlasBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Shard != 0 {
+		n += 1 + sovProxy(uint64(m.Shard))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovProxy(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *HyperBlockByNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovProxy(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *HyperBlockByHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	return n
+}
+
+func (m *StreamHyperBlocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartNonce != 0 {
+		n += 1 + sovProxy(uint64(m.StartNonce))
+	}
+	if m.FromLatest {
+		n += 2
+	}
+	return n
+}
+
+func (m *NotarizedBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovProxy(uint64(m.Nonce))
+	}
+	if m.Round != 0 {
+		n += 1 + sovProxy(uint64(m.Round))
+	}
+	if m.Shard != 0 {
+		n += 1 + sovProxy(uint64(m.Shard))
+	}
+	return n
+}
+
+func (m *MiniBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if m.SourceShard != 0 {
+		n += 1 + sovProxy(uint64(m.SourceShard))
+	}
+	if m.DestinationShard != 0 {
+		n += 1 + sovProxy(uint64(m.DestinationShard))
+	}
+	if len(m.Transactions) > 0 {
+		for _, e := range m.Transactions {
+			l = e.Size()
+			n += 1 + l + sovProxy(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EpochStartInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TotalSupply)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.TotalToDistribute)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.TotalNewlyMinted)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.RewardsPerBlock)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.RewardsForProtocolSustainability)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.NodePrice)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if m.PrevEpochStartRound != 0 {
+		n += 1 + sovProxy(uint64(m.PrevEpochStartRound))
+	}
+	l = len(m.PrevEpochStartHash)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	return n
+}
+
+func (m *Block) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovProxy(uint64(m.Nonce))
+	}
+	if m.Round != 0 {
+		n += 1 + sovProxy(uint64(m.Round))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.PrevBlockHash)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovProxy(uint64(m.Epoch))
+	}
+	if m.Shard != 0 {
+		n += 1 + sovProxy(uint64(m.Shard))
+	}
+	if m.NumTxs != 0 {
+		n += 1 + sovProxy(uint64(m.NumTxs))
+	}
+	if len(m.NotarizedBlocks) > 0 {
+		for _, e := range m.NotarizedBlocks {
+			l = e.Size()
+			n += 1 + l + sovProxy(uint64(l))
+		}
+	}
+	if len(m.MiniBlocks) > 0 {
+		for _, e := range m.MiniBlocks {
+			l = e.Size()
+			n += 1 + l + sovProxy(uint64(l))
+		}
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovProxy(uint64(m.Timestamp))
+	}
+	l = len(m.AccumulatedFees)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.DeveloperFees)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.AccumulatedFeesInEpoch)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.DeveloperFeesInEpoch)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if m.EpochStartInfo != nil {
+		l = m.EpochStartInfo.Size()
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 2 + l + sovProxy(uint64(l))
+	}
+	return n
+}
+
+func (m *Hyperblock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovProxy(uint64(m.Nonce))
+	}
+	if m.Round != 0 {
+		n += 1 + sovProxy(uint64(m.Round))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.PrevBlockHash)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovProxy(uint64(m.Epoch))
+	}
+	if m.NumTxs != 0 {
+		n += 1 + sovProxy(uint64(m.NumTxs))
+	}
+	if len(m.ShardBlocks) > 0 {
+		for _, e := range m.ShardBlocks {
+			l = e.Size()
+			n += 1 + l + sovProxy(uint64(l))
+		}
+	}
+	if len(m.Transactions) > 0 {
+		for _, e := range m.Transactions {
+			l = e.Size()
+			n += 1 + l + sovProxy(uint64(l))
+		}
+	}
+	l = len(m.AccumulatedFees)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.DeveloperFees)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.AccumulatedFeesInEpoch)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.DeveloperFeesInEpoch)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if m.EpochStartInfo != nil {
+		l = m.EpochStartInfo.Size()
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	return n
+}
+
+func (m *AtlasBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovProxy(uint64(m.Nonce))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if len(m.Transactions) > 0 {
+		for _, e := range m.Transactions {
+			l = e.Size()
+			n += 1 + l + sovProxy(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NetworkConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if m.Denomination != 0 {
+		n += 1 + sovProxy(uint64(m.Denomination))
+	}
+	if m.GasPerDataByte != 0 {
+		n += 1 + sovProxy(uint64(m.GasPerDataByte))
+	}
+	l = len(m.LatestTagSoftwareVersion)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if m.MetaConsensusGroupSize != 0 {
+		n += 1 + sovProxy(uint64(m.MetaConsensusGroupSize))
+	}
+	if m.MinGasLimit != 0 {
+		n += 1 + sovProxy(uint64(m.MinGasLimit))
+	}
+	if m.MinGasPrice != 0 {
+		n += 1 + sovProxy(uint64(m.MinGasPrice))
+	}
+	if m.MinTransactionVersion != 0 {
+		n += 1 + sovProxy(uint64(m.MinTransactionVersion))
+	}
+	if m.NumMetachainNodes != 0 {
+		n += 1 + sovProxy(uint64(m.NumMetachainNodes))
+	}
+	if m.NumNodesInShard != 0 {
+		n += 1 + sovProxy(uint64(m.NumNodesInShard))
+	}
+	if m.NumShardsWithoutMeta != 0 {
+		n += 1 + sovProxy(uint64(m.NumShardsWithoutMeta))
+	}
+	l = len(m.RewardsTopUpGradientPoint)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if m.RoundDuration != 0 {
+		n += 1 + sovProxy(uint64(m.RoundDuration))
+	}
+	if m.ShardConsensusGroupSize != 0 {
+		n += 1 + sovProxy(uint64(m.ShardConsensusGroupSize))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovProxy(uint64(m.StartTime))
+	}
+	l = len(m.TopUpFactor)
+	if l > 0 {
+		n += 2 + l + sovProxy(uint64(l))
+	}
+	return n
+}
+
+func (m *NetworkStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentRound != 0 {
+		n += 1 + sovProxy(uint64(m.CurrentRound))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovProxy(uint64(m.EpochNumber))
+	}
+	if m.HighestFinalNonce != 0 {
+		n += 1 + sovProxy(uint64(m.HighestFinalNonce))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovProxy(uint64(m.Nonce))
+	}
+	if m.NonceAtEpochStart != 0 {
+		n += 1 + sovProxy(uint64(m.NonceAtEpochStart))
+	}
+	if m.NoncesPassedInCurrentEpoch != 0 {
+		n += 1 + sovProxy(uint64(m.NoncesPassedInCurrentEpoch))
+	}
+	if m.RoundAtEpochStart != 0 {
+		n += 1 + sovProxy(uint64(m.RoundAtEpochStart))
+	}
+	if m.RoundsPassedInCurrentEpoch != 0 {
+		n += 1 + sovProxy(uint64(m.RoundsPassedInCurrentEpoch))
+	}
+	if m.RoundsPerEpoch != 0 {
+		n += 1 + sovProxy(uint64(m.RoundsPerEpoch))
+	}
+	l = len(m.CrossCheckBlockHeight)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	return n
+}
+
+func (m *EconomicsData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TotalSupply)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.CirculatingSupply)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.StakedValue)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.TotalBaseStakedValue)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.TotalTopUpValue)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.DevRewards)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Inflation)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.TotalFees)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovProxy(uint64(m.EpochNumber))
+	}
+	if m.EpochForEconomicsData != 0 {
+		n += 1 + sovProxy(uint64(m.EpochForEconomicsData))
+	}
+	return n
+}
+
+func (m *EnableEpochsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EnableEpochs) > 0 {
+		for k, v := range m.EnableEpochs {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovProxy(uint64(len(k))) + 1 + sovProxy(uint64(v))
+			n += mapEntrySize + 1 + sovProxy(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *NonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovProxy(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *StakedInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.BaseStaked)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.TopUp)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Total)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	return n
+}
+
+func (m *DirectStakedInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.List) > 0 {
+		for _, e := range m.List {
+			l = e.Size()
+			n += 1 + l + sovProxy(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DelegatedValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegationScAddress)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegatorInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if len(m.DelegatedTo) > 0 {
+		for _, e := range m.DelegatedTo {
+			l = e.Size()
+			n += 1 + l + sovProxy(uint64(l))
+		}
+	}
+	l = len(m.Total)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegatedInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.List) > 0 {
+		for _, e := range m.List {
+			l = e.Size()
+			n += 1 + l + sovProxy(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SCQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScAddress)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.FuncName)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.CallerAddr)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.CallValue)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if len(m.Arguments) > 0 {
+		for _, b := range m.Arguments {
+			l = len(b)
+			n += 1 + l + sovProxy(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StorageUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Offset)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	return n
+}
+
+func (m *OutputTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovProxy(uint64(m.GasLimit))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if m.CallType != 0 {
+		n += 1 + sovProxy(uint64(m.CallType))
+	}
+	return n
+}
+
+func (m *OutputAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovProxy(uint64(m.Nonce))
+	}
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.BalanceDelta)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if len(m.StorageUpdates) > 0 {
+		for k, v := range m.StorageUpdates {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovProxy(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovProxy(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovProxy(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.CodeMetadata)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if len(m.OutputTransfers) > 0 {
+		for _, e := range m.OutputTransfers {
+			l = e.Size()
+			n += 1 + l + sovProxy(uint64(l))
+		}
+	}
+	if m.CallType != 0 {
+		n += 1 + sovProxy(uint64(m.CallType))
+	}
+	return n
+}
+
+func (m *LogEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Identifier)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if len(m.Topics) > 0 {
+		for _, b := range m.Topics {
+			l = len(b)
+			n += 1 + l + sovProxy(uint64(l))
+		}
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	return n
+}
+
+func (m *VMOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ReturnData) > 0 {
+		for _, b := range m.ReturnData {
+			l = len(b)
+			n += 1 + l + sovProxy(uint64(l))
+		}
+	}
+	l = len(m.ReturnCode)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	l = len(m.ReturnMessage)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if m.GasRemaining != 0 {
+		n += 1 + sovProxy(uint64(m.GasRemaining))
+	}
+	l = len(m.GasRefund)
+	if l > 0 {
+		n += 1 + l + sovProxy(uint64(l))
+	}
+	if len(m.OutputAccounts) > 0 {
+		for k, v := range m.OutputAccounts {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovProxy(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovProxy(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovProxy(uint64(mapEntrySize))
+		}
+	}
+	if len(m.DeletedAccounts) > 0 {
+		for _, b := range m.DeletedAccounts {
+			l = len(b)
+			n += 1 + l + sovProxy(uint64(l))
+		}
+	}
+	if len(m.TouchedAccounts) > 0 {
+		for _, b := range m.TouchedAccounts {
+			l = len(b)
+			n += 1 + l + sovProxy(uint64(l))
+		}
+	}
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovProxy(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovProxy(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProxy(x uint64) (n int) {
+	return sovProxy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *Empty) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Empty{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AddressRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AddressRequest{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AccountKeyRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AccountKeyRequest{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ESDTTokenRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ESDTTokenRequest{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`TokenIdentifier:` + fmt.Sprintf("%v", this.TokenIdentifier) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ESDTNftTokenRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ESDTNftTokenRequest{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`TokenIdentifier:` + fmt.Sprintf("%v", this.TokenIdentifier) + `,`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ESDTsWithRoleRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ESDTsWithRoleRequest{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShardRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShardRequest{`,
+		`Shard:` + fmt.Sprintf("%v", this.Shard) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TokenTypeRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TokenTypeRequest{`,
+		`TokenType:` + fmt.Sprintf("%v", this.TokenType) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Account) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Account{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Balance:` + fmt.Sprintf("%v", this.Balance) + `,`,
+		`Username:` + fmt.Sprintf("%v", this.Username) + `,`,
+		`Code:` + fmt.Sprintf("%v", this.Code) + `,`,
+		`CodeHash:` + fmt.Sprintf("%v", this.CodeHash) + `,`,
+		`RootHash:` + fmt.Sprintf("%v", this.RootHash) + `,`,
+		`CodeMetadata:` + fmt.Sprintf("%v", this.CodeMetadata) + `,`,
+		`DeveloperReward:` + fmt.Sprintf("%v", this.DeveloperReward) + `,`,
+		`OwnerAddress:` + fmt.Sprintf("%v", this.OwnerAddress) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ShardIDResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShardIDResponse{`,
+		`ShardID:` + fmt.Sprintf("%v", this.ShardID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ValueResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ValueResponse{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *KeyValuePairsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForPairs := make([]string, 0, len(this.Pairs))
+	for k, _ := range this.Pairs {
+		keysForPairs = append(keysForPairs, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForPairs)
+	mapStringForPairs := "map[string]string{"
+	for _, k := range keysForPairs {
+		mapStringForPairs += fmt.Sprintf("%v: %v,", k, this.Pairs[k])
+	}
+	mapStringForPairs += "}"
+	s := strings.Join([]string{`&KeyValuePairsResponse{`,
+		`Pairs:` + mapStringForPairs + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ESDTToken) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ESDTToken{`,
+		`TokenIdentifier:` + fmt.Sprintf("%v", this.TokenIdentifier) + `,`,
+		`Balance:` + fmt.Sprintf("%v", this.Balance) + `,`,
+		`Properties:` + fmt.Sprintf("%v", this.Properties) + `,`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Creator:` + fmt.Sprintf("%v", this.Creator) + `,`,
+		`Royalties:` + fmt.Sprintf("%v", this.Royalties) + `,`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`URIs:` + fmt.Sprintf("%v", this.URIs) + `,`,
+		`Attributes:` + fmt.Sprintf("%v", this.Attributes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ESDTTokensResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTokens := "[]*ESDTToken{"
+	for _, f := range this.Tokens {
+		repeatedStringForTokens += strings.Replace(f.String(), "ESDTToken", "ESDTToken", 1) + ","
+	}
+	repeatedStringForTokens += "}"
+	s := strings.Join([]string{`&ESDTTokensResponse{`,
+		`Tokens:` + repeatedStringForTokens + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TokenIdentifiersResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TokenIdentifiersResponse{`,
+		`TokenIdentifiers:` + fmt.Sprintf("%v", this.TokenIdentifiers) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DatabaseTransaction) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DatabaseTransaction{`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`Fee:` + fmt.Sprintf("%v", this.Fee) + `,`,
+		`MiniBlockHash:` + fmt.Sprintf("%v", this.MiniBlockHash) + `,`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Round:` + fmt.Sprintf("%v", this.Round) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Receiver:` + fmt.Sprintf("%v", this.Receiver) + `,`,
+		`Sender:` + fmt.Sprintf("%v", this.Sender) + `,`,
+		`ReceiverShard:` + fmt.Sprintf("%v", this.ReceiverShard) + `,`,
+		`SenderShard:` + fmt.Sprintf("%v", this.SenderShard) + `,`,
+		`GasPrice:` + fmt.Sprintf("%v", this.GasPrice) + `,`,
+		`GasLimit:` + fmt.Sprintf("%v", this.GasLimit) + `,`,
+		`GasUsed:` + fmt.Sprintf("%v", this.GasUsed) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`Signature:` + fmt.Sprintf("%v", this.Signature) + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DatabaseTransactionsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTransactions := "[]*DatabaseTransaction{"
+	for _, f := range this.Transactions {
+		repeatedStringForTransactions += strings.Replace(f.String(), "DatabaseTransaction", "DatabaseTransaction", 1) + ","
+	}
+	repeatedStringForTransactions += "}"
+	s := strings.Join([]string{`&DatabaseTransactionsResponse{`,
+		`Transactions:` + repeatedStringForTransactions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Transaction) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Transaction{`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Receiver:` + fmt.Sprintf("%v", this.Receiver) + `,`,
+		`Sender:` + fmt.Sprintf("%v", this.Sender) + `,`,
+		`SenderUsername:` + fmt.Sprintf("%v", this.SenderUsername) + `,`,
+		`ReceiverUsername:` + fmt.Sprintf("%v", this.ReceiverUsername) + `,`,
+		`GasPrice:` + fmt.Sprintf("%v", this.GasPrice) + `,`,
+		`GasLimit:` + fmt.Sprintf("%v", this.GasLimit) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`Signature:` + fmt.Sprintf("%v", this.Signature) + `,`,
+		`ChainID:` + fmt.Sprintf("%v", this.ChainID) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`Options:` + fmt.Sprintf("%v", this.Options) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MultipleTransactionsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTransactions := "[]*Transaction{"
+	for _, f := range this.Transactions {
+		repeatedStringForTransactions += strings.Replace(f.String(), "Transaction", "Transaction", 1) + ","
+	}
+	repeatedStringForTransactions += "}"
+	s := strings.Join([]string{`&MultipleTransactionsRequest{`,
+		`Transactions:` + repeatedStringForTransactions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MultipleTransactionsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForTxsHashes := make([]uint32, 0, len(this.TxsHashes))
+	for k, _ := range this.TxsHashes {
+		keysForTxsHashes = append(keysForTxsHashes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Uint32s(keysForTxsHashes)
+	mapStringForTxsHashes := "map[uint32]string{"
+	for _, k := range keysForTxsHashes {
+		mapStringForTxsHashes += fmt.Sprintf("%v: %v,", k, this.TxsHashes[k])
+	}
+	mapStringForTxsHashes += "}"
+	s := strings.Join([]string{`&MultipleTransactionsResponse{`,
+		`NumOfSentTxs:` + fmt.Sprintf("%v", this.NumOfSentTxs) + `,`,
+		`TxsHashes:` + mapStringForTxsHashes + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SimulateTransactionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SimulateTransactionRequest{`,
+		`Transaction:` + strings.Replace(this.Transaction.String(), "Transaction", "Transaction", 1) + `,`,
+		`SkipSignatureCheck:` + fmt.Sprintf("%v", this.SkipSignatureCheck) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SmartContractResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SmartContractResult{`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Receiver:` + fmt.Sprintf("%v", this.Receiver) + `,`,
+		`Sender:` + fmt.Sprintf("%v", this.Sender) + `,`,
+		`RelayerAddress:` + fmt.Sprintf("%v", this.RelayerAddress) + `,`,
+		`RelayedValue:` + fmt.Sprintf("%v", this.RelayedValue) + `,`,
+		`Code:` + fmt.Sprintf("%v", this.Code) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`PrevTxHash:` + fmt.Sprintf("%v", this.PrevTxHash) + `,`,
+		`OriginalTxHash:` + fmt.Sprintf("%v", this.OriginalTxHash) + `,`,
+		`GasLimit:` + fmt.Sprintf("%v", this.GasLimit) + `,`,
+		`GasPrice:` + fmt.Sprintf("%v", this.GasPrice) + `,`,
+		`CallType:` + fmt.Sprintf("%v", this.CallType) + `,`,
+		`CodeMetadata:` + fmt.Sprintf("%v", this.CodeMetadata) + `,`,
+		`ReturnMessage:` + fmt.Sprintf("%v", this.ReturnMessage) + `,`,
+		`OriginalSender:` + fmt.Sprintf("%v", this.OriginalSender) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Receipt) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Receipt{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Sender:` + fmt.Sprintf("%v", this.Sender) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`TxHash:` + fmt.Sprintf("%v", this.TxHash) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SimulationResults) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForScResults := make([]string, 0, len(this.ScResults))
+	for k, _ := range this.ScResults {
+		keysForScResults = append(keysForScResults, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForScResults)
+	mapStringForScResults := "map[string]*SmartContractResult{"
+	for _, k := range keysForScResults {
+		mapStringForScResults += fmt.Sprintf("%v: %v,", k, this.ScResults[k])
+	}
+	mapStringForScResults += "}"
+	keysForReceipts := make([]string, 0, len(this.Receipts))
+	for k, _ := range this.Receipts {
+		keysForReceipts = append(keysForReceipts, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForReceipts)
+	mapStringForReceipts := "map[string]*Receipt{"
+	for _, k := range keysForReceipts {
+		mapStringForReceipts += fmt.Sprintf("%v: %v,", k, this.Receipts[k])
+	}
+	mapStringForReceipts += "}"
+	s := strings.Join([]string{`&SimulationResults{`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`FailReason:` + fmt.Sprintf("%v", this.FailReason) + `,`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`ScResults:` + mapStringForScResults + `,`,
+		`Receipts:` + mapStringForReceipts + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SimulationResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SimulationResponse{`,
+		`SenderShard:` + strings.Replace(this.SenderShard.String(), "SimulationResults", "SimulationResults", 1) + `,`,
+		`ReceiverShard:` + strings.Replace(this.ReceiverShard.String(), "SimulationResults", "SimulationResults", 1) + `,`,
+		`IsCrossShard:` + fmt.Sprintf("%v", this.IsCrossShard) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TransactionCostResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TransactionCostResponse{`,
+		`GasUnits:` + fmt.Sprintf("%v", this.GasUnits) + `,`,
+		`ReturnMessage:` + fmt.Sprintf("%v", this.ReturnMessage) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TransactionHashResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TransactionHashResponse{`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GetTransactionRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GetTransactionRequest{`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`Sender:` + fmt.Sprintf("%v", this.Sender) + `,`,
+		`WithResults:` + fmt.Sprintf("%v", this.WithResults) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TransactionStatusRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TransactionStatusRequest{`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`Sender:` + fmt.Sprintf("%v", this.Sender) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TransactionStatusResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TransactionStatusResponse{`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FullTransaction) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSmartContractResults := "[]*SmartContractResult{"
+	for _, f := range this.SmartContractResults {
+		repeatedStringForSmartContractResults += strings.Replace(f.String(), "SmartContractResult", "SmartContractResult", 1) + ","
+	}
+	repeatedStringForSmartContractResults += "}"
+	s := strings.Join([]string{`&FullTransaction{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Round:` + fmt.Sprintf("%v", this.Round) + `,`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`Receiver:` + fmt.Sprintf("%v", this.Receiver) + `,`,
+		`Sender:` + fmt.Sprintf("%v", this.Sender) + `,`,
+		`SenderUsername:` + fmt.Sprintf("%v", this.SenderUsername) + `,`,
+		`ReceiverUsername:` + fmt.Sprintf("%v", this.ReceiverUsername) + `,`,
+		`GasPrice:` + fmt.Sprintf("%v", this.GasPrice) + `,`,
+		`GasLimit:` + fmt.Sprintf("%v", this.GasLimit) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`CodeMetadata:` + fmt.Sprintf("%v", this.CodeMetadata) + `,`,
+		`Code:` + fmt.Sprintf("%v", this.Code) + `,`,
+		`PreviousTransactionHash:` + fmt.Sprintf("%v", this.PreviousTransactionHash) + `,`,
+		`OriginalTransactionHash:` + fmt.Sprintf("%v", this.OriginalTransactionHash) + `,`,
+		`ReturnMessage:` + fmt.Sprintf("%v", this.ReturnMessage) + `,`,
+		`OriginalSender:` + fmt.Sprintf("%v", this.OriginalSender) + `,`,
+		`Signature:` + fmt.Sprintf("%v", this.Signature) + `,`,
+		`SourceShard:` + fmt.Sprintf("%v", this.SourceShard) + `,`,
+		`DestinationShard:` + fmt.Sprintf("%v", this.DestinationShard) + `,`,
+		`BlockNonce:` + fmt.Sprintf("%v", this.BlockNonce) + `,`,
+		`BlockHash:` + fmt.Sprintf("%v", this.BlockHash) + `,`,
+		`NotarizedAtSourceInMetaNonce:` + fmt.Sprintf("%v", this.NotarizedAtSourceInMetaNonce) + `,`,
+		`NotarizedAtSourceInMetaHash:` + fmt.Sprintf("%v", this.NotarizedAtSourceInMetaHash) + `,`,
+		`NotarizedAtDestinationInMetaNonce:` + fmt.Sprintf("%v", this.NotarizedAtDestinationInMetaNonce) + `,`,
+		`NotarizedAtDestinationInMetaHash:` + fmt.Sprintf("%v", this.NotarizedAtDestinationInMetaHash) + `,`,
+		`MiniBlockType:` + fmt.Sprintf("%v", this.MiniBlockType) + `,`,
+		`MiniBlockHash:` + fmt.Sprintf("%v", this.MiniBlockHash) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`HyperblockNonce:` + fmt.Sprintf("%v", this.HyperblockNonce) + `,`,
+		`HyperblockHash:` + fmt.Sprintf("%v", this.HyperblockHash) + `,`,
+		`Receipt:` + strings.Replace(this.Receipt.String(), "Receipt", "Receipt", 1) + `,`,
+		`SmartContractResults:` + repeatedStringForSmartContractResults + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BlockByNonceRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BlockByNonceRequest{`,
+		`Shard:` + fmt.Sprintf("%v", this.Shard) + `,`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`WithTxs:` + fmt.Sprintf("%v", this.WithTxs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *BlockByHashRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&BlockByHashRequest{`,
+		`Shard:` + fmt.Sprintf("%v", this.Shard) + `,`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`WithTxs:` + fmt.Sprintf("%v", this.WithTxs) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AtlasBlockRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AtlasBlockRequest{`,
+		`Shard:` + fmt.Sprintf("%v", this.Shard) + `,`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HyperBlockByNonceRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HyperBlockByNonceRequest{`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HyperBlockByHashRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HyperBlockByHashRequest{`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StreamHyperBlocksRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StreamHyperBlocksRequest{`,
+		`StartNonce:` + fmt.Sprintf("%v", this.StartNonce) + `,`,
+		`FromLatest:` + fmt.Sprintf("%v", this.FromLatest) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NotarizedBlock) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NotarizedBlock{`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Round:` + fmt.Sprintf("%v", this.Round) + `,`,
+		`Shard:` + fmt.Sprintf("%v", this.Shard) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *MiniBlock) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTransactions := "[]*FullTransaction{"
+	for _, f := range this.Transactions {
+		repeatedStringForTransactions += strings.Replace(f.String(), "FullTransaction", "FullTransaction", 1) + ","
+	}
+	repeatedStringForTransactions += "}"
+	s := strings.Join([]string{`&MiniBlock{`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`SourceShard:` + fmt.Sprintf("%v", this.SourceShard) + `,`,
+		`DestinationShard:` + fmt.Sprintf("%v", this.DestinationShard) + `,`,
+		`Transactions:` + repeatedStringForTransactions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EpochStartInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EpochStartInfo{`,
+		`TotalSupply:` + fmt.Sprintf("%v", this.TotalSupply) + `,`,
+		`TotalToDistribute:` + fmt.Sprintf("%v", this.TotalToDistribute) + `,`,
+		`TotalNewlyMinted:` + fmt.Sprintf("%v", this.TotalNewlyMinted) + `,`,
+		`RewardsPerBlock:` + fmt.Sprintf("%v", this.RewardsPerBlock) + `,`,
+		`RewardsForProtocolSustainability:` + fmt.Sprintf("%v", this.RewardsForProtocolSustainability) + `,`,
+		`NodePrice:` + fmt.Sprintf("%v", this.NodePrice) + `,`,
+		`PrevEpochStartRound:` + fmt.Sprintf("%v", this.PrevEpochStartRound) + `,`,
+		`PrevEpochStartHash:` + fmt.Sprintf("%v", this.PrevEpochStartHash) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Block) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForNotarizedBlocks := "[]*NotarizedBlock{"
+	for _, f := range this.NotarizedBlocks {
+		repeatedStringForNotarizedBlocks += strings.Replace(f.String(), "NotarizedBlock", "NotarizedBlock", 1) + ","
+	}
+	repeatedStringForNotarizedBlocks += "}"
+	repeatedStringForMiniBlocks := "[]*MiniBlock{"
+	for _, f := range this.MiniBlocks {
+		repeatedStringForMiniBlocks += strings.Replace(f.String(), "MiniBlock", "MiniBlock", 1) + ","
+	}
+	repeatedStringForMiniBlocks += "}"
+	s := strings.Join([]string{`&Block{`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Round:` + fmt.Sprintf("%v", this.Round) + `,`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`PrevBlockHash:` + fmt.Sprintf("%v", this.PrevBlockHash) + `,`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`Shard:` + fmt.Sprintf("%v", this.Shard) + `,`,
+		`NumTxs:` + fmt.Sprintf("%v", this.NumTxs) + `,`,
+		`NotarizedBlocks:` + repeatedStringForNotarizedBlocks + `,`,
+		`MiniBlocks:` + repeatedStringForMiniBlocks + `,`,
+		`Timestamp:` + fmt.Sprintf("%v", this.Timestamp) + `,`,
+		`AccumulatedFees:` + fmt.Sprintf("%v", this.AccumulatedFees) + `,`,
+		`DeveloperFees:` + fmt.Sprintf("%v", this.DeveloperFees) + `,`,
+		`AccumulatedFeesInEpoch:` + fmt.Sprintf("%v", this.AccumulatedFeesInEpoch) + `,`,
+		`DeveloperFeesInEpoch:` + fmt.Sprintf("%v", this.DeveloperFeesInEpoch) + `,`,
+		`EpochStartInfo:` + strings.Replace(this.EpochStartInfo.String(), "EpochStartInfo", "EpochStartInfo", 1) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Hyperblock) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForShardBlocks := "[]*NotarizedBlock{"
+	for _, f := range this.ShardBlocks {
+		repeatedStringForShardBlocks += strings.Replace(f.String(), "NotarizedBlock", "NotarizedBlock", 1) + ","
+	}
+	repeatedStringForShardBlocks += "}"
+	repeatedStringForTransactions := "[]*FullTransaction{"
+	for _, f := range this.Transactions {
+		repeatedStringForTransactions += strings.Replace(f.String(), "FullTransaction", "FullTransaction", 1) + ","
+	}
+	repeatedStringForTransactions += "}"
+	s := strings.Join([]string{`&Hyperblock{`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Round:` + fmt.Sprintf("%v", this.Round) + `,`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`PrevBlockHash:` + fmt.Sprintf("%v", this.PrevBlockHash) + `,`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`NumTxs:` + fmt.Sprintf("%v", this.NumTxs) + `,`,
+		`ShardBlocks:` + repeatedStringForShardBlocks + `,`,
+		`Transactions:` + repeatedStringForTransactions + `,`,
+		`AccumulatedFees:` + fmt.Sprintf("%v", this.AccumulatedFees) + `,`,
+		`DeveloperFees:` + fmt.Sprintf("%v", this.DeveloperFees) + `,`,
+		`AccumulatedFeesInEpoch:` + fmt.Sprintf("%v", this.AccumulatedFeesInEpoch) + `,`,
+		`DeveloperFeesInEpoch:` + fmt.Sprintf("%v", this.DeveloperFeesInEpoch) + `,`,
+		`EpochStartInfo:` + strings.Replace(this.EpochStartInfo.String(), "EpochStartInfo", "EpochStartInfo", 1) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AtlasBlock) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTransactions := "[]*DatabaseTransaction{"
+	for _, f := range this.Transactions {
+		repeatedStringForTransactions += strings.Replace(f.String(), "DatabaseTransaction", "DatabaseTransaction", 1) + ","
+	}
+	repeatedStringForTransactions += "}"
+	s := strings.Join([]string{`&AtlasBlock{`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Hash:` + fmt.Sprintf("%v", this.Hash) + `,`,
+		`Transactions:` + repeatedStringForTransactions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NetworkConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NetworkConfig{`,
+		`ChainID:` + fmt.Sprintf("%v", this.ChainID) + `,`,
+		`Denomination:` + fmt.Sprintf("%v", this.Denomination) + `,`,
+		`GasPerDataByte:` + fmt.Sprintf("%v", this.GasPerDataByte) + `,`,
+		`LatestTagSoftwareVersion:` + fmt.Sprintf("%v", this.LatestTagSoftwareVersion) + `,`,
+		`MetaConsensusGroupSize:` + fmt.Sprintf("%v", this.MetaConsensusGroupSize) + `,`,
+		`MinGasLimit:` + fmt.Sprintf("%v", this.MinGasLimit) + `,`,
+		`MinGasPrice:` + fmt.Sprintf("%v", this.MinGasPrice) + `,`,
+		`MinTransactionVersion:` + fmt.Sprintf("%v", this.MinTransactionVersion) + `,`,
+		`NumMetachainNodes:` + fmt.Sprintf("%v", this.NumMetachainNodes) + `,`,
+		`NumNodesInShard:` + fmt.Sprintf("%v", this.NumNodesInShard) + `,`,
+		`NumShardsWithoutMeta:` + fmt.Sprintf("%v", this.NumShardsWithoutMeta) + `,`,
+		`RewardsTopUpGradientPoint:` + fmt.Sprintf("%v", this.RewardsTopUpGradientPoint) + `,`,
+		`RoundDuration:` + fmt.Sprintf("%v", this.RoundDuration) + `,`,
+		`ShardConsensusGroupSize:` + fmt.Sprintf("%v", this.ShardConsensusGroupSize) + `,`,
+		`StartTime:` + fmt.Sprintf("%v", this.StartTime) + `,`,
+		`TopUpFactor:` + fmt.Sprintf("%v", this.TopUpFactor) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NetworkStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NetworkStatus{`,
+		`CurrentRound:` + fmt.Sprintf("%v", this.CurrentRound) + `,`,
+		`EpochNumber:` + fmt.Sprintf("%v", this.EpochNumber) + `,`,
+		`HighestFinalNonce:` + fmt.Sprintf("%v", this.HighestFinalNonce) + `,`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`NonceAtEpochStart:` + fmt.Sprintf("%v", this.NonceAtEpochStart) + `,`,
+		`NoncesPassedInCurrentEpoch:` + fmt.Sprintf("%v", this.NoncesPassedInCurrentEpoch) + `,`,
+		`RoundAtEpochStart:` + fmt.Sprintf("%v", this.RoundAtEpochStart) + `,`,
+		`RoundsPassedInCurrentEpoch:` + fmt.Sprintf("%v", this.RoundsPassedInCurrentEpoch) + `,`,
+		`RoundsPerEpoch:` + fmt.Sprintf("%v", this.RoundsPerEpoch) + `,`,
+		`CrossCheckBlockHeight:` + fmt.Sprintf("%v", this.CrossCheckBlockHeight) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EconomicsData) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EconomicsData{`,
+		`TotalSupply:` + fmt.Sprintf("%v", this.TotalSupply) + `,`,
+		`CirculatingSupply:` + fmt.Sprintf("%v", this.CirculatingSupply) + `,`,
+		`StakedValue:` + fmt.Sprintf("%v", this.StakedValue) + `,`,
+		`TotalBaseStakedValue:` + fmt.Sprintf("%v", this.TotalBaseStakedValue) + `,`,
+		`TotalTopUpValue:` + fmt.Sprintf("%v", this.TotalTopUpValue) + `,`,
+		`DevRewards:` + fmt.Sprintf("%v", this.DevRewards) + `,`,
+		`Inflation:` + fmt.Sprintf("%v", this.Inflation) + `,`,
+		`TotalFees:` + fmt.Sprintf("%v", this.TotalFees) + `,`,
+		`EpochNumber:` + fmt.Sprintf("%v", this.EpochNumber) + `,`,
+		`EpochForEconomicsData:` + fmt.Sprintf("%v", this.EpochForEconomicsData) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EnableEpochsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForEnableEpochs := make([]string, 0, len(this.EnableEpochs))
+	for k, _ := range this.EnableEpochs {
+		keysForEnableEpochs = append(keysForEnableEpochs, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForEnableEpochs)
+	mapStringForEnableEpochs := "map[string]uint32{"
+	for _, k := range keysForEnableEpochs {
+		mapStringForEnableEpochs += fmt.Sprintf("%v: %v,", k, this.EnableEpochs[k])
+	}
+	mapStringForEnableEpochs += "}"
+	s := strings.Join([]string{`&EnableEpochsResponse{`,
+		`EnableEpochs:` + mapStringForEnableEpochs + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NonceResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NonceResponse{`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StakedInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StakedInfo{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`BaseStaked:` + fmt.Sprintf("%v", this.BaseStaked) + `,`,
+		`TopUp:` + fmt.Sprintf("%v", this.TopUp) + `,`,
+		`Total:` + fmt.Sprintf("%v", this.Total) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DirectStakedInfoResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForList := "[]*StakedInfo{"
+	for _, f := range this.List {
+		repeatedStringForList += strings.Replace(f.String(), "StakedInfo", "StakedInfo", 1) + ","
+	}
+	repeatedStringForList += "}"
+	s := strings.Join([]string{`&DirectStakedInfoResponse{`,
+		`List:` + repeatedStringForList + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DelegatedValue) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DelegatedValue{`,
+		`DelegationScAddress:` + fmt.Sprintf("%v", this.DelegationScAddress) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DelegatorInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForDelegatedTo := "[]*DelegatedValue{"
+	for _, f := range this.DelegatedTo {
+		repeatedStringForDelegatedTo += strings.Replace(f.String(), "DelegatedValue", "DelegatedValue", 1) + ","
+	}
+	repeatedStringForDelegatedTo += "}"
+	s := strings.Join([]string{`&DelegatorInfo{`,
+		`DelegatorAddress:` + fmt.Sprintf("%v", this.DelegatorAddress) + `,`,
+		`DelegatedTo:` + repeatedStringForDelegatedTo + `,`,
+		`Total:` + fmt.Sprintf("%v", this.Total) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DelegatedInfoResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForList := "[]*DelegatorInfo{"
+	for _, f := range this.List {
+		repeatedStringForList += strings.Replace(f.String(), "DelegatorInfo", "DelegatorInfo", 1) + ","
+	}
+	repeatedStringForList += "}"
+	s := strings.Join([]string{`&DelegatedInfoResponse{`,
+		`List:` + repeatedStringForList + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SCQuery) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SCQuery{`,
+		`ScAddress:` + fmt.Sprintf("%v", this.ScAddress) + `,`,
+		`FuncName:` + fmt.Sprintf("%v", this.FuncName) + `,`,
+		`CallerAddr:` + fmt.Sprintf("%v", this.CallerAddr) + `,`,
+		`CallValue:` + fmt.Sprintf("%v", this.CallValue) + `,`,
+		`Arguments:` + fmt.Sprintf("%v", this.Arguments) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StorageUpdate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StorageUpdate{`,
+		`Offset:` + fmt.Sprintf("%v", this.Offset) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OutputTransfer) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OutputTransfer{`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`GasLimit:` + fmt.Sprintf("%v", this.GasLimit) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`CallType:` + fmt.Sprintf("%v", this.CallType) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OutputAccount) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForOutputTransfers := "[]*OutputTransfer{"
+	for _, f := range this.OutputTransfers {
+		repeatedStringForOutputTransfers += strings.Replace(f.String(), "OutputTransfer", "OutputTransfer", 1) + ","
+	}
+	repeatedStringForOutputTransfers += "}"
+	keysForStorageUpdates := make([]string, 0, len(this.StorageUpdates))
+	for k, _ := range this.StorageUpdates {
+		keysForStorageUpdates = append(keysForStorageUpdates, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForStorageUpdates)
+	mapStringForStorageUpdates := "map[string]*StorageUpdate{"
+	for _, k := range keysForStorageUpdates {
+		mapStringForStorageUpdates += fmt.Sprintf("%v: %v,", k, this.StorageUpdates[k])
+	}
+	mapStringForStorageUpdates += "}"
+	s := strings.Join([]string{`&OutputAccount{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Nonce:` + fmt.Sprintf("%v", this.Nonce) + `,`,
+		`Balance:` + fmt.Sprintf("%v", this.Balance) + `,`,
+		`BalanceDelta:` + fmt.Sprintf("%v", this.BalanceDelta) + `,`,
+		`StorageUpdates:` + mapStringForStorageUpdates + `,`,
+		`Code:` + fmt.Sprintf("%v", this.Code) + `,`,
+		`CodeMetadata:` + fmt.Sprintf("%v", this.CodeMetadata) + `,`,
+		`OutputTransfers:` + repeatedStringForOutputTransfers + `,`,
+		`CallType:` + fmt.Sprintf("%v", this.CallType) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *LogEntry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LogEntry{`,
+		`Identifier:` + fmt.Sprintf("%v", this.Identifier) + `,`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Topics:` + fmt.Sprintf("%v", this.Topics) + `,`,
+		`Data:` + fmt.Sprintf("%v", this.Data) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VMOutput) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForLogs := "[]*LogEntry{"
+	for _, f := range this.Logs {
+		repeatedStringForLogs += strings.Replace(f.String(), "LogEntry", "LogEntry", 1) + ","
+	}
+	repeatedStringForLogs += "}"
+	keysForOutputAccounts := make([]string, 0, len(this.OutputAccounts))
+	for k, _ := range this.OutputAccounts {
+		keysForOutputAccounts = append(keysForOutputAccounts, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForOutputAccounts)
+	mapStringForOutputAccounts := "map[string]*OutputAccount{"
+	for _, k := range keysForOutputAccounts {
+		mapStringForOutputAccounts += fmt.Sprintf("%v: %v,", k, this.OutputAccounts[k])
+	}
+	mapStringForOutputAccounts += "}"
+	s := strings.Join([]string{`&VMOutput{`,
+		`ReturnData:` + fmt.Sprintf("%v", this.ReturnData) + `,`,
+		`ReturnCode:` + fmt.Sprintf("%v", this.ReturnCode) + `,`,
+		`ReturnMessage:` + fmt.Sprintf("%v", this.ReturnMessage) + `,`,
+		`GasRemaining:` + fmt.Sprintf("%v", this.GasRemaining) + `,`,
+		`GasRefund:` + fmt.Sprintf("%v", this.GasRefund) + `,`,
+		`OutputAccounts:` + mapStringForOutputAccounts + `,`,
+		`DeletedAccounts:` + fmt.Sprintf("%v", this.DeletedAccounts) + `,`,
+		`TouchedAccounts:` + fmt.Sprintf("%v", this.TouchedAccounts) + `,`,
+		`Logs:` + repeatedStringForLogs + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringProxy(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *Empty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Empty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Empty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ESDTTokenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ESDTTokenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ESDTTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ESDTNftTokenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ESDTNftTokenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ESDTNftTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ESDTsWithRoleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ESDTsWithRoleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ESDTsWithRoleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShardRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShardRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+			}
+			m.Shard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Shard |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenTypeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenTypeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Account) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Account: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Account: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = append(m.CodeHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeHash == nil {
+				m.CodeHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RootHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RootHash = append(m.RootHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RootHash == nil {
+				m.RootHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeMetadata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeMetadata = append(m.CodeMetadata[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeMetadata == nil {
+				m.CodeMetadata = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeveloperReward = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShardIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShardIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShardIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardID", wireType)
+			}
+			m.ShardID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardID |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyValuePairsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyValuePairsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyValuePairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pairs == nil {
+				m.Pairs = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProxy
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProxy
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthProxy
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthProxy
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProxy
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthProxy
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthProxy
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipProxy(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthProxy
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Pairs[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ESDTToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ESDTToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ESDTToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Properties", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Properties = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalties", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Royalties = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URIs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URIs = append(m.URIs, make([]byte, postIndex-iNdEx))
+			copy(m.URIs[len(m.URIs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attributes = append(m.Attributes[:0], dAtA[iNdEx:postIndex]...)
+			if m.Attributes == nil {
+				m.Attributes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ESDTTokensResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ESDTTokensResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ESDTTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, &ESDTToken{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenIdentifiersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenIdentifiersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenIdentifiersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIdentifiers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIdentifiers = append(m.TokenIdentifiers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DatabaseTransaction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DatabaseTransaction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DatabaseTransaction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MiniBlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MiniBlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverShard", wireType)
+			}
+			m.ReceiverShard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiverShard |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderShard", wireType)
+			}
+			m.SenderShard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SenderShard |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			m.GasPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DatabaseTransactionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DatabaseTransactionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DatabaseTransactionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transactions = append(m.Transactions, &DatabaseTransaction{})
+			if err := m.Transactions[len(m.Transactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Transaction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Transaction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Transaction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderUsername", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderUsername = append(m.SenderUsername[:0], dAtA[iNdEx:postIndex]...)
+			if m.SenderUsername == nil {
+				m.SenderUsername = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverUsername", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiverUsername = append(m.ReceiverUsername[:0], dAtA[iNdEx:postIndex]...)
+			if m.ReceiverUsername == nil {
+				m.ReceiverUsername = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			m.GasPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			m.Options = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Options |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultipleTransactionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultipleTransactionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultipleTransactionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transactions = append(m.Transactions, &Transaction{})
+			if err := m.Transactions[len(m.Transactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultipleTransactionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultipleTransactionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultipleTransactionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOfSentTxs", wireType)
+			}
+			m.NumOfSentTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumOfSentTxs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxsHashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TxsHashes == nil {
+				m.TxsHashes = make(map[uint32]string)
+			}
+			var mapkey uint32
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProxy
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProxy
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProxy
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthProxy
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthProxy
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipProxy(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthProxy
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.TxsHashes[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulateTransactionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulateTransactionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulateTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transaction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Transaction == nil {
+				m.Transaction = &Transaction{}
+			}
+			if err := m.Transaction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipSignatureCheck", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipSignatureCheck = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SmartContractResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SmartContractResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SmartContractResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayedValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RelayedValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrevTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			m.GasPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallType", wireType)
+			}
+			m.CallType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeMetadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeMetadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Receipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Receipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Receipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulationResults) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulationResults: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulationResults: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScResults == nil {
+				m.ScResults = make(map[string]*SmartContractResult)
+			}
+			var mapkey string
+			var mapvalue *SmartContractResult
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProxy
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProxy
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthProxy
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthProxy
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProxy
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthProxy
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthProxy
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &SmartContractResult{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipProxy(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthProxy
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ScResults[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Receipts == nil {
+				m.Receipts = make(map[string]*Receipt)
+			}
+			var mapkey string
+			var mapvalue *Receipt
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProxy
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProxy
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthProxy
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthProxy
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProxy
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthProxy
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthProxy
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Receipt{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipProxy(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthProxy
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Receipts[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SimulationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SimulationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SimulationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderShard", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SenderShard == nil {
+				m.SenderShard = &SimulationResults{}
+			}
+			if err := m.SenderShard.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverShard", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReceiverShard == nil {
+				m.ReceiverShard = &SimulationResults{}
+			}
+			if err := m.ReceiverShard.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsCrossShard", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsCrossShard = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransactionCostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransactionCostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransactionCostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUnits", wireType)
+			}
+			m.GasUnits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUnits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransactionHashResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransactionHashResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransactionHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTransactionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTransactionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTransactionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithResults", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithResults = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransactionStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransactionStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransactionStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransactionStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransactionStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransactionStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FullTransaction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FullTransaction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FullTransaction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderUsername", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderUsername = append(m.SenderUsername[:0], dAtA[iNdEx:postIndex]...)
+			if m.SenderUsername == nil {
+				m.SenderUsername = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverUsername", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiverUsername = append(m.ReceiverUsername[:0], dAtA[iNdEx:postIndex]...)
+			if m.ReceiverUsername == nil {
+				m.ReceiverUsername = []byte{}
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			m.GasPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeMetadata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeMetadata = append(m.CodeMetadata[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeMetadata == nil {
+				m.CodeMetadata = []byte{}
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousTransactionHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousTransactionHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalTransactionHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalTransactionHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceShard", wireType)
+			}
+			m.SourceShard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceShard |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationShard", wireType)
+			}
+			m.DestinationShard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationShard |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNonce", wireType)
+			}
+			m.BlockNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotarizedAtSourceInMetaNonce", wireType)
+			}
+			m.NotarizedAtSourceInMetaNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NotarizedAtSourceInMetaNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotarizedAtSourceInMetaHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NotarizedAtSourceInMetaHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotarizedAtDestinationInMetaNonce", wireType)
+			}
+			m.NotarizedAtDestinationInMetaNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NotarizedAtDestinationInMetaNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotarizedAtDestinationInMetaHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NotarizedAtDestinationInMetaHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MiniBlockType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MiniBlockType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MiniBlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MiniBlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 32:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HyperblockNonce", wireType)
+			}
+			m.HyperblockNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HyperblockNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HyperblockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HyperblockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 34:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Receipt == nil {
+				m.Receipt = &Receipt{}
+			}
+			if err := m.Receipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SmartContractResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SmartContractResults = append(m.SmartContractResults, &SmartContractResult{})
+			if err := m.SmartContractResults[len(m.SmartContractResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockByNonceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockByNonceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockByNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+			}
+			m.Shard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Shard |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithTxs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithTxs = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockByHashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockByHashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockByHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+			}
+			m.Shard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Shard |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithTxs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WithTxs = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AtlasBlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AtlasBlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AtlasBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+			}
+			m.Shard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Shard |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HyperBlockByNonceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HyperBlockByNonceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HyperBlockByNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HyperBlockByHashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HyperBlockByHashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HyperBlockByHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamHyperBlocksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamHyperBlocksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamHyperBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartNonce", wireType)
+			}
+			m.StartNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromLatest", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FromLatest = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NotarizedBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NotarizedBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NotarizedBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+			}
+			m.Shard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Shard |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MiniBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MiniBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MiniBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceShard", wireType)
+			}
+			m.SourceShard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceShard |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationShard", wireType)
+			}
+			m.DestinationShard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationShard |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transactions = append(m.Transactions, &FullTransaction{})
+			if err := m.Transactions[len(m.Transactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochStartInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochStartInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochStartInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalSupply = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalToDistribute", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalToDistribute = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalNewlyMinted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalNewlyMinted = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsPerBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsPerBlock = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsForProtocolSustainability", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsForProtocolSustainability = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodePrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevEpochStartRound", wireType)
+			}
+			m.PrevEpochStartRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrevEpochStartRound |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevEpochStartHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrevEpochStartHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Block) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Block: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Block: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevBlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrevBlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+			}
+			m.Shard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Shard |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumTxs", wireType)
+			}
+			m.NumTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumTxs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotarizedBlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NotarizedBlocks = append(m.NotarizedBlocks, &NotarizedBlock{})
+			if err := m.NotarizedBlocks[len(m.NotarizedBlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MiniBlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MiniBlocks = append(m.MiniBlocks, &MiniBlock{})
+			if err := m.MiniBlocks[len(m.MiniBlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatedFees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccumulatedFees = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperFees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeveloperFees = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatedFeesInEpoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccumulatedFeesInEpoch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperFeesInEpoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeveloperFeesInEpoch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStartInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EpochStartInfo == nil {
+				m.EpochStartInfo = &EpochStartInfo{}
+			}
+			if err := m.EpochStartInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Hyperblock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Hyperblock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Hyperblock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevBlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrevBlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumTxs", wireType)
+			}
+			m.NumTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumTxs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardBlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShardBlocks = append(m.ShardBlocks, &NotarizedBlock{})
+			if err := m.ShardBlocks[len(m.ShardBlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transactions = append(m.Transactions, &FullTransaction{})
+			if err := m.Transactions[len(m.Transactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatedFees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccumulatedFees = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperFees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeveloperFees = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatedFeesInEpoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccumulatedFeesInEpoch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperFeesInEpoch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeveloperFeesInEpoch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStartInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EpochStartInfo == nil {
+				m.EpochStartInfo = &EpochStartInfo{}
+			}
+			if err := m.EpochStartInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AtlasBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AtlasBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AtlasBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transactions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transactions = append(m.Transactions, &DatabaseTransaction{})
+			if err := m.Transactions[len(m.Transactions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetworkConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denomination", wireType)
+			}
+			m.Denomination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Denomination |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPerDataByte", wireType)
+			}
+			m.GasPerDataByte = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPerDataByte |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestTagSoftwareVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LatestTagSoftwareVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaConsensusGroupSize", wireType)
+			}
+			m.MetaConsensusGroupSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MetaConsensusGroupSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasLimit", wireType)
+			}
+			m.MinGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+			}
+			m.MinGasPrice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinGasPrice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTransactionVersion", wireType)
+			}
+			m.MinTransactionVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinTransactionVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumMetachainNodes", wireType)
+			}
+			m.NumMetachainNodes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumMetachainNodes |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumNodesInShard", wireType)
+			}
+			m.NumNodesInShard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumNodesInShard |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumShardsWithoutMeta", wireType)
+			}
+			m.NumShardsWithoutMeta = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumShardsWithoutMeta |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsTopUpGradientPoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsTopUpGradientPoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundDuration", wireType)
+			}
+			m.RoundDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoundDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardConsensusGroupSize", wireType)
+			}
+			m.ShardConsensusGroupSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardConsensusGroupSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopUpFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopUpFactor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetworkStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRound", wireType)
+			}
+			m.CurrentRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentRound |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighestFinalNonce", wireType)
+			}
+			m.HighestFinalNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HighestFinalNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonceAtEpochStart", wireType)
+			}
+			m.NonceAtEpochStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NonceAtEpochStart |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoncesPassedInCurrentEpoch", wireType)
+			}
+			m.NoncesPassedInCurrentEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NoncesPassedInCurrentEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundAtEpochStart", wireType)
+			}
+			m.RoundAtEpochStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoundAtEpochStart |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundsPassedInCurrentEpoch", wireType)
+			}
+			m.RoundsPassedInCurrentEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoundsPassedInCurrentEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundsPerEpoch", wireType)
+			}
+			m.RoundsPerEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoundsPerEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossCheckBlockHeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CrossCheckBlockHeight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EconomicsData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EconomicsData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EconomicsData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalSupply = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CirculatingSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CirculatingSupply = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakedValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakedValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBaseStakedValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalBaseStakedValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalTopUpValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalTopUpValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DevRewards", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DevRewards = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inflation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalFees = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochForEconomicsData", wireType)
+			}
+			m.EpochForEconomicsData = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochForEconomicsData |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnableEpochsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnableEpochsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnableEpochsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableEpochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EnableEpochs == nil {
+				m.EnableEpochs = make(map[string]uint32)
+			}
+			var mapkey string
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProxy
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProxy
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthProxy
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthProxy
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProxy
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipProxy(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthProxy
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.EnableEpochs[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NonceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NonceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakedInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakedInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakedInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseStaked", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseStaked = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TopUp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TopUp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DirectStakedInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DirectStakedInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DirectStakedInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.List = append(m.List, &StakedInfo{})
+			if err := m.List[len(m.List)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegatedValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatedValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatedValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationScAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegationScAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegatorInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedTo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatedTo = append(m.DelegatedTo, &DelegatedValue{})
+			if err := m.DelegatedTo[len(m.DelegatedTo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegatedInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatedInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatedInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.List = append(m.List, &DelegatorInfo{})
+			if err := m.List[len(m.List)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SCQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SCQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SCQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FuncName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FuncName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallerAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallerAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arguments", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Arguments = append(m.Arguments, make([]byte, postIndex-iNdEx))
+			copy(m.Arguments[len(m.Arguments)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offset", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Offset = append(m.Offset[:0], dAtA[iNdEx:postIndex]...)
+			if m.Offset == nil {
+				m.Offset = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutputTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutputTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutputTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallType", wireType)
+			}
+			m.CallType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutputAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutputAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutputAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceDelta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BalanceDelta = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StorageUpdates == nil {
+				m.StorageUpdates = make(map[string]*StorageUpdate)
+			}
+			var mapkey string
+			var mapvalue *StorageUpdate
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProxy
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProxy
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthProxy
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthProxy
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProxy
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthProxy
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthProxy
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &StorageUpdate{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipProxy(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthProxy
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.StorageUpdates[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = append(m.Code[:0], dAtA[iNdEx:postIndex]...)
+			if m.Code == nil {
+				m.Code = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeMetadata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeMetadata = append(m.CodeMetadata[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeMetadata == nil {
+				m.CodeMetadata = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutputTransfers = append(m.OutputTransfers, &OutputTransfer{})
+			if err := m.OutputTransfers[len(m.OutputTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallType", wireType)
+			}
+			m.CallType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CallType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LogEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LogEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LogEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identifier", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identifier = append(m.Identifier[:0], dAtA[iNdEx:postIndex]...)
+			if m.Identifier == nil {
+				m.Identifier = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topics = append(m.Topics, make([]byte, postIndex-iNdEx))
+			copy(m.Topics[len(m.Topics)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VMOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VMOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VMOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnData = append(m.ReturnData, make([]byte, postIndex-iNdEx))
+			copy(m.ReturnData[len(m.ReturnData)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnMessage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnMessage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasRemaining", wireType)
+			}
+			m.GasRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasRemaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasRefund", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasRefund = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OutputAccounts == nil {
+				m.OutputAccounts = make(map[string]*OutputAccount)
+			}
+			var mapkey string
+			var mapvalue *OutputAccount
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProxy
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProxy
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthProxy
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthProxy
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProxy
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthProxy
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthProxy
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &OutputAccount{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipProxy(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthProxy
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.OutputAccounts[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAccounts", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletedAccounts = append(m.DeletedAccounts, make([]byte, postIndex-iNdEx))
+			copy(m.DeletedAccounts[len(m.DeletedAccounts)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TouchedAccounts", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TouchedAccounts = append(m.TouchedAccounts, make([]byte, postIndex-iNdEx))
+			copy(m.TouchedAccounts[len(m.TouchedAccounts)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProxy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = append(m.Logs, &LogEntry{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProxy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProxy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProxy(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProxy
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProxy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProxy
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProxy
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProxy
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProxy        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProxy          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProxy = fmt.Errorf("proto: unexpected end of group")
+)
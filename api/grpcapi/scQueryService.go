@@ -0,0 +1,27 @@
+package grpcapi
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+type scQueryService struct {
+	facade SCQueryFacadeHandler
+}
+
+// ExecuteQuery executes a smart contract view function and returns the VM's output
+func (sqs *scQueryService) ExecuteQuery(_ context.Context, query *SCQuery) (*VMOutput, error) {
+	vmOutput, err := sqs.facade.ExecuteSCQuery(&data.SCQuery{
+		ScAddress:  query.ScAddress,
+		FuncName:   query.FuncName,
+		CallerAddr: query.CallerAddr,
+		CallValue:  query.CallValue,
+		Arguments:  query.Arguments,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return fromVMOutput(vmOutput), nil
+}
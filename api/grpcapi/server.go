@@ -0,0 +1,75 @@
+//go:generate protoc -I=proto -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf --gogoslick_out=plugins=grpc:. proxy.proto
+
+package grpcapi
+
+import (
+	"crypto/tls"
+	"io"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/config"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+var log = logger.GetOrCreate("api/grpcapi")
+
+// ArgsServer holds the arguments needed to create the gRPC server
+type ArgsServer struct {
+	Facade              FacadeHandler
+	Config              config.GrpcServerConfig
+	TLSConfig           *tls.Config
+	ApiConfig           data.ApiRoutesConfig
+	CredentialsVerifier CredentialsVerifier
+	RateLimitWindow     time.Duration
+}
+
+// CreateServer creates a gRPC server exposing the facade's accounts, transactions, blocks, node status and SC query
+// operations. If the TLS config is not nil, the server only accepts TLS connections. Each method follows the Open,
+// Secured and RateLimit settings of the REST route it mirrors. The returned closer stops the rate limiter
+func CreateServer(args ArgsServer) (*grpc.Server, io.Closer, error) {
+	if check.IfNilReflect(args.Facade) {
+		return nil, nil, ErrNilFacade
+	}
+	if args.Config.HyperblocksPollingIntervalMs <= 0 {
+		return nil, nil, ErrInvalidPollingInterval
+	}
+	if check.IfNil(args.CredentialsVerifier) {
+		return nil, nil, ErrNilCredentialsVerifier
+	}
+	if args.RateLimitWindow <= 0 {
+		return nil, nil, ErrInvalidRateLimitWindow
+	}
+
+	policy, err := newMethodsPolicy(args.ApiConfig, args.CredentialsVerifier, args.RateLimitWindow)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	options := []grpc.ServerOption{
+		grpc.UnaryInterceptor(policy.unaryInterceptor),
+		grpc.StreamInterceptor(policy.streamInterceptor),
+	}
+	if args.Config.MaxConcurrentStreams > 0 {
+		options = append(options, grpc.MaxConcurrentStreams(args.Config.MaxConcurrentStreams))
+	}
+	if args.TLSConfig != nil {
+		options = append(options, grpc.Creds(credentials.NewTLS(args.TLSConfig)))
+	}
+
+	facade := args.Facade
+	server := grpc.NewServer(options...)
+	RegisterAccountServiceServer(server, &accountService{facade: facade})
+	RegisterTransactionServiceServer(server, &transactionService{facade: facade})
+	RegisterBlockServiceServer(server, &blockService{
+		facade:          facade,
+		pollingInterval: time.Duration(args.Config.HyperblocksPollingIntervalMs) * time.Millisecond,
+	})
+	RegisterNodeStatusServiceServer(server, &nodeStatusService{facade: facade})
+	RegisterSCQueryServiceServer(server, &scQueryService{facade: facade})
+
+	return server, policy, nil
+}
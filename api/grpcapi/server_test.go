@@ -0,0 +1,505 @@
+package grpcapi_test
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/vm"
+	apiErrors "github.com/ElrondNetwork/elrond-proxy-go/api/errors"
+	"github.com/ElrondNetwork/elrond-proxy-go/api/grpcapi"
+	"github.com/ElrondNetwork/elrond-proxy-go/api/mock"
+	"github.com/ElrondNetwork/elrond-proxy-go/config"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const bufferSize = 1024 * 1024
+
+func createTestServerConfig() config.GrpcServerConfig {
+	return config.GrpcServerConfig{
+		Enabled:                      true,
+		HyperblocksPollingIntervalMs: 10,
+	}
+}
+
+type credentialsVerifierStub struct {
+	VerifyCalled func(username string, password string) error
+}
+
+func (cvs *credentialsVerifierStub) Verify(username string, password string) error {
+	if cvs.VerifyCalled != nil {
+		return cvs.VerifyCalled(username, password)
+	}
+
+	return nil
+}
+
+func (cvs *credentialsVerifierStub) IsInterfaceNil() bool {
+	return cvs == nil
+}
+
+func createTestServerArgs(facade grpcapi.FacadeHandler) grpcapi.ArgsServer {
+	return grpcapi.ArgsServer{
+		Facade:              facade,
+		Config:              createTestServerConfig(),
+		CredentialsVerifier: &credentialsVerifierStub{},
+		RateLimitWindow:     time.Minute,
+	}
+}
+
+func startTestServer(t *testing.T, facade grpcapi.FacadeHandler) *grpc.ClientConn {
+	return startTestServerWithArgs(t, createTestServerArgs(facade))
+}
+
+func startTestServerWithArgs(t *testing.T, args grpcapi.ArgsServer) *grpc.ClientConn {
+	server, closer, err := grpcapi.CreateServer(args)
+	require.NoError(t, err)
+
+	listener := bufconn.Listen(bufferSize)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	conn, err := grpc.DialContext(
+		context.Background(),
+		"bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.Dial()
+		}),
+		grpc.WithInsecure(),
+	)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = conn.Close()
+		server.Stop()
+		_ = closer.Close()
+	})
+
+	return conn
+}
+
+func TestCreateServer(t *testing.T) {
+	t.Parallel()
+
+	args := createTestServerArgs(nil)
+	server, closer, err := grpcapi.CreateServer(args)
+	assert.Nil(t, server)
+	assert.Nil(t, closer)
+	assert.Equal(t, grpcapi.ErrNilFacade, err)
+
+	args = createTestServerArgs(&mock.Facade{})
+	args.Config.HyperblocksPollingIntervalMs = 0
+	server, _, err = grpcapi.CreateServer(args)
+	assert.Nil(t, server)
+	assert.Equal(t, grpcapi.ErrInvalidPollingInterval, err)
+
+	args = createTestServerArgs(&mock.Facade{})
+	args.CredentialsVerifier = nil
+	server, _, err = grpcapi.CreateServer(args)
+	assert.Nil(t, server)
+	assert.Equal(t, grpcapi.ErrNilCredentialsVerifier, err)
+
+	args = createTestServerArgs(&mock.Facade{})
+	args.RateLimitWindow = 0
+	server, _, err = grpcapi.CreateServer(args)
+	assert.Nil(t, server)
+	assert.Equal(t, grpcapi.ErrInvalidRateLimitWindow, err)
+
+	server, closer, err = grpcapi.CreateServer(createTestServerArgs(&mock.Facade{}))
+	assert.NoError(t, err)
+	assert.NotNil(t, server)
+	assert.Nil(t, closer.Close())
+	assert.Nil(t, closer.Close())
+}
+
+func createTestApiConfig(sendRoute data.RouteConfig) data.ApiRoutesConfig {
+	return data.ApiRoutesConfig{
+		APIPackages: map[string]data.APIPackageConfig{
+			"transaction": {Routes: []data.RouteConfig{sendRoute}},
+		},
+	}
+}
+
+func TestCreateServer_MethodOfClosedRouteShouldNotBeAvailable(t *testing.T) {
+	t.Parallel()
+
+	args := createTestServerArgs(&mock.Facade{})
+	args.ApiConfig = createTestApiConfig(data.RouteConfig{Name: "/send", Open: false})
+	client := grpcapi.NewTransactionServiceClient(startTestServerWithArgs(t, args))
+
+	_, err := client.SendTransaction(context.Background(), &grpcapi.Transaction{})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	_, err = client.ComputeTransactionHash(context.Background(), &grpcapi.Transaction{})
+	assert.NotEqual(t, codes.Unimplemented, status.Code(err))
+}
+
+func TestCreateServer_MethodOfSecuredRouteShouldRequireCredentials(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		SendTransactionHandler: func(tx *data.Transaction) (int, string, error) {
+			return 0, "txHash", nil
+		},
+	}
+	args := createTestServerArgs(facade)
+	args.ApiConfig = createTestApiConfig(data.RouteConfig{Name: "/send", Open: true, Secured: true})
+	args.CredentialsVerifier = &credentialsVerifierStub{
+		VerifyCalled: func(username string, password string) error {
+			if username == "user" && password == "pass" {
+				return nil
+			}
+
+			return errors.New("invalid password")
+		},
+	}
+	client := grpcapi.NewTransactionServiceClient(startTestServerWithArgs(t, args))
+
+	_, err := client.SendTransaction(context.Background(), &grpcapi.Transaction{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte("user:wrong")))
+	_, err = client.SendTransaction(ctx, &grpcapi.Transaction{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	ctx = metadata.AppendToOutgoingContext(context.Background(), "authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte("user:pass")))
+	response, err := client.SendTransaction(ctx, &grpcapi.Transaction{})
+	require.NoError(t, err)
+	assert.Equal(t, "txHash", response.Hash)
+}
+
+func TestCreateServer_MethodShouldBeRateLimited(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		SendTransactionHandler: func(tx *data.Transaction) (int, string, error) {
+			return 0, "txHash", nil
+		},
+	}
+	args := createTestServerArgs(facade)
+	args.ApiConfig = createTestApiConfig(data.RouteConfig{Name: "/send", Open: true, RateLimit: 2})
+	client := grpcapi.NewTransactionServiceClient(startTestServerWithArgs(t, args))
+
+	_, err := client.SendTransaction(context.Background(), &grpcapi.Transaction{})
+	require.NoError(t, err)
+
+	_, err = client.SendTransaction(context.Background(), &grpcapi.Transaction{})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestAccountService_GetAccount(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetAccountHandler: func(address string) (*data.Account, error) {
+			return &data.Account{Address: address, Nonce: 37, Balance: "1000", CodeHash: []byte("hash")}, nil
+		},
+	}
+	client := grpcapi.NewAccountServiceClient(startTestServer(t, facade))
+
+	account, err := client.GetAccount(context.Background(), &grpcapi.AddressRequest{Address: "erd1a"})
+	require.NoError(t, err)
+	assert.Equal(t, "erd1a", account.Address)
+	assert.Equal(t, uint64(37), account.Nonce)
+	assert.Equal(t, "1000", account.Balance)
+	assert.Equal(t, []byte("hash"), account.CodeHash)
+
+	_, err = client.GetAccount(context.Background(), &grpcapi.AddressRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestAccountService_GetAllESDTTokensAndKeyValuePairs(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetAllESDTTokensCalled: func(address string) (*data.GenericAPIResponse, error) {
+			return &data.GenericAPIResponse{
+				Data: map[string]interface{}{
+					"esdts": map[string]interface{}{
+						"TKN-0102": map[string]interface{}{"tokenIdentifier": "TKN-0102", "balance": "5"},
+						"ABC-0304": map[string]interface{}{"tokenIdentifier": "ABC-0304", "balance": "7"},
+					},
+				},
+			}, nil
+		},
+		GetKeyValuePairsHandler: func(address string) (*data.GenericAPIResponse, error) {
+			return &data.GenericAPIResponse{
+				Data: map[string]interface{}{
+					"pairs": map[string]string{"aa": "bb"},
+				},
+			}, nil
+		},
+	}
+	client := grpcapi.NewAccountServiceClient(startTestServer(t, facade))
+
+	tokens, err := client.GetAllESDTTokens(context.Background(), &grpcapi.AddressRequest{Address: "erd1a"})
+	require.NoError(t, err)
+	require.Len(t, tokens.Tokens, 2)
+	assert.Equal(t, "ABC-0304", tokens.Tokens[0].TokenIdentifier)
+	assert.Equal(t, "7", tokens.Tokens[0].Balance)
+	assert.Equal(t, "TKN-0102", tokens.Tokens[1].TokenIdentifier)
+
+	pairs, err := client.GetKeyValuePairs(context.Background(), &grpcapi.AddressRequest{Address: "erd1a"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"aa": "bb"}, pairs.Pairs)
+}
+
+func TestTransactionService_GetTransaction(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetTransactionHandler: func(txHash string, withResults bool) (*data.FullTransaction, error) {
+			if txHash == "missing" {
+				return nil, apiErrors.ErrTransactionNotFound
+			}
+
+			return &data.FullTransaction{
+				Hash:   txHash,
+				Status: transaction.TxStatusSuccess,
+				ScResults: []*transaction.ApiSmartContractResult{
+					{Hash: "scr"},
+				},
+			}, nil
+		},
+		GetTransactionByHashAndSenderAddressHandler: func(txHash string, sndAddr string, withResults bool) (*data.FullTransaction, int, error) {
+			return &data.FullTransaction{Hash: txHash, Sender: sndAddr}, 0, nil
+		},
+	}
+	client := grpcapi.NewTransactionServiceClient(startTestServer(t, facade))
+
+	tx, err := client.GetTransaction(context.Background(), &grpcapi.GetTransactionRequest{Hash: "aa", WithResults: true})
+	require.NoError(t, err)
+	assert.Equal(t, "aa", tx.Hash)
+	assert.Equal(t, string(transaction.TxStatusSuccess), tx.Status)
+	require.Len(t, tx.SmartContractResults, 1)
+	assert.Equal(t, "scr", tx.SmartContractResults[0].Hash)
+
+	tx, err = client.GetTransaction(context.Background(), &grpcapi.GetTransactionRequest{Hash: "bb", Sender: "erd1a"})
+	require.NoError(t, err)
+	assert.Equal(t, "erd1a", tx.Sender)
+
+	_, err = client.GetTransaction(context.Background(), &grpcapi.GetTransactionRequest{Hash: "missing"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestTransactionService_SendTransaction(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := &mock.Facade{
+		SendTransactionHandler: func(tx *data.Transaction) (int, string, error) {
+			if tx.Nonce == 0 {
+				return 0, "", expectedErr
+			}
+
+			return 0, "hash-" + tx.Sender, nil
+		},
+	}
+	client := grpcapi.NewTransactionServiceClient(startTestServer(t, facade))
+
+	response, err := client.SendTransaction(context.Background(), &grpcapi.Transaction{Nonce: 1, Sender: "erd1a"})
+	require.NoError(t, err)
+	assert.Equal(t, "hash-erd1a", response.Hash)
+
+	_, err = client.SendTransaction(context.Background(), &grpcapi.Transaction{})
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.Equal(t, expectedErr.Error(), status.Convert(err).Message())
+}
+
+func TestTransactionService_SimulateTransaction(t *testing.T) {
+	t.Parallel()
+
+	isCrossShard := false
+	facade := &mock.Facade{
+		SimulateTransactionHandler: func(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error) {
+			assert.False(t, checkSignature)
+			if !isCrossShard {
+				return &data.GenericAPIResponse{
+					Data: data.TransactionSimulationResponseData{
+						Result: data.TransactionSimulationResults{Status: transaction.TxStatusSuccess, Hash: "hash"},
+					},
+				}, nil
+			}
+
+			return &data.GenericAPIResponse{
+				Data: data.TransactionSimulationResponseDataCrossShard{
+					Result: map[string]data.TransactionSimulationResults{
+						"senderShard":   {Status: transaction.TxStatusSuccess},
+						"receiverShard": {Status: transaction.TxStatusFail, FailReason: "reason"},
+					},
+				},
+			}, nil
+		},
+	}
+	client := grpcapi.NewTransactionServiceClient(startTestServer(t, facade))
+	request := &grpcapi.SimulateTransactionRequest{
+		Transaction:        &grpcapi.Transaction{Nonce: 1},
+		SkipSignatureCheck: true,
+	}
+
+	response, err := client.SimulateTransaction(context.Background(), request)
+	require.NoError(t, err)
+	assert.False(t, response.IsCrossShard)
+	assert.Equal(t, "hash", response.SenderShard.Hash)
+	assert.Nil(t, response.ReceiverShard)
+
+	isCrossShard = true
+	response, err = client.SimulateTransaction(context.Background(), request)
+	require.NoError(t, err)
+	assert.True(t, response.IsCrossShard)
+	assert.Equal(t, string(transaction.TxStatusSuccess), response.SenderShard.Status)
+	assert.Equal(t, "reason", response.ReceiverShard.FailReason)
+
+	_, err = client.SimulateTransaction(context.Background(), &grpcapi.SimulateTransactionRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestNodeStatusService_GetNetworkConfig(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetConfigMetricsHandler: func() (*data.GenericAPIResponse, error) {
+			return &data.GenericAPIResponse{
+				Data: map[string]interface{}{
+					"config": map[string]interface{}{
+						"erd_chain_id":                "T",
+						"erd_min_gas_price":           1000000000,
+						"erd_num_shards_without_meta": 3,
+					},
+				},
+			}, nil
+		},
+	}
+	client := grpcapi.NewNodeStatusServiceClient(startTestServer(t, facade))
+
+	networkConfig, err := client.GetNetworkConfig(context.Background(), &grpcapi.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, "T", networkConfig.ChainID)
+	assert.Equal(t, uint64(1000000000), networkConfig.MinGasPrice)
+	assert.Equal(t, uint32(3), networkConfig.NumShardsWithoutMeta)
+}
+
+func TestSCQueryService_ExecuteQuery(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		ExecuteSCQueryHandler: func(query *data.SCQuery) (*vm.VMOutputApi, error) {
+			return &vm.VMOutputApi{
+				ReturnData: query.Arguments,
+				ReturnCode: "ok",
+			}, nil
+		},
+	}
+	client := grpcapi.NewSCQueryServiceClient(startTestServer(t, facade))
+
+	vmOutput, err := client.ExecuteQuery(context.Background(), &grpcapi.SCQuery{
+		ScAddress: "erd1qqq",
+		FuncName:  "get",
+		Arguments: [][]byte{[]byte("arg")},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "ok", vmOutput.ReturnCode)
+	assert.Equal(t, [][]byte{[]byte("arg")}, vmOutput.ReturnData)
+}
+
+func TestBlockService_StreamHyperBlocks(t *testing.T) {
+	t.Parallel()
+
+	latestNonce := uint64(5)
+	numFailures := int32(1)
+	facade := &mock.Facade{
+		GetLatestHyperblockNonceCalled: func() (uint64, error) {
+			return atomic.LoadUint64(&latestNonce), nil
+		},
+		GetHyperBlockByNonceCalled: func(nonce uint64) (*data.HyperblockApiResponse, error) {
+			if nonce == 7 && atomic.AddInt32(&numFailures, -1) >= 0 {
+				return nil, errors.New("observer not synchronized")
+			}
+
+			return data.NewHyperblockApiResponse(data.Hyperblock{Nonce: nonce}), nil
+		},
+	}
+	client := grpcapi.NewBlockServiceClient(startTestServer(t, facade))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	stream, err := client.StreamHyperBlocks(ctx, &grpcapi.StreamHyperBlocksRequest{StartNonce: 4})
+	require.NoError(t, err)
+
+	receivedNonces := make([]uint64, 0)
+	for len(receivedNonces) < 5 {
+		hyperblock, errRecv := stream.Recv()
+		require.NoError(t, errRecv)
+		receivedNonces = append(receivedNonces, hyperblock.Nonce)
+
+		if hyperblock.Nonce == 5 {
+			atomic.StoreUint64(&latestNonce, 8)
+		}
+	}
+
+	assert.Equal(t, []uint64{4, 5, 6, 7, 8}, receivedNonces)
+
+	cancel()
+	_, err = stream.Recv()
+	assert.NotEqual(t, io.EOF, err)
+}
+
+func TestBlockService_StreamHyperBlocksShouldStartWithTheLatestNonce(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetLatestHyperblockNonceCalled: func() (uint64, error) {
+			return 10, nil
+		},
+		GetHyperBlockByNonceCalled: func(nonce uint64) (*data.HyperblockApiResponse, error) {
+			return data.NewHyperblockApiResponse(data.Hyperblock{Nonce: nonce}), nil
+		},
+	}
+	client := grpcapi.NewBlockServiceClient(startTestServer(t, facade))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	stream, err := client.StreamHyperBlocks(ctx, &grpcapi.StreamHyperBlocksRequest{StartNonce: 3, FromLatest: true})
+	require.NoError(t, err)
+
+	hyperblock, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), hyperblock.Nonce)
+}
+
+func TestBlockService_StreamHyperBlocksShouldStartWithNonceZero(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.Facade{
+		GetLatestHyperblockNonceCalled: func() (uint64, error) {
+			return 10, nil
+		},
+		GetHyperBlockByNonceCalled: func(nonce uint64) (*data.HyperblockApiResponse, error) {
+			return data.NewHyperblockApiResponse(data.Hyperblock{Nonce: nonce}), nil
+		},
+	}
+	client := grpcapi.NewBlockServiceClient(startTestServer(t, facade))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	stream, err := client.StreamHyperBlocks(ctx, &grpcapi.StreamHyperBlocksRequest{})
+	require.NoError(t, err)
+
+	hyperblock, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), hyperblock.Nonce)
+}
@@ -0,0 +1,161 @@
+package grpcapi
+
+import (
+	"context"
+	"encoding/json"
+
+	apiErrors "github.com/ElrondNetwork/elrond-proxy-go/api/errors"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+const (
+	senderShardResultKey   = "senderShard"
+	receiverShardResultKey = "receiverShard"
+)
+
+type transactionService struct {
+	facade TransactionFacadeHandler
+}
+
+// SendTransaction sends the transaction to an observer from the sender's shard and returns its hash
+func (ts *transactionService) SendTransaction(_ context.Context, tx *Transaction) (*TransactionHashResponse, error) {
+	_, txHash, err := ts.facade.SendTransaction(toDataTransaction(tx))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &TransactionHashResponse{Hash: txHash}, nil
+}
+
+// SendMultipleTransactions sends the transactions to the observers from their senders' shards
+func (ts *transactionService) SendMultipleTransactions(_ context.Context, request *MultipleTransactionsRequest) (*MultipleTransactionsResponse, error) {
+	txs := make([]*data.Transaction, 0, len(request.Transactions))
+	for _, tx := range request.Transactions {
+		if tx == nil {
+			return nil, invalidArgumentError(ErrNilTransaction)
+		}
+		txs = append(txs, toDataTransaction(tx))
+	}
+
+	response, err := ts.facade.SendMultipleTransactions(txs)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	txsHashes := make(map[uint32]string, len(response.TxsHashes))
+	for index, hash := range response.TxsHashes {
+		txsHashes[uint32(index)] = hash
+	}
+
+	return &MultipleTransactionsResponse{
+		NumOfSentTxs: response.NumOfTxs,
+		TxsHashes:    txsHashes,
+	}, nil
+}
+
+// SimulateTransaction simulates the transaction's execution. Cross-shard transactions are simulated in both the
+// sender's and the receiver's shards
+func (ts *transactionService) SimulateTransaction(_ context.Context, request *SimulateTransactionRequest) (*SimulationResponse, error) {
+	if request.Transaction == nil {
+		return nil, invalidArgumentError(ErrNilTransaction)
+	}
+
+	response, err := ts.facade.SimulateTransaction(toDataTransaction(request.Transaction), !request.SkipSignatureCheck)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	resultData := struct {
+		Result map[string]json.RawMessage `json:"result"`
+	}{}
+	err = convertResponseData(response, &resultData)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	_, isCrossShard := resultData.Result[senderShardResultKey]
+	if !isCrossShard {
+		sameShardResult := &data.TransactionSimulationResponseData{}
+		err = convertResponseData(response, sameShardResult)
+		if err != nil {
+			return nil, toStatusError(err)
+		}
+
+		return &SimulationResponse{
+			SenderShard: fromSimulationResults(&sameShardResult.Result),
+		}, nil
+	}
+
+	crossShardResult := &data.TransactionSimulationResponseDataCrossShard{}
+	err = convertResponseData(response, crossShardResult)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	senderShardResult := crossShardResult.Result[senderShardResultKey]
+	receiverShardResult := crossShardResult.Result[receiverShardResultKey]
+
+	return &SimulationResponse{
+		SenderShard:   fromSimulationResults(&senderShardResult),
+		ReceiverShard: fromSimulationResults(&receiverShardResult),
+		IsCrossShard:  true,
+	}, nil
+}
+
+// TransactionCost returns the gas units needed by the transaction
+func (ts *transactionService) TransactionCost(_ context.Context, tx *Transaction) (*TransactionCostResponse, error) {
+	response, err := ts.facade.TransactionCostRequest(toDataTransaction(tx))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &TransactionCostResponse{
+		GasUnits:      response.TxCost,
+		ReturnMessage: response.RetMessage,
+	}, nil
+}
+
+// ComputeTransactionHash returns the hash of the transaction, without sending it
+func (ts *transactionService) ComputeTransactionHash(_ context.Context, tx *Transaction) (*TransactionHashResponse, error) {
+	txHash, err := ts.facade.ComputeTransactionHash(toDataTransaction(tx))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &TransactionHashResponse{Hash: txHash}, nil
+}
+
+// GetTransaction returns the transaction with the given hash. If the sender is provided, only the observers from
+// the sender's shard are queried
+func (ts *transactionService) GetTransaction(_ context.Context, request *GetTransactionRequest) (*FullTransaction, error) {
+	if len(request.Hash) == 0 {
+		return nil, invalidArgumentError(apiErrors.ErrTransactionHashMissing)
+	}
+
+	var tx *data.FullTransaction
+	var err error
+	if len(request.Sender) > 0 {
+		tx, _, err = ts.facade.GetTransactionByHashAndSenderAddress(request.Hash, request.Sender, request.WithResults)
+	} else {
+		tx, err = ts.facade.GetTransaction(request.Hash, request.WithResults)
+	}
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return fromFullTransaction(tx), nil
+}
+
+// GetTransactionStatus returns the status of the transaction with the given hash
+func (ts *transactionService) GetTransactionStatus(_ context.Context, request *TransactionStatusRequest) (*TransactionStatusResponse, error) {
+	if len(request.Hash) == 0 {
+		return nil, invalidArgumentError(apiErrors.ErrTransactionHashMissing)
+	}
+
+	txStatus, err := ts.facade.GetTransactionStatus(request.Hash, request.Sender)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &TransactionStatusResponse{Status: txStatus}, nil
+}
@@ -0,0 +1,265 @@
+package jsonrpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+)
+
+var log = logger.GetOrCreate("api/jsonrpc")
+
+var nullID = json.RawMessage("null")
+
+type dispatcher struct {
+	mutMethods         sync.RWMutex
+	methods            map[string]MethodHandler
+	mutLimits          sync.RWMutex
+	maxBatchSize       int
+	maxConcurrentCalls int
+}
+
+// NewDispatcher returns a new instance of dispatcher. The requests from a batch are processed concurrently, at most
+// maxConcurrentCalls at a time
+func NewDispatcher(maxBatchSize int, maxConcurrentCalls int) (*dispatcher, error) {
+	if maxBatchSize < 1 {
+		return nil, ErrInvalidMaxBatchSize
+	}
+	if maxConcurrentCalls < 1 {
+		return nil, ErrInvalidMaxConcurrentCalls
+	}
+
+	return &dispatcher{
+		methods:            make(map[string]MethodHandler),
+		maxBatchSize:       maxBatchSize,
+		maxConcurrentCalls: maxConcurrentCalls,
+	}, nil
+}
+
+// SetLimits changes the maximum number of requests of a batch and the maximum number of requests processed concurrently
+func (d *dispatcher) SetLimits(maxBatchSize int, maxConcurrentCalls int) error {
+	if maxBatchSize < 1 {
+		return ErrInvalidMaxBatchSize
+	}
+	if maxConcurrentCalls < 1 {
+		return ErrInvalidMaxConcurrentCalls
+	}
+
+	d.mutLimits.Lock()
+	d.maxBatchSize = maxBatchSize
+	d.maxConcurrentCalls = maxConcurrentCalls
+	d.mutLimits.Unlock()
+
+	return nil
+}
+
+// RegisterMethod will register the handler for the given method name
+func (d *dispatcher) RegisterMethod(name string, handler MethodHandler) error {
+	if handler == nil {
+		return ErrNilMethodHandler
+	}
+
+	d.mutMethods.Lock()
+	defer d.mutMethods.Unlock()
+
+	_, exists := d.methods[name]
+	if exists {
+		return fmt.Errorf("%w: %s", ErrMethodAlreadyRegistered, name)
+	}
+	d.methods[name] = handler
+
+	return nil
+}
+
+// Methods returns the sorted names of the registered methods
+func (d *dispatcher) Methods() []string {
+	d.mutMethods.RLock()
+	defer d.mutMethods.RUnlock()
+
+	names := make([]string, 0, len(d.methods))
+	for name := range d.methods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// HandleMessage processes a single request or a batch of requests and returns the value that should be sent back
+// to the client. The returned boolean is false if there is nothing to send back (the message only had notifications)
+func (d *dispatcher) HandleMessage(message []byte) (interface{}, bool) {
+	trimmedMessage := bytes.TrimSpace(message)
+	if len(trimmedMessage) > 0 && trimmedMessage[0] == '[' {
+		return d.handleBatch(trimmedMessage)
+	}
+
+	request := &Request{}
+	err := json.Unmarshal(trimmedMessage, request)
+	if err != nil {
+		return newErrorResponse(nullID, newRequestError(ErrorCodeParse, err.Error())), true
+	}
+
+	response := d.handleRequest(request)
+	if request.IsNotification() {
+		return nil, false
+	}
+
+	return response, true
+}
+
+func (d *dispatcher) handleBatch(message []byte) (interface{}, bool) {
+	rawRequests := make([]json.RawMessage, 0)
+	err := json.Unmarshal(message, &rawRequests)
+	if err != nil {
+		return newErrorResponse(nullID, newRequestError(ErrorCodeParse, err.Error())), true
+	}
+	if len(rawRequests) == 0 {
+		return newErrorResponse(nullID, newRequestError(ErrorCodeInvalidRequest, "empty batch")), true
+	}
+
+	d.mutLimits.RLock()
+	maxBatchSize, maxConcurrentCalls := d.maxBatchSize, d.maxConcurrentCalls
+	d.mutLimits.RUnlock()
+
+	if len(rawRequests) > maxBatchSize {
+		message := fmt.Sprintf("batch too large: %d requests, maximum is %d", len(rawRequests), maxBatchSize)
+		return newErrorResponse(nullID, newRequestError(ErrorCodeInvalidRequest, message)), true
+	}
+
+	responses := make([]*Response, len(rawRequests))
+	isNotification := make([]bool, len(rawRequests))
+	semaphore := make(chan struct{}, maxConcurrentCalls)
+	wg := sync.WaitGroup{}
+	for i, rawRequest := range rawRequests {
+		request := &Request{}
+		err = json.Unmarshal(rawRequest, request)
+		if err != nil {
+			responses[i] = newErrorResponse(nullID, newRequestError(ErrorCodeInvalidRequest, err.Error()))
+			continue
+		}
+
+		isNotification[i] = request.IsNotification()
+		semaphore <- struct{}{}
+		wg.Add(1)
+		go func(idx int, req *Request) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			responses[idx] = d.handleRequest(req)
+		}(i, request)
+	}
+	wg.Wait()
+
+	batchResponse := make([]*Response, 0, len(responses))
+	for i, response := range responses {
+		if isNotification[i] {
+			continue
+		}
+		batchResponse = append(batchResponse, response)
+	}
+	if len(batchResponse) == 0 {
+		return nil, false
+	}
+
+	return batchResponse, true
+}
+
+func (d *dispatcher) handleRequest(request *Request) (response *Response) {
+	id := request.ID
+	if len(id) == 0 {
+		id = nullID
+	}
+
+	if request.JSONRPC != Version {
+		return newErrorResponse(id, newRequestError(ErrorCodeInvalidRequest, "jsonrpc field must be \""+Version+"\""))
+	}
+	if len(request.Method) == 0 {
+		return newErrorResponse(id, newRequestError(ErrorCodeInvalidRequest, "empty method"))
+	}
+
+	d.mutMethods.RLock()
+	handler, exists := d.methods[request.Method]
+	d.mutMethods.RUnlock()
+	if !exists {
+		return newErrorResponse(id, NewMethodNotFoundError(request.Method))
+	}
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			log.Error("JSON-RPC method panicked", "method", request.Method, "error", r)
+			response = newErrorResponse(id, NewInternalError(fmt.Errorf("%v", r)))
+		}
+	}()
+
+	result, err := handler(request.Params)
+	if err != nil {
+		rpcErr, ok := err.(*Error)
+		if !ok {
+			rpcErr = NewInternalError(err)
+		}
+
+		return newErrorResponse(id, rpcErr)
+	}
+
+	return &Response{
+		JSONRPC: Version,
+		Result:  result,
+		ID:      id,
+	}
+}
+
+// GetCalledMethods returns the method of each request from a message holding a single request or a batch. The requests
+// which cannot be parsed are skipped, as they are rejected when the message is handled
+func GetCalledMethods(message []byte) []string {
+	type methodHolder struct {
+		Method string `json:"method"`
+	}
+
+	trimmedMessage := bytes.TrimSpace(message)
+	if len(trimmedMessage) == 0 || trimmedMessage[0] != '[' {
+		request := methodHolder{}
+		err := json.Unmarshal(trimmedMessage, &request)
+		if err != nil {
+			return nil
+		}
+
+		return []string{request.Method}
+	}
+
+	rawRequests := make([]json.RawMessage, 0)
+	err := json.Unmarshal(trimmedMessage, &rawRequests)
+	if err != nil {
+		return nil
+	}
+
+	methods := make([]string, 0, len(rawRequests))
+	for _, rawRequest := range rawRequests {
+		request := methodHolder{}
+		err = json.Unmarshal(rawRequest, &request)
+		if err != nil {
+			continue
+		}
+		methods = append(methods, request.Method)
+	}
+
+	return methods
+}
+
+func newErrorResponse(id json.RawMessage, err *Error) *Response {
+	return &Response{
+		JSONRPC: Version,
+		Error:   err,
+		ID:      id,
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (d *dispatcher) IsInterfaceNil() bool {
+	return d == nil
+}
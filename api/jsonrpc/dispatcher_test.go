@@ -0,0 +1,224 @@
+package jsonrpc
+
+import (
+	"encoding/json"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createDispatcherWithEcho(t *testing.T) *dispatcher {
+	d, err := NewDispatcher(10, 5)
+	require.Nil(t, err)
+
+	err = d.RegisterMethod("echo", func(params json.RawMessage) (interface{}, error) {
+		return params, nil
+	})
+	require.Nil(t, err)
+
+	return d
+}
+
+func TestNewDispatcher_InvalidValuesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	d, err := NewDispatcher(0, 1)
+	assert.True(t, check.IfNil(d))
+	assert.Equal(t, ErrInvalidMaxBatchSize, err)
+
+	d, err = NewDispatcher(1, 0)
+	assert.True(t, check.IfNil(d))
+	assert.Equal(t, ErrInvalidMaxConcurrentCalls, err)
+}
+
+func TestDispatcher_RegisterMethod(t *testing.T) {
+	t.Parallel()
+
+	d := createDispatcherWithEcho(t)
+
+	err := d.RegisterMethod("nil", nil)
+	assert.Equal(t, ErrNilMethodHandler, err)
+
+	err = d.RegisterMethod("echo", func(params json.RawMessage) (interface{}, error) {
+		return nil, nil
+	})
+	assert.True(t, errors.Is(err, ErrMethodAlreadyRegistered))
+
+	_ = d.RegisterMethod("another", func(params json.RawMessage) (interface{}, error) {
+		return nil, nil
+	})
+	assert.Equal(t, []string{"another", "echo"}, d.Methods())
+}
+
+func TestDispatcher_HandleMessageSingleRequest(t *testing.T) {
+	t.Parallel()
+
+	d := createDispatcherWithEcho(t)
+
+	response, ok := d.HandleMessage([]byte(`{"jsonrpc":"2.0","method":"echo","params":{"a":1},"id":7}`))
+	require.True(t, ok)
+
+	rpcResponse := response.(*Response)
+	assert.Nil(t, rpcResponse.Error)
+	assert.Equal(t, json.RawMessage(`7`), rpcResponse.ID)
+	assert.Equal(t, json.RawMessage(`{"a":1}`), rpcResponse.Result)
+}
+
+func TestDispatcher_HandleMessageErrors(t *testing.T) {
+	t.Parallel()
+
+	d := createDispatcherWithEcho(t)
+	_ = d.RegisterMethod("fail", func(params json.RawMessage) (interface{}, error) {
+		return nil, errors.New("observer down")
+	})
+	_ = d.RegisterMethod("badParams", func(params json.RawMessage) (interface{}, error) {
+		return nil, NewInvalidParamsError(errors.New("missing address"))
+	})
+	_ = d.RegisterMethod("panic", func(params json.RawMessage) (interface{}, error) {
+		panic("unexpected")
+	})
+
+	testCases := []struct {
+		message    string
+		code       int
+		returnCode data.ReturnCode
+	}{
+		{message: `{"jsonrpc":`, code: ErrorCodeParse, returnCode: data.ReturnCodeRequestError},
+		{message: `{"jsonrpc":"1.0","method":"echo","id":1}`, code: ErrorCodeInvalidRequest, returnCode: data.ReturnCodeRequestError},
+		{message: `{"jsonrpc":"2.0","method":"missing","id":1}`, code: ErrorCodeMethodNotFound, returnCode: data.ReturnCodeRequestError},
+		{message: `{"jsonrpc":"2.0","method":"badParams","id":1}`, code: ErrorCodeInvalidParams, returnCode: data.ReturnCodeRequestError},
+		{message: `{"jsonrpc":"2.0","method":"fail","id":1}`, code: ErrorCodeInternal, returnCode: data.ReturnCodeInternalError},
+		{message: `{"jsonrpc":"2.0","method":"panic","id":1}`, code: ErrorCodeInternal, returnCode: data.ReturnCodeInternalError},
+		{message: `[]`, code: ErrorCodeInvalidRequest, returnCode: data.ReturnCodeRequestError},
+	}
+
+	for _, tc := range testCases {
+		response, ok := d.HandleMessage([]byte(tc.message))
+		require.True(t, ok, tc.message)
+
+		rpcResponse := response.(*Response)
+		require.NotNil(t, rpcResponse.Error, tc.message)
+		assert.Equal(t, tc.code, rpcResponse.Error.Code, tc.message)
+		assert.Equal(t, tc.returnCode, rpcResponse.Error.Data, tc.message)
+		assert.Nil(t, rpcResponse.Result, tc.message)
+	}
+}
+
+func TestDispatcher_HandleMessageNotificationShouldNotRespond(t *testing.T) {
+	t.Parallel()
+
+	d := createDispatcherWithEcho(t)
+
+	response, ok := d.HandleMessage([]byte(`{"jsonrpc":"2.0","method":"echo","params":{}}`))
+	assert.False(t, ok)
+	assert.Nil(t, response)
+
+	response, ok = d.HandleMessage([]byte(`[{"jsonrpc":"2.0","method":"echo"},{"jsonrpc":"2.0","method":"echo"}]`))
+	assert.False(t, ok)
+	assert.Nil(t, response)
+}
+
+func TestDispatcher_HandleMessageBatchShouldKeepOrder(t *testing.T) {
+	t.Parallel()
+
+	d := createDispatcherWithEcho(t)
+	_ = d.RegisterMethod("slow", func(params json.RawMessage) (interface{}, error) {
+		time.Sleep(50 * time.Millisecond)
+		return "slow", nil
+	})
+
+	message := `[
+		{"jsonrpc":"2.0","method":"slow","id":1},
+		{"jsonrpc":"2.0","method":"echo","params":2,"id":2},
+		{"jsonrpc":"2.0","method":"echo","params":3},
+		5,
+		{"jsonrpc":"2.0","method":"missing","id":"four"}
+	]`
+	response, ok := d.HandleMessage([]byte(message))
+	require.True(t, ok)
+
+	responses := response.([]*Response)
+	require.Equal(t, 4, len(responses))
+	assert.Equal(t, json.RawMessage(`1`), responses[0].ID)
+	assert.Equal(t, "slow", responses[0].Result)
+	assert.Equal(t, json.RawMessage(`2`), responses[1].ID)
+	assert.Equal(t, ErrorCodeInvalidRequest, responses[2].Error.Code)
+	assert.Equal(t, json.RawMessage(`"four"`), responses[3].ID)
+	assert.Equal(t, ErrorCodeMethodNotFound, responses[3].Error.Code)
+}
+
+func TestDispatcher_HandleMessageBatchShouldBeProcessedConcurrently(t *testing.T) {
+	t.Parallel()
+
+	d, _ := NewDispatcher(10, 10)
+	numInProgress := int32(0)
+	maxInProgress := int32(0)
+	_ = d.RegisterMethod("slow", func(params json.RawMessage) (interface{}, error) {
+		current := atomic.AddInt32(&numInProgress, 1)
+		for {
+			max := atomic.LoadInt32(&maxInProgress)
+			if current <= max || atomic.CompareAndSwapInt32(&maxInProgress, max, current) {
+				break
+			}
+		}
+		time.Sleep(50 * time.Millisecond)
+		atomic.AddInt32(&numInProgress, -1)
+
+		return nil, nil
+	})
+
+	message := `[{"jsonrpc":"2.0","method":"slow","id":1},{"jsonrpc":"2.0","method":"slow","id":2},{"jsonrpc":"2.0","method":"slow","id":3}]`
+	_, ok := d.HandleMessage([]byte(message))
+	require.True(t, ok)
+	assert.True(t, atomic.LoadInt32(&maxInProgress) > 1)
+}
+
+func TestDispatcher_HandleMessageBatchTooLargeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	d, _ := NewDispatcher(1, 1)
+
+	response, ok := d.HandleMessage([]byte(`[{"jsonrpc":"2.0","method":"a","id":1},{"jsonrpc":"2.0","method":"a","id":2}]`))
+	require.True(t, ok)
+	assert.Equal(t, ErrorCodeInvalidRequest, response.(*Response).Error.Code)
+}
+
+func TestDispatcher_SetLimits(t *testing.T) {
+	t.Parallel()
+
+	d, _ := NewDispatcher(1, 1)
+	assert.Equal(t, ErrInvalidMaxBatchSize, d.SetLimits(0, 1))
+	assert.Equal(t, ErrInvalidMaxConcurrentCalls, d.SetLimits(1, 0))
+
+	require.Nil(t, d.SetLimits(2, 1))
+	response, ok := d.HandleMessage([]byte(`[{"jsonrpc":"2.0","method":"a","id":1},{"jsonrpc":"2.0","method":"a","id":2}]`))
+	require.True(t, ok)
+	require.Len(t, response, 2)
+}
+
+func TestResponse_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	buff, err := json.Marshal(&Response{JSONRPC: Version, ID: json.RawMessage("1")})
+	require.Nil(t, err)
+	assert.Equal(t, `{"jsonrpc":"2.0","result":null,"id":1}`, string(buff))
+
+	buff, err = json.Marshal(newErrorResponse(json.RawMessage("1"), NewMethodNotFoundError("a")))
+	require.Nil(t, err)
+	assert.Equal(t, `{"jsonrpc":"2.0","error":{"code":-32601,"message":"method not found: a","data":"bad_request"},"id":1}`, string(buff))
+}
+
+func TestGetCalledMethods(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []string{"a"}, GetCalledMethods([]byte(` {"jsonrpc":"2.0","method":"a","id":1}`)))
+	assert.Equal(t, []string{"a", "b"}, GetCalledMethods([]byte(`[{"method":"a"}, 5, {"method":"b"}]`)))
+	assert.Nil(t, GetCalledMethods([]byte(`{"method":`)))
+	assert.Nil(t, GetCalledMethods([]byte(`[{"method":"a"}`)))
+}
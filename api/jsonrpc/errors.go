@@ -0,0 +1,15 @@
+package jsonrpc
+
+import "errors"
+
+// ErrNilMethodHandler signals that a nil method handler has been provided
+var ErrNilMethodHandler = errors.New("nil method handler")
+
+// ErrMethodAlreadyRegistered signals that the method has already been registered
+var ErrMethodAlreadyRegistered = errors.New("method already registered")
+
+// ErrInvalidMaxBatchSize signals that an invalid maximum batch size has been provided
+var ErrInvalidMaxBatchSize = errors.New("invalid maximum batch size")
+
+// ErrInvalidMaxConcurrentCalls signals that an invalid maximum number of concurrent calls has been provided
+var ErrInvalidMaxConcurrentCalls = errors.New("invalid maximum number of concurrent calls")
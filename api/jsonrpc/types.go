@@ -0,0 +1,112 @@
+package jsonrpc
+
+import (
+	"encoding/json"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// Version is the only JSON-RPC protocol version supported
+const Version = "2.0"
+
+const (
+	// ErrorCodeParse is returned when the request body is not a valid JSON
+	ErrorCodeParse = -32700
+
+	// ErrorCodeInvalidRequest is returned when the request object is not a valid JSON-RPC request
+	ErrorCodeInvalidRequest = -32600
+
+	// ErrorCodeMethodNotFound is returned when the requested method does not exist
+	ErrorCodeMethodNotFound = -32601
+
+	// ErrorCodeInvalidParams is returned when the method parameters are invalid
+	ErrorCodeInvalidParams = -32602
+
+	// ErrorCodeInternal is returned when the request could not be processed because of an internal error
+	ErrorCodeInternal = -32603
+)
+
+// Request defines a JSON-RPC 2.0 request object
+type Request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	ID      json.RawMessage `json:"id,omitempty"`
+}
+
+// IsNotification returns true if the request does not expect a response
+func (r *Request) IsNotification() bool {
+	return len(r.ID) == 0
+}
+
+// Response defines a JSON-RPC 2.0 response object
+type Response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Result  interface{}     `json:"result"`
+	Error   *Error          `json:"error,omitempty"`
+	ID      json.RawMessage `json:"id"`
+}
+
+// errorResponse is the serialized form of a failed response, which must not hold the result member
+type errorResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Error   *Error          `json:"error"`
+	ID      json.RawMessage `json:"id"`
+}
+
+// MarshalJSON serializes the response holding either the result, even if it is null, or the error
+func (r Response) MarshalJSON() ([]byte, error) {
+	if r.Error != nil {
+		return json.Marshal(errorResponse{JSONRPC: r.JSONRPC, Error: r.Error, ID: r.ID})
+	}
+
+	type successResponse Response
+	return json.Marshal(successResponse(r))
+}
+
+// Error defines a JSON-RPC 2.0 error object. The data field holds the proxy's return code for the failed request
+type Error struct {
+	Code    int             `json:"code"`
+	Message string          `json:"message"`
+	Data    data.ReturnCode `json:"data"`
+}
+
+// Error returns the error message
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// NewInvalidParamsError creates an error that signals invalid method parameters
+func NewInvalidParamsError(err error) *Error {
+	return &Error{
+		Code:    ErrorCodeInvalidParams,
+		Message: err.Error(),
+		Data:    data.ReturnCodeRequestError,
+	}
+}
+
+// NewInternalError creates an error that signals a failure while processing a valid request
+func NewInternalError(err error) *Error {
+	return &Error{
+		Code:    ErrorCodeInternal,
+		Message: err.Error(),
+		Data:    data.ReturnCodeInternalError,
+	}
+}
+
+// NewMethodNotFoundError creates an error that signals a method which does not exist or is not available
+func NewMethodNotFoundError(method string) *Error {
+	return newRequestError(ErrorCodeMethodNotFound, "method not found: "+method)
+}
+
+func newRequestError(code int, message string) *Error {
+	return &Error{
+		Code:    code,
+		Message: message,
+		Data:    data.ReturnCodeRequestError,
+	}
+}
+
+// MethodHandler defines the function called for a JSON-RPC method. Returning an *Error allows the handler to choose
+// the error code, any other error is reported as an internal error
+type MethodHandler func(params json.RawMessage) (interface{}, error)
@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/gin-gonic/gin"
+)
+
+type clientCertificateVerifier struct {
+}
+
+// NewClientCertificateVerifier returns a new instance of clientCertificateVerifier
+func NewClientCertificateVerifier() *clientCertificateVerifier {
+	return &clientCertificateVerifier{}
+}
+
+// MiddlewareHandlerFunc returns the gin middleware that rejects the requests which were not made over a TLS connection
+// authenticated with a client certificate verified against the configured client CA bundle
+func (ccv *clientCertificateVerifier) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		connectionState := c.Request.TLS
+		if connectionState != nil && len(connectionState.VerifiedChains) > 0 {
+			return
+		}
+
+		c.AbortWithStatusJSON(http.StatusUnauthorized, shared.GenericAPIResponse{
+			Data:  nil,
+			Error: "this endpoint requires a valid client certificate",
+			Code:  shared.ReturnCodeRequestError,
+		})
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ccv *clientCertificateVerifier) IsInterfaceNil() bool {
+	return ccv == nil
+}
@@ -0,0 +1,74 @@
+package middleware
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func startServerWithClientCertificateVerifier() *gin.Engine {
+	ccv := NewClientCertificateVerifier()
+
+	ws := gin.New()
+	group := ws.Group("/actions")
+	group.Use(ccv.MiddlewareHandlerFunc())
+	group.POST("/reload-observers", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	return ws
+}
+
+func TestNewClientCertificateVerifier(t *testing.T) {
+	t.Parallel()
+
+	ccv := NewClientCertificateVerifier()
+	require.False(t, check.IfNil(ccv))
+}
+
+func TestClientCertificateVerifier_PlainHttpRequestShouldBeRejected(t *testing.T) {
+	t.Parallel()
+
+	ws := startServerWithClientCertificateVerifier()
+
+	resp := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodPost, "/actions/reload-observers", nil)
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+}
+
+func TestClientCertificateVerifier_TLSRequestWithoutClientCertificateShouldBeRejected(t *testing.T) {
+	t.Parallel()
+
+	ws := startServerWithClientCertificateVerifier()
+
+	resp := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodPost, "/actions/reload-observers", nil)
+	req.TLS = &tls.ConnectionState{}
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+}
+
+func TestClientCertificateVerifier_VerifiedClientCertificateShouldPass(t *testing.T) {
+	t.Parallel()
+
+	ws := startServerWithClientCertificateVerifier()
+
+	resp := httptest.NewRecorder()
+	req, _ := http.NewRequest(http.MethodPost, "/actions/reload-observers", nil)
+	req.TLS = &tls.ConnectionState{
+		VerifiedChains: [][]*x509.Certificate{{&x509.Certificate{}}},
+	}
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	"encoding/hex"
+
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/hashing/factory"
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-proxy-go/config"
+)
+
+type credentialsVerifier struct {
+	accounts map[string]string
+	hasher   hashing.Hasher
+}
+
+// NewCredentialsVerifier returns a new instance of credentialsVerifier, which checks the provided credentials against
+// the ones from the credentials config
+func NewCredentialsVerifier(credentialsConfig config.CredentialsConfig) *credentialsVerifier {
+	hasher, err := factory.NewHasher(credentialsConfig.Hasher.Type)
+	if err != nil {
+		log.Warn("cannot create hasher from config. Will use Sha256 as default", "error", err)
+		hasher = sha256.Sha256{} // fallback in case the hasher creation failed
+	}
+
+	accounts := make(map[string]string)
+	for _, pair := range credentialsConfig.Credentials {
+		accounts[pair.Username] = pair.Password
+	}
+
+	return &credentialsVerifier{
+		accounts: accounts,
+		hasher:   hasher,
+	}
+}
+
+// Verify returns nil if the password matches the hashed password configured for the username
+func (cv *credentialsVerifier) Verify(username string, password string) error {
+	if len(cv.accounts) == 0 {
+		return ErrNoCredentialsOnServer
+	}
+
+	hashedPassword, ok := cv.accounts[username]
+	if !ok {
+		return ErrUnknownUsername
+	}
+	if hashedPassword != hex.EncodeToString(cv.hasher.Compute(password)) {
+		return ErrInvalidPassword
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cv *credentialsVerifier) IsInterfaceNil() bool {
+	return cv == nil
+}
@@ -0,0 +1,30 @@
+package middleware
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/hashing/sha256"
+	"github.com/ElrondNetwork/elrond-proxy-go/config"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCredentialsVerifier_Verify(t *testing.T) {
+	t.Parallel()
+
+	cv := NewCredentialsVerifier(config.CredentialsConfig{})
+	assert.False(t, cv.IsInterfaceNil())
+	assert.Equal(t, ErrNoCredentialsOnServer, cv.Verify("user", "pass"))
+
+	credentialsConfig := config.CredentialsConfig{
+		Credentials: []data.Credential{
+			{Username: "user", Password: hex.EncodeToString(sha256.Sha256{}.Compute("pass"))},
+		},
+	}
+	credentialsConfig.Hasher.Type = "sha256"
+	cv = NewCredentialsVerifier(credentialsConfig)
+	assert.Equal(t, ErrUnknownUsername, cv.Verify("other", "pass"))
+	assert.Equal(t, ErrInvalidPassword, cv.Verify("user", "wrong"))
+	assert.Nil(t, cv.Verify("user", "pass"))
+}
@@ -4,3 +4,12 @@ import "errors"
 
 // ErrNilLimitsMapForEndpoints signals that a nil limits map has been provided
 var ErrNilLimitsMapForEndpoints = errors.New("nil limits map")
+
+// ErrNoCredentialsOnServer signals that no credentials have been configured
+var ErrNoCredentialsOnServer = errors.New("no credentials found on server")
+
+// ErrUnknownUsername signals that the provided username does not exist
+var ErrUnknownUsername = errors.New("username does not exist")
+
+// ErrInvalidPassword signals that the provided password is not valid
+var ErrInvalidPassword = errors.New("invalid password")
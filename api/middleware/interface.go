@@ -5,5 +5,6 @@ import "github.com/ElrondNetwork/elrond-go/api"
 // RateLimiterHandler defines the actions that an implementation of rate limiter handler should do
 type RateLimiterHandler interface {
 	api.MiddlewareProcessor
+	AddRequests(endpoint string, clientIP string, numRequests uint64) (uint64, bool)
 	ResetMap(version string)
 }
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	proxyShared "github.com/ElrondNetwork/elrond-proxy-go/api/shared"
 	"github.com/gin-gonic/gin"
 )
 
@@ -29,42 +30,57 @@ func NewRateLimiter(limits map[string]uint64, countDuration time.Duration) (*rat
 	}, nil
 }
 
-// MiddlewareHandlerFunc returns the gin middleware for limiting the number of requests for a given endpoint
+// MiddlewareHandlerFunc returns the gin middleware for limiting the number of requests for a given endpoint. If the
+// context holds a list of endpoints, each entry of the list is counted as a request to that endpoint
 func (rl *rateLimiter) MiddlewareHandlerFunc() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		endpoint := c.FullPath()
+		endpoints, ok := c.Value(proxyShared.RateLimitedEndpointsContextKey).([]string)
+		if !ok {
+			endpoints = []string{c.FullPath()}
+		}
 
-		limitForEndpoint, isEndpointLimited := rl.limits[endpoint]
-		if !isEndpointLimited {
-			return
+		numRequestsPerEndpoint := make(map[string]uint64)
+		for _, endpoint := range endpoints {
+			numRequestsPerEndpoint[endpoint]++
 		}
 
 		clientIP := c.ClientIP()
-		key := fmt.Sprintf("%s_%s", endpoint, clientIP)
+		for endpoint, numRequests := range numRequestsPerEndpoint {
+			limitForEndpoint, isAllowed := rl.AddRequests(endpoint, clientIP, numRequests)
+			if isAllowed {
+				continue
+			}
 
-		numRequests := rl.addInRequestsMap(key)
-		if numRequests >= limitForEndpoint {
 			printMessage := fmt.Sprintf("your IP exceeded the limit of %d requests in %v for this endpoint", limitForEndpoint, rl.countDuration)
 			c.AbortWithStatusJSON(http.StatusTooManyRequests, shared.GenericAPIResponse{
 				Data:  nil,
 				Error: printMessage,
 				Code:  shared.ReturnCodeRequestError,
 			})
+			return
 		}
 	}
 }
 
-func (rl *rateLimiter) addInRequestsMap(key string) uint64 {
+// AddRequests counts the requests made by the client to the endpoint and returns the limit of the endpoint, along with
+// false if the client exceeded it
+func (rl *rateLimiter) AddRequests(endpoint string, clientIP string, numRequests uint64) (uint64, bool) {
+	limitForEndpoint, isEndpointLimited := rl.limits[endpoint]
+	if !isEndpointLimited {
+		return 0, true
+	}
+
+	key := fmt.Sprintf("%s_%s", endpoint, clientIP)
+	totalRequests := rl.addInRequestsMap(key, numRequests)
+
+	return limitForEndpoint, totalRequests < limitForEndpoint
+}
+
+func (rl *rateLimiter) addInRequestsMap(key string, numRequests uint64) uint64 {
 	rl.mutRequestsMap.Lock()
 	defer rl.mutRequestsMap.Unlock()
 
-	_, ok := rl.requestsMap[key]
-	if !ok {
-		rl.requestsMap[key] = 1
-		return 1
-	}
-
-	rl.requestsMap[key]++
+	rl.requestsMap[key] += numRequests
 
 	return rl.requestsMap[key]
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core/check"
 	"github.com/ElrondNetwork/elrond-proxy-go/api/groups"
 	"github.com/ElrondNetwork/elrond-proxy-go/api/mock"
+	proxyShared "github.com/ElrondNetwork/elrond-proxy-go/api/shared"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -129,3 +130,30 @@ func startProxyServer(group data.GroupHandler, rateLimiter RateLimiterHandler, r
 	group.RegisterRoutes(routes, apiConfig, func(_ *gin.Context) {}, rateLimiter.MiddlewareHandlerFunc())
 	return ws
 }
+
+func TestRateLimiter_ShouldCountTheEndpointsFromContext(t *testing.T) {
+	t.Parallel()
+
+	rl, _ := NewRateLimiter(map[string]uint64{"/rpc": 4, "/transaction/send": 3}, time.Millisecond)
+
+	ws := gin.New()
+	ws.POST("/rpc", func(c *gin.Context) {
+		c.Set(proxyShared.RateLimitedEndpointsContextKey, []string{"/rpc", "/transaction/send", "/rpc", "/transaction/send"})
+	}, rl.MiddlewareHandlerFunc(), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	resp := httptest.NewRecorder()
+	req, _ := http.NewRequest("POST", "/rpc", nil)
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	resp = httptest.NewRecorder()
+	req, _ = http.NewRequest("POST", "/rpc", nil)
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+
+	limit, isAllowed := rl.AddRequests("/not-limited", "ip", 100)
+	assert.Equal(t, uint64(0), limit)
+	assert.True(t, isAllowed)
+}
@@ -20,13 +20,26 @@ type Facade struct {
 	GetESDTsWithRoleCalled                      func(address string, role string) (*data.GenericAPIResponse, error)
 	GetNFTTokenIDsRegisteredByAddressCalled     func(address string) (*data.GenericAPIResponse, error)
 	GetAllESDTTokensCalled                      func(address string) (*data.GenericAPIResponse, error)
-	GetTransactionsHandler                      func(address string) ([]data.DatabaseTransaction, error)
+	GetAccountAtBlockHandler                    func(address string, options data.AccountQueryOptions) (*data.ResponseAccount, error)
+	GetValueForKeyAtBlockHandler                func(address string, key string, options data.AccountQueryOptions) (*data.AccountKeyValueResponseData, error)
+	GetKeyValuePairsAtBlockHandler              func(address string, options data.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetAllESDTTokensAtBlockHandler              func(address string, options data.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetESDTTokenDataAtBlockHandler              func(address string, key string, options data.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetBulkAccountsHandler                      func(addresses []string, fields []string) (map[string]*data.BulkAccountResult, error)
+	GetESDTNftTokenDataAtBlockHandler           func(address string, key string, nonce uint64, options data.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetTransactionsHandler                      func(address string, options data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error)
+	GetTokenTransfersHandler                    func(address string, options data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error)
+	GetEventsHandler                            func(address string, identifier string, options data.TransactionsHistoryOptions) ([]data.DatabaseEvent, error)
+	GetSCResultsByTxHashHandler                 func(txHash string) ([]data.DatabaseSCResult, error)
 	GetTransactionHandler                       func(txHash string, withResults bool) (*data.FullTransaction, error)
 	SendTransactionHandler                      func(tx *data.Transaction) (int, string, error)
 	SendMultipleTransactionsHandler             func(txs []*data.Transaction) (data.MultipleTransactionsResponseData, error)
 	SimulateTransactionHandler                  func(tx *data.Transaction, checkSignature bool) (*data.GenericAPIResponse, error)
 	SendUserFundsCalled                         func(receiver string, value *big.Int) error
 	ExecuteSCQueryHandler                       func(query *data.SCQuery) (*vm.VMOutputApi, error)
+	ExecuteSCQueriesHandler                     func(queries []*data.SCQuery) []*data.SCQueryResult
+	DecodeSCQueryOutputHandler                  func(query *data.SCQuery, vmOutput *vm.VMOutputApi) ([]interface{}, error)
+	DecodeTransactionHandler                    func(tx *data.FullTransaction)
 	GetHeartbeatDataHandler                     func() (*data.HeartbeatResponse, error)
 	ValidatorStatisticsHandler                  func() (map[string]*data.ValidatorApiResponse, error)
 	TransactionCostRequestHandler               func(tx *data.Transaction) (*data.TxCostResponseData, error)
@@ -49,6 +62,8 @@ type Facade struct {
 	GetProofCalled                              func(string, string) (*data.GenericAPIResponse, error)
 	GetProofCurrentRootHashCalled               func(string) (*data.GenericAPIResponse, error)
 	VerifyProofCalled                           func(string, string, []string) (*data.GenericAPIResponse, error)
+	GetLatestHyperblockNonceCalled              func() (uint64, error)
+	ComputeTransactionHashCalled                func(tx *data.Transaction) (string, error)
 }
 
 // GetProof -
@@ -202,6 +217,41 @@ func (f *Facade) GetValueForKey(address string, key string) (string, error) {
 	return f.GetValueForKeyHandler(address, key)
 }
 
+// GetAccountAtBlock -
+func (f *Facade) GetAccountAtBlock(address string, options data.AccountQueryOptions) (*data.ResponseAccount, error) {
+	return f.GetAccountAtBlockHandler(address, options)
+}
+
+// GetValueForKeyAtBlock -
+func (f *Facade) GetValueForKeyAtBlock(address string, key string, options data.AccountQueryOptions) (*data.AccountKeyValueResponseData, error) {
+	return f.GetValueForKeyAtBlockHandler(address, key, options)
+}
+
+// GetKeyValuePairsAtBlock -
+func (f *Facade) GetKeyValuePairsAtBlock(address string, options data.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return f.GetKeyValuePairsAtBlockHandler(address, options)
+}
+
+// GetAllESDTTokensAtBlock -
+func (f *Facade) GetAllESDTTokensAtBlock(address string, options data.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return f.GetAllESDTTokensAtBlockHandler(address, options)
+}
+
+// GetESDTTokenDataAtBlock -
+func (f *Facade) GetESDTTokenDataAtBlock(address string, key string, options data.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return f.GetESDTTokenDataAtBlockHandler(address, key, options)
+}
+
+// GetESDTNftTokenDataAtBlock -
+func (f *Facade) GetESDTNftTokenDataAtBlock(address string, key string, nonce uint64, options data.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return f.GetESDTNftTokenDataAtBlockHandler(address, key, nonce, options)
+}
+
+// GetBulkAccounts -
+func (f *Facade) GetBulkAccounts(addresses []string, fields []string) (map[string]*data.BulkAccountResult, error) {
+	return f.GetBulkAccountsHandler(addresses, fields)
+}
+
 // GetShardIDForAddress -
 func (f *Facade) GetShardIDForAddress(address string) (uint32, error) {
 	return f.GetShardIDForAddressHandler(address)
@@ -235,8 +285,23 @@ func (f *Facade) GetESDTNftTokenData(address string, key string, nonce uint64) (
 }
 
 // GetTransactions -
-func (f *Facade) GetTransactions(address string) ([]data.DatabaseTransaction, error) {
-	return f.GetTransactionsHandler(address)
+func (f *Facade) GetTransactions(address string, options data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error) {
+	return f.GetTransactionsHandler(address, options)
+}
+
+// GetTokenTransfers -
+func (f *Facade) GetTokenTransfers(address string, options data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error) {
+	return f.GetTokenTransfersHandler(address, options)
+}
+
+// GetEvents -
+func (f *Facade) GetEvents(address string, identifier string, options data.TransactionsHistoryOptions) ([]data.DatabaseEvent, error) {
+	return f.GetEventsHandler(address, identifier, options)
+}
+
+// GetSCResultsByTxHash -
+func (f *Facade) GetSCResultsByTxHash(txHash string) ([]data.DatabaseSCResult, error) {
+	return f.GetSCResultsByTxHashHandler(txHash)
 }
 
 // GetTransactionByHashAndSenderAddress -
@@ -289,6 +354,21 @@ func (f *Facade) ExecuteSCQuery(query *data.SCQuery) (*vm.VMOutputApi, error) {
 	return f.ExecuteSCQueryHandler(query)
 }
 
+// ExecuteSCQueries -
+func (f *Facade) ExecuteSCQueries(queries []*data.SCQuery) []*data.SCQueryResult {
+	return f.ExecuteSCQueriesHandler(queries)
+}
+
+// DecodeSCQueryOutput -
+func (f *Facade) DecodeSCQueryOutput(query *data.SCQuery, vmOutput *vm.VMOutputApi) ([]interface{}, error) {
+	return f.DecodeSCQueryOutputHandler(query, vmOutput)
+}
+
+// DecodeTransaction -
+func (f *Facade) DecodeTransaction(tx *data.FullTransaction) {
+	f.DecodeTransactionHandler(tx)
+}
+
 // GetHeartbeatData -
 func (f *Facade) GetHeartbeatData() (*data.HeartbeatResponse, error) {
 	return f.GetHeartbeatDataHandler()
@@ -319,6 +399,24 @@ func (f *Facade) GetHyperBlockByNonce(nonce uint64) (*data.HyperblockApiResponse
 	return f.GetHyperBlockByNonceCalled(nonce)
 }
 
+// GetLatestFullySynchronizedHyperblockNonce -
+func (f *Facade) GetLatestFullySynchronizedHyperblockNonce() (uint64, error) {
+	if f.GetLatestHyperblockNonceCalled != nil {
+		return f.GetLatestHyperblockNonceCalled()
+	}
+
+	return 0, nil
+}
+
+// ComputeTransactionHash -
+func (f *Facade) ComputeTransactionHash(tx *data.Transaction) (string, error) {
+	if f.ComputeTransactionHashCalled != nil {
+		return f.ComputeTransactionHashCalled(tx)
+	}
+
+	return "", nil
+}
+
 // WrongFacade is a struct that can be used as a wrong implementation of the node router handler
 type WrongFacade struct {
 }
@@ -0,0 +1,12 @@
+package openapi
+
+import "errors"
+
+// ErrNilVersionData signals that a nil version data has been provided
+var ErrNilVersionData = errors.New("nil version data")
+
+// ErrNilApiHandler signals that a nil api handler has been provided
+var ErrNilApiHandler = errors.New("nil api handler")
+
+// ErrUnsupportedMethod signals that an endpoint uses a HTTP method which cannot be described
+var ErrUnsupportedMethod = errors.New("unsupported HTTP method")
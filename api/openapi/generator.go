@@ -0,0 +1,225 @@
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go-logger/check"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+const (
+	documentTitle      = "Elrond Proxy REST API"
+	jsonContentType    = "application/json"
+	basicAuthScheme    = "basicAuth"
+	successDescription = "successful operation"
+)
+
+// CreateSpecification generates the OpenAPI document of an API version. Only the endpoints opened in the version's
+// routes configuration are described
+func CreateSpecification(version string, versionData *data.VersionData, rateLimitWindowInSeconds int) (*Document, error) {
+	if versionData == nil {
+		return nil, ErrNilVersionData
+	}
+	if check.IfNil(versionData.ApiHandler) {
+		return nil, ErrNilApiHandler
+	}
+
+	schemas := newSchemasGenerator()
+	document := &Document{
+		OpenAPI: Version,
+		Info: Info{
+			Title:   documentTitle,
+			Version: version,
+		},
+		Servers: []Server{{URL: "/" + version}},
+		Paths:   make(map[string]*PathItem),
+	}
+
+	groups := versionData.ApiHandler.GetAllGroups()
+	groupsPaths := make([]string, 0, len(groups))
+	for groupPath := range groups {
+		groupsPaths = append(groupsPaths, groupPath)
+	}
+	sort.Strings(groupsPaths)
+
+	hasSecuredOperations := false
+	for _, groupPath := range groupsPaths {
+		packageName := strings.TrimPrefix(groupPath, "/")
+		packageConfig, ok := versionData.ApiConfig.APIPackages[packageName]
+		if !ok {
+			continue
+		}
+
+		for _, endpoint := range groups[groupPath].GetEndpoints() {
+			routeConfig, isOpen := getOpenRouteConfig(packageConfig, endpoint.Path)
+			if !isOpen {
+				continue
+			}
+
+			path, parameters := convertPath(groupPath + endpoint.Path)
+			parameters = append(parameters, convertQueryParameters(endpoint.QueryParameters)...)
+			operation := &Operation{
+				OperationID:                 createOperationID(endpoint.Method, path),
+				Tags:                        []string{packageName},
+				Parameters:                  parameters,
+				Responses:                   createResponses(schemas, endpoint.Response, routeConfig.Secured),
+				IsClientCertificateRequired: packageConfig.RequireClientCertificate,
+			}
+			if endpoint.Request != nil {
+				operation.RequestBody = &RequestBody{
+					Required: true,
+					Content:  jsonContent(schemas.schemaForSample(endpoint.Request)),
+				}
+			}
+			if routeConfig.Secured {
+				hasSecuredOperations = true
+				operation.Security = []map[string][]string{{basicAuthScheme: {}}}
+			}
+			if routeConfig.RateLimit > 0 {
+				operation.RateLimit = &RateLimit{
+					Requests:      routeConfig.RateLimit,
+					WindowSeconds: rateLimitWindowInSeconds,
+				}
+			}
+
+			err := document.addOperation(path, endpoint.Method, operation)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	document.Components.Schemas = schemas.schemas
+	if hasSecuredOperations {
+		document.Components.SecuritySchemes = map[string]SecurityScheme{
+			basicAuthScheme: {Type: "http", Scheme: "basic"},
+		}
+	}
+
+	return document, nil
+}
+
+func (doc *Document) addOperation(path string, method string, operation *Operation) error {
+	pathItem, ok := doc.Paths[path]
+	if !ok {
+		pathItem = &PathItem{}
+		doc.Paths[path] = pathItem
+	}
+
+	switch method {
+	case http.MethodGet:
+		pathItem.Get = operation
+	case http.MethodPut:
+		pathItem.Put = operation
+	case http.MethodPost:
+		pathItem.Post = operation
+	case http.MethodDelete:
+		pathItem.Delete = operation
+	case http.MethodPatch:
+		pathItem.Patch = operation
+	default:
+		return fmt.Errorf("%w: %s for path %s", ErrUnsupportedMethod, method, path)
+	}
+
+	return nil
+}
+
+func getOpenRouteConfig(packageConfig data.APIPackageConfig, path string) (data.RouteConfig, bool) {
+	for _, route := range packageConfig.Routes {
+		if route.Name == path {
+			return route, route.Open
+		}
+	}
+
+	return data.RouteConfig{}, false
+}
+
+// convertPath converts a gin path into an OpenAPI path template, returning the path parameters as well
+func convertPath(ginPath string) (string, []Parameter) {
+	segments := strings.Split(ginPath, "/")
+	parameters := make([]Parameter, 0)
+	for i, segment := range segments {
+		if !strings.HasPrefix(segment, ":") && !strings.HasPrefix(segment, "*") {
+			continue
+		}
+
+		name := segment[1:]
+		segments[i] = "{" + name + "}"
+		parameters = append(parameters, Parameter{
+			Name:     name,
+			In:       "path",
+			Required: true,
+			Schema:   &Schema{Type: "string"},
+		})
+	}
+
+	return strings.Join(segments, "/"), parameters
+}
+
+// convertQueryParameters converts the query parameters of an endpoint into optional OpenAPI parameters
+func convertQueryParameters(queryParameters []data.QueryParameter) []Parameter {
+	parameters := make([]Parameter, 0, len(queryParameters))
+	for _, queryParameter := range queryParameters {
+		parameters = append(parameters, Parameter{
+			Name:        queryParameter.Name,
+			In:          "query",
+			Description: queryParameter.Description,
+			Schema:      &Schema{Type: queryParameter.Type},
+		})
+	}
+
+	return parameters
+}
+
+// createOperationID builds an unique operation identifier from the method and the path, such as
+// getAddressByAddressBalance for GET /address/{address}/balance
+func createOperationID(method string, path string) string {
+	operationID := strings.ToLower(method)
+	for _, segment := range strings.Split(path, "/") {
+		if len(segment) == 0 {
+			continue
+		}
+
+		isParameter := strings.HasPrefix(segment, "{")
+		if isParameter {
+			operationID += "By"
+			segment = strings.Trim(segment, "{}")
+		}
+
+		for _, word := range strings.FieldsFunc(segment, isWordSeparator) {
+			operationID += strings.ToUpper(word[:1]) + word[1:]
+		}
+	}
+
+	return operationID
+}
+
+func isWordSeparator(r rune) bool {
+	return r == '-' || r == '_' || r == '.'
+}
+
+func createResponses(schemas *schemasGenerator, responseSample interface{}, isSecured bool) map[string]Response {
+	responses := map[string]Response{
+		"200": {
+			Description: successDescription,
+			Content:     jsonContent(schemas.schemaForSample(responseSample)),
+		},
+	}
+	if isSecured {
+		responses["401"] = Response{
+			Description: "missing or invalid credentials",
+			Content:     jsonContent(schemas.schemaForSample(data.GenericAPIResponse{})),
+		}
+	}
+
+	return responses
+}
+
+func jsonContent(schema *Schema) map[string]MediaType {
+	return map[string]MediaType{
+		jsonContentType: {Schema: schema},
+	}
+}
@@ -0,0 +1,184 @@
+package openapi_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/api"
+	"github.com/ElrondNetwork/elrond-proxy-go/api/mock"
+	"github.com/ElrondNetwork/elrond-proxy-go/api/openapi"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testGroup struct {
+	endpoints []data.EndpointHandlerData
+}
+
+func (tg *testGroup) AddEndpoint(_ string, _ data.EndpointHandlerData) error    { return nil }
+func (tg *testGroup) UpdateEndpoint(_ string, _ data.EndpointHandlerData) error { return nil }
+func (tg *testGroup) RemoveEndpoint(_ string) error                             { return nil }
+func (tg *testGroup) GetEndpoints() []data.EndpointHandlerData                  { return tg.endpoints }
+func (tg *testGroup) IsInterfaceNil() bool                                      { return tg == nil }
+func (tg *testGroup) RegisterRoutes(_ *gin.RouterGroup, _ data.ApiRoutesConfig, _ gin.HandlerFunc, _ gin.HandlerFunc) {
+}
+
+type transferRequest struct {
+	Receiver string `json:"receiver"`
+	Amount   uint64 `json:"amount,string"`
+	Ignored  string `json:"-"`
+}
+
+func createVersionData(t *testing.T, endpoints []data.EndpointHandlerData, routes []data.RouteConfig) *data.VersionData {
+	apiHandler, err := api.NewApiHandler(&mock.Facade{})
+	require.NoError(t, err)
+	for path := range apiHandler.GetAllGroups() {
+		require.NoError(t, apiHandler.RemoveGroup(path))
+	}
+	require.NoError(t, apiHandler.AddGroup("/test", &testGroup{endpoints: endpoints}))
+
+	return &data.VersionData{
+		ApiHandler: apiHandler,
+		ApiConfig: data.ApiRoutesConfig{
+			APIPackages: map[string]data.APIPackageConfig{
+				"test": {Routes: routes, RequireClientCertificate: true},
+			},
+		},
+	}
+}
+
+func TestCreateSpecification_InvalidArguments(t *testing.T) {
+	t.Parallel()
+
+	document, err := openapi.CreateSpecification("v1.0", nil, 60)
+	assert.Nil(t, document)
+	assert.Equal(t, openapi.ErrNilVersionData, err)
+
+	document, err = openapi.CreateSpecification("v1.0", &data.VersionData{}, 60)
+	assert.Nil(t, document)
+	assert.Equal(t, openapi.ErrNilApiHandler, err)
+}
+
+func TestCreateSpecification_UnsupportedMethodShouldErr(t *testing.T) {
+	t.Parallel()
+
+	versionData := createVersionData(
+		t,
+		[]data.EndpointHandlerData{{Path: "/options", Method: http.MethodOptions}},
+		[]data.RouteConfig{{Name: "/options", Open: true}},
+	)
+
+	document, err := openapi.CreateSpecification("v1.0", versionData, 60)
+	assert.Nil(t, document)
+	assert.True(t, errors.Is(err, openapi.ErrUnsupportedMethod))
+}
+
+func TestCreateSpecification_ShouldDescribeOnlyOpenRoutes(t *testing.T) {
+	t.Parallel()
+
+	endpoints := []data.EndpointHandlerData{
+		{
+			Path:     "/:address/transfer",
+			Method:   http.MethodPost,
+			Request:  transferRequest{},
+			Response: data.GenericAPIResponse{Data: gin.H{"txHash": ""}},
+		},
+		{Path: "/closed", Method: http.MethodGet},
+		{Path: "/not-in-config", Method: http.MethodGet},
+	}
+	routes := []data.RouteConfig{
+		{Name: "/:address/transfer", Open: true, Secured: true, RateLimit: 5},
+		{Name: "/closed", Open: false},
+	}
+
+	document, err := openapi.CreateSpecification("v1.0", createVersionData(t, endpoints, routes), 60)
+	require.NoError(t, err)
+
+	assert.Equal(t, openapi.Version, document.OpenAPI)
+	assert.Equal(t, "v1.0", document.Info.Version)
+	assert.Equal(t, []openapi.Server{{URL: "/v1.0"}}, document.Servers)
+	require.Equal(t, 1, len(document.Paths))
+
+	pathItem := document.Paths["/test/{address}/transfer"]
+	require.NotNil(t, pathItem)
+	assert.Nil(t, pathItem.Get)
+	operation := pathItem.Post
+	require.NotNil(t, operation)
+
+	assert.Equal(t, "postTestByAddressTransfer", operation.OperationID)
+	assert.Equal(t, []string{"test"}, operation.Tags)
+	require.Equal(t, 1, len(operation.Parameters))
+	assert.Equal(t, "address", operation.Parameters[0].Name)
+	assert.Equal(t, "path", operation.Parameters[0].In)
+	assert.True(t, operation.Parameters[0].Required)
+
+	assert.Equal(t, []map[string][]string{{"basicAuth": {}}}, operation.Security)
+	assert.Equal(t, &openapi.RateLimit{Requests: 5, WindowSeconds: 60}, operation.RateLimit)
+	assert.True(t, operation.IsClientCertificateRequired)
+	assert.Equal(t, openapi.SecurityScheme{Type: "http", Scheme: "basic"}, document.Components.SecuritySchemes["basicAuth"])
+
+	requestSchema := operation.RequestBody.Content["application/json"].Schema
+	assert.Equal(t, "#/components/schemas/transferRequest", requestSchema.Ref)
+	transferSchema := document.Components.Schemas["transferRequest"]
+	require.NotNil(t, transferSchema)
+	assert.Equal(t, 2, len(transferSchema.Properties))
+	assert.Equal(t, "string", transferSchema.Properties["receiver"].Type)
+	assert.Equal(t, "string", transferSchema.Properties["amount"].Type)
+
+	responseSchema := operation.Responses["200"].Content["application/json"].Schema
+	assert.Equal(t, "object", responseSchema.Type)
+	assert.Equal(t, "string", responseSchema.Properties["data"].Properties["txHash"].Type)
+	assert.Equal(t, "string", responseSchema.Properties["code"].Type)
+	assert.Contains(t, operation.Responses, "401")
+}
+
+func TestCreateSpecification_ShouldDescribeTheRegisteredGroups(t *testing.T) {
+	t.Parallel()
+
+	apiHandler, err := api.NewApiHandler(&mock.Facade{})
+	require.NoError(t, err)
+
+	versionData := &data.VersionData{
+		ApiHandler: apiHandler,
+		ApiConfig: data.ApiRoutesConfig{
+			APIPackages: map[string]data.APIPackageConfig{
+				"address": {Routes: []data.RouteConfig{{Name: "/:address", Open: true}}},
+				"block":   {Routes: []data.RouteConfig{{Name: "/:shard/by-nonce/:nonce", Open: true}}},
+			},
+		},
+	}
+
+	document, err := openapi.CreateSpecification("v1.0", versionData, 60)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(document.Paths))
+
+	accountOperation := document.Paths["/address/{address}"].Get
+	require.NotNil(t, accountOperation)
+	assert.Nil(t, accountOperation.Security)
+	assert.Nil(t, accountOperation.RateLimit)
+	accountSchema := accountOperation.Responses["200"].Content["application/json"].Schema.Properties["data"].Properties["account"]
+	assert.Equal(t, "#/components/schemas/Account", accountSchema.Ref)
+	assert.Equal(t, "integer", document.Components.Schemas["Account"].Properties["nonce"].Type)
+
+	blockOperation := document.Paths["/block/{shard}/by-nonce/{nonce}"].Get
+	require.NotNil(t, blockOperation)
+	require.Equal(t, 4, len(blockOperation.Parameters))
+	assert.Equal(t, openapi.Parameter{
+		Name:        "withTxs",
+		In:          "query",
+		Description: "if true, the transactions of the block are returned as well",
+		Schema:      &openapi.Schema{Type: "boolean"},
+	}, blockOperation.Parameters[2])
+	assert.Equal(t, "decode", blockOperation.Parameters[3].Name)
+	accountParameters := make([]string, 0)
+	for _, parameter := range accountOperation.Parameters {
+		accountParameters = append(accountParameters, parameter.In+":"+parameter.Name)
+	}
+	assert.Equal(t, []string{"path:address", "query:blockNonce", "query:blockHash", "query:rootHash"}, accountParameters)
+	assert.Equal(t, "#/components/schemas/BlockApiResponse", blockOperation.Responses["200"].Content["application/json"].Schema.Ref)
+	assert.Contains(t, document.Components.Schemas, "Block")
+	assert.Nil(t, document.Components.SecuritySchemes)
+}
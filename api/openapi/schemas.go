@@ -0,0 +1,274 @@
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+	"time"
+)
+
+const componentsSchemasPrefix = "#/components/schemas/"
+
+var (
+	bigIntType         = reflect.TypeOf(big.Int{})
+	timeType           = reflect.TypeOf(time.Time{})
+	rawMessageType     = reflect.TypeOf(json.RawMessage{})
+	jsonMarshalerType  = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	minimumUnsignedInt = float64(0)
+)
+
+// schemasGenerator derives schemas from sample values. Named struct types are registered as components and referenced,
+// while values holding samples inside interface fields or maps are described inline, as their shape is given by the sample
+type schemasGenerator struct {
+	schemas    map[string]*Schema
+	namesTypes map[string]reflect.Type
+	typesNames map[reflect.Type]string
+}
+
+func newSchemasGenerator() *schemasGenerator {
+	return &schemasGenerator{
+		schemas:    make(map[string]*Schema),
+		namesTypes: make(map[string]reflect.Type),
+		typesNames: make(map[reflect.Type]string),
+	}
+}
+
+func (sg *schemasGenerator) schemaForSample(sample interface{}) *Schema {
+	if sample == nil {
+		return &Schema{}
+	}
+
+	return sg.schemaForValue(reflect.ValueOf(sample))
+}
+
+func (sg *schemasGenerator) schemaForValue(value reflect.Value) *Schema {
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return sg.schemaForType(value.Type())
+		}
+		value = value.Elem()
+	}
+
+	switch value.Kind() {
+	case reflect.Struct:
+		if !holdsSamples(value) {
+			return sg.schemaForType(value.Type())
+		}
+
+		return sg.structSchema(value.Type(), value)
+	case reflect.Map:
+		if value.Len() == 0 || !isDynamicType(value.Type().Elem()) {
+			return sg.schemaForType(value.Type())
+		}
+
+		schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+		for _, key := range value.MapKeys() {
+			schema.Properties[fmt.Sprintf("%v", key.Interface())] = sg.schemaForValue(value.MapIndex(key))
+		}
+
+		return schema
+	case reflect.Slice, reflect.Array:
+		if value.Len() == 0 || !isDynamicType(value.Type().Elem()) {
+			return sg.schemaForType(value.Type())
+		}
+
+		return &Schema{Type: "array", Items: sg.schemaForValue(value.Index(0))}
+	default:
+		return sg.schemaForType(value.Type())
+	}
+}
+
+func (sg *schemasGenerator) schemaForType(valueType reflect.Type) *Schema {
+	for valueType.Kind() == reflect.Ptr {
+		valueType = valueType.Elem()
+	}
+
+	switch valueType {
+	case bigIntType:
+		return &Schema{Type: "integer"}
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case rawMessageType:
+		return &Schema{}
+	}
+
+	switch valueType.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Uint, reflect.Uint64, reflect.Uintptr:
+		return &Schema{Type: "integer", Format: "int64", Minimum: &minimumUnsignedInt}
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32", Minimum: &minimumUnsignedInt}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if valueType.Elem().Kind() == reflect.Uint8 {
+			// byte slices are marshaled as base64 encoded strings
+			return &Schema{Type: "string", Format: "byte"}
+		}
+
+		return &Schema{Type: "array", Items: sg.schemaForType(valueType.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: sg.schemaForType(valueType.Elem())}
+	case reflect.Struct:
+		if valueType.Implements(jsonMarshalerType) || reflect.PtrTo(valueType).Implements(jsonMarshalerType) {
+			return &Schema{}
+		}
+		if valueType.Name() == "" {
+			return sg.structSchema(valueType, reflect.Value{})
+		}
+
+		return sg.referenceStruct(valueType)
+	default:
+		return &Schema{}
+	}
+}
+
+func (sg *schemasGenerator) referenceStruct(structType reflect.Type) *Schema {
+	name, ok := sg.typesNames[structType]
+	if ok {
+		return &Schema{Ref: componentsSchemasPrefix + name}
+	}
+
+	name = sg.componentName(structType)
+	sg.typesNames[structType] = name
+	sg.namesTypes[name] = structType
+	// the schema is registered before being filled so recursive types can reference it
+	schema := &Schema{}
+	sg.schemas[name] = schema
+	*schema = *sg.structSchema(structType, reflect.Value{})
+
+	return &Schema{Ref: componentsSchemasPrefix + name}
+}
+
+func (sg *schemasGenerator) componentName(structType reflect.Type) string {
+	name := structType.Name()
+	_, isTaken := sg.namesTypes[name]
+	if !isTaken {
+		return name
+	}
+
+	pkgPath := strings.Split(structType.PkgPath(), "/")
+	pkgName := pkgPath[len(pkgPath)-1]
+	name = strings.Title(pkgName) + name
+	_, isTaken = sg.namesTypes[name]
+	if !isTaken {
+		return name
+	}
+
+	for index := 2; ; index++ {
+		candidate := fmt.Sprintf("%s%d", name, index)
+		_, isTaken = sg.namesTypes[candidate]
+		if !isTaken {
+			return candidate
+		}
+	}
+}
+
+// structSchema describes the exported fields of a struct. If a value is provided, the fields are described using it
+func (sg *schemasGenerator) structSchema(structType reflect.Type, value reflect.Value) *Schema {
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	sg.addStructFields(schema, structType, value)
+
+	return schema
+}
+
+func (sg *schemasGenerator) addStructFields(schema *Schema, structType reflect.Type, value reflect.Value) {
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		name, isString, ok := jsonFieldName(field)
+		if !ok {
+			continue
+		}
+
+		var fieldValue reflect.Value
+		if value.IsValid() {
+			fieldValue = value.Field(i)
+		}
+
+		if field.Anonymous && name == "" {
+			embeddedType := field.Type
+			if embeddedType.Kind() == reflect.Ptr {
+				embeddedType = embeddedType.Elem()
+				if fieldValue.IsValid() {
+					fieldValue = fieldValue.Elem()
+				}
+			}
+			if embeddedType.Kind() == reflect.Struct {
+				sg.addStructFields(schema, embeddedType, fieldValue)
+				continue
+			}
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		switch {
+		case isString:
+			schema.Properties[name] = &Schema{Type: "string"}
+		case fieldValue.IsValid():
+			schema.Properties[name] = sg.schemaForValue(fieldValue)
+		default:
+			schema.Properties[name] = sg.schemaForType(field.Type)
+		}
+	}
+}
+
+// jsonFieldName returns the name used by the json encoding for a struct field and whether the field is encoded as string
+func jsonFieldName(field reflect.StructField) (string, bool, bool) {
+	if field.PkgPath != "" && !field.Anonymous {
+		return "", false, false
+	}
+
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false, false
+	}
+
+	options := strings.Split(tag, ",")
+	isString := false
+	for _, option := range options[1:] {
+		if option == "string" {
+			isString = true
+		}
+	}
+
+	return options[0], isString, true
+}
+
+// holdsSamples returns true if the value holds samples inside interface fields, at any depth
+func holdsSamples(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Interface:
+		return !value.IsNil()
+	case reflect.Ptr:
+		return !value.IsNil() && holdsSamples(value.Elem())
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			if value.Type().Field(i).PkgPath != "" {
+				continue
+			}
+			if holdsSamples(value.Field(i)) {
+				return true
+			}
+		}
+	case reflect.Map, reflect.Slice:
+		return value.Len() > 0 && isDynamicType(value.Type().Elem())
+	}
+
+	return false
+}
+
+func isDynamicType(valueType reflect.Type) bool {
+	return valueType.Kind() == reflect.Interface
+}
@@ -0,0 +1,92 @@
+package openapi
+
+import (
+	"encoding/json"
+	"math/big"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type embeddedFields struct {
+	Hash string `json:"hash"`
+}
+
+type node struct {
+	embeddedFields
+	Value    *big.Int          `json:"value"`
+	Data     []byte            `json:"data,omitempty"`
+	Children []*node           `json:"children"`
+	Tags     map[string]string `json:"tags"`
+	Extra    json.RawMessage   `json:"extra"`
+	Untagged bool
+	private  int
+}
+
+type otherNode struct {
+	Node node `json:"node"`
+}
+
+func TestSchemasGenerator_NamedStructsShouldBeReferenced(t *testing.T) {
+	t.Parallel()
+
+	sg := newSchemasGenerator()
+	schema := sg.schemaForSample(&otherNode{})
+	assert.Equal(t, componentsSchemasPrefix+"otherNode", schema.Ref)
+	require.Equal(t, 2, len(sg.schemas))
+
+	nodeSchema := sg.schemas["node"]
+	require.NotNil(t, nodeSchema)
+	assert.Equal(t, []string{"Untagged", "children", "data", "extra", "hash", "tags", "value"}, sortedPropertiesNames(nodeSchema))
+	assert.Equal(t, "integer", nodeSchema.Properties["value"].Type)
+	assert.Equal(t, &Schema{Type: "string", Format: "byte"}, nodeSchema.Properties["data"])
+	assert.Equal(t, componentsSchemasPrefix+"node", nodeSchema.Properties["children"].Items.Ref)
+	assert.Equal(t, "string", nodeSchema.Properties["tags"].AdditionalProperties.Type)
+	assert.Equal(t, &Schema{}, nodeSchema.Properties["extra"])
+	assert.Equal(t, "boolean", nodeSchema.Properties["Untagged"].Type)
+}
+
+func TestSchemasGenerator_SamplesShouldBeDescribedInline(t *testing.T) {
+	t.Parallel()
+
+	sg := newSchemasGenerator()
+	schema := sg.schemaForSample(map[string]interface{}{
+		"nonce":  uint64(0),
+		"hashes": []interface{}{""},
+		"nested": map[string]interface{}{"ok": false},
+	})
+
+	assert.Equal(t, "object", schema.Type)
+	assert.Equal(t, "integer", schema.Properties["nonce"].Type)
+	assert.Equal(t, "string", schema.Properties["hashes"].Items.Type)
+	assert.Equal(t, "boolean", schema.Properties["nested"].Properties["ok"].Type)
+	assert.Equal(t, 0, len(sg.schemas))
+
+	assert.Equal(t, &Schema{}, sg.schemaForSample(nil))
+}
+
+func TestSchemasGenerator_NamesCollisionsShouldBeResolved(t *testing.T) {
+	t.Parallel()
+
+	type node struct {
+		Other string `json:"other"`
+	}
+
+	sg := newSchemasGenerator()
+	sg.schemaForSample(otherNode{})
+	schema := sg.schemaForSample(node{})
+	assert.Equal(t, componentsSchemasPrefix+"Openapinode", schema.Ref)
+	assert.Contains(t, sg.schemas["Openapinode"].Properties, "other")
+}
+
+func sortedPropertiesNames(schema *Schema) []string {
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,102 @@
+package openapi
+
+// Version is the OpenAPI specification version the generated documents comply with
+const Version = "3.0.3"
+
+// Document is the root object of an OpenAPI document
+type Document struct {
+	OpenAPI    string               `json:"openapi"`
+	Info       Info                 `json:"info"`
+	Servers    []Server             `json:"servers,omitempty"`
+	Paths      map[string]*PathItem `json:"paths"`
+	Components Components           `json:"components"`
+}
+
+// Info holds the metadata about the API
+type Info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+// Server holds the base URL the paths are relative to
+type Server struct {
+	URL string `json:"url"`
+}
+
+// PathItem holds the operations available on a single path
+type PathItem struct {
+	Get    *Operation `json:"get,omitempty"`
+	Put    *Operation `json:"put,omitempty"`
+	Post   *Operation `json:"post,omitempty"`
+	Delete *Operation `json:"delete,omitempty"`
+	Patch  *Operation `json:"patch,omitempty"`
+}
+
+// Operation describes a single API operation on a path. The rate limit and the client certificate requirement are
+// exposed as specification extensions
+type Operation struct {
+	OperationID                 string                `json:"operationId"`
+	Tags                        []string              `json:"tags,omitempty"`
+	Parameters                  []Parameter           `json:"parameters,omitempty"`
+	RequestBody                 *RequestBody          `json:"requestBody,omitempty"`
+	Responses                   map[string]Response   `json:"responses"`
+	Security                    []map[string][]string `json:"security,omitempty"`
+	RateLimit                   *RateLimit            `json:"x-rate-limit,omitempty"`
+	IsClientCertificateRequired bool                  `json:"x-client-certificate-required,omitempty"`
+}
+
+// RateLimit describes the number of requests an IP address can make to an operation in a time window
+type RateLimit struct {
+	Requests      uint64 `json:"requests"`
+	WindowSeconds int    `json:"windowSeconds"`
+}
+
+// Parameter describes a single operation parameter
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required"`
+	Schema      *Schema `json:"schema"`
+}
+
+// RequestBody describes the body of a request
+type RequestBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+// Response describes a single response of an operation
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+// MediaType holds the schema of a request or response body
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Components holds the reusable objects of the document
+type Components struct {
+	Schemas         map[string]*Schema        `json:"schemas,omitempty"`
+	SecuritySchemes map[string]SecurityScheme `json:"securitySchemes,omitempty"`
+}
+
+// SecurityScheme defines a security scheme that can be used by the operations
+type SecurityScheme struct {
+	Type   string `json:"type"`
+	Scheme string `json:"scheme,omitempty"`
+}
+
+// Schema defines a data type. An empty schema allows any value
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Enum                 []interface{}      `json:"enum,omitempty"`
+}
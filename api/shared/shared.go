@@ -9,6 +9,11 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
+// RateLimitedEndpointsContextKey is the key under which a handler can list the endpoints counted by the rate limiter for
+// the current request, one entry per call, instead of its own route. It is used by the routes which serve calls
+// mirroring other routes, such as the JSON-RPC batches
+const RateLimitedEndpointsContextKey = "rateLimitedEndpoints"
+
 // RespondWith will respond with the generic API response
 func RespondWith(c *gin.Context, status int, dataField interface{}, error string, code data.ReturnCode) {
 	c.JSON(
@@ -2,8 +2,12 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
+	"io"
 	"math/big"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -20,6 +24,8 @@ import (
 	marshalFactory "github.com/ElrondNetwork/elrond-go/marshal/factory"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-proxy-go/api"
+	"github.com/ElrondNetwork/elrond-proxy-go/api/grpcapi"
+	"github.com/ElrondNetwork/elrond-proxy-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-proxy-go/config"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 	"github.com/ElrondNetwork/elrond-proxy-go/observer"
@@ -29,8 +35,12 @@ import (
 	processFactory "github.com/ElrondNetwork/elrond-proxy-go/process/factory"
 	"github.com/ElrondNetwork/elrond-proxy-go/rosetta"
 	"github.com/ElrondNetwork/elrond-proxy-go/testing"
+	"github.com/ElrondNetwork/elrond-proxy-go/tlsconfig"
 	versionsFactory "github.com/ElrondNetwork/elrond-proxy-go/versions/factory"
+	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
 	"github.com/urfave/cli"
+	"google.golang.org/grpc"
 )
 
 const (
@@ -128,6 +138,20 @@ VERSION:
 		Usage: "Starts the proxy as a rosetta server",
 	}
 
+	// rosettaOffline defines a flag for starting the rosetta server without observers
+	rosettaOffline = cli.BoolFlag{
+		Name: "offline",
+		Usage: "Starts the rosetta server in offline mode. No observer is used and only the endpoints that do not " +
+			"need the network state are served. Must be used together with the --rosetta flag",
+	}
+
+	// rosettaOfflineConfigFile defines a flag for the path to the rosetta offline network toml configuration file
+	rosettaOfflineConfigFile = cli.StringFlag{
+		Name:  "rosetta-offline-config",
+		Usage: "The static network configuration file used by the rosetta server in offline mode",
+		Value: "./config/rosettaOffline.toml",
+	}
+
 	// logLevel defines the logger level
 	logLevel = cli.StringFlag{
 		Name: "log-level",
@@ -150,6 +174,9 @@ VERSION:
 	}
 
 	testServer *testing.TestHttpServer
+
+	// closableComponents holds the components which should be closed on shutdown, such as the background go routines
+	closableComponents []io.Closer
 )
 
 func main() {
@@ -170,6 +197,8 @@ func main() {
 		walletKeyPemFile,
 		testHttpServerEn,
 		startAsRosetta,
+		rosettaOffline,
+		rosettaOfflineConfigFile,
 		logLevel,
 		logSaveFile,
 		workingDirectory,
@@ -253,6 +282,10 @@ func startProxy(ctx *cli.Context) error {
 		return err
 	}
 
+	if ctx.GlobalBool(rosettaOffline.Name) {
+		return startRosettaOffline(ctx, generalConfig, fileLogging)
+	}
+
 	credentialsConfigurationFileName := ctx.GlobalString(credentialsConfigFile.Name)
 	credentialsConfig, err := loadCredentialsConfig(credentialsConfigurationFileName)
 	if err != nil {
@@ -264,14 +297,73 @@ func startProxy(ctx *cli.Context) error {
 		return err
 	}
 
-	httpServer, err := startWebServer(versionsRegistry, ctx, generalConfig, *credentialsConfig, isProfileModeActivated)
+	var serverTLSConfig *tls.Config
+	if generalConfig.ServerTLS.Enabled {
+		var tlsCloser io.Closer
+		serverTLSConfig, tlsCloser, err = tlsconfig.CreateServerTLSConfig(generalConfig.ServerTLS)
+		if err != nil {
+			return err
+		}
+		registerClosableComponent(tlsCloser)
+	}
+
+	httpServer, err := startWebServer(versionsRegistry, ctx, generalConfig, *credentialsConfig, isProfileModeActivated, serverTLSConfig)
 	if err != nil {
 		return err
 	}
 
-	waitForServerShutdown(httpServer)
+	grpcServer, err := startGrpcServer(versionsRegistry, ctx, generalConfig, *credentialsConfig, serverTLSConfig)
+	if err != nil {
+		return err
+	}
+
+	waitForServerShutdown(httpServer, grpcServer)
 
 	log.Debug("closing proxy")
+	closeComponents()
+	if !check.IfNil(fileLogging) {
+		err = fileLogging.Close()
+		log.LogIfError(err)
+	}
+
+	return nil
+}
+
+// startRosettaOffline starts only the rosetta server, with a static network config and without creating the
+// components that need the observers
+func startRosettaOffline(ctx *cli.Context, generalConfig *config.Config, fileLogging nodeFactory.FileLoggingHandler) error {
+	if !ctx.GlobalBool(startAsRosetta.Name) {
+		return fmt.Errorf("the --%s flag can only be used together with the --%s flag", rosettaOffline.Name, startAsRosetta.Name)
+	}
+
+	offlineConfigFileName := ctx.GlobalString(rosettaOfflineConfigFile.Name)
+	offlineConfig, err := loadRosettaOfflineConfig(offlineConfigFileName)
+	if err != nil {
+		return err
+	}
+	log.Info(fmt.Sprintf("Initialized with rosetta offline config from: %s", offlineConfigFileName))
+
+	httpServer, err := rosetta.CreateOfflineServer(generalConfig, offlineConfig, generalConfig.GeneralSettings.ServerPort)
+	if err != nil {
+		return err
+	}
+
+	if generalConfig.ServerTLS.Enabled {
+		var tlsCloser io.Closer
+		httpServer.TLSConfig, tlsCloser, err = tlsconfig.CreateServerTLSConfig(generalConfig.ServerTLS)
+		if err != nil {
+			return err
+		}
+		registerClosableComponent(tlsCloser)
+	}
+
+	serveHttp(httpServer)
+	log.Info("started rosetta server in offline mode", "port", generalConfig.GeneralSettings.ServerPort)
+
+	waitForServerShutdown(httpServer, nil)
+
+	log.Debug("closing proxy")
+	closeComponents()
 	if !check.IfNil(fileLogging) {
 		err = fileLogging.Close()
 		log.LogIfError(err)
@@ -289,6 +381,15 @@ func loadMainConfig(filepath string) (*config.Config, error) {
 	return cfg, nil
 }
 
+func loadRosettaOfflineConfig(filepath string) (*config.RosettaOfflineConfig, error) {
+	cfg := &config.RosettaOfflineConfig{}
+	err := core.LoadTomlFile(cfg, filepath)
+	if err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
 func loadEconomicsConfig(filepath string) (*erdConfig.EconomicsConfig, error) {
 	cfg := &erdConfig.EconomicsConfig{}
 	err := core.LoadTomlFile(cfg, filepath)
@@ -298,8 +399,8 @@ func loadEconomicsConfig(filepath string) (*erdConfig.EconomicsConfig, error) {
 	return cfg, nil
 }
 
-func loadExternalConfig(filepath string) (*erdConfig.ExternalConfig, error) {
-	cfg := &erdConfig.ExternalConfig{}
+func loadExternalConfig(filepath string) (*config.ExternalConfig, error) {
+	cfg := &config.ExternalConfig{}
 	err := core.LoadTomlFile(cfg, filepath)
 	if err != nil {
 		return nil, err
@@ -313,7 +414,7 @@ func createVersionsRegistryTestOrProduction(
 	cfg *config.Config,
 	configurationFilePath string,
 	ecCfg *erdConfig.EconomicsConfig,
-	exCfg *erdConfig.ExternalConfig,
+	exCfg *config.ExternalConfig,
 ) (data.VersionsRegistryHandler, error) {
 
 	var testHTTPServerEnabled bool
@@ -394,7 +495,7 @@ func createVersionsRegistry(
 	cfg *config.Config,
 	configurationFilePath string,
 	ecConf *erdConfig.EconomicsConfig,
-	exCfg *erdConfig.ExternalConfig,
+	exCfg *config.ExternalConfig,
 	pemFileLocation string,
 	apiConfigDirectoryPath string,
 	isRosettaModeEnabled bool,
@@ -446,7 +547,19 @@ func createVersionsRegistry(
 		return nil, err
 	}
 
-	connector, err := createElasticSearchConnector(exCfg)
+	observersTLSConfig, observersTLSCloser, err := tlsconfig.CreateClientTLSConfig(cfg.ObserversTLS)
+	if err != nil {
+		return nil, err
+	}
+	registerClosableComponent(observersTLSCloser)
+	if observersTLSConfig != nil {
+		err = bp.SetTLSClientConfig(observersTLSConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	connector, err := createExternalStorageConnector(exCfg)
 	if err != nil {
 		return nil, err
 	}
@@ -468,7 +581,7 @@ func createVersionsRegistry(
 		return nil, err
 	}
 
-	scQueryProc, err := process.NewSCQueryProcessor(bp, pubKeyConverter)
+	scQueryProc, err := createSCQueryProcessor(cfg, bp, pubKeyConverter, isRosettaModeEnabled)
 	if err != nil {
 		return nil, err
 	}
@@ -511,11 +624,21 @@ func createVersionsRegistry(
 		return nil, err
 	}
 
+	err = startHyperBlocksIndexer(exCfg, connector, blockProc, nodeStatusProc)
+	if err != nil {
+		return nil, err
+	}
+
 	proofProc, err := process.NewProofProcessor(bp, pubKeyConverter)
 	if err != nil {
 		return nil, err
 	}
 
+	abiDecoderProc, err := process.NewABIDecoderProcessor(cfg.ABIDecoder.Directory, pubKeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
 	facadeArgs := versionsFactory.FacadeArgs{
 		ActionsProcessor:             bp,
 		AccountProcessor:             accntProc,
@@ -527,6 +650,7 @@ func createVersionsRegistry(
 		TransactionProcessor:         txProc,
 		ValidatorStatisticsProcessor: valStatsProc,
 		ProofProcessor:               proofProc,
+		ABIDecoderProcessor:          abiDecoderProc,
 		PubKeyConverter:              pubKeyConverter,
 	}
 
@@ -538,7 +662,25 @@ func createVersionsRegistry(
 	return versionsFactory.CreateVersionsRegistry(facadeArgs, apiConfigParser)
 }
 
-func createElasticSearchConnector(exCfg *erdConfig.ExternalConfig) (process.ExternalStorageConnector, error) {
+func createExternalStorageConnector(exCfg *config.ExternalConfig) (process.ExternalStorageConnector, error) {
+	numEnabled := 0
+	for _, isEnabled := range []bool{exCfg.ElasticSearchConnector.Enabled, exCfg.SQLConnector.Enabled, exCfg.EmbeddedIndexer.Enabled} {
+		if isEnabled {
+			numEnabled++
+		}
+	}
+	if numEnabled > 1 {
+		return nil, errors.New("only one of the ElasticSearchConnector, SQLConnector and EmbeddedIndexer can be enabled")
+	}
+
+	if exCfg.SQLConnector.Enabled {
+		return database.NewSQLConnector(exCfg.SQLConnector.Driver, exCfg.SQLConnector.DataSourceName)
+	}
+
+	if exCfg.EmbeddedIndexer.Enabled {
+		return database.NewLevelDBConnector(exCfg.EmbeddedIndexer.DBPath)
+	}
+
 	if !exCfg.ElasticSearchConnector.Enabled {
 		return database.NewDisabledElasticSearchConnector(), nil
 	}
@@ -550,6 +692,97 @@ func createElasticSearchConnector(exCfg *erdConfig.ExternalConfig) (process.Exte
 	)
 }
 
+// createSCQueryProcessor creates the smart contract queries processor, which caches the outputs of the configured
+// views if the cache is enabled and the rosetta mode is not
+func createSCQueryProcessor(
+	cfg *config.Config,
+	bp process.Processor,
+	pubKeyConverter core.PubkeyConverter,
+	isRosettaModeEnabled bool,
+) (process.SCQueryHandler, error) {
+	scQueryProc, err := process.NewSCQueryProcessor(bp, pubKeyConverter)
+	if err != nil {
+		return nil, err
+	}
+	if !cfg.SCQueryCache.Enabled || isRosettaModeEnabled {
+		return scQueryProc, nil
+	}
+
+	scQueryCacher, err := cache.NewSCQueryMemoryCacher(cfg.SCQueryCache.MaxEntriesPerShard)
+	if err != nil {
+		return nil, err
+	}
+
+	cachedViews := make(map[string][]string)
+	for _, contract := range cfg.SCQueryCache.Contracts {
+		cachedViews[contract.Address] = append(cachedViews[contract.Address], contract.Functions...)
+	}
+
+	cachedSCQueryProc, err := process.NewCachedSCQueryProcessor(process.ArgsCachedSCQueryProcessor{
+		SCQueryHandler:       scQueryProc,
+		Processor:            bp,
+		PubKeyConverter:      pubKeyConverter,
+		Cacher:               scQueryCacher,
+		CachedViews:          cachedViews,
+		BlockPollingInterval: time.Duration(cfg.SCQueryCache.BlockPollingIntervalMs) * time.Millisecond,
+	})
+	if err != nil {
+		return nil, err
+	}
+	cachedSCQueryProc.StartCacheInvalidation()
+	registerClosableComponent(cachedSCQueryProc)
+
+	log.Info("smart contract views cache enabled", "contracts", len(cachedViews))
+
+	return cachedSCQueryProc, nil
+}
+
+// startHyperBlocksIndexer starts filling the SQL database or the embedded index with the hyperblocks, if one of
+// them is enabled
+func startHyperBlocksIndexer(
+	exCfg *config.ExternalConfig,
+	connector process.ExternalStorageConnector,
+	blockProc *process.BlockProcessor,
+	nodeStatusProc *process.NodeStatusProcessor,
+) error {
+	var startNonce uint64
+	var pollingIntervalSec int
+	switch {
+	case exCfg.SQLConnector.Enabled:
+		startNonce, pollingIntervalSec = exCfg.SQLConnector.IndexerStartNonce, exCfg.SQLConnector.IndexerPollingIntervalSec
+	case exCfg.EmbeddedIndexer.Enabled:
+		startNonce, pollingIntervalSec = exCfg.EmbeddedIndexer.StartNonce, exCfg.EmbeddedIndexer.PollingIntervalSec
+	default:
+		return nil
+	}
+
+	storer, ok := connector.(database.HyperBlocksStorer)
+	if !ok {
+		return errors.New("the external storage connector cannot store hyperblocks")
+	}
+
+	indexer, err := database.NewHyperBlocksIndexer(database.ArgsHyperBlocksIndexer{
+		HyperBlockProvider:    blockProc,
+		FinalNonceProvider:    nodeStatusProc,
+		NetworkConfigProvider: nodeStatusProc,
+		Storer:                storer,
+		StartNonce:            startNonce,
+		PollingInterval:       time.Duration(pollingIntervalSec) * time.Second,
+	})
+	if err != nil {
+		return err
+	}
+
+	// the indexer is closed first, so that it does not save a hyperblock into a closed storer
+	registerClosableComponent(storer)
+	registerClosableComponent(indexer)
+
+	indexer.StartIndexing()
+	log.Info("started the hyperblocks indexer", "start nonce", startNonce)
+
+	return nil
+}
+
 func getShardCoordinator(cfg *config.Config) (sharding.Coordinator, error) {
 	maxShardID := uint32(0)
 	for _, obs := range cfg.Observers {
@@ -574,6 +807,7 @@ func startWebServer(
 	generalConfig *config.Config,
 	credentialsConfig config.CredentialsConfig,
 	isProfileModeActivated bool,
+	tlsConfig *tls.Config,
 ) (*http.Server, error) {
 	var err error
 	var httpServer *http.Server
@@ -581,11 +815,21 @@ func startWebServer(
 	port := generalConfig.GeneralSettings.ServerPort
 	asRosetta := cliContext.GlobalBool(startAsRosetta.Name)
 	if asRosetta {
-		facades, err := versionsRegistry.GetAllVersions()
+		var facades map[string]*data.VersionData
+		facades, err = versionsRegistry.GetAllVersions()
+		if err != nil {
+			return nil, err
+		}
+		var additionalNetworks []rosetta.NetworkArgs
+		additionalNetworks, err = createRosettaAdditionalNetworks(cliContext, generalConfig)
+		if err != nil {
+			return nil, err
+		}
+		networks := append([]rosetta.NetworkArgs{{Facade: facades["v1.0"].Facade, GeneralConfig: generalConfig}}, additionalNetworks...)
+		httpServer, err = createRosettaServer(networks, port, tlsConfig)
 		if err != nil {
 			return nil, err
 		}
-		httpServer, err = rosetta.CreateServer(facades["v1.0"].Facade, generalConfig, port)
 	} else {
 		if generalConfig.GeneralSettings.RateLimitWindowDurationSeconds <= 0 {
 			return nil, fmt.Errorf("invalid value %d for RateLimitWindowDurationSeconds. It must be greater "+
@@ -598,24 +842,157 @@ func startWebServer(
 			credentialsConfig,
 			generalConfig.GeneralSettings.RateLimitWindowDurationSeconds,
 			isProfileModeActivated,
+			tlsConfig,
 		)
 	}
 	if err != nil {
 		return nil, err
 	}
-	go func() {
-		err = httpServer.ListenAndServe()
+	serveHttp(httpServer)
+
+	return httpServer, nil
+}
+
+// createRosettaServer creates the rosetta server of the provided networks, using the TLS configuration, if any
+func createRosettaServer(networks []rosetta.NetworkArgs, port int, tlsConfig *tls.Config) (*http.Server, error) {
+	httpServer, err := rosetta.CreateServer(networks, port)
+	if err != nil {
+		return nil, err
+	}
+	httpServer.TLSConfig = tlsConfig
+
+	return httpServer, nil
+}
+
+// createRosettaAdditionalNetworks creates a facade for each of the other networks served by the rosetta server, using
+// the observers defined in the network's own config file
+func createRosettaAdditionalNetworks(ctx *cli.Context, generalConfig *config.Config) ([]rosetta.NetworkArgs, error) {
+	networks := make([]rosetta.NetworkArgs, 0, len(generalConfig.Rosetta.AdditionalNetworks))
+	if len(generalConfig.Rosetta.AdditionalNetworks) == 0 {
+		return networks, nil
+	}
+
+	economicsConfig, err := loadEconomicsConfig(ctx.GlobalString(economicsFile.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, networkConfig := range generalConfig.Rosetta.AdditionalNetworks {
+		networkGeneralConfig, err := loadMainConfig(networkConfig.ConfigFile)
+		if err != nil {
+			return nil, err
+		}
+
+		externalConfig := &config.ExternalConfig{}
+		if len(networkConfig.ExternalConfigFile) > 0 {
+			externalConfig, err = loadExternalConfig(networkConfig.ExternalConfigFile)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		versionsRegistry, err := createVersionsRegistry(
+			networkGeneralConfig,
+			networkConfig.ConfigFile,
+			economicsConfig,
+			externalConfig,
+			ctx.GlobalString(walletKeyPemFile.Name),
+			ctx.GlobalString(apiConfigDirectory.Name),
+			true,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		facades, err := versionsRegistry.GetAllVersions()
 		if err != nil {
-			log.Error("cannot ListenAndServe()", "err", err)
+			return nil, err
+		}
+
+		networks = append(networks, rosetta.NetworkArgs{
+			Facade:        facades["v1.0"].Facade,
+			GeneralConfig: networkGeneralConfig,
+		})
+		log.Info(fmt.Sprintf("Initialized rosetta network with config from: %s", networkConfig.ConfigFile))
+	}
+
+	return networks, nil
+}
+
+func serveHttp(httpServer *http.Server) {
+	go func() {
+		var errServe error
+		if httpServer.TLSConfig != nil {
+			// the certificates are provided by the TLS config, so the file arguments are left empty
+			errServe = httpServer.ListenAndServeTLS("", "")
+		} else {
+			errServe = httpServer.ListenAndServe()
+		}
+		if errServe != nil && errServe != http.ErrServerClosed {
+			log.Error("cannot start the web server", "err", errServe)
 			os.Exit(1)
 		}
 	}()
+}
 
-	return httpServer, nil
+// startGrpcServer starts the gRPC server, if enabled. It exposes the operations of the v1.0 facade, following the
+// routes config of the v1.0 API
+func startGrpcServer(
+	versionsRegistry data.VersionsRegistryHandler,
+	cliContext *cli.Context,
+	generalConfig *config.Config,
+	credentialsConfig config.CredentialsConfig,
+	tlsConfig *tls.Config,
+) (*grpc.Server, error) {
+	if !generalConfig.GrpcServer.Enabled || cliContext.GlobalBool(startAsRosetta.Name) {
+		return nil, nil
+	}
+
+	facades, err := versionsRegistry.GetAllVersions()
+	if err != nil {
+		return nil, err
+	}
+	versionData, ok := facades["v1.0"]
+	if !ok {
+		return nil, fmt.Errorf("the v1.0 facade cannot be used by the gRPC server")
+	}
+	facade, ok := versionData.Facade.(grpcapi.FacadeHandler)
+	if !ok {
+		return nil, fmt.Errorf("the v1.0 facade cannot be used by the gRPC server")
+	}
+
+	grpcServer, policyCloser, err := grpcapi.CreateServer(grpcapi.ArgsServer{
+		Facade:              facade,
+		Config:              generalConfig.GrpcServer,
+		TLSConfig:           tlsConfig,
+		ApiConfig:           versionData.ApiConfig,
+		CredentialsVerifier: middleware.NewCredentialsVerifier(credentialsConfig),
+		RateLimitWindow:     time.Duration(generalConfig.GeneralSettings.RateLimitWindowDurationSeconds) * time.Second,
+	})
+	if err != nil {
+		return nil, err
+	}
+	registerClosableComponent(policyCloser)
+
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", generalConfig.GrpcServer.Port))
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		errServe := grpcServer.Serve(listener)
+		if errServe != nil {
+			log.Error("cannot start the gRPC server", "err", errServe)
+			os.Exit(1)
+		}
+	}()
+	log.Info("started gRPC server", "port", generalConfig.GrpcServer.Port)
+
+	return grpcServer, nil
 }
 
-func waitForServerShutdown(httpServer *http.Server) {
-	quit := make(chan os.Signal)
+func waitForServerShutdown(httpServer *http.Server, grpcServer *grpc.Server) {
+	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, os.Interrupt, os.Kill)
 	<-quit
 
@@ -623,6 +1000,39 @@ func waitForServerShutdown(httpServer *http.Server) {
 	defer cancel()
 	_ = httpServer.Shutdown(shutdownContext)
 	_ = httpServer.Close()
+
+	if grpcServer != nil {
+		stopGrpcServer(shutdownContext, grpcServer)
+	}
+}
+
+// registerClosableComponent adds a component to the ones closed on shutdown
+func registerClosableComponent(component io.Closer) {
+	closableComponents = append(closableComponents, component)
+}
+
+// closeComponents closes the registered components, in the reverse order of their registration
+func closeComponents() {
+	for i := len(closableComponents) - 1; i >= 0; i-- {
+		err := closableComponents[i].Close()
+		log.LogIfError(err)
+	}
+}
+
+// stopGrpcServer waits for the pending calls until the context expires. The remaining calls and the hyperblocks
+// streams are then closed
+func stopGrpcServer(ctx context.Context, grpcServer *grpc.Server) {
+	stopped := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		grpcServer.Stop()
+	}
 }
 
 func removeLogColors() {
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/config"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/rosetta"
+	"github.com/ElrondNetwork/elrond-proxy-go/rosetta/provider/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func createRosettaNetworkArgs(chainID string) rosetta.NetworkArgs {
+	generalConfig := &config.Config{}
+	generalConfig.Rosetta.GenesisBlockHash = "genesis"
+
+	return rosetta.NetworkArgs{
+		Facade: &mock.ElrondProxyClientMock{
+			GetNetworkConfigMetricsCalled: func() (*data.GenericAPIResponse, error) {
+				return &data.GenericAPIResponse{
+					Data: map[string]interface{}{
+						"config": map[string]interface{}{
+							"erd_chain_id": chainID,
+						},
+					},
+				}, nil
+			},
+		},
+		GeneralConfig: generalConfig,
+	}
+}
+
+func TestCreateRosettaServer_DuplicatedNetworkShouldErr(t *testing.T) {
+	t.Parallel()
+
+	networks := []rosetta.NetworkArgs{createRosettaNetworkArgs("1"), createRosettaNetworkArgs("1")}
+	httpServer, err := createRosettaServer(networks, 8080, nil)
+	require.Equal(t, rosetta.ErrDuplicatedNetwork, err)
+	require.Nil(t, httpServer)
+}
+
+func TestCreateRosettaServer_NoNetworkShouldErr(t *testing.T) {
+	t.Parallel()
+
+	httpServer, err := createRosettaServer(nil, 8080, nil)
+	require.Equal(t, rosetta.ErrNoNetwork, err)
+	require.Nil(t, httpServer)
+}
+
+func TestCreateRosettaServer(t *testing.T) {
+	t.Parallel()
+
+	networks := []rosetta.NetworkArgs{createRosettaNetworkArgs("1"), createRosettaNetworkArgs("T")}
+	httpServer, err := createRosettaServer(networks, 8080, nil)
+	require.Nil(t, err)
+	require.NotNil(t, httpServer)
+}
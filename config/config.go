@@ -25,10 +25,114 @@ type Config struct {
 	Marshalizer            config.TypeConfig
 	Hasher                 config.TypeConfig
 	ApiLogging             ApiLoggingConfig
+	ServerTLS              ServerTLSConfig
+	ObserversTLS           ClientTLSConfig
+	GrpcServer             GrpcServerConfig
+	SCQueryCache           SCQueryCacheConfig
+	ABIDecoder             ABIDecoderConfig
+	Rosetta                RosettaConfig
 	Observers              []*data.NodeData
 	FullHistoryNodes       []*data.NodeData
 }
 
+// ServerTLSConfig holds the configuration related to serving the proxy's API over TLS
+type ServerTLSConfig struct {
+	Enabled                  bool
+	CertificateFile          string
+	KeyFile                  string
+	MinVersion               string
+	ClientCAFile             string
+	RequireClientCertificate bool
+	ReloadIntervalSec        int
+}
+
+// ClientTLSConfig holds the configuration used by the proxy when it connects to the nodes over https
+type ClientTLSConfig struct {
+	CAFile            string
+	CertificateFile   string
+	KeyFile           string
+	MinVersion        string
+	ReloadIntervalSec int
+}
+
+// GrpcServerConfig holds the configuration of the gRPC server started next to the web server
+type GrpcServerConfig struct {
+	Enabled                      bool
+	Port                         int
+	HyperblocksPollingIntervalMs int
+	MaxConcurrentStreams         uint32
+}
+
+// SCQueryCacheConfig holds the configuration of the cache for the outputs of the smart contract views
+type SCQueryCacheConfig struct {
+	Enabled                bool
+	BlockPollingIntervalMs int
+	MaxEntriesPerShard     int
+	Contracts              []SCQueryCacheContractConfig
+}
+
+// SCQueryCacheContractConfig defines the views of a contract whose outputs may be cached
+type SCQueryCacheContractConfig struct {
+	Address   string
+	Functions []string
+}
+
+// ABIDecoderConfig holds the configuration of the decoder for the data of the contracts having an ABI
+type ABIDecoderConfig struct {
+	Directory string
+}
+
+// RosettaConfig holds the configuration used when the proxy is started as a rosetta server
+type RosettaConfig struct {
+	ESDTCurrencies      []ESDTCurrencyConfig
+	AdditionalNetworks  []RosettaNetworkConfig
+	GenesisBlockIndex   *int64
+	GenesisBlockHash    string
+	CurrencySymbol      string
+	CurrencyDecimals    int32
+	OldestBlockLookback uint64
+	Peers               []RosettaPeerConfig
+	DelegationGasLimits RosettaDelegationGasLimitsConfig
+}
+
+// RosettaDelegationGasLimitsConfig holds the gas limits of the delegation calls constructed by the rosetta server
+type RosettaDelegationGasLimitsConfig struct {
+	Delegate          uint64
+	UnDelegate        uint64
+	Withdraw          uint64
+	ClaimRewards      uint64
+	ReDelegateRewards uint64
+}
+
+// RosettaPeerConfig defines a peer reported by the rosetta network status
+type RosettaPeerConfig struct {
+	PeerID  string
+	Address string
+	ShardID uint32
+}
+
+// RosettaNetworkConfig defines another network served by the same rosetta server, through its own observers
+type RosettaNetworkConfig struct {
+	ConfigFile         string
+	ExternalConfigFile string
+}
+
+// ESDTCurrencyConfig defines an ESDT token tracked by the rosetta server
+type ESDTCurrencyConfig struct {
+	Identifier string
+	Decimals   int32
+}
+
+// RosettaOfflineConfig holds the static network configuration used by the rosetta server when started in offline mode
+type RosettaOfflineConfig struct {
+	ChainID        string
+	Denomination   uint64
+	MinGasPrice    uint64
+	MinGasLimit    uint64
+	GasPerDataByte uint64
+	MinTxVersion   uint32
+}
+
 // ApiLoggingConfig holds the configuration related to API requests logging
 type ApiLoggingConfig struct {
 	LoggingEnabled          bool
@@ -40,3 +144,27 @@ type CredentialsConfig struct {
 	Credentials []data.Credential
 	Hasher      config.TypeConfig
 }
+
+// ExternalConfig holds the configuration of the external storages used for the history queries
+type ExternalConfig struct {
+	ElasticSearchConnector config.ElasticSearchConfig
+	SQLConnector           SQLConnectorConfig
+	EmbeddedIndexer        EmbeddedIndexerConfig
+}
+
+// SQLConnectorConfig holds the configuration of the SQL database filled by the proxy's own hyperblocks indexer
+type SQLConnectorConfig struct {
+	Enabled                   bool
+	Driver                    string
+	DataSourceName            string
+	IndexerStartNonce         uint64
+	IndexerPollingIntervalSec int
+}
+
+// EmbeddedIndexerConfig holds the configuration of the on-disk index filled by the proxy's own hyperblocks indexer
+type EmbeddedIndexerConfig struct {
+	Enabled            bool
+	DBPath             string
+	StartNonce         uint64
+	PollingIntervalSec int
+}
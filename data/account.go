@@ -45,9 +45,31 @@ type ValidatorStatisticsApiResponse struct {
 	Code  string                      `json:"code"`
 }
 
+// AccountQueryOptions selects the block at which the state of an account is read. If none of the options is set, the
+// current state is read
+type AccountQueryOptions struct {
+	BlockNonce    uint64
+	HasBlockNonce bool
+	BlockHash     string
+	RootHash      string
+}
+
+// IsHistorical returns true if the options select the state at a given block
+func (aqo AccountQueryOptions) IsHistorical() bool {
+	return aqo.HasBlockNonce || aqo.BlockHash != "" || aqo.RootHash != ""
+}
+
+// BlockInfo holds the coordinates of the block an account state was read at
+type BlockInfo struct {
+	Nonce    uint64 `json:"nonce,omitempty"`
+	Hash     string `json:"hash,omitempty"`
+	RootHash string `json:"rootHash,omitempty"`
+}
+
 // ResponseAccount follows the format of the data field of an account response
 type ResponseAccount struct {
-	AccountData Account `json:"account"`
+	AccountData Account    `json:"account"`
+	BlockInfo   *BlockInfo `json:"blockInfo,omitempty"`
 }
 
 // AccountApiResponse defines a wrapped account that the node respond with
@@ -59,7 +81,8 @@ type AccountApiResponse struct {
 
 // AccountKeyValueResponseData follows the format of the data field on an account key-value response
 type AccountKeyValueResponseData struct {
-	Value string `json:"value"`
+	Value     string     `json:"value"`
+	BlockInfo *BlockInfo `json:"blockInfo,omitempty"`
 }
 
 // AccountKeyValueResponse defines the response for a request for a value of a key for an account
@@ -68,3 +91,30 @@ type AccountKeyValueResponse struct {
 	Error string                      `json:"error"`
 	Code  string                      `json:"code"`
 }
+
+const (
+	// BulkAccountFieldBalance selects the balance of the accounts in a bulk accounts request
+	BulkAccountFieldBalance = "balance"
+	// BulkAccountFieldNonce selects the nonce of the accounts in a bulk accounts request
+	BulkAccountFieldNonce = "nonce"
+	// BulkAccountFieldUsername selects the username of the accounts in a bulk accounts request
+	BulkAccountFieldUsername = "username"
+	// BulkAccountFieldESDTs selects the ESDT tokens of the accounts in a bulk accounts request
+	BulkAccountFieldESDTs = "esdts"
+)
+
+// BulkAccountsRequest defines the body of a bulk accounts request. If no field is provided, the balance, the nonce and
+// the username are returned
+type BulkAccountsRequest struct {
+	Addresses []string `json:"addresses"`
+	Fields    []string `json:"fields"`
+}
+
+// BulkAccountResult holds the requested fields of an account, or the error which prevented fetching them
+type BulkAccountResult struct {
+	Balance  *string     `json:"balance,omitempty"`
+	Nonce    *uint64     `json:"nonce,omitempty"`
+	Username *string     `json:"username,omitempty"`
+	ESDTs    interface{} `json:"esdts,omitempty"`
+	Error    string      `json:"error,omitempty"`
+}
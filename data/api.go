@@ -32,11 +32,22 @@ type VersionData struct {
 	ApiConfig  ApiRoutesConfig
 }
 
-// EndpointHandlerData holds the items needed for creating a new HTTP endpoint
+// EndpointHandlerData holds the items needed for creating a new HTTP endpoint. The optional Request, Response and
+// QueryParameters fields describe the endpoint and are used when generating the API specification
 type EndpointHandlerData struct {
-	Path    string
-	Handler gin.HandlerFunc
-	Method  string
+	Path            string
+	Handler         gin.HandlerFunc
+	Method          string
+	Request         interface{}
+	Response        interface{}
+	QueryParameters []QueryParameter
+}
+
+// QueryParameter describes an optional query parameter of an endpoint. The type is one of string, integer or boolean
+type QueryParameter struct {
+	Name        string
+	Type        string
+	Description string
 }
 
 // GroupHandler defines the actions that an api group handler should be able to do
@@ -45,6 +56,7 @@ type GroupHandler interface {
 	UpdateEndpoint(path string, handlerData EndpointHandlerData) error
 	RegisterRoutes(ws *gin.RouterGroup, apiConfig ApiRoutesConfig, authenticationFunc gin.HandlerFunc, rateLimiter gin.HandlerFunc)
 	RemoveEndpoint(path string) error
+	GetEndpoints() []EndpointHandlerData
 	IsInterfaceNil() bool
 }
 
@@ -76,15 +88,18 @@ type ApiRoutesConfig struct {
 
 // APIPackageConfig holds the configuration for the routes of each package
 type APIPackageConfig struct {
-	Routes []RouteConfig
+	Routes                   []RouteConfig
+	RequireClientCertificate bool
 }
 
 // RouteConfig holds the configuration for a single route
 type RouteConfig struct {
-	Name      string
-	Open      bool
-	Secured   bool
-	RateLimit uint64
+	Name               string
+	Open               bool
+	Secured            bool
+	RateLimit          uint64
+	MaxBatchSize       uint64
+	MaxConcurrentCalls uint64
 }
 
 // Credential holds an username and a password
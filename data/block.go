@@ -28,6 +28,7 @@ type Block struct {
 	Round                  uint64            `json:"round"`
 	Hash                   string            `json:"hash"`
 	PrevBlockHash          string            `json:"prevBlockHash"`
+	StateRootHash          string            `json:"stateRootHash,omitempty"`
 	Epoch                  uint32            `json:"epoch"`
 	Shard                  uint32            `json:"shard"`
 	NumTxs                 uint32            `json:"numTxs"`
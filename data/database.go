@@ -1,11 +1,25 @@
 package data
 
 import (
+	"fmt"
 	"math/big"
+	"time"
 
 	"github.com/ElrondNetwork/elastic-indexer-go"
 )
 
+const (
+	// TransactionsDirectionIn selects the transactions received by an address
+	TransactionsDirectionIn = "in"
+	// TransactionsDirectionOut selects the transactions sent by an address
+	TransactionsDirectionOut = "out"
+
+	// SortOrderAscending sorts the transactions from the oldest to the newest
+	SortOrderAscending = "asc"
+	// SortOrderDescending sorts the transactions from the newest to the oldest
+	SortOrderDescending = "desc"
+)
+
 // DatabaseTransaction extends indexer.Transaction with the 'hash' field that is not ignored in json schema
 type DatabaseTransaction struct {
 	Hash string `json:"hash"`
@@ -21,3 +35,33 @@ func (dt *DatabaseTransaction) CalculateFee() string {
 
 	return fee.String()
 }
+
+// HistoryCursor returns the cursor of the transaction, used to fetch the transactions that follow it in a history
+func (dt *DatabaseTransaction) HistoryCursor() string {
+	return fmt.Sprintf("%d-%d", uint64(dt.Timestamp), dt.SearchOrder)
+}
+
+// DatabaseSCResult is a smart contract result, as stored in the external storage along with its transaction
+type DatabaseSCResult = indexer.ScResult
+
+// DatabaseEvent is an event logged by a smart contract, as stored in the logs index of the external storage
+type DatabaseEvent struct {
+	TxHash    string        `json:"txHash"`
+	Timestamp time.Duration `json:"timestamp"`
+	indexer.Event
+}
+
+// TransactionsHistoryOptions holds the paging, filtering and sorting options of an address' transactions history.
+// The zero value selects the latest transactions, in both directions
+type TransactionsHistoryOptions struct {
+	From         int
+	Size         int
+	Cursor       string
+	Direction    string
+	Status       string
+	StartTime    uint64
+	EndTime      uint64
+	Token        string
+	Counterparty string
+	Order        string
+}
@@ -1,9 +1,23 @@
 package data
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 // ErrNilTransaction signals that a nil transaction has been provided
 var ErrNilTransaction = errors.New("nil transaction")
 
 // ErrNilPubKeyConverter signals that a nil pub key converter has been provided
 var ErrNilPubKeyConverter = errors.New("nil pub key converter")
+
+// HistoryScanLimitError is returned along with the transactions found so far when a history query reached the maximum
+// number of scanned entries before filling its page. The query can be resumed from the Cursor
+type HistoryScanLimitError struct {
+	Cursor string
+}
+
+// Error returns the error message
+func (e *HistoryScanLimitError) Error() string {
+	return fmt.Sprintf("the history scan limit was reached, the query can be resumed from cursor %s", e.Cursor)
+}
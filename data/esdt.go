@@ -19,3 +19,27 @@ func IsValidEsdtPath(path string) bool {
 
 	return false
 }
+
+// ESDTToken holds the details of an ESDT token owned by an account, as returned by the nodes
+type ESDTToken struct {
+	TokenIdentifier string   `json:"tokenIdentifier"`
+	Balance         string   `json:"balance"`
+	Properties      string   `json:"properties"`
+	Nonce           uint64   `json:"nonce,omitempty"`
+	Name            string   `json:"name,omitempty"`
+	Creator         string   `json:"creator,omitempty"`
+	Royalties       string   `json:"royalties,omitempty"`
+	Hash            []byte   `json:"hash,omitempty"`
+	URIs            [][]byte `json:"uris,omitempty"`
+	Attributes      []byte   `json:"attributes,omitempty"`
+}
+
+// ESDTTokensResponseData follows the format of the data field of an account's ESDT tokens response
+type ESDTTokensResponseData struct {
+	ESDTs map[string]*ESDTToken `json:"esdts"`
+}
+
+// ESDTTokenResponseData follows the format of the data field of an account's ESDT token response
+type ESDTTokenResponseData struct {
+	TokenData *ESDTToken `json:"tokenData"`
+}
@@ -64,6 +64,25 @@ type FullTransaction struct {
 	HyperblockHash                    string                                `json:"hyperblockHash,omitempty"`
 	Receipt                           *transaction.ReceiptApi               `json:"receipt,omitempty"`
 	ScResults                         []*transaction.ApiSmartContractResult `json:"smartContractResults,omitempty"`
+	DecodedData                       *DecodedCall                          `json:"decodedData,omitempty"`
+	DecodedScResults                  []*DecodedSCResult                    `json:"decodedSmartContractResults,omitempty"`
+}
+
+// DecodedCall holds a smart contract call decoded with the ABI of the contract
+type DecodedCall struct {
+	Contract  string        `json:"contract"`
+	Function  string        `json:"function"`
+	Arguments []interface{} `json:"arguments,omitempty"`
+	Error     string        `json:"error,omitempty"`
+}
+
+// DecodedSCResult holds the values returned to the caller by a smart contract result, decoded with the ABI of the
+// called contract
+type DecodedSCResult struct {
+	Hash         string        `json:"hash"`
+	ReturnCode   string        `json:"returnCode"`
+	ReturnValues []interface{} `json:"returnValues,omitempty"`
+	Error        string        `json:"error,omitempty"`
 }
 
 // GetTransactionResponseData follows the format of the data field of get transaction response
@@ -78,6 +97,30 @@ type GetTransactionResponse struct {
 	Code  string                     `json:"code"`
 }
 
+// WrappedTransaction holds the fields of a transaction found in an observer's pool
+type WrappedTransaction struct {
+	TxFields map[string]interface{} `json:"txFields"`
+}
+
+// TransactionsPool holds the transactions found in the pools of the observers, grouped by their type
+type TransactionsPool struct {
+	RegularTransactions  []WrappedTransaction `json:"regularTransactions"`
+	SmartContractResults []WrappedTransaction `json:"smartContractResults"`
+	Rewards              []WrappedTransaction `json:"rewards"`
+}
+
+// TransactionsPoolResponseData follows the format of the data field of a transactions pool response
+type TransactionsPoolResponseData struct {
+	Transactions TransactionsPool `json:"txPool"`
+}
+
+// TransactionsPoolApiResponse defines a response from the node holding the transactions in its pool
+type TransactionsPoolApiResponse struct {
+	Data  TransactionsPoolResponseData `json:"data"`
+	Error string                       `json:"error"`
+	Code  string                       `json:"code"`
+}
+
 // transactionWrapper is a wrapper over a normal transaction in order to implement the interface needed in elrond-go
 // for computing gas cost for a transaction
 type transactionWrapper struct {
@@ -33,3 +33,9 @@ type SCQuery struct {
 	CallValue  string
 	Arguments  [][]byte
 }
+
+// SCQueryResult holds the output of a query from a batch, or the error which prevented its execution
+type SCQueryResult struct {
+	VMOutput *vm.VMOutputApi
+	Err      error
+}
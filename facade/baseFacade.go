@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/data/vm"
 	"github.com/ElrondNetwork/elrond-proxy-go/api/groups"
+	"github.com/ElrondNetwork/elrond-proxy-go/api/grpcapi"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 )
 
@@ -16,12 +17,16 @@ var _ groups.AccountsFacadeHandler = (*ElrondProxyFacade)(nil)
 var _ groups.BlocksFacadeHandler = (*ElrondProxyFacade)(nil)
 var _ groups.BlockAtlasFacadeHandler = (*ElrondProxyFacade)(nil)
 var _ groups.HyperBlockFacadeHandler = (*ElrondProxyFacade)(nil)
+var _ groups.TransactionDecoderFacadeHandler = (*ElrondProxyFacade)(nil)
 var _ groups.NetworkFacadeHandler = (*ElrondProxyFacade)(nil)
 var _ groups.NodeFacadeHandler = (*ElrondProxyFacade)(nil)
 var _ groups.TransactionFacadeHandler = (*ElrondProxyFacade)(nil)
 var _ groups.ValidatorFacadeHandler = (*ElrondProxyFacade)(nil)
 var _ groups.VmValuesFacadeHandler = (*ElrondProxyFacade)(nil)
 var _ groups.ProofFacadeHandler = (*ElrondProxyFacade)(nil)
+var _ groups.RpcFacadeHandler = (*ElrondProxyFacade)(nil)
+var _ groups.GraphQLFacadeHandler = (*ElrondProxyFacade)(nil)
+var _ grpcapi.FacadeHandler = (*ElrondProxyFacade)(nil)
 
 // ElrondProxyFacade implements the facade used in api calls
 type ElrondProxyFacade struct {
@@ -35,6 +40,7 @@ type ElrondProxyFacade struct {
 	nodeStatusProc NodeStatusProcessor
 	blockProc      BlockProcessor
 	proofProc      ProofProcessor
+	abiDecoderProc ABIDecoderProcessor
 
 	pubKeyConverter core.PubkeyConverter
 }
@@ -51,6 +57,7 @@ func NewElrondProxyFacade(
 	nodeStatusProc NodeStatusProcessor,
 	blockProc BlockProcessor,
 	proofProc ProofProcessor,
+	abiDecoderProc ABIDecoderProcessor,
 	pubKeyConverter core.PubkeyConverter,
 ) (*ElrondProxyFacade, error) {
 	if actionsProc == nil {
@@ -83,6 +90,9 @@ func NewElrondProxyFacade(
 	if proofProc == nil {
 		return nil, ErrNilProofProcessor
 	}
+	if abiDecoderProc == nil {
+		return nil, ErrNilABIDecoderProcessor
+	}
 
 	return &ElrondProxyFacade{
 		actionsProc:     actionsProc,
@@ -95,6 +105,7 @@ func NewElrondProxyFacade(
 		nodeStatusProc:  nodeStatusProc,
 		blockProc:       blockProc,
 		proofProc:       proofProc,
+		abiDecoderProc:  abiDecoderProc,
 		pubKeyConverter: pubKeyConverter,
 	}, nil
 }
@@ -104,6 +115,11 @@ func (epf *ElrondProxyFacade) GetAccount(address string) (*data.Account, error)
 	return epf.accountProc.GetAccount(address)
 }
 
+// GetAccountAtRootHash returns the state of an account at the given state root hash
+func (epf *ElrondProxyFacade) GetAccountAtRootHash(address string, rootHash string) (*data.Account, error) {
+	return epf.accountProc.GetAccountAtRootHash(address, rootHash)
+}
+
 // GetKeyValuePairs returns the key-value pairs for the given address
 func (epf *ElrondProxyFacade) GetKeyValuePairs(address string) (*data.GenericAPIResponse, error) {
 	return epf.accountProc.GetKeyValuePairs(address)
@@ -120,8 +136,18 @@ func (epf *ElrondProxyFacade) GetShardIDForAddress(address string) (uint32, erro
 }
 
 // GetTransactions returns transactions by address
-func (epf *ElrondProxyFacade) GetTransactions(address string) ([]data.DatabaseTransaction, error) {
-	return epf.accountProc.GetTransactions(address)
+func (epf *ElrondProxyFacade) GetTransactions(address string, options data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error) {
+	return epf.accountProc.GetTransactions(address, options)
+}
+
+// GetTokenTransfers returns the ESDT and NFT transfers of a token, of an address, or of both
+func (epf *ElrondProxyFacade) GetTokenTransfers(address string, options data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error) {
+	return epf.accountProc.GetTokenTransfers(address, options)
+}
+
+// GetEvents returns the events emitted by an address
+func (epf *ElrondProxyFacade) GetEvents(address string, identifier string, options data.TransactionsHistoryOptions) ([]data.DatabaseEvent, error) {
+	return epf.accountProc.GetEvents(address, identifier, options)
 }
 
 // GetESDTTokenData returns the token data for a given token name
@@ -129,6 +155,11 @@ func (epf *ElrondProxyFacade) GetESDTTokenData(address string, key string) (*dat
 	return epf.accountProc.GetESDTTokenData(address, key)
 }
 
+// GetESDTTokenDataAtRootHash returns the token data for a given token name at the given state root hash
+func (epf *ElrondProxyFacade) GetESDTTokenDataAtRootHash(address string, key string, rootHash string) (*data.GenericAPIResponse, error) {
+	return epf.accountProc.GetESDTTokenDataAtRootHash(address, key, rootHash)
+}
+
 // GetESDTTokenData returns the token data for a given token name
 func (epf *ElrondProxyFacade) GetESDTNftTokenData(address string, key string, nonce uint64) (*data.GenericAPIResponse, error) {
 	return epf.accountProc.GetESDTNftTokenData(address, key, nonce)
@@ -149,6 +180,41 @@ func (epf *ElrondProxyFacade) GetAllESDTTokens(address string) (*data.GenericAPI
 	return epf.accountProc.GetAllESDTTokens(address)
 }
 
+// GetAccountAtBlock returns the state of an account at the block selected by the options
+func (epf *ElrondProxyFacade) GetAccountAtBlock(address string, options data.AccountQueryOptions) (*data.ResponseAccount, error) {
+	return epf.accountProc.GetAccountAtBlock(address, options)
+}
+
+// GetValueForKeyAtBlock returns the value for the given address and key at the block selected by the options
+func (epf *ElrondProxyFacade) GetValueForKeyAtBlock(address string, key string, options data.AccountQueryOptions) (*data.AccountKeyValueResponseData, error) {
+	return epf.accountProc.GetValueForKeyAtBlock(address, key, options)
+}
+
+// GetKeyValuePairsAtBlock returns the key-value pairs for the given address at the block selected by the options
+func (epf *ElrondProxyFacade) GetKeyValuePairsAtBlock(address string, options data.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return epf.accountProc.GetKeyValuePairsAtBlock(address, options)
+}
+
+// GetAllESDTTokensAtBlock returns all the ESDT tokens for a given address at the block selected by the options
+func (epf *ElrondProxyFacade) GetAllESDTTokensAtBlock(address string, options data.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return epf.accountProc.GetAllESDTTokensAtBlock(address, options)
+}
+
+// GetESDTTokenDataAtBlock returns the token data for a given token name at the block selected by the options
+func (epf *ElrondProxyFacade) GetESDTTokenDataAtBlock(address string, key string, options data.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return epf.accountProc.GetESDTTokenDataAtBlock(address, key, options)
+}
+
+// GetESDTNftTokenDataAtBlock returns the nft token data for a given token at the block selected by the options
+func (epf *ElrondProxyFacade) GetESDTNftTokenDataAtBlock(address string, key string, nonce uint64, options data.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return epf.accountProc.GetESDTNftTokenDataAtBlock(address, key, nonce, options)
+}
+
+// GetBulkAccounts returns the requested fields of the accounts with the given addresses
+func (epf *ElrondProxyFacade) GetBulkAccounts(addresses []string, fields []string) (map[string]*data.BulkAccountResult, error) {
+	return epf.accountProc.GetBulkAccounts(addresses, fields)
+}
+
 // SendTransaction should send the transaction to the correct observer
 func (epf *ElrondProxyFacade) SendTransaction(tx *data.Transaction) (int, string, error) {
 	return epf.txProc.SendTransaction(tx)
@@ -179,6 +245,11 @@ func (epf *ElrondProxyFacade) GetTransaction(txHash string, withResults bool) (*
 	return epf.txProc.GetTransaction(txHash, withResults)
 }
 
+// DecodeTransaction decodes the smart contract call of the transaction and its results, using the ABI of the contract
+func (epf *ElrondProxyFacade) DecodeTransaction(tx *data.FullTransaction) {
+	epf.abiDecoderProc.DecodeTransaction(tx)
+}
+
 // ReloadObservers will try to reload the observers
 func (epf *ElrondProxyFacade) ReloadObservers() data.NodesReloadResponse {
 	return epf.actionsProc.ReloadObservers()
@@ -270,6 +341,16 @@ func (epf *ElrondProxyFacade) ExecuteSCQuery(query *data.SCQuery) (*vm.VMOutputA
 	return epf.scQueryService.ExecuteQuery(query)
 }
 
+// DecodeSCQueryOutput decodes the values returned by a smart contract query, using the ABI of the contract
+func (epf *ElrondProxyFacade) DecodeSCQueryOutput(query *data.SCQuery, vmOutput *vm.VMOutputApi) ([]interface{}, error) {
+	return epf.abiDecoderProc.DecodeSCQueryOutput(query, vmOutput)
+}
+
+// ExecuteSCQueries executes a batch of smart contract queries, returning a result for each of them
+func (epf *ElrondProxyFacade) ExecuteSCQueries(queries []*data.SCQuery) []*data.SCQueryResult {
+	return epf.scQueryService.ExecuteQueries(queries)
+}
+
 // GetHeartbeatData retrieves the heartbeat status from one observer
 func (epf *ElrondProxyFacade) GetHeartbeatData() (*data.HeartbeatResponse, error) {
 	return epf.heartbeatProc.GetHeartbeatData()
@@ -345,6 +426,11 @@ func (epf *ElrondProxyFacade) GetAtlasBlockByShardIDAndNonce(shardID uint32, non
 	return epf.blockProc.GetAtlasBlockByShardIDAndNonce(shardID, nonce)
 }
 
+// GetSCResultsByTxHash returns the smart contract results generated by a transaction
+func (epf *ElrondProxyFacade) GetSCResultsByTxHash(txHash string) ([]data.DatabaseSCResult, error) {
+	return epf.blockProc.GetSCResultsByTxHash(txHash)
+}
+
 // GetAddressConverter returns the address converter
 func (epf *ElrondProxyFacade) GetAddressConverter() (core.PubkeyConverter, error) {
 	return epf.pubKeyConverter, nil
@@ -355,11 +441,21 @@ func (epf *ElrondProxyFacade) GetLatestFullySynchronizedHyperblockNonce() (uint6
 	return epf.nodeStatusProc.GetLatestFullySynchronizedHyperblockNonce()
 }
 
+// GetLatestFinalHyperblockNonce returns the nonce of the latest hyperblock which cannot be reverted anymore
+func (epf *ElrondProxyFacade) GetLatestFinalHyperblockNonce() (uint64, error) {
+	return epf.nodeStatusProc.GetLatestFinalHyperblockNonce()
+}
+
 // ComputeTransactionHash will compute hash of a given transaction
 func (epf *ElrondProxyFacade) ComputeTransactionHash(tx *data.Transaction) (string, error) {
 	return epf.txProc.ComputeTransactionHash(tx)
 }
 
+// GetTransactionsPool returns the transactions found in the pools of the observers
+func (epf *ElrondProxyFacade) GetTransactionsPool() (*data.TransactionsPool, error) {
+	return epf.txProc.GetTransactionsPool()
+}
+
 // GetProof returns the Merkle proof for the given address
 func (epf *ElrondProxyFacade) GetProof(rootHash string, address string) (*data.GenericAPIResponse, error) {
 	return epf.proofProc.GetProof(rootHash, address)
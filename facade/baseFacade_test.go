@@ -32,6 +32,7 @@ func TestNewElrondProxyFacade_NilActionsProcShouldErr(t *testing.T) {
 		&mock.NodeStatusProcessorStub{},
 		&mock.BlockProcessorStub{},
 		&mock.ProofProcessorStub{},
+		&mock.ABIDecoderProcessorStub{},
 		publicKeyConverter,
 	)
 
@@ -53,6 +54,7 @@ func TestNewElrondProxyFacade_NilAccountProcShouldErr(t *testing.T) {
 		&mock.NodeStatusProcessorStub{},
 		&mock.BlockProcessorStub{},
 		&mock.ProofProcessorStub{},
+		&mock.ABIDecoderProcessorStub{},
 		publicKeyConverter,
 	)
 
@@ -74,6 +76,7 @@ func TestNewElrondProxyFacade_NilTransactionProcShouldErr(t *testing.T) {
 		&mock.NodeStatusProcessorStub{},
 		&mock.BlockProcessorStub{},
 		&mock.ProofProcessorStub{},
+		&mock.ABIDecoderProcessorStub{},
 		publicKeyConverter,
 	)
 
@@ -95,6 +98,7 @@ func TestNewElrondProxyFacade_NilGetValuesProcShouldErr(t *testing.T) {
 		&mock.NodeStatusProcessorStub{},
 		&mock.BlockProcessorStub{},
 		&mock.ProofProcessorStub{},
+		&mock.ABIDecoderProcessorStub{},
 		publicKeyConverter,
 	)
 
@@ -116,6 +120,7 @@ func TestNewElrondProxyFacade_NilHeartbeatProcShouldErr(t *testing.T) {
 		&mock.NodeStatusProcessorStub{},
 		&mock.BlockProcessorStub{},
 		&mock.ProofProcessorStub{},
+		&mock.ABIDecoderProcessorStub{},
 		publicKeyConverter,
 	)
 
@@ -137,6 +142,7 @@ func TestNewElrondProxyFacade_NilValStatsProcShouldErr(t *testing.T) {
 		&mock.NodeStatusProcessorStub{},
 		&mock.BlockProcessorStub{},
 		&mock.ProofProcessorStub{},
+		&mock.ABIDecoderProcessorStub{},
 		publicKeyConverter,
 	)
 
@@ -158,6 +164,7 @@ func TestNewElrondProxyFacade_NilFaucetProcShouldErr(t *testing.T) {
 		&mock.NodeStatusProcessorStub{},
 		&mock.BlockProcessorStub{},
 		&mock.ProofProcessorStub{},
+		&mock.ABIDecoderProcessorStub{},
 		publicKeyConverter,
 	)
 
@@ -179,6 +186,7 @@ func TestNewElrondProxyFacade_NilNodeProcessor(t *testing.T) {
 		nil,
 		&mock.BlockProcessorStub{},
 		&mock.ProofProcessorStub{},
+		&mock.ABIDecoderProcessorStub{},
 		publicKeyConverter,
 	)
 
@@ -200,6 +208,7 @@ func TestNewElrondProxyFacade_NilProofProcessor(t *testing.T) {
 		&mock.NodeStatusProcessorStub{},
 		&mock.BlockProcessorStub{},
 		nil,
+		&mock.ABIDecoderProcessorStub{},
 		publicKeyConverter,
 	)
 
@@ -207,6 +216,28 @@ func TestNewElrondProxyFacade_NilProofProcessor(t *testing.T) {
 	assert.Equal(t, facade.ErrNilProofProcessor, err)
 }
 
+func TestNewElrondProxyFacade_NilABIDecoderProcessor(t *testing.T) {
+	t.Parallel()
+
+	epf, err := facade.NewElrondProxyFacade(
+		&mock.ActionsProcessorStub{},
+		&mock.AccountProcessorStub{},
+		&mock.TransactionProcessorStub{},
+		&mock.SCQueryServiceStub{},
+		&mock.HeartbeatProcessorStub{},
+		&mock.ValidatorStatisticsProcessorStub{},
+		&mock.FaucetProcessorStub{},
+		&mock.NodeStatusProcessorStub{},
+		&mock.BlockProcessorStub{},
+		&mock.ProofProcessorStub{},
+		nil,
+		publicKeyConverter,
+	)
+
+	assert.Nil(t, epf)
+	assert.Equal(t, facade.ErrNilABIDecoderProcessor, err)
+}
+
 func TestNewElrondProxyFacade_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -221,6 +252,7 @@ func TestNewElrondProxyFacade_ShouldWork(t *testing.T) {
 		&mock.NodeStatusProcessorStub{},
 		&mock.BlockProcessorStub{},
 		&mock.ProofProcessorStub{},
+		&mock.ABIDecoderProcessorStub{},
 		publicKeyConverter,
 	)
 
@@ -248,6 +280,7 @@ func TestElrondProxyFacade_GetAccount(t *testing.T) {
 		&mock.NodeStatusProcessorStub{},
 		&mock.BlockProcessorStub{},
 		&mock.ProofProcessorStub{},
+		&mock.ABIDecoderProcessorStub{},
 		publicKeyConverter,
 	)
 
@@ -277,6 +310,7 @@ func TestElrondProxyFacade_SendTransaction(t *testing.T) {
 		&mock.NodeStatusProcessorStub{},
 		&mock.BlockProcessorStub{},
 		&mock.ProofProcessorStub{},
+		&mock.ABIDecoderProcessorStub{},
 		publicKeyConverter,
 	)
 
@@ -305,6 +339,7 @@ func TestElrondProxyFacade_SimulateTransaction(t *testing.T) {
 		&mock.NodeStatusProcessorStub{},
 		&mock.BlockProcessorStub{},
 		&mock.ProofProcessorStub{},
+		&mock.ABIDecoderProcessorStub{},
 		publicKeyConverter,
 	)
 
@@ -357,6 +392,7 @@ func TestElrondProxyFacade_SendUserFunds(t *testing.T) {
 		},
 		&mock.BlockProcessorStub{},
 		&mock.ProofProcessorStub{},
+		&mock.ABIDecoderProcessorStub{},
 		publicKeyConverter,
 	)
 
@@ -385,6 +421,7 @@ func TestElrondProxyFacade_GetDataValue(t *testing.T) {
 		&mock.NodeStatusProcessorStub{},
 		&mock.BlockProcessorStub{},
 		&mock.ProofProcessorStub{},
+		&mock.ABIDecoderProcessorStub{},
 		publicKeyConverter,
 	)
 
@@ -419,6 +456,7 @@ func TestElrondProxyFacade_GetHeartbeatData(t *testing.T) {
 		&mock.NodeStatusProcessorStub{},
 		&mock.BlockProcessorStub{},
 		&mock.ProofProcessorStub{},
+		&mock.ABIDecoderProcessorStub{},
 		publicKeyConverter,
 	)
 
@@ -450,6 +488,7 @@ func TestElrondProxyFacade_ReloadObservers(t *testing.T) {
 		&mock.NodeStatusProcessorStub{},
 		&mock.BlockProcessorStub{},
 		&mock.ProofProcessorStub{},
+		&mock.ABIDecoderProcessorStub{},
 		publicKeyConverter,
 	)
 
@@ -481,6 +520,7 @@ func TestElrondProxyFacade_ReloadFullHistoryObservers(t *testing.T) {
 		&mock.NodeStatusProcessorStub{},
 		&mock.BlockProcessorStub{},
 		&mock.ProofProcessorStub{},
+		&mock.ABIDecoderProcessorStub{},
 		publicKeyConverter,
 	)
 
@@ -31,3 +31,6 @@ var ErrNilBlockProcessor = errors.New("nil block processor provided")
 
 // ErrNilProofProcessor signals that a nil proof processor has been provided
 var ErrNilProofProcessor = errors.New("nil proof processor provided")
+
+// ErrNilABIDecoderProcessor signals that a nil ABI decoder processor has been provided
+var ErrNilABIDecoderProcessor = errors.New("nil ABI decoder processor provided")
@@ -17,15 +17,26 @@ type ActionsProcessor interface {
 // AccountProcessor defines what an account request processor should do
 type AccountProcessor interface {
 	GetAccount(address string) (*data.Account, error)
+	GetAccountAtRootHash(address string, rootHash string) (*data.Account, error)
 	GetShardIDForAddress(address string) (uint32, error)
 	GetValueForKey(address string, key string) (string, error)
-	GetTransactions(address string) ([]data.DatabaseTransaction, error)
+	GetTransactions(address string, options data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error)
+	GetTokenTransfers(address string, options data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error)
+	GetEvents(address string, identifier string, options data.TransactionsHistoryOptions) ([]data.DatabaseEvent, error)
 	GetAllESDTTokens(address string) (*data.GenericAPIResponse, error)
 	GetKeyValuePairs(address string) (*data.GenericAPIResponse, error)
 	GetESDTTokenData(address string, key string) (*data.GenericAPIResponse, error)
+	GetESDTTokenDataAtRootHash(address string, key string, rootHash string) (*data.GenericAPIResponse, error)
 	GetESDTsWithRole(address string, role string) (*data.GenericAPIResponse, error)
 	GetESDTNftTokenData(address string, key string, nonce uint64) (*data.GenericAPIResponse, error)
 	GetNFTTokenIDsRegisteredByAddress(address string) (*data.GenericAPIResponse, error)
+	GetAccountAtBlock(address string, options data.AccountQueryOptions) (*data.ResponseAccount, error)
+	GetValueForKeyAtBlock(address string, key string, options data.AccountQueryOptions) (*data.AccountKeyValueResponseData, error)
+	GetKeyValuePairsAtBlock(address string, options data.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetAllESDTTokensAtBlock(address string, options data.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetESDTTokenDataAtBlock(address string, key string, options data.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetESDTNftTokenDataAtBlock(address string, key string, nonce uint64, options data.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetBulkAccounts(addresses []string, fields []string) (map[string]*data.BulkAccountResult, error)
 }
 
 // TransactionProcessor defines what a transaction request processor should do
@@ -38,6 +49,7 @@ type TransactionProcessor interface {
 	GetTransaction(txHash string, withEvents bool) (*data.FullTransaction, error)
 	GetTransactionByHashAndSenderAddress(txHash string, sndAddr string, withEvents bool) (*data.FullTransaction, int, error)
 	ComputeTransactionHash(tx *data.Transaction) (string, error)
+	GetTransactionsPool() (*data.TransactionsPool, error)
 }
 
 // ProofProcessor defines what a proof request processor should do
@@ -47,9 +59,16 @@ type ProofProcessor interface {
 	VerifyProof(rootHash string, address string, proof []string) (*data.GenericAPIResponse, error)
 }
 
+// ABIDecoderProcessor defines what an ABI based decoder of the smart contracts data should do
+type ABIDecoderProcessor interface {
+	DecodeSCQueryOutput(query *data.SCQuery, vmOutput *vm.VMOutputApi) ([]interface{}, error)
+	DecodeTransaction(tx *data.FullTransaction)
+}
+
 // SCQueryService defines how data should be get from a SC account
 type SCQueryService interface {
 	ExecuteQuery(query *data.SCQuery) (*vm.VMOutputApi, error)
+	ExecuteQueries(queries []*data.SCQuery) []*data.SCQueryResult
 }
 
 // HeartbeatProcessor defines what a heartbeat processor should do
@@ -68,6 +87,7 @@ type NodeStatusProcessor interface {
 	GetNetworkStatusMetrics(shardID uint32) (*data.GenericAPIResponse, error)
 	GetEconomicsDataMetrics() (*data.GenericAPIResponse, error)
 	GetLatestFullySynchronizedHyperblockNonce() (uint64, error)
+	GetLatestFinalHyperblockNonce() (uint64, error)
 	GetAllIssuedESDTs(tokenType string) (*data.GenericAPIResponse, error)
 	GetEnableEpochsMetrics() (*data.GenericAPIResponse, error)
 	GetDirectStakedInfo() (*data.GenericAPIResponse, error)
@@ -77,6 +97,7 @@ type NodeStatusProcessor interface {
 // BlockProcessor defines what a block processor should do
 type BlockProcessor interface {
 	GetAtlasBlockByShardIDAndNonce(shardID uint32, nonce uint64) (data.AtlasBlock, error)
+	GetSCResultsByTxHash(txHash string) ([]data.DatabaseSCResult, error)
 	GetBlockByHash(shardID uint32, hash string, withTxs bool) (*data.BlockApiResponse, error)
 	GetBlockByNonce(shardID uint32, nonce uint64, withTxs bool) (*data.BlockApiResponse, error)
 	GetHyperBlockByHash(hash string) (*data.HyperblockApiResponse, error)
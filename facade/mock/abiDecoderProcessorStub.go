@@ -0,0 +1,28 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/vm"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// ABIDecoderProcessorStub -
+type ABIDecoderProcessorStub struct {
+	DecodeSCQueryOutputCalled func(query *data.SCQuery, vmOutput *vm.VMOutputApi) ([]interface{}, error)
+	DecodeTransactionCalled   func(tx *data.FullTransaction)
+}
+
+// DecodeSCQueryOutput -
+func (adps *ABIDecoderProcessorStub) DecodeSCQueryOutput(query *data.SCQuery, vmOutput *vm.VMOutputApi) ([]interface{}, error) {
+	if adps.DecodeSCQueryOutputCalled != nil {
+		return adps.DecodeSCQueryOutputCalled(query, vmOutput)
+	}
+
+	return nil, nil
+}
+
+// DecodeTransaction -
+func (adps *ABIDecoderProcessorStub) DecodeTransaction(tx *data.FullTransaction) {
+	if adps.DecodeTransactionCalled != nil {
+		adps.DecodeTransactionCalled(tx)
+	}
+}
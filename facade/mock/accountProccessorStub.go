@@ -9,7 +9,9 @@ type AccountProcessorStub struct {
 	GetAccountCalled                        func(address string) (*data.Account, error)
 	GetValueForKeyCalled                    func(address string, key string) (string, error)
 	GetShardIDForAddressCalled              func(address string) (uint32, error)
-	GetTransactionsCalled                   func(address string) ([]data.DatabaseTransaction, error)
+	GetTransactionsCalled                   func(address string, options data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error)
+	GetTokenTransfersCalled                 func(address string, options data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error)
+	GetEventsCalled                         func(address string, identifier string, options data.TransactionsHistoryOptions) ([]data.DatabaseEvent, error)
 	ValidatorStatisticsCalled               func() (map[string]*data.ValidatorApiResponse, error)
 	GetAllESDTTokensCalled                  func(address string) (*data.GenericAPIResponse, error)
 	GetESDTTokenDataCalled                  func(address string, key string) (*data.GenericAPIResponse, error)
@@ -17,6 +19,15 @@ type AccountProcessorStub struct {
 	GetESDTsWithRoleCalled                  func(address string, role string) (*data.GenericAPIResponse, error)
 	GetNFTTokenIDsRegisteredByAddressCalled func(address string) (*data.GenericAPIResponse, error)
 	GetKeyValuePairsCalled                  func(address string) (*data.GenericAPIResponse, error)
+	GetAccountAtRootHashCalled              func(address string, rootHash string) (*data.Account, error)
+	GetESDTTokenDataAtRootHashCalled        func(address string, key string, rootHash string) (*data.GenericAPIResponse, error)
+	GetAccountAtBlockCalled                 func(address string, options data.AccountQueryOptions) (*data.ResponseAccount, error)
+	GetValueForKeyAtBlockCalled             func(address string, key string, options data.AccountQueryOptions) (*data.AccountKeyValueResponseData, error)
+	GetKeyValuePairsAtBlockCalled           func(address string, options data.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetAllESDTTokensAtBlockCalled           func(address string, options data.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetESDTTokenDataAtBlockCalled           func(address string, key string, options data.AccountQueryOptions) (*data.GenericAPIResponse, error)
+	GetBulkAccountsCalled                   func(addresses []string, fields []string) (map[string]*data.BulkAccountResult, error)
+	GetESDTNftTokenDataAtBlockCalled        func(address string, key string, nonce uint64, options data.AccountQueryOptions) (*data.GenericAPIResponse, error)
 }
 
 // GetKeyValuePairs -
@@ -65,11 +76,66 @@ func (aps *AccountProcessorStub) GetShardIDForAddress(address string) (uint32, e
 }
 
 // GetTransactions --
-func (aps *AccountProcessorStub) GetTransactions(address string) ([]data.DatabaseTransaction, error) {
-	return aps.GetTransactionsCalled(address)
+func (aps *AccountProcessorStub) GetTransactions(address string, options data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error) {
+	return aps.GetTransactionsCalled(address, options)
+}
+
+// GetTokenTransfers --
+func (aps *AccountProcessorStub) GetTokenTransfers(address string, options data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error) {
+	return aps.GetTokenTransfersCalled(address, options)
+}
+
+// GetEvents --
+func (aps *AccountProcessorStub) GetEvents(address string, identifier string, options data.TransactionsHistoryOptions) ([]data.DatabaseEvent, error) {
+	return aps.GetEventsCalled(address, identifier, options)
 }
 
 // ValidatorStatistics --
 func (aps *AccountProcessorStub) ValidatorStatistics() (map[string]*data.ValidatorApiResponse, error) {
 	return aps.ValidatorStatisticsCalled()
 }
+
+// GetAccountAtRootHash --
+func (aps *AccountProcessorStub) GetAccountAtRootHash(address string, rootHash string) (*data.Account, error) {
+	return aps.GetAccountAtRootHashCalled(address, rootHash)
+}
+
+// GetESDTTokenDataAtRootHash --
+func (aps *AccountProcessorStub) GetESDTTokenDataAtRootHash(address string, key string, rootHash string) (*data.GenericAPIResponse, error) {
+	return aps.GetESDTTokenDataAtRootHashCalled(address, key, rootHash)
+}
+
+// GetAccountAtBlock --
+func (aps *AccountProcessorStub) GetAccountAtBlock(address string, options data.AccountQueryOptions) (*data.ResponseAccount, error) {
+	return aps.GetAccountAtBlockCalled(address, options)
+}
+
+// GetValueForKeyAtBlock --
+func (aps *AccountProcessorStub) GetValueForKeyAtBlock(address string, key string, options data.AccountQueryOptions) (*data.AccountKeyValueResponseData, error) {
+	return aps.GetValueForKeyAtBlockCalled(address, key, options)
+}
+
+// GetKeyValuePairsAtBlock --
+func (aps *AccountProcessorStub) GetKeyValuePairsAtBlock(address string, options data.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return aps.GetKeyValuePairsAtBlockCalled(address, options)
+}
+
+// GetAllESDTTokensAtBlock --
+func (aps *AccountProcessorStub) GetAllESDTTokensAtBlock(address string, options data.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return aps.GetAllESDTTokensAtBlockCalled(address, options)
+}
+
+// GetESDTTokenDataAtBlock --
+func (aps *AccountProcessorStub) GetESDTTokenDataAtBlock(address string, key string, options data.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return aps.GetESDTTokenDataAtBlockCalled(address, key, options)
+}
+
+// GetESDTNftTokenDataAtBlock --
+func (aps *AccountProcessorStub) GetESDTNftTokenDataAtBlock(address string, key string, nonce uint64, options data.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return aps.GetESDTNftTokenDataAtBlockCalled(address, key, nonce, options)
+}
+
+// GetBulkAccounts --
+func (aps *AccountProcessorStub) GetBulkAccounts(addresses []string, fields []string) (map[string]*data.BulkAccountResult, error) {
+	return aps.GetBulkAccountsCalled(addresses, fields)
+}
@@ -5,6 +5,7 @@ import "github.com/ElrondNetwork/elrond-proxy-go/data"
 // BlockProcessorStub -
 type BlockProcessorStub struct {
 	GetBlockByShardIDAndNonceCalled func(shardID uint32, nonce uint64) (data.AtlasBlock, error)
+	GetSCResultsByTxHashCalled      func(txHash string) ([]data.DatabaseSCResult, error)
 	GetBlockByHashCalled            func(shardID uint32, hash string, withTxs bool) (*data.BlockApiResponse, error)
 	GetBlockByNonceCalled           func(shardID uint32, nonce uint64, withTxs bool) (*data.BlockApiResponse, error)
 	GetHyperBlockByHashCalled       func(hash string) (*data.HyperblockApiResponse, error)
@@ -24,6 +25,11 @@ func (bps *BlockProcessorStub) GetAtlasBlockByShardIDAndNonce(shardID uint32, no
 	return bps.GetBlockByShardIDAndNonceCalled(shardID, nonce)
 }
 
+// GetSCResultsByTxHash -
+func (bps *BlockProcessorStub) GetSCResultsByTxHash(txHash string) ([]data.DatabaseSCResult, error) {
+	return bps.GetSCResultsByTxHashCalled(txHash)
+}
+
 // GetHyperBlockByHash -
 func (bps *BlockProcessorStub) GetHyperBlockByHash(hash string) (*data.HyperblockApiResponse, error) {
 	if bps.GetHyperBlockByHashCalled != nil {
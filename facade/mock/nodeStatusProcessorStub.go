@@ -7,6 +7,7 @@ type NodeStatusProcessorStub struct {
 	GetConfigMetricsCalled        func() (*data.GenericAPIResponse, error)
 	GetNetworkMetricsCalled       func(shardID uint32) (*data.GenericAPIResponse, error)
 	GetLatestBlockNonceCalled     func() (uint64, error)
+	GetLatestFinalNonceCalled     func() (uint64, error)
 	GetEconomicsDataMetricsCalled func() (*data.GenericAPIResponse, error)
 	GetAllIssuedESDTsCalled       func(tokenType string) (*data.GenericAPIResponse, error)
 	GetDirectStakedInfoCalled     func() (*data.GenericAPIResponse, error)
@@ -34,6 +35,11 @@ func (nsps *NodeStatusProcessorStub) GetLatestFullySynchronizedHyperblockNonce()
 	return nsps.GetLatestBlockNonceCalled()
 }
 
+// GetLatestFinalHyperblockNonce -
+func (nsps *NodeStatusProcessorStub) GetLatestFinalHyperblockNonce() (uint64, error) {
+	return nsps.GetLatestFinalNonceCalled()
+}
+
 // GetAllIssuedESDTs -
 func (nsps *NodeStatusProcessorStub) GetAllIssuedESDTs(tokenType string) (*data.GenericAPIResponse, error) {
 	return nsps.GetAllIssuedESDTsCalled(tokenType)
@@ -7,10 +7,16 @@ import (
 
 // SCQueryServiceStub is a stub
 type SCQueryServiceStub struct {
-	ExecuteQueryCalled func(*data.SCQuery) (*vm.VMOutputApi, error)
+	ExecuteQueryCalled   func(*data.SCQuery) (*vm.VMOutputApi, error)
+	ExecuteQueriesCalled func([]*data.SCQuery) []*data.SCQueryResult
 }
 
 // ExecuteQuery is a stub
 func (serviceStub *SCQueryServiceStub) ExecuteQuery(query *data.SCQuery) (*vm.VMOutputApi, error) {
 	return serviceStub.ExecuteQueryCalled(query)
 }
+
+// ExecuteQueries is a stub
+func (serviceStub *SCQueryServiceStub) ExecuteQueries(queries []*data.SCQuery) []*data.SCQueryResult {
+	return serviceStub.ExecuteQueriesCalled(queries)
+}
@@ -17,6 +17,7 @@ type TransactionProcessorStub struct {
 	GetTransactionCalled                       func(txHash string, withEvents bool) (*data.FullTransaction, error)
 	GetTransactionByHashAndSenderAddressCalled func(txHash string, sndAddr string, withEvents bool) (*data.FullTransaction, int, error)
 	ComputeTransactionHashCalled               func(tx *data.Transaction) (string, error)
+	GetTransactionsPoolCalled                  func() (*data.TransactionsPool, error)
 }
 
 // SimulateTransaction -
@@ -63,3 +64,8 @@ func (tps *TransactionProcessorStub) GetTransactionByHashAndSenderAddress(txHash
 func (tps *TransactionProcessorStub) TransactionCostRequest(tx *data.Transaction) (*data.TxCostResponseData, error) {
 	return tps.TransactionCostRequestHandler(tx)
 }
+
+// GetTransactionsPool -
+func (tps *TransactionProcessorStub) GetTransactionsPool() (*data.TransactionsPool, error) {
+	return tps.GetTransactionsPoolCalled()
+}
@@ -0,0 +1,152 @@
+package abi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+)
+
+// ContractABI holds the parts of an Elrond ABI JSON file used for decoding the calls and the outputs of a contract
+type ContractABI struct {
+	Name      string                     `json:"name"`
+	Endpoints []*Endpoint                `json:"endpoints"`
+	Types     map[string]*TypeDefinition `json:"types"`
+
+	endpoints map[string]*Endpoint
+}
+
+// Endpoint describes a function of a contract
+type Endpoint struct {
+	Name    string       `json:"name"`
+	Inputs  []*Parameter `json:"inputs"`
+	Outputs []*Parameter `json:"outputs"`
+}
+
+// Parameter describes an input or an output of an endpoint
+type Parameter struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// TypeDefinition describes a custom type of a contract: a struct or an enum
+type TypeDefinition struct {
+	Type     string         `json:"type"`
+	Fields   []*Parameter   `json:"fields"`
+	Variants []*EnumVariant `json:"variants"`
+}
+
+// EnumVariant describes a variant of an enum, along with its fields, if any
+type EnumVariant struct {
+	Name         string       `json:"name"`
+	Discriminant uint8        `json:"discriminant"`
+	Fields       []*Parameter `json:"fields"`
+}
+
+// LoadContractABI reads and parses the ABI file at the given path
+func LoadContractABI(path string) (*ContractABI, error) {
+	buff, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseContractABI(buff)
+}
+
+// ParseContractABI parses an ABI JSON, checking that all the types used by its endpoints are known
+func ParseContractABI(buff []byte) (*ContractABI, error) {
+	contractABI := &ContractABI{}
+	err := json.Unmarshal(buff, contractABI)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidABI, err)
+	}
+
+	contractABI.endpoints = make(map[string]*Endpoint, len(contractABI.Endpoints))
+	for _, endpoint := range contractABI.Endpoints {
+		if endpoint == nil || len(endpoint.Name) == 0 {
+			return nil, fmt.Errorf("%w: endpoint without name", ErrInvalidABI)
+		}
+
+		err = contractABI.checkParameters(endpoint.Inputs)
+		if err != nil {
+			return nil, fmt.Errorf("%w: endpoint %s: %v", ErrInvalidABI, endpoint.Name, err)
+		}
+		err = contractABI.checkParameters(endpoint.Outputs)
+		if err != nil {
+			return nil, fmt.Errorf("%w: endpoint %s: %v", ErrInvalidABI, endpoint.Name, err)
+		}
+
+		contractABI.endpoints[endpoint.Name] = endpoint
+	}
+
+	for name, definition := range contractABI.Types {
+		err = contractABI.checkTypeDefinition(definition)
+		if err != nil {
+			return nil, fmt.Errorf("%w: type %s: %v", ErrInvalidABI, name, err)
+		}
+	}
+
+	return contractABI, nil
+}
+
+// GetEndpoint returns the endpoint with the given name, if any
+func (ca *ContractABI) GetEndpoint(name string) (*Endpoint, bool) {
+	endpoint, ok := ca.endpoints[name]
+	return endpoint, ok
+}
+
+func (ca *ContractABI) checkTypeDefinition(definition *TypeDefinition) error {
+	if definition == nil {
+		return ErrUnknownType
+	}
+
+	switch definition.Type {
+	case typeDefinitionStruct:
+		return ca.checkParameters(definition.Fields)
+	case typeDefinitionEnum:
+		for _, variant := range definition.Variants {
+			err := ca.checkParameters(variant.Fields)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownType, definition.Type)
+	}
+}
+
+func (ca *ContractABI) checkParameters(parameters []*Parameter) error {
+	for _, parameter := range parameters {
+		if parameter == nil {
+			return ErrUnknownType
+		}
+
+		abiType, err := parseType(parameter.Type)
+		if err != nil {
+			return err
+		}
+
+		err = ca.checkType(abiType)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ca *ContractABI) checkType(abiType *typeExpression) error {
+	_, isCustom := ca.Types[abiType.name]
+	if !isCustom && !isKnownType(abiType) {
+		return fmt.Errorf("%w: %s", ErrUnknownType, abiType)
+	}
+
+	for _, argument := range abiType.arguments {
+		err := ca.checkType(argument)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package abi
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseType(t *testing.T) {
+	t.Parallel()
+
+	abiType, err := parseType("variadic<multi<u32,List<Option<utf-8 string>>>>")
+	require.Nil(t, err)
+	require.Equal(t, "variadic", abiType.name)
+	require.Equal(t, "variadic<multi<u32,List<Option<utf-8 string>>>>", abiType.String())
+	require.Equal(t, "utf-8 string", abiType.arguments[0].arguments[1].arguments[0].arguments[0].name)
+
+	abiType, err = parseType("tuple<u8, Address>")
+	require.Nil(t, err)
+	require.Equal(t, "tuple<u8,Address>", abiType.String())
+
+	for _, invalid := range []string{"", "List<", "List<u8", "List<u8>>", "List<>", "tuple<u8,>"} {
+		_, err = parseType(invalid)
+		require.True(t, errors.Is(err, ErrInvalidTypeExpression), invalid)
+	}
+}
+
+func TestLoadContractABI(t *testing.T) {
+	t.Parallel()
+
+	contractABI, err := LoadContractABI("testdata/test.abi.json")
+	require.Nil(t, err)
+	require.Equal(t, "Test", contractABI.Name)
+
+	endpoint, ok := contractABI.GetEndpoint("stake")
+	require.True(t, ok)
+	require.Len(t, endpoint.Inputs, 3)
+
+	_, ok = contractABI.GetEndpoint("missing")
+	require.False(t, ok)
+
+	_, err = LoadContractABI("testdata/missing.abi.json")
+	require.NotNil(t, err)
+}
+
+func TestParseContractABI_InvalidABIShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, err := ParseContractABI([]byte("not json"))
+	require.True(t, errors.Is(err, ErrInvalidABI))
+
+	_, err = ParseContractABI([]byte(`{"endpoints": [{"name": "f", "inputs": [{"type": "Unknown"}]}]}`))
+	require.True(t, errors.Is(err, ErrInvalidABI))
+	require.Contains(t, err.Error(), ErrUnknownType.Error())
+
+	_, err = ParseContractABI([]byte(`{"endpoints": [{"name": "f", "outputs": [{"type": "List<u8,u8>"}]}]}`))
+	require.True(t, errors.Is(err, ErrInvalidABI))
+
+	_, err = ParseContractABI([]byte(`{"endpoints": [], "types": {"T": {"type": "union"}}}`))
+	require.True(t, errors.Is(err, ErrInvalidABI))
+
+	contractABI, err := ParseContractABI([]byte(`{"endpoints": [{"name": "f", "outputs": [{"type": "array32<u8>"}]}]}`))
+	require.Nil(t, err)
+	require.NotNil(t, contractABI)
+}
@@ -0,0 +1,419 @@
+package abi
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+)
+
+const (
+	addressLength     = 32
+	hashLength        = 32
+	codeMetadataLen   = 2
+	lengthPrefixBytes = 4
+)
+
+// integersSizes holds the number of bytes of the integer types, when nested in other values
+var integersSizes = map[string]int{
+	"u8":    1,
+	"u16":   2,
+	"u32":   4,
+	"u64":   8,
+	"usize": 4,
+	"i8":    1,
+	"i16":   2,
+	"i32":   4,
+	"i64":   8,
+	"isize": 4,
+}
+
+var signedIntegers = map[string]bool{
+	"i8":    true,
+	"i16":   true,
+	"i32":   true,
+	"i64":   true,
+	"isize": true,
+}
+
+// Decoder decodes the arguments and the return values of the contracts' endpoints into JSON friendly values. The
+// integers of up to 32 bits are returned as numbers, the larger ones as decimal strings. The addresses are returned
+// in their bech32 form, the strings and the token identifiers as they are and the other bytes hex encoded
+type Decoder struct {
+	pubKeyConverter core.PubkeyConverter
+}
+
+// NewDecoder creates a new instance of Decoder
+func NewDecoder(pubKeyConverter core.PubkeyConverter) (*Decoder, error) {
+	if check.IfNil(pubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+
+	return &Decoder{
+		pubKeyConverter: pubKeyConverter,
+	}, nil
+}
+
+// DecodeInputs decodes the arguments of a call to the given endpoint
+func (d *Decoder) DecodeInputs(contractABI *ContractABI, endpointName string, values [][]byte) ([]interface{}, error) {
+	endpoint, ok := contractABI.GetEndpoint(endpointName)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownEndpoint, endpointName)
+	}
+
+	return d.decodeTopLevelValues(contractABI, endpoint.Inputs, values)
+}
+
+// DecodeOutputs decodes the values returned by the given endpoint
+func (d *Decoder) DecodeOutputs(contractABI *ContractABI, endpointName string, values [][]byte) ([]interface{}, error) {
+	endpoint, ok := contractABI.GetEndpoint(endpointName)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownEndpoint, endpointName)
+	}
+
+	return d.decodeTopLevelValues(contractABI, endpoint.Outputs, values)
+}
+
+func (d *Decoder) decodeTopLevelValues(contractABI *ContractABI, parameters []*Parameter, values [][]byte) ([]interface{}, error) {
+	decodedValues := make([]interface{}, 0, len(parameters))
+	remainingValues := values
+	for _, parameter := range parameters {
+		abiType, err := parseType(parameter.Type)
+		if err != nil {
+			return nil, err
+		}
+
+		var decoded interface{}
+		decoded, remainingValues, err = d.decodeMultiValue(contractABI, abiType, remainingValues)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", parameter.Type, err)
+		}
+		decodedValues = append(decodedValues, decoded)
+	}
+
+	if len(remainingValues) > 0 {
+		return nil, fmt.Errorf("%w: %d values more than expected", ErrInvalidEncodedValue, len(remainingValues))
+	}
+
+	return decodedValues, nil
+}
+
+// decodeMultiValue decodes the next top level values, returning the ones which were not used
+func (d *Decoder) decodeMultiValue(contractABI *ContractABI, abiType *typeExpression, values [][]byte) (interface{}, [][]byte, error) {
+	switch abiType.name {
+	case "variadic":
+		items := make([]interface{}, 0)
+		for len(values) > 0 {
+			item, remainingValues, err := d.decodeMultiValue(contractABI, abiType.arguments[0], values)
+			if err != nil {
+				return nil, nil, err
+			}
+			items = append(items, item)
+			values = remainingValues
+		}
+		return items, values, nil
+	case "optional":
+		if len(values) == 0 {
+			return nil, values, nil
+		}
+		return d.decodeMultiValue(contractABI, abiType.arguments[0], values)
+	case "multi":
+		items := make([]interface{}, 0, len(abiType.arguments))
+		for _, argument := range abiType.arguments {
+			item, remainingValues, err := d.decodeMultiValue(contractABI, argument, values)
+			if err != nil {
+				return nil, nil, err
+			}
+			items = append(items, item)
+			values = remainingValues
+		}
+		return items, values, nil
+	default:
+		if len(values) == 0 {
+			return nil, nil, fmt.Errorf("%w: missing value", ErrInvalidEncodedValue)
+		}
+		decoded, err := d.decodeTopLevel(contractABI, abiType, values[0])
+		return decoded, values[1:], err
+	}
+}
+
+// decodeTopLevel decodes a value which is not nested in another one, so it spans over the whole buffer
+func (d *Decoder) decodeTopLevel(contractABI *ContractABI, abiType *typeExpression, buff []byte) (interface{}, error) {
+	if size, isInteger := integersSizes[abiType.name]; isInteger {
+		if len(buff) > size {
+			return nil, fmt.Errorf("%w: %d bytes for %s", ErrInvalidEncodedValue, len(buff), abiType.name)
+		}
+		return formatInteger(toBigInt(buff, signedIntegers[abiType.name]), size), nil
+	}
+
+	switch abiType.name {
+	case "BigUint":
+		return big.NewInt(0).SetBytes(buff).String(), nil
+	case "BigInt":
+		return toBigInt(buff, true).String(), nil
+	case "bool":
+		if len(buff) == 0 {
+			return false, nil
+		}
+		if len(buff) == 1 && buff[0] == 1 {
+			return true, nil
+		}
+		return nil, fmt.Errorf("%w: %x for bool", ErrInvalidEncodedValue, buff)
+	case "Address", "H256", "CodeMetadata":
+		decoded, remaining, err := d.decodeNested(contractABI, abiType, buff)
+		if err != nil {
+			return nil, err
+		}
+		if len(remaining) > 0 {
+			return nil, fmt.Errorf("%w: %d bytes for %s", ErrInvalidEncodedValue, len(buff), abiType.name)
+		}
+		return decoded, nil
+	case "TokenIdentifier", "EgldOrEsdtTokenIdentifier", "utf-8 string":
+		return string(buff), nil
+	case "bytes", "BoxedBytes", "ManagedBuffer":
+		return hex.EncodeToString(buff), nil
+	case "Option":
+		if len(buff) == 0 {
+			return nil, nil
+		}
+		if buff[0] != 1 {
+			return nil, fmt.Errorf("%w: option tag %d", ErrInvalidEncodedValue, buff[0])
+		}
+		return d.decodeWholeNested(contractABI, abiType.arguments[0], buff[1:])
+	case "List", "Vec":
+		items := make([]interface{}, 0)
+		for len(buff) > 0 {
+			item, remaining, err := d.decodeNested(contractABI, abiType.arguments[0], buff)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+			buff = remaining
+		}
+		return items, nil
+	}
+
+	definition, isCustom := contractABI.Types[abiType.name]
+	if isCustom && definition.Type == typeDefinitionEnum && len(buff) == 0 {
+		return d.decodeEnumVariant(contractABI, definition, 0, buff)
+	}
+
+	return d.decodeWholeNested(contractABI, abiType, buff)
+}
+
+func (d *Decoder) decodeWholeNested(contractABI *ContractABI, abiType *typeExpression, buff []byte) (interface{}, error) {
+	decoded, remaining, err := d.decodeNested(contractABI, abiType, buff)
+	if err != nil {
+		return nil, err
+	}
+	if len(remaining) > 0 {
+		return nil, fmt.Errorf("%w: %d bytes left after %s", ErrInvalidEncodedValue, len(remaining), abiType)
+	}
+
+	return decoded, nil
+}
+
+// decodeNested decodes a value from the beginning of the buffer, returning the bytes which were not used
+func (d *Decoder) decodeNested(contractABI *ContractABI, abiType *typeExpression, buff []byte) (interface{}, []byte, error) {
+	if size, isInteger := integersSizes[abiType.name]; isInteger {
+		value, remaining, err := readBytes(buff, size)
+		if err != nil {
+			return nil, nil, err
+		}
+		return formatInteger(toBigInt(value, signedIntegers[abiType.name]), size), remaining, nil
+	}
+
+	if length, isArray := getArrayLength(abiType.name); isArray {
+		return d.decodeNestedItems(contractABI, abiType.arguments[0], length, buff)
+	}
+
+	switch abiType.name {
+	case "BigUint", "BigInt", "TokenIdentifier", "EgldOrEsdtTokenIdentifier", "utf-8 string", "bytes", "BoxedBytes", "ManagedBuffer":
+		value, remaining, err := readLengthPrefixed(buff)
+		if err != nil {
+			return nil, nil, err
+		}
+		decoded, err := d.decodeTopLevel(contractABI, abiType, value)
+		return decoded, remaining, err
+	case "bool":
+		value, remaining, err := readBytes(buff, 1)
+		if err != nil {
+			return nil, nil, err
+		}
+		if value[0] > 1 {
+			return nil, nil, fmt.Errorf("%w: %d for bool", ErrInvalidEncodedValue, value[0])
+		}
+		return value[0] == 1, remaining, nil
+	case "Address":
+		value, remaining, err := readBytes(buff, addressLength)
+		if err != nil {
+			return nil, nil, err
+		}
+		return d.pubKeyConverter.Encode(value), remaining, nil
+	case "H256":
+		value, remaining, err := readBytes(buff, hashLength)
+		if err != nil {
+			return nil, nil, err
+		}
+		return hex.EncodeToString(value), remaining, nil
+	case "CodeMetadata":
+		value, remaining, err := readBytes(buff, codeMetadataLen)
+		if err != nil {
+			return nil, nil, err
+		}
+		return hex.EncodeToString(value), remaining, nil
+	case "Option":
+		tag, remaining, err := readBytes(buff, 1)
+		if err != nil {
+			return nil, nil, err
+		}
+		switch tag[0] {
+		case 0:
+			return nil, remaining, nil
+		case 1:
+			return d.decodeNested(contractABI, abiType.arguments[0], remaining)
+		default:
+			return nil, nil, fmt.Errorf("%w: option tag %d", ErrInvalidEncodedValue, tag[0])
+		}
+	case "List", "Vec":
+		lengthBytes, remaining, err := readBytes(buff, lengthPrefixBytes)
+		if err != nil {
+			return nil, nil, err
+		}
+		return d.decodeNestedItems(contractABI, abiType.arguments[0], int(binary.BigEndian.Uint32(lengthBytes)), remaining)
+	case "tuple":
+		items := make([]interface{}, 0, len(abiType.arguments))
+		for _, argument := range abiType.arguments {
+			item, remaining, err := d.decodeNested(contractABI, argument, buff)
+			if err != nil {
+				return nil, nil, err
+			}
+			items = append(items, item)
+			buff = remaining
+		}
+		return items, buff, nil
+	}
+
+	definition, isCustom := contractABI.Types[abiType.name]
+	if !isCustom {
+		return nil, nil, fmt.Errorf("%w: %s cannot be nested", ErrUnknownType, abiType)
+	}
+
+	if definition.Type == typeDefinitionEnum {
+		discriminant, remaining, err := readBytes(buff, 1)
+		if err != nil {
+			return nil, nil, err
+		}
+		return d.decodeEnumVariantNested(contractABI, definition, discriminant[0], remaining)
+	}
+
+	return d.decodeFields(contractABI, definition.Fields, buff)
+}
+
+func (d *Decoder) decodeNestedItems(contractABI *ContractABI, itemType *typeExpression, numItems int, buff []byte) (interface{}, []byte, error) {
+	items := make([]interface{}, 0)
+	for i := 0; i < numItems; i++ {
+		item, remaining, err := d.decodeNested(contractABI, itemType, buff)
+		if err != nil {
+			return nil, nil, err
+		}
+		items = append(items, item)
+		buff = remaining
+	}
+
+	return items, buff, nil
+}
+
+func (d *Decoder) decodeFields(contractABI *ContractABI, fields []*Parameter, buff []byte) (map[string]interface{}, []byte, error) {
+	decodedFields := make(map[string]interface{}, len(fields))
+	for _, field := range fields {
+		fieldType, err := parseType(field.Type)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var decoded interface{}
+		decoded, buff, err = d.decodeNested(contractABI, fieldType, buff)
+		if err != nil {
+			return nil, nil, fmt.Errorf("field %s: %w", field.Name, err)
+		}
+		decodedFields[field.Name] = decoded
+	}
+
+	return decodedFields, buff, nil
+}
+
+func (d *Decoder) decodeEnumVariant(contractABI *ContractABI, definition *TypeDefinition, discriminant uint8, buff []byte) (interface{}, error) {
+	decoded, remaining, err := d.decodeEnumVariantNested(contractABI, definition, discriminant, buff)
+	if err != nil {
+		return nil, err
+	}
+	if len(remaining) > 0 {
+		return nil, fmt.Errorf("%w: %d bytes left after enum", ErrInvalidEncodedValue, len(remaining))
+	}
+
+	return decoded, nil
+}
+
+// decodeEnumVariantNested returns the name of the variant or, if the variant has fields, an object holding its name
+// and its fields
+func (d *Decoder) decodeEnumVariantNested(contractABI *ContractABI, definition *TypeDefinition, discriminant uint8, buff []byte) (interface{}, []byte, error) {
+	for _, variant := range definition.Variants {
+		if variant.Discriminant != discriminant {
+			continue
+		}
+		if len(variant.Fields) == 0 {
+			return variant.Name, buff, nil
+		}
+
+		fields, remaining, err := d.decodeFields(contractABI, variant.Fields, buff)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return map[string]interface{}{"name": variant.Name, "fields": fields}, remaining, nil
+	}
+
+	return nil, nil, fmt.Errorf("%w: unknown enum discriminant %d", ErrInvalidEncodedValue, discriminant)
+}
+
+func readBytes(buff []byte, length int) ([]byte, []byte, error) {
+	if len(buff) < length {
+		return nil, nil, fmt.Errorf("%w: expected %d bytes, got %d", ErrInvalidEncodedValue, length, len(buff))
+	}
+
+	return buff[:length], buff[length:], nil
+}
+
+func readLengthPrefixed(buff []byte) ([]byte, []byte, error) {
+	lengthBytes, remaining, err := readBytes(buff, lengthPrefixBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return readBytes(remaining, int(binary.BigEndian.Uint32(lengthBytes)))
+}
+
+// toBigInt converts big endian bytes to an integer, the signed ones being encoded in two's complement
+func toBigInt(buff []byte, isSigned bool) *big.Int {
+	value := big.NewInt(0).SetBytes(buff)
+	if isSigned && len(buff) > 0 && buff[0]&0x80 != 0 {
+		value.Sub(value, big.NewInt(0).Lsh(big.NewInt(1), uint(len(buff)*8)))
+	}
+
+	return value
+}
+
+func formatInteger(value *big.Int, size int) interface{} {
+	if size > 4 {
+		return value.String()
+	}
+	if value.Sign() < 0 {
+		return value.Int64()
+	}
+
+	return value.Uint64()
+}
@@ -0,0 +1,156 @@
+package abi
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/pubkeyConverter"
+	"github.com/stretchr/testify/require"
+)
+
+var testPubKeyConverter, _ = pubkeyConverter.NewBech32PubkeyConverter(32)
+
+func createTestDecoder(t *testing.T) (*Decoder, *ContractABI) {
+	decoder, err := NewDecoder(testPubKeyConverter)
+	require.Nil(t, err)
+
+	contractABI, err := LoadContractABI("testdata/test.abi.json")
+	require.Nil(t, err)
+
+	return decoder, contractABI
+}
+
+func mustDecodeHex(t *testing.T, value string) []byte {
+	buff, err := hex.DecodeString(value)
+	require.Nil(t, err)
+
+	return buff
+}
+
+func TestNewDecoder(t *testing.T) {
+	t.Parallel()
+
+	decoder, err := NewDecoder(nil)
+	require.Nil(t, decoder)
+	require.Equal(t, ErrNilPubKeyConverter, err)
+}
+
+func TestDecoder_DecodeOutputsSimpleValues(t *testing.T) {
+	t.Parallel()
+
+	decoder, contractABI := createTestDecoder(t)
+
+	decoded, err := decoder.DecodeOutputs(contractABI, "getTotalStaked", [][]byte{mustDecodeHex(t, "0de0b6b3a7640000")})
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{"1000000000000000000"}, decoded)
+
+	decoded, err = decoder.DecodeOutputs(contractABI, "getTotalStaked", [][]byte{{}})
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{"0"}, decoded)
+
+	_, err = decoder.DecodeOutputs(contractABI, "getTotalStaked", [][]byte{})
+	require.True(t, errors.Is(err, ErrInvalidEncodedValue))
+
+	_, err = decoder.DecodeOutputs(contractABI, "getTotalStaked", [][]byte{{1}, {2}})
+	require.True(t, errors.Is(err, ErrInvalidEncodedValue))
+
+	_, err = decoder.DecodeOutputs(contractABI, "missing", [][]byte{})
+	require.True(t, errors.Is(err, ErrUnknownEndpoint))
+}
+
+func TestDecoder_DecodeInputs(t *testing.T) {
+	t.Parallel()
+
+	decoder, contractABI := createTestDecoder(t)
+	delegator := bytes.Repeat([]byte{1}, 32)
+	referrer := bytes.Repeat([]byte{2}, 32)
+
+	decoded, err := decoder.DecodeInputs(contractABI, "stake", [][]byte{delegator, {0x01, 0x00}})
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{testPubKeyConverter.Encode(delegator), "256", nil}, decoded)
+
+	decoded, err = decoder.DecodeInputs(contractABI, "stake", [][]byte{delegator, {0x01}, referrer})
+	require.Nil(t, err)
+	require.Equal(t, testPubKeyConverter.Encode(referrer), decoded[2])
+
+	_, err = decoder.DecodeInputs(contractABI, "stake", [][]byte{{1, 2}, {0x01}})
+	require.True(t, errors.Is(err, ErrInvalidEncodedValue))
+
+	_, err = decoder.DecodeInputs(contractABI, "stake", [][]byte{delegator, bytes.Repeat([]byte{1}, 9)})
+	require.True(t, errors.Is(err, ErrInvalidEncodedValue))
+
+	decoded, err = decoder.DecodeInputs(contractABI, "getPositions", [][]byte{{1}, {2}, {}})
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{[]interface{}{uint64(1), uint64(2), uint64(0)}}, decoded)
+}
+
+func TestDecoder_DecodeOutputsStructsInMultiValues(t *testing.T) {
+	t.Parallel()
+
+	decoder, contractABI := createTestDecoder(t)
+	owner := bytes.Repeat([]byte{3}, 32)
+	position := append([]byte{}, owner...)
+	position = append(position, mustDecodeHex(t, "00000001"+"0a")...)
+	position = append(position, mustDecodeHex(t, "0000000a"+hex.EncodeToString([]byte("TKN-123456")))...)
+	position = append(position, 1)
+	position = append(position, mustDecodeHex(t, "00000002"+"00000001"+"61"+"00000002"+"6263")...)
+	position = append(position, mustDecodeHex(t, "deadbeef")...)
+
+	decoded, err := decoder.DecodeOutputs(contractABI, "getPositions", [][]byte{{7}, position})
+	require.Nil(t, err)
+	expectedPosition := map[string]interface{}{
+		"owner":  testPubKeyConverter.Encode(owner),
+		"amount": "10",
+		"token":  "TKN-123456",
+		"active": true,
+		"tags":   []interface{}{"a", "bc"},
+		"hash":   []interface{}{uint64(0xde), uint64(0xad), uint64(0xbe), uint64(0xef)},
+	}
+	require.Equal(t, []interface{}{[]interface{}{[]interface{}{uint64(7), expectedPosition}}}, decoded)
+
+	_, err = decoder.DecodeOutputs(contractABI, "getPositions", [][]byte{{7}, position[:len(position)-1]})
+	require.True(t, errors.Is(err, ErrInvalidEncodedValue))
+
+	_, err = decoder.DecodeOutputs(contractABI, "getPositions", [][]byte{{7}, append(position, 0)})
+	require.True(t, errors.Is(err, ErrInvalidEncodedValue))
+
+	_, err = decoder.DecodeOutputs(contractABI, "getPositions", [][]byte{{7}})
+	require.True(t, errors.Is(err, ErrInvalidEncodedValue))
+}
+
+func TestDecoder_DecodeOutputsEnumsAndSignedIntegers(t *testing.T) {
+	t.Parallel()
+
+	decoder, contractABI := createTestDecoder(t)
+
+	decoded, err := decoder.DecodeOutputs(contractABI, "getStatus", [][]byte{{}, {}, mustDecodeHex(t, "ffff0001")})
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{"Inactive", nil, []interface{}{int64(-1), uint64(1)}}, decoded)
+
+	action := mustDecodeHex(t, "01"+"01"+"00000001"+"ff"+"01"+"05")
+	decoded, err = decoder.DecodeOutputs(contractABI, "getStatus", [][]byte{{1}, action, {}})
+	require.Nil(t, err)
+	expectedAction := map[string]interface{}{
+		"name":   "Transfer",
+		"fields": map[string]interface{}{"0": "-1", "1": uint64(5)},
+	}
+	require.Equal(t, []interface{}{"Active", expectedAction, []interface{}{}}, decoded)
+
+	_, err = decoder.DecodeOutputs(contractABI, "getStatus", [][]byte{{2}, {}, {}})
+	require.True(t, errors.Is(err, ErrInvalidEncodedValue))
+
+	_, err = decoder.DecodeOutputs(contractABI, "getStatus", [][]byte{{1}, {2}, {}})
+	require.True(t, errors.Is(err, ErrInvalidEncodedValue))
+}
+
+func TestToBigInt(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "-128", toBigInt([]byte{0x80}, true).String())
+	require.Equal(t, "128", toBigInt([]byte{0x80}, false).String())
+	require.Equal(t, "127", toBigInt([]byte{0x7f}, true).String())
+	require.Equal(t, "-2", toBigInt([]byte{0xff, 0xfe}, true).String())
+	require.Equal(t, "0", toBigInt([]byte{}, true).String())
+}
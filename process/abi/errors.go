@@ -0,0 +1,21 @@
+package abi
+
+import "errors"
+
+// ErrInvalidABI signals that an ABI file cannot be parsed
+var ErrInvalidABI = errors.New("invalid ABI")
+
+// ErrUnknownType signals that a type is neither a known one nor a custom type of the contract
+var ErrUnknownType = errors.New("unknown ABI type")
+
+// ErrInvalidTypeExpression signals that a type expression cannot be parsed
+var ErrInvalidTypeExpression = errors.New("invalid ABI type expression")
+
+// ErrUnknownEndpoint signals that the contract ABI has no endpoint with the given name
+var ErrUnknownEndpoint = errors.New("unknown endpoint")
+
+// ErrInvalidEncodedValue signals that a value does not match the encoding of its type
+var ErrInvalidEncodedValue = errors.New("invalid encoded value")
+
+// ErrNilPubKeyConverter signals that a nil public key converter has been provided
+var ErrNilPubKeyConverter = errors.New("nil public key converter")
@@ -0,0 +1,167 @@
+package abi
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	typeDefinitionStruct = "struct"
+	typeDefinitionEnum   = "enum"
+
+	arrayTypePrefix = "array"
+)
+
+// typeExpression is a parsed ABI type, such as "List<Option<u64>>"
+type typeExpression struct {
+	name      string
+	arguments []*typeExpression
+}
+
+// String returns the type expression in its ABI format
+func (te *typeExpression) String() string {
+	if len(te.arguments) == 0 {
+		return te.name
+	}
+
+	arguments := make([]string, len(te.arguments))
+	for i, argument := range te.arguments {
+		arguments[i] = argument.String()
+	}
+
+	return fmt.Sprintf("%s<%s>", te.name, strings.Join(arguments, ","))
+}
+
+// isMultiValue returns true for the types which span over several top level values, such as the variadic arguments
+func (te *typeExpression) isMultiValue() bool {
+	switch te.name {
+	case "variadic", "optional", "multi":
+		return true
+	default:
+		return false
+	}
+}
+
+// typesArities holds the known types along with their number of type arguments. A negative arity allows any
+// number of type arguments, but at least one
+var typesArities = map[string]int{
+	"u8":                        0,
+	"u16":                       0,
+	"u32":                       0,
+	"u64":                       0,
+	"usize":                     0,
+	"i8":                        0,
+	"i16":                       0,
+	"i32":                       0,
+	"i64":                       0,
+	"isize":                     0,
+	"BigUint":                   0,
+	"BigInt":                    0,
+	"bool":                      0,
+	"Address":                   0,
+	"H256":                      0,
+	"TokenIdentifier":           0,
+	"EgldOrEsdtTokenIdentifier": 0,
+	"utf-8 string":              0,
+	"bytes":                     0,
+	"BoxedBytes":                0,
+	"ManagedBuffer":             0,
+	"CodeMetadata":              0,
+	"Option":                    1,
+	"List":                      1,
+	"Vec":                       1,
+	"variadic":                  1,
+	"optional":                  1,
+	"tuple":                     -1,
+	"multi":                     -1,
+}
+
+func isKnownType(abiType *typeExpression) bool {
+	arity, ok := typesArities[abiType.name]
+	if !ok {
+		_, isArray := getArrayLength(abiType.name)
+		if !isArray {
+			return false
+		}
+		arity = 1
+	}
+
+	if arity < 0 {
+		return len(abiType.arguments) > 0
+	}
+
+	return len(abiType.arguments) == arity
+}
+
+// getArrayLength returns the length of the fixed size array types, such as "array32"
+func getArrayLength(name string) (int, bool) {
+	if !strings.HasPrefix(name, arrayTypePrefix) {
+		return 0, false
+	}
+
+	length, err := strconv.Atoi(strings.TrimPrefix(name, arrayTypePrefix))
+	if err != nil || length <= 0 {
+		return 0, false
+	}
+
+	return length, true
+}
+
+// parseType parses an ABI type expression
+func parseType(expression string) (*typeExpression, error) {
+	parser := &typeParser{input: expression}
+	abiType, err := parser.parse()
+	if err != nil {
+		return nil, err
+	}
+	if parser.position != len(parser.input) {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidTypeExpression, expression)
+	}
+
+	return abiType, nil
+}
+
+type typeParser struct {
+	input    string
+	position int
+}
+
+func (tp *typeParser) parse() (*typeExpression, error) {
+	start := tp.position
+	for tp.position < len(tp.input) && !strings.ContainsRune("<>,", rune(tp.input[tp.position])) {
+		tp.position++
+	}
+
+	name := strings.TrimSpace(tp.input[start:tp.position])
+	if len(name) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidTypeExpression, tp.input)
+	}
+
+	abiType := &typeExpression{name: name}
+	if tp.position == len(tp.input) || tp.input[tp.position] != '<' {
+		return abiType, nil
+	}
+
+	tp.position++
+	for {
+		argument, err := tp.parse()
+		if err != nil {
+			return nil, err
+		}
+		abiType.arguments = append(abiType.arguments, argument)
+
+		if tp.position == len(tp.input) {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidTypeExpression, tp.input)
+		}
+
+		separator := tp.input[tp.position]
+		tp.position++
+		if separator == '>' {
+			return abiType, nil
+		}
+		if separator != ',' {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidTypeExpression, tp.input)
+		}
+	}
+}
@@ -0,0 +1,234 @@
+package process
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/vm"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/abi"
+)
+
+// ABIFileSuffix is the suffix of the ABI files, which are named after the address of their contract
+const ABIFileSuffix = ".abi.json"
+
+const (
+	builtInFunctionESDTNFTTransfer      = "ESDTNFTTransfer"
+	builtInFunctionMultiESDTNFTTransfer = "MultiESDTNFTTransfer"
+	vmReturnCodeOk                      = "ok"
+	callArgumentsSeparator              = "@"
+)
+
+// ABIDecoderProcessor decodes the smart contract queries outputs, the calls and the results of the transactions, for
+// the contracts having an ABI file in the configured directory
+type ABIDecoderProcessor struct {
+	decoder         *abi.Decoder
+	pubKeyConverter core.PubkeyConverter
+	contracts       map[string]*abi.ContractABI
+}
+
+// NewABIDecoderProcessor creates a new instance of ABIDecoderProcessor, loading the ABI files from the given
+// directory. If the directory is empty, no contract can be decoded
+func NewABIDecoderProcessor(abiDirectory string, pubKeyConverter core.PubkeyConverter) (*ABIDecoderProcessor, error) {
+	if check.IfNil(pubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+
+	decoder, err := abi.NewDecoder(pubKeyConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	adp := &ABIDecoderProcessor{
+		decoder:         decoder,
+		pubKeyConverter: pubKeyConverter,
+		contracts:       make(map[string]*abi.ContractABI),
+	}
+	if len(abiDirectory) == 0 {
+		return adp, nil
+	}
+
+	err = adp.loadABIFiles(abiDirectory)
+	if err != nil {
+		return nil, err
+	}
+
+	return adp, nil
+}
+
+func (adp *ABIDecoderProcessor) loadABIFiles(abiDirectory string) error {
+	files, err := ioutil.ReadDir(abiDirectory)
+	if err != nil {
+		return fmt.Errorf("cannot read the ABI directory: %w", err)
+	}
+
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), ABIFileSuffix) {
+			continue
+		}
+
+		address := strings.TrimSuffix(file.Name(), ABIFileSuffix)
+		_, err = adp.pubKeyConverter.Decode(address)
+		if err != nil {
+			return fmt.Errorf("%w: ABI file %s is not named after a contract address", ErrInvalidAddress, file.Name())
+		}
+
+		contractABI, errLoad := abi.LoadContractABI(filepath.Join(abiDirectory, file.Name()))
+		if errLoad != nil {
+			return fmt.Errorf("ABI file %s: %w", file.Name(), errLoad)
+		}
+
+		adp.contracts[address] = contractABI
+		log.Debug("loaded contract ABI", "address", address, "name", contractABI.Name)
+	}
+
+	return nil
+}
+
+// DecodeSCQueryOutput decodes the values returned by a smart contract query, using the ABI of the queried contract
+func (adp *ABIDecoderProcessor) DecodeSCQueryOutput(query *data.SCQuery, vmOutput *vm.VMOutputApi) ([]interface{}, error) {
+	contractABI, ok := adp.contracts[query.ScAddress]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNoABIForContract, query.ScAddress)
+	}
+	if vmOutput == nil {
+		return nil, ErrNilVMOutput
+	}
+	if vmOutput.ReturnCode != vmReturnCodeOk {
+		return nil, fmt.Errorf("%w: %s %s", ErrSCQueryNotSuccessful, vmOutput.ReturnCode, vmOutput.ReturnMessage)
+	}
+
+	return adp.decoder.DecodeOutputs(contractABI, query.FuncName, vmOutput.ReturnData)
+}
+
+// DecodeTransaction sets the decoded call of the transaction, if it calls a contract having an ABI, along with the
+// values returned by the contract in the smart contract results
+func (adp *ABIDecoderProcessor) DecodeTransaction(tx *data.FullTransaction) {
+	contract, function, arguments, ok := adp.parseCall(tx)
+	if !ok {
+		return
+	}
+
+	contractABI, ok := adp.contracts[contract]
+	if !ok {
+		return
+	}
+
+	decodedCall := &data.DecodedCall{
+		Contract: contract,
+		Function: function,
+	}
+	decodedArguments, err := adp.decoder.DecodeInputs(contractABI, function, arguments)
+	if err != nil {
+		decodedCall.Error = err.Error()
+	} else {
+		decodedCall.Arguments = decodedArguments
+	}
+	tx.DecodedData = decodedCall
+
+	for _, scResult := range tx.ScResults {
+		if scResult == nil || scResult.SndAddr != contract || scResult.RcvAddr != tx.Sender {
+			continue
+		}
+		if !strings.HasPrefix(scResult.Data, callArgumentsSeparator) {
+			continue
+		}
+
+		tx.DecodedScResults = append(tx.DecodedScResults, adp.decodeSCResult(contractABI, function, scResult.Hash, scResult.Data))
+	}
+}
+
+// decodeSCResult decodes the data of a smart contract result holding the values returned by a call, which looks
+// like @<hex return code>@<hex value>@<hex value>
+func (adp *ABIDecoderProcessor) decodeSCResult(contractABI *abi.ContractABI, function string, hash string, scrData string) *data.DecodedSCResult {
+	decodedResult := &data.DecodedSCResult{Hash: hash}
+
+	values, err := decodeHexArguments(strings.Split(strings.TrimPrefix(scrData, callArgumentsSeparator), callArgumentsSeparator))
+	if err != nil {
+		decodedResult.Error = err.Error()
+		return decodedResult
+	}
+
+	decodedResult.ReturnCode = string(values[0])
+	if decodedResult.ReturnCode != vmReturnCodeOk {
+		return decodedResult
+	}
+
+	returnValues, err := adp.decoder.DecodeOutputs(contractABI, function, values[1:])
+	if err != nil {
+		decodedResult.Error = err.Error()
+		return decodedResult
+	}
+	decodedResult.ReturnValues = returnValues
+
+	return decodedResult
+}
+
+// parseCall returns the called contract, the function and its arguments. The ESDT transfers are followed to the
+// function they call on the receiving contract, if any
+func (adp *ABIDecoderProcessor) parseCall(tx *data.FullTransaction) (string, string, [][]byte, bool) {
+	if len(tx.Data) == 0 {
+		return "", "", nil, false
+	}
+
+	tokens := strings.Split(string(tx.Data), callArgumentsSeparator)
+	arguments, err := decodeHexArguments(tokens[1:])
+	if err != nil {
+		return "", "", nil, false
+	}
+
+	contract := tx.Receiver
+	functionIndex := -1
+	switch tokens[0] {
+	case core.BuiltInFunctionESDTTransfer:
+		functionIndex = 2
+	case builtInFunctionESDTNFTTransfer:
+		if len(arguments) < 5 || len(arguments[3]) != adp.pubKeyConverter.Len() {
+			return "", "", nil, false
+		}
+		contract = adp.pubKeyConverter.Encode(arguments[3])
+		functionIndex = 4
+	case builtInFunctionMultiESDTNFTTransfer:
+		if len(arguments) < 2 || len(arguments[0]) != adp.pubKeyConverter.Len() {
+			return "", "", nil, false
+		}
+		numTransfers := big.NewInt(0).SetBytes(arguments[1])
+		if !numTransfers.IsInt64() || numTransfers.Int64() > int64(len(arguments)) {
+			return "", "", nil, false
+		}
+		contract = adp.pubKeyConverter.Encode(arguments[0])
+		functionIndex = 2 + 3*int(numTransfers.Int64())
+	default:
+		return contract, tokens[0], arguments, len(tokens[0]) > 0
+	}
+
+	if functionIndex >= len(arguments) {
+		return "", "", nil, false
+	}
+
+	return contract, string(arguments[functionIndex]), arguments[functionIndex+1:], true
+}
+
+func decodeHexArguments(tokens []string) ([][]byte, error) {
+	arguments := make([][]byte, len(tokens))
+	for i, token := range tokens {
+		argument, err := hex.DecodeString(token)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a valid hex string: %w", token, err)
+		}
+		arguments[i] = argument
+	}
+
+	return arguments, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (adp *ABIDecoderProcessor) IsInterfaceNil() bool {
+	return adp == nil
+}
@@ -0,0 +1,186 @@
+package process_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core/pubkeyConverter"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/data/vm"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/process"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	bech32PubKeyConverter, _ = pubkeyConverter.NewBech32PubkeyConverter(32)
+	testContractAddress      = bech32PubKeyConverter.Encode(bytes.Repeat([]byte{5}, 32))
+	testCallerAddress        = bech32PubKeyConverter.Encode(bytes.Repeat([]byte{1}, 32))
+)
+
+func createABIDirectory(t *testing.T) string {
+	abiDirectory, err := ioutil.TempDir("", "abi")
+	require.Nil(t, err)
+
+	buff, err := ioutil.ReadFile(filepath.Join("abi", "testdata", "test.abi.json"))
+	require.Nil(t, err)
+	err = ioutil.WriteFile(filepath.Join(abiDirectory, testContractAddress+process.ABIFileSuffix), buff, 0644)
+	require.Nil(t, err)
+
+	return abiDirectory
+}
+
+func createTestABIDecoderProcessor(t *testing.T) *process.ABIDecoderProcessor {
+	abiDirectory := createABIDirectory(t)
+	defer func() {
+		_ = os.RemoveAll(abiDirectory)
+	}()
+
+	adp, err := process.NewABIDecoderProcessor(abiDirectory, bech32PubKeyConverter)
+	require.Nil(t, err)
+
+	return adp
+}
+
+func TestNewABIDecoderProcessor(t *testing.T) {
+	t.Parallel()
+
+	adp, err := process.NewABIDecoderProcessor("", nil)
+	require.Nil(t, adp)
+	require.Equal(t, process.ErrNilPubKeyConverter, err)
+
+	adp, err = process.NewABIDecoderProcessor("", bech32PubKeyConverter)
+	require.Nil(t, err)
+	require.False(t, adp.IsInterfaceNil())
+
+	adp, err = process.NewABIDecoderProcessor("missing-directory", bech32PubKeyConverter)
+	require.Nil(t, adp)
+	require.NotNil(t, err)
+}
+
+func TestNewABIDecoderProcessor_FileNotNamedAfterAnAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	abiDirectory := createABIDirectory(t)
+	defer func() {
+		_ = os.RemoveAll(abiDirectory)
+	}()
+
+	err := ioutil.WriteFile(filepath.Join(abiDirectory, "test"+process.ABIFileSuffix), []byte("{}"), 0644)
+	require.Nil(t, err)
+
+	adp, err := process.NewABIDecoderProcessor(abiDirectory, bech32PubKeyConverter)
+	require.Nil(t, adp)
+	require.True(t, errors.Is(err, process.ErrInvalidAddress))
+}
+
+func TestABIDecoderProcessor_DecodeSCQueryOutput(t *testing.T) {
+	t.Parallel()
+
+	adp := createTestABIDecoderProcessor(t)
+	query := &data.SCQuery{ScAddress: testContractAddress, FuncName: "getTotalStaked"}
+	vmOutput := &vm.VMOutputApi{ReturnCode: "ok", ReturnData: [][]byte{{0x01, 0x00}}}
+
+	decoded, err := adp.DecodeSCQueryOutput(query, vmOutput)
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{"256"}, decoded)
+
+	_, err = adp.DecodeSCQueryOutput(query, nil)
+	require.Equal(t, process.ErrNilVMOutput, err)
+
+	_, err = adp.DecodeSCQueryOutput(query, &vm.VMOutputApi{ReturnCode: "user error", ReturnMessage: "failed"})
+	require.True(t, errors.Is(err, process.ErrSCQueryNotSuccessful))
+
+	_, err = adp.DecodeSCQueryOutput(&data.SCQuery{ScAddress: testCallerAddress, FuncName: "getTotalStaked"}, vmOutput)
+	require.True(t, errors.Is(err, process.ErrNoABIForContract))
+}
+
+func TestABIDecoderProcessor_DecodeTransaction(t *testing.T) {
+	t.Parallel()
+
+	adp := createTestABIDecoderProcessor(t)
+	delegator := bytes.Repeat([]byte{1}, 32)
+	tx := &data.FullTransaction{
+		Sender:   testCallerAddress,
+		Receiver: testContractAddress,
+		Data:     []byte("stake@" + hex.EncodeToString(delegator) + "@0100"),
+		ScResults: []*transaction.ApiSmartContractResult{
+			{Hash: "scr-return", SndAddr: testContractAddress, RcvAddr: testCallerAddress, Data: "@6f6b@05"},
+			{Hash: "scr-other", SndAddr: testContractAddress, RcvAddr: testContractAddress, Data: "@6f6b@05"},
+			{Hash: "scr-error", SndAddr: testContractAddress, RcvAddr: testCallerAddress, Data: "@" + hex.EncodeToString([]byte("user error"))},
+		},
+	}
+
+	adp.DecodeTransaction(tx)
+
+	require.Equal(t, &data.DecodedCall{
+		Contract:  testContractAddress,
+		Function:  "stake",
+		Arguments: []interface{}{bech32PubKeyConverter.Encode(delegator), "256", nil},
+	}, tx.DecodedData)
+	require.Equal(t, []*data.DecodedSCResult{
+		{Hash: "scr-return", ReturnCode: "ok", ReturnValues: []interface{}{uint64(5)}},
+		{Hash: "scr-error", ReturnCode: "user error"},
+	}, tx.DecodedScResults)
+}
+
+func TestABIDecoderProcessor_DecodeTransactionWithESDTTransfer(t *testing.T) {
+	t.Parallel()
+
+	adp := createTestABIDecoderProcessor(t)
+	tx := &data.FullTransaction{
+		Sender:   testCallerAddress,
+		Receiver: testContractAddress,
+		Data:     []byte("ESDTTransfer@54534b2d616263646566@0a@" + hex.EncodeToString([]byte("getPositions")) + "@01@02"),
+	}
+
+	adp.DecodeTransaction(tx)
+
+	require.Equal(t, &data.DecodedCall{
+		Contract:  testContractAddress,
+		Function:  "getPositions",
+		Arguments: []interface{}{[]interface{}{uint64(1), uint64(2)}},
+	}, tx.DecodedData)
+}
+
+func TestABIDecoderProcessor_DecodeTransactionInvalidArgumentsShouldSetError(t *testing.T) {
+	t.Parallel()
+
+	adp := createTestABIDecoderProcessor(t)
+	tx := &data.FullTransaction{
+		Sender:   testCallerAddress,
+		Receiver: testContractAddress,
+		Data:     []byte("stake@0102"),
+	}
+
+	adp.DecodeTransaction(tx)
+
+	require.NotNil(t, tx.DecodedData)
+	require.Equal(t, "stake", tx.DecodedData.Function)
+	require.Nil(t, tx.DecodedData.Arguments)
+	require.NotEmpty(t, tx.DecodedData.Error)
+}
+
+func TestABIDecoderProcessor_DecodeTransactionShouldIgnoreUnknownContracts(t *testing.T) {
+	t.Parallel()
+
+	adp := createTestABIDecoderProcessor(t)
+	txs := []*data.FullTransaction{
+		{Receiver: testCallerAddress, Data: []byte("stake@0102")},
+		{Receiver: testContractAddress, Data: []byte("stake@zz")},
+		{Receiver: testContractAddress},
+		{Receiver: testCallerAddress, Data: []byte("ESDTNFTTransfer@01@02@03@0505@" + hex.EncodeToString([]byte("stake")))},
+		{Receiver: testCallerAddress, Data: []byte("MultiESDTNFTTransfer@0505@01@01@02@03@" + hex.EncodeToString([]byte("stake")))},
+	}
+
+	for _, tx := range txs {
+		adp.DecodeTransaction(tx)
+		require.Nil(t, tx.DecodedData)
+		require.Nil(t, tx.DecodedScResults)
+	}
+}
@@ -1,9 +1,13 @@
 package process
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
@@ -13,6 +17,23 @@ import (
 // AddressPath defines the address path at which the nodes answer
 const AddressPath = "/address/"
 
+const (
+	blockNonceParam    = "blockNonce"
+	blockHashParam     = "blockHash"
+	blockRootHashParam = "blockRootHash"
+)
+
+// MaxTransactionsHistorySize defines the maximum number of transactions returned by a transactions history request
+const MaxTransactionsHistorySize = 100
+
+// maxBulkAccountsConcurrentRequests bounds the number of requests sent at once to the observers for a bulk accounts
+// request
+const maxBulkAccountsConcurrentRequests = 20
+
+// maxTransactionsHistoryWindow is the maximum number of transactions that can be paged with from and size, as
+// limited by Elasticsearch. Deeper pages have to be requested with a cursor
+const maxTransactionsHistoryWindow = 10000
+
 // AccountProcessor is able to process account requests
 type AccountProcessor struct {
 	connector       ExternalStorageConnector
@@ -56,10 +77,14 @@ func (ap *AccountProcessor) GetAccount(address string) (*data.Account, error) {
 		return nil, err
 	}
 
+	return ap.getAccountFromObservers(address, observers)
+}
+
+func (ap *AccountProcessor) getAccountFromObservers(address string, observers []*data.NodeData) (*data.Account, error) {
 	for _, observer := range observers {
 		responseAccount := &data.AccountApiResponse{}
 
-		_, err = ap.proc.CallGetRestEndPoint(observer.Address, AddressPath+address, responseAccount)
+		_, err := ap.proc.CallGetRestEndPoint(observer.Address, AddressPath+address, responseAccount)
 		if err == nil {
 			log.Info("account request", "address", address, "shard ID", observer.ShardId, "observer", observer.Address)
 			return &responseAccount.Data.AccountData, nil
@@ -71,6 +96,33 @@ func (ap *AccountProcessor) GetAccount(address string) (*data.Account, error) {
 	return nil, ErrSendingRequest
 }
 
+// GetAccountAtRootHash returns the state of the account at the given state root hash
+func (ap *AccountProcessor) GetAccountAtRootHash(address string, rootHash string) (*data.Account, error) {
+	response, err := ap.GetAccountAtBlock(address, data.AccountQueryOptions{RootHash: rootHash})
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.AccountData, nil
+}
+
+// GetAccountAtBlock returns the state of the account at the block selected by the options, along with the coordinates
+// of that block
+func (ap *AccountProcessor) GetAccountAtBlock(address string, options data.AccountQueryOptions) (*data.ResponseAccount, error) {
+	apiResponse := &data.AccountApiResponse{}
+	respCode, err := ap.getStateAtBlock(address, AddressPath+address, options, apiResponse)
+	if err != nil {
+		return nil, stateAtBlockError(apiResponse.Error, respCode, err)
+	}
+
+	err = checkBlockInfo(apiResponse.Data.BlockInfo, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiResponse.Data, nil
+}
+
 // GetValueForKey returns the value for the given address and key
 func (ap *AccountProcessor) GetValueForKey(address string, key string) (string, error) {
 	observers, err := ap.getObserversForAddress(address)
@@ -132,6 +184,16 @@ func (ap *AccountProcessor) GetESDTTokenData(address string, key string) (*data.
 	return nil, ErrSendingRequest
 }
 
+// GetESDTTokenDataAtRootHash returns the token data for a token with the given name at the given state root hash
+func (ap *AccountProcessor) GetESDTTokenDataAtRootHash(address string, key string, rootHash string) (*data.GenericAPIResponse, error) {
+	return ap.GetESDTTokenDataAtBlock(address, key, data.AccountQueryOptions{RootHash: rootHash})
+}
+
+// GetESDTTokenDataAtBlock returns the token data for a token with the given name at the block selected by the options
+func (ap *AccountProcessor) GetESDTTokenDataAtBlock(address string, key string, options data.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return ap.getGenericStateAtBlock(address, AddressPath+address+"/esdt/"+key, options)
+}
+
 // GetESDTsWithRole returns the token identifiers where the given address has the given role assigned
 func (ap *AccountProcessor) GetESDTsWithRole(address string, role string) (*data.GenericAPIResponse, error) {
 	observers, err := ap.proc.GetObservers(core.MetachainShardId)
@@ -234,6 +296,10 @@ func (ap *AccountProcessor) GetAllESDTTokens(address string) (*data.GenericAPIRe
 		return nil, err
 	}
 
+	return ap.getAllESDTTokensFromObservers(address, observers)
+}
+
+func (ap *AccountProcessor) getAllESDTTokensFromObservers(address string, observers []*data.NodeData) (*data.GenericAPIResponse, error) {
 	for _, observer := range observers {
 		apiResponse := data.GenericAPIResponse{}
 		apiPath := AddressPath + address + "/esdt"
@@ -287,13 +353,361 @@ func (ap *AccountProcessor) GetKeyValuePairs(address string) (*data.GenericAPIRe
 	return nil, ErrSendingRequest
 }
 
+// GetValueForKeyAtBlock returns the value for the given address and key at the block selected by the options
+func (ap *AccountProcessor) GetValueForKeyAtBlock(address string, key string, options data.AccountQueryOptions) (*data.AccountKeyValueResponseData, error) {
+	apiResponse := &data.AccountKeyValueResponse{}
+	respCode, err := ap.getStateAtBlock(address, AddressPath+address+"/key/"+key, options, apiResponse)
+	if err != nil {
+		return nil, stateAtBlockError(apiResponse.Error, respCode, err)
+	}
+
+	err = checkBlockInfo(apiResponse.Data.BlockInfo, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiResponse.Data, nil
+}
+
+// GetKeyValuePairsAtBlock returns all the key-value pairs of the address at the block selected by the options
+func (ap *AccountProcessor) GetKeyValuePairsAtBlock(address string, options data.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return ap.getGenericStateAtBlock(address, AddressPath+address+"/keys", options)
+}
+
+// GetAllESDTTokensAtBlock returns all the tokens of the address at the block selected by the options
+func (ap *AccountProcessor) GetAllESDTTokensAtBlock(address string, options data.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	return ap.getGenericStateAtBlock(address, AddressPath+address+"/esdt", options)
+}
+
+// GetESDTNftTokenDataAtBlock returns the nft token data for a token with the given identifier and nonce at the block
+// selected by the options
+func (ap *AccountProcessor) GetESDTNftTokenDataAtBlock(address string, key string, nonce uint64, options data.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	apiPath := AddressPath + address + "/nft/" + key + "/nonce/" + strconv.FormatUint(nonce, 10)
+
+	return ap.getGenericStateAtBlock(address, apiPath, options)
+}
+
+func (ap *AccountProcessor) getGenericStateAtBlock(address string, apiPath string, options data.AccountQueryOptions) (*data.GenericAPIResponse, error) {
+	apiResponse := &data.GenericAPIResponse{}
+	respCode, err := ap.getStateAtBlock(address, apiPath, options, apiResponse)
+	if err != nil {
+		return nil, stateAtBlockError(apiResponse.Error, respCode, err)
+	}
+
+	var blockInfo *data.BlockInfo
+	responseData, ok := apiResponse.Data.(map[string]interface{})
+	if ok && responseData["blockInfo"] != nil {
+		blockInfo = &data.BlockInfo{}
+		blockInfoBytes, errMarshal := json.Marshal(responseData["blockInfo"])
+		if errMarshal != nil {
+			return nil, errMarshal
+		}
+		err = json.Unmarshal(blockInfoBytes, blockInfo)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = checkBlockInfo(blockInfo, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiResponse, nil
+}
+
+// getStateAtBlock asks for the account state at the block selected by the options. The full history nodes are
+// preferred, as the regular observers might have already pruned that state. It returns the status code of the node's
+// response along with the error, if any
+func (ap *AccountProcessor) getStateAtBlock(address string, apiPath string, options data.AccountQueryOptions, response interface{}) (int, error) {
+	if !options.IsHistorical() {
+		return http.StatusBadRequest, ErrInvalidAccountQueryOptions
+	}
+
+	nodes, err := ap.getFullHistoryNodesForAddress(address)
+	if err != nil {
+		return http.StatusInternalServerError, err
+	}
+
+	apiPath += "?" + accountQueryParams(options)
+	for _, node := range nodes {
+		respCode, errGet := ap.proc.CallGetRestEndPoint(node.Address, apiPath, response)
+		if errGet == nil {
+			log.Info("account state request at block", "address", address, "path", apiPath,
+				"shard ID", node.ShardId, "node", node.Address)
+			return respCode, nil
+		}
+		if respCode == http.StatusBadRequest || respCode == http.StatusInternalServerError {
+			// the node answered, but the state is not available
+			return respCode, errGet
+		}
+
+		log.Error("account state request at block", "node", node.Address, "path", apiPath, "error", errGet.Error())
+	}
+
+	return http.StatusInternalServerError, ErrSendingRequest
+}
+
+func accountQueryParams(options data.AccountQueryOptions) string {
+	params := url.Values{}
+	if options.HasBlockNonce {
+		params.Set(blockNonceParam, strconv.FormatUint(options.BlockNonce, 10))
+	}
+	if options.BlockHash != "" {
+		params.Set(blockHashParam, options.BlockHash)
+	}
+	if options.RootHash != "" {
+		params.Set(blockRootHashParam, options.RootHash)
+	}
+
+	return params.Encode()
+}
+
+// checkBlockInfo checks that the node returned the block the state was read at and that it is the requested one. The
+// nodes which do not support reading the state at a given block ignore the options and return the current state,
+// without any block info
+func checkBlockInfo(blockInfo *data.BlockInfo, options data.AccountQueryOptions) error {
+	if blockInfo == nil || *blockInfo == (data.BlockInfo{}) {
+		return ErrHistoricalStateNotSupported
+	}
+
+	isSameNonce := !options.HasBlockNonce || blockInfo.Nonce == options.BlockNonce
+	isSameHash := options.BlockHash == "" || blockInfo.Hash == options.BlockHash
+	isSameRootHash := options.RootHash == "" || blockInfo.RootHash == options.RootHash
+	if !isSameNonce || !isSameHash || !isSameRootHash {
+		return fmt.Errorf("%w: nonce %d, hash %s, root hash %s", ErrUnexpectedBlockInfo,
+			blockInfo.Nonce, blockInfo.Hash, blockInfo.RootHash)
+	}
+
+	return nil
+}
+
+// stateAtBlockError returns the error of an account state request at a block. The requests rejected by the node are
+// reported as ErrInvalidAccountStateRequest
+func stateAtBlockError(responseError string, respCode int, err error) error {
+	err = errorFromResponse(responseError, err)
+	if respCode == http.StatusBadRequest && !errors.Is(err, ErrInvalidAccountQueryOptions) {
+		return fmt.Errorf("%w: %v", ErrInvalidAccountStateRequest, err)
+	}
+
+	return err
+}
+
+func errorFromResponse(responseError string, err error) error {
+	if responseError != "" {
+		return errors.New(responseError)
+	}
+
+	return err
+}
+
 // GetTransactions resolves the request and returns a slice of transaction for the specific address
-func (ap *AccountProcessor) GetTransactions(address string) ([]data.DatabaseTransaction, error) {
+func (ap *AccountProcessor) GetTransactions(address string, options data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error) {
 	if _, err := ap.pubKeyConverter.Decode(address); err != nil {
 		return nil, fmt.Errorf("%w, %v", ErrInvalidAddress, err)
 	}
 
-	return ap.connector.GetTransactionsByAddress(address)
+	err := ap.checkTransactionsHistoryOptions(options)
+	if err != nil {
+		return nil, err
+	}
+
+	return ap.connector.GetTransactionsByAddress(address, options)
+}
+
+// GetTokenTransfers returns the ESDT and NFT transfers of the token from the options, or of any token if none is
+// provided. If the address is not empty, only the transfers sent or received by it are returned
+func (ap *AccountProcessor) GetTokenTransfers(address string, options data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error) {
+	if address == "" {
+		if options.Token == "" {
+			return nil, fmt.Errorf("%w: a token or an address is required", ErrInvalidTransactionsHistoryOptions)
+		}
+		if options.Direction != "" || options.Counterparty != "" {
+			return nil, fmt.Errorf("%w: direction and counterparty require an address", ErrInvalidTransactionsHistoryOptions)
+		}
+	} else if _, err := ap.pubKeyConverter.Decode(address); err != nil {
+		return nil, fmt.Errorf("%w, %v", ErrInvalidAddress, err)
+	}
+
+	err := ap.checkTransactionsHistoryOptions(options)
+	if err != nil {
+		return nil, err
+	}
+
+	return ap.connector.GetTokenTransfers(address, options)
+}
+
+// GetEvents returns the events emitted by the address, optionally filtered by their identifier
+func (ap *AccountProcessor) GetEvents(address string, identifier string, options data.TransactionsHistoryOptions) ([]data.DatabaseEvent, error) {
+	if _, err := ap.pubKeyConverter.Decode(address); err != nil {
+		return nil, fmt.Errorf("%w, %v", ErrInvalidAddress, err)
+	}
+
+	err := ap.checkTransactionsHistoryOptions(options)
+	if err != nil {
+		return nil, err
+	}
+
+	return ap.connector.GetEventsByAddress(address, identifier, options)
+}
+
+func (ap *AccountProcessor) checkTransactionsHistoryOptions(options data.TransactionsHistoryOptions) error {
+	if options.From < 0 || options.Size < 0 || options.Size > MaxTransactionsHistorySize {
+		return fmt.Errorf("%w: size must be at most %d", ErrInvalidTransactionsHistoryOptions, MaxTransactionsHistorySize)
+	}
+	if options.From+options.Size > maxTransactionsHistoryWindow {
+		return fmt.Errorf("%w: use a cursor for the transactions older than %d", ErrInvalidTransactionsHistoryOptions, maxTransactionsHistoryWindow)
+	}
+	if options.From > 0 && options.Cursor != "" {
+		return fmt.Errorf("%w: from and cursor cannot be used together", ErrInvalidTransactionsHistoryOptions)
+	}
+	if options.StartTime > 0 && options.EndTime > 0 && options.StartTime > options.EndTime {
+		return fmt.Errorf("%w: invalid time range", ErrInvalidTransactionsHistoryOptions)
+	}
+
+	switch options.Direction {
+	case "", data.TransactionsDirectionIn, data.TransactionsDirectionOut:
+	default:
+		return fmt.Errorf("%w: invalid direction %s", ErrInvalidTransactionsHistoryOptions, options.Direction)
+	}
+
+	switch options.Order {
+	case "", data.SortOrderAscending, data.SortOrderDescending:
+	default:
+		return fmt.Errorf("%w: invalid order %s", ErrInvalidTransactionsHistoryOptions, options.Order)
+	}
+
+	if options.Counterparty != "" {
+		if _, err := ap.pubKeyConverter.Decode(options.Counterparty); err != nil {
+			return fmt.Errorf("%w: invalid counterparty, %v", ErrInvalidTransactionsHistoryOptions, err)
+		}
+	}
+
+	return nil
+}
+
+// GetBulkAccounts returns the requested fields of the accounts with the given addresses. The addresses are grouped by
+// shard and the observers are called concurrently. An address which could not be fetched gets an error in its result,
+// without failing the others
+func (ap *AccountProcessor) GetBulkAccounts(addresses []string, fields []string) (map[string]*data.BulkAccountResult, error) {
+	selectedFields, err := getBulkAccountsFields(addresses, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make(map[string]*data.BulkAccountResult, len(addresses))
+	addressesByShard := make(map[uint32][]string)
+	for _, address := range addresses {
+		if _, exists := results[address]; exists {
+			continue
+		}
+
+		addressBytes, errDecode := ap.pubKeyConverter.Decode(address)
+		if errDecode != nil {
+			results[address] = &data.BulkAccountResult{Error: fmt.Sprintf("%v, %v", ErrInvalidAddress, errDecode)}
+			continue
+		}
+		shardID, errCompute := ap.proc.ComputeShardId(addressBytes)
+		if errCompute != nil {
+			results[address] = &data.BulkAccountResult{Error: errCompute.Error()}
+			continue
+		}
+
+		results[address] = &data.BulkAccountResult{}
+		addressesByShard[shardID] = append(addressesByShard[shardID], address)
+	}
+
+	semaphore := make(chan struct{}, maxBulkAccountsConcurrentRequests)
+	wg := sync.WaitGroup{}
+	for shardID, shardAddresses := range addressesByShard {
+		observers, errGet := ap.proc.GetObservers(shardID)
+		if errGet != nil {
+			for _, address := range shardAddresses {
+				results[address].Error = errGet.Error()
+			}
+			continue
+		}
+
+		for _, address := range shardAddresses {
+			semaphore <- struct{}{}
+			wg.Add(1)
+			go func(addr string, result *data.BulkAccountResult) {
+				defer func() {
+					<-semaphore
+					wg.Done()
+				}()
+
+				ap.fillBulkAccountResult(addr, observers, selectedFields, result)
+			}(address, results[address])
+		}
+	}
+	wg.Wait()
+
+	return results, nil
+}
+
+func getBulkAccountsFields(addresses []string, fields []string) (map[string]bool, error) {
+	if len(addresses) == 0 {
+		return nil, fmt.Errorf("%w: no address provided", ErrInvalidBulkAccountsRequest)
+	}
+
+	if len(fields) == 0 {
+		fields = []string{data.BulkAccountFieldBalance, data.BulkAccountFieldNonce, data.BulkAccountFieldUsername}
+	}
+
+	selectedFields := make(map[string]bool)
+	for _, field := range fields {
+		switch field {
+		case data.BulkAccountFieldBalance, data.BulkAccountFieldNonce, data.BulkAccountFieldUsername, data.BulkAccountFieldESDTs:
+			selectedFields[field] = true
+		default:
+			return nil, fmt.Errorf("%w: unknown field %s", ErrInvalidBulkAccountsRequest, field)
+		}
+	}
+
+	return selectedFields, nil
+}
+
+// fillBulkAccountResult fetches the selected fields of an account. Each result is filled by a single go routine
+func (ap *AccountProcessor) fillBulkAccountResult(
+	address string,
+	observers []*data.NodeData,
+	selectedFields map[string]bool,
+	result *data.BulkAccountResult,
+) {
+	needsAccount := selectedFields[data.BulkAccountFieldBalance] || selectedFields[data.BulkAccountFieldNonce] ||
+		selectedFields[data.BulkAccountFieldUsername]
+	if needsAccount {
+		account, err := ap.getAccountFromObservers(address, observers)
+		if err != nil {
+			result.Error = err.Error()
+			return
+		}
+
+		if selectedFields[data.BulkAccountFieldBalance] {
+			result.Balance = &account.Balance
+		}
+		if selectedFields[data.BulkAccountFieldNonce] {
+			result.Nonce = &account.Nonce
+		}
+		if selectedFields[data.BulkAccountFieldUsername] {
+			result.Username = &account.Username
+		}
+	}
+
+	if selectedFields[data.BulkAccountFieldESDTs] {
+		response, err := ap.getAllESDTTokensFromObservers(address, observers)
+		if err != nil {
+			result.Error = err.Error()
+			return
+		}
+
+		result.ESDTs = make(map[string]interface{})
+		responseData, ok := response.Data.(map[string]interface{})
+		if ok && responseData["esdts"] != nil {
+			result.ESDTs = responseData["esdts"]
+		}
+	}
 }
 
 func (ap *AccountProcessor) getObserversForAddress(address string) ([]*data.NodeData, error) {
@@ -315,6 +729,27 @@ func (ap *AccountProcessor) getObserversForAddress(address string) ([]*data.Node
 	return observers, nil
 }
 
+// getFullHistoryNodesForAddress returns the full history nodes of the address' shard, or its observers if no full
+// history node is configured for that shard
+func (ap *AccountProcessor) getFullHistoryNodesForAddress(address string) ([]*data.NodeData, error) {
+	addressBytes, err := ap.pubKeyConverter.Decode(address)
+	if err != nil {
+		return nil, err
+	}
+
+	shardID, err := ap.proc.ComputeShardId(addressBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	fullHistoryNodes, err := ap.proc.GetFullHistoryNodes(shardID)
+	if err == nil && len(fullHistoryNodes) > 0 {
+		return fullHistoryNodes, nil
+	}
+
+	return ap.proc.GetObservers(shardID)
+}
+
 // GetBaseProcessor returns the base processor
 func (ap *AccountProcessor) GetBaseProcessor() Processor {
 	return ap.proc
@@ -2,7 +2,9 @@ package process_test
 
 import (
 	"errors"
+	"net/http"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/config"
@@ -299,16 +301,139 @@ func TestAccountProcessor_GetTransactions(t *testing.T) {
 		&mock.ElasticSearchConnectorMock{},
 	)
 
-	_, err := ap.GetTransactions("invalidAddress")
+	_, err := ap.GetTransactions("invalidAddress", data.TransactionsHistoryOptions{})
 	assert.True(t, errors.Is(err, process.ErrInvalidAddress))
 
-	_, err = ap.GetTransactions("")
+	_, err = ap.GetTransactions("", data.TransactionsHistoryOptions{})
 	assert.True(t, errors.Is(err, process.ErrInvalidAddress))
 
-	_, err = ap.GetTransactions("erd1ycega644rvjtgtyd8hfzt6hl5ymaa8ml2nhhs5cv045cz5vxm00q022myr")
+	_, err = ap.GetTransactions("erd1ycega644rvjtgtyd8hfzt6hl5ymaa8ml2nhhs5cv045cz5vxm00q022myr", data.TransactionsHistoryOptions{})
 	assert.Nil(t, err)
 }
 
+func TestAccountProcessor_GetTransactionsShouldCheckOptions(t *testing.T) {
+	t.Parallel()
+
+	address := "erd1ycega644rvjtgtyd8hfzt6hl5ymaa8ml2nhhs5cv045cz5vxm00q022myr"
+	converter, _ := factory.NewPubkeyConverter(config.PubkeyConfig{
+		Length: 32,
+		Type:   "bech32",
+	})
+	var providedOptions data.TransactionsHistoryOptions
+	ap, _ := process.NewAccountProcessor(
+		&mock.ProcessorStub{},
+		converter,
+		&mock.ExternalStorageConnectorStub{
+			GetTransactionsByAddressCalled: func(_ string, options data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error) {
+				providedOptions = options
+				return nil, nil
+			},
+		},
+	)
+
+	invalidOptions := []data.TransactionsHistoryOptions{
+		{Size: process.MaxTransactionsHistorySize + 1},
+		{Size: -1},
+		{From: 9950, Size: 100},
+		{From: 10, Cursor: "1600000000-1"},
+		{StartTime: 20, EndTime: 10},
+		{Direction: "sideways"},
+		{Order: "random"},
+		{Counterparty: "invalidAddress"},
+	}
+	for _, options := range invalidOptions {
+		_, err := ap.GetTransactions(address, options)
+		assert.True(t, errors.Is(err, process.ErrInvalidTransactionsHistoryOptions))
+	}
+
+	options := data.TransactionsHistoryOptions{
+		Size:         50,
+		Cursor:       "1600000000-1",
+		Direction:    data.TransactionsDirectionIn,
+		Status:       "success",
+		StartTime:    10,
+		EndTime:      20,
+		Token:        "TKN-123456",
+		Counterparty: address,
+		Order:        data.SortOrderAscending,
+	}
+	_, err := ap.GetTransactions(address, options)
+	assert.Nil(t, err)
+	assert.Equal(t, options, providedOptions)
+}
+
+func TestAccountProcessor_GetTokenTransfers(t *testing.T) {
+	t.Parallel()
+
+	address := "erd1ycega644rvjtgtyd8hfzt6hl5ymaa8ml2nhhs5cv045cz5vxm00q022myr"
+	converter, _ := factory.NewPubkeyConverter(config.PubkeyConfig{
+		Length: 32,
+		Type:   "bech32",
+	})
+	var providedAddress string
+	ap, _ := process.NewAccountProcessor(
+		&mock.ProcessorStub{},
+		converter,
+		&mock.ExternalStorageConnectorStub{
+			GetTokenTransfersCalled: func(address string, _ data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error) {
+				providedAddress = address
+				return nil, nil
+			},
+		},
+	)
+
+	_, err := ap.GetTokenTransfers("invalidAddress", data.TransactionsHistoryOptions{})
+	assert.True(t, errors.Is(err, process.ErrInvalidAddress))
+
+	_, err = ap.GetTokenTransfers("", data.TransactionsHistoryOptions{})
+	assert.True(t, errors.Is(err, process.ErrInvalidTransactionsHistoryOptions))
+
+	_, err = ap.GetTokenTransfers("", data.TransactionsHistoryOptions{Token: "TKN-123456", Direction: data.TransactionsDirectionIn})
+	assert.True(t, errors.Is(err, process.ErrInvalidTransactionsHistoryOptions))
+
+	_, err = ap.GetTokenTransfers(address, data.TransactionsHistoryOptions{Size: process.MaxTransactionsHistorySize + 1})
+	assert.True(t, errors.Is(err, process.ErrInvalidTransactionsHistoryOptions))
+
+	_, err = ap.GetTokenTransfers("", data.TransactionsHistoryOptions{Token: "TKN-123456"})
+	assert.Nil(t, err)
+	assert.Equal(t, "", providedAddress)
+
+	_, err = ap.GetTokenTransfers(address, data.TransactionsHistoryOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, address, providedAddress)
+}
+
+func TestAccountProcessor_GetEvents(t *testing.T) {
+	t.Parallel()
+
+	address := "erd1ycega644rvjtgtyd8hfzt6hl5ymaa8ml2nhhs5cv045cz5vxm00q022myr"
+	converter, _ := factory.NewPubkeyConverter(config.PubkeyConfig{
+		Length: 32,
+		Type:   "bech32",
+	})
+	var providedIdentifier string
+	ap, _ := process.NewAccountProcessor(
+		&mock.ProcessorStub{},
+		converter,
+		&mock.ExternalStorageConnectorStub{
+			GetEventsByAddressCalled: func(_ string, identifier string, _ data.TransactionsHistoryOptions) ([]data.DatabaseEvent, error) {
+				providedIdentifier = identifier
+				return nil, nil
+			},
+		},
+	)
+
+	_, err := ap.GetEvents("", "transfer", data.TransactionsHistoryOptions{})
+	assert.True(t, errors.Is(err, process.ErrInvalidAddress))
+
+	_, err = ap.GetEvents(address, "transfer", data.TransactionsHistoryOptions{Order: "random"})
+	assert.True(t, errors.Is(err, process.ErrInvalidTransactionsHistoryOptions))
+
+	_, err = ap.GetEvents(address, "transfer", data.TransactionsHistoryOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, "transfer", providedIdentifier)
+}
+
 func TestAccountProcessor_GetESDTsWithRoleGetObserversFails(t *testing.T) {
 	t.Parallel()
 
@@ -384,3 +509,309 @@ func TestAccountProcessor_GetESDTsWithRoleShouldWork(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "token0", response.Data.([]string)[0])
 }
+
+func TestAccountProcessor_GetAccountAtRootHashShouldPreferFullHistoryNodes(t *testing.T) {
+	t.Parallel()
+
+	fullHistoryNodeAddress := "full history node"
+	rootHash := "aabbcc"
+	respondedAccount := data.Account{
+		Address: "an address",
+		Balance: "37",
+	}
+	ap, _ := process.NewAccountProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+				return 0, nil
+			},
+			GetObserversCalled: func(shardId uint32) (observers []*data.NodeData, e error) {
+				assert.Fail(t, "should have used the full history nodes")
+				return nil, nil
+			},
+			GetFullHistoryNodesCalled: func(shardId uint32) ([]*data.NodeData, error) {
+				return []*data.NodeData{
+					{Address: fullHistoryNodeAddress, ShardId: 0},
+				}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				assert.Equal(t, fullHistoryNodeAddress, address)
+				assert.Equal(t, process.AddressPath+"DEADBEEF?blockRootHash="+rootHash, path)
+
+				valRespond := value.(*data.AccountApiResponse)
+				valRespond.Data.AccountData = respondedAccount
+				valRespond.Data.BlockInfo = &data.BlockInfo{Nonce: 42, RootHash: rootHash}
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		database.NewDisabledElasticSearchConnector(),
+	)
+
+	accnt, err := ap.GetAccountAtRootHash("DEADBEEF", rootHash)
+	assert.Nil(t, err)
+	assert.Equal(t, &respondedAccount, accnt)
+}
+
+func TestAccountProcessor_GetAccountAtRootHashStateNotAvailableShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("trie was not found")
+	numCalls := 0
+	ap, _ := process.NewAccountProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+				return 0, nil
+			},
+			GetFullHistoryNodesCalled: func(shardId uint32) ([]*data.NodeData, error) {
+				return nil, errors.New("no full history node")
+			},
+			GetObserversCalled: func(shardId uint32) (observers []*data.NodeData, e error) {
+				return []*data.NodeData{
+					{Address: "observer0", ShardId: 0},
+					{Address: "observer1", ShardId: 0},
+				}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				numCalls++
+				return http.StatusInternalServerError, errExpected
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		database.NewDisabledElasticSearchConnector(),
+	)
+
+	accnt, err := ap.GetAccountAtRootHash("DEADBEEF", "aabbcc")
+	assert.Nil(t, accnt)
+	assert.Equal(t, errExpected, err)
+	assert.Equal(t, 1, numCalls)
+}
+
+func TestAccountProcessor_GetAccountAtBlockShouldReturnBlockInfo(t *testing.T) {
+	t.Parallel()
+
+	ap, _ := process.NewAccountProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+				return 0, nil
+			},
+			GetFullHistoryNodesCalled: func(shardId uint32) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "full history node", ShardId: 0}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				assert.Equal(t, process.AddressPath+"DEADBEEF?blockNonce=42", path)
+
+				valRespond := value.(*data.AccountApiResponse)
+				valRespond.Data.AccountData = data.Account{Balance: "37"}
+				valRespond.Data.BlockInfo = &data.BlockInfo{Nonce: 42, Hash: "hash", RootHash: "root hash"}
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		database.NewDisabledElasticSearchConnector(),
+	)
+
+	response, err := ap.GetAccountAtBlock("DEADBEEF", data.AccountQueryOptions{BlockNonce: 42, HasBlockNonce: true})
+	require.Nil(t, err)
+	require.Equal(t, "37", response.AccountData.Balance)
+	require.Equal(t, &data.BlockInfo{Nonce: 42, Hash: "hash", RootHash: "root hash"}, response.BlockInfo)
+}
+
+func TestAccountProcessor_GetAccountAtBlockWithoutNodeBlockInfoShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ap, _ := process.NewAccountProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+				return 0, nil
+			},
+			GetFullHistoryNodesCalled: func(shardId uint32) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "full history node", ShardId: 0}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				valRespond := value.(*data.AccountApiResponse)
+				valRespond.Data.AccountData = data.Account{Balance: "37"}
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		database.NewDisabledElasticSearchConnector(),
+	)
+
+	response, err := ap.GetAccountAtBlock("DEADBEEF", data.AccountQueryOptions{BlockNonce: 42, HasBlockNonce: true})
+	require.Nil(t, response)
+	require.Equal(t, process.ErrHistoricalStateNotSupported, err)
+}
+
+func TestAccountProcessor_GetAccountAtBlockWithAnotherNodeBlockInfoShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ap, _ := process.NewAccountProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+				return 0, nil
+			},
+			GetFullHistoryNodesCalled: func(shardId uint32) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "full history node", ShardId: 0}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				valRespond := value.(*data.AccountApiResponse)
+				valRespond.Data.BlockInfo = &data.BlockInfo{Nonce: 43, Hash: "hash"}
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		database.NewDisabledElasticSearchConnector(),
+	)
+
+	response, err := ap.GetAccountAtBlock("DEADBEEF", data.AccountQueryOptions{BlockNonce: 42, HasBlockNonce: true})
+	require.Nil(t, response)
+	require.True(t, errors.Is(err, process.ErrUnexpectedBlockInfo))
+}
+
+func TestAccountProcessor_GetAccountAtBlockRejectedByNodeShouldErrInvalidRequest(t *testing.T) {
+	t.Parallel()
+
+	ap, _ := process.NewAccountProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+				return 0, nil
+			},
+			GetFullHistoryNodesCalled: func(shardId uint32) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "full history node", ShardId: 0}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				valRespond := value.(*data.AccountApiResponse)
+				valRespond.Error = "invalid block hash"
+				return http.StatusBadRequest, errors.New("bad request")
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		database.NewDisabledElasticSearchConnector(),
+	)
+
+	response, err := ap.GetAccountAtBlock("DEADBEEF", data.AccountQueryOptions{BlockHash: "zz"})
+	require.Nil(t, response)
+	require.True(t, errors.Is(err, process.ErrInvalidAccountStateRequest))
+	require.True(t, strings.Contains(err.Error(), "invalid block hash"))
+}
+
+func TestAccountProcessor_GetAccountAtBlockWithoutBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ap, _ := process.NewAccountProcessor(
+		&mock.ProcessorStub{},
+		&mock.PubKeyConverterMock{},
+		database.NewDisabledElasticSearchConnector(),
+	)
+
+	response, err := ap.GetAccountAtBlock("DEADBEEF", data.AccountQueryOptions{})
+	require.Nil(t, response)
+	require.Equal(t, process.ErrInvalidAccountQueryOptions, err)
+}
+
+func TestAccountProcessor_GetESDTTokenDataAtBlockShouldCheckBlockInfo(t *testing.T) {
+	t.Parallel()
+
+	ap, _ := process.NewAccountProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (u uint32, e error) {
+				return 0, nil
+			},
+			GetObserversCalled: func(shardId uint32) (observers []*data.NodeData, e error) {
+				return []*data.NodeData{{Address: "observer", ShardId: 0}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				assert.Equal(t, process.AddressPath+"DEADBEEF/esdt/TKN-123456?blockHash=aabb", path)
+
+				valRespond := value.(*data.GenericAPIResponse)
+				valRespond.Data = map[string]interface{}{
+					"tokenData": "data",
+					"blockInfo": map[string]interface{}{"nonce": 42, "hash": "aabb"},
+				}
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		database.NewDisabledElasticSearchConnector(),
+	)
+
+	response, err := ap.GetESDTTokenDataAtBlock("DEADBEEF", "TKN-123456", data.AccountQueryOptions{BlockHash: "aabb"})
+	require.Nil(t, err)
+	responseData := response.Data.(map[string]interface{})
+	require.Equal(t, "data", responseData["tokenData"])
+	require.Equal(t, map[string]interface{}{"nonce": 42, "hash": "aabb"}, responseData["blockInfo"])
+}
+
+func TestAccountProcessor_GetBulkAccountsInvalidRequestShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ap, _ := process.NewAccountProcessor(
+		&mock.ProcessorStub{},
+		&mock.PubKeyConverterMock{},
+		database.NewDisabledElasticSearchConnector(),
+	)
+
+	_, err := ap.GetBulkAccounts(nil, nil)
+	require.True(t, errors.Is(err, process.ErrInvalidBulkAccountsRequest))
+
+	_, err = ap.GetBulkAccounts([]string{"aa"}, []string{"code"})
+	require.True(t, errors.Is(err, process.ErrInvalidBulkAccountsRequest))
+}
+
+func TestAccountProcessor_GetBulkAccountsShouldReturnPerAddressErrors(t *testing.T) {
+	t.Parallel()
+
+	mutRequestedShards := sync.Mutex{}
+	requestedShards := make(map[uint32]int)
+	ap, _ := process.NewAccountProcessor(
+		&mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				return uint32(addressBuff[0]) % 2, nil
+			},
+			GetObserversCalled: func(shardId uint32) ([]*data.NodeData, error) {
+				mutRequestedShards.Lock()
+				requestedShards[shardId]++
+				mutRequestedShards.Unlock()
+
+				if shardId == 1 {
+					return nil, errors.New("no observer in shard 1")
+				}
+				return []*data.NodeData{{Address: "observer", ShardId: shardId}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				switch path {
+				case process.AddressPath + "02":
+					valRespond := value.(*data.AccountApiResponse)
+					valRespond.Data.AccountData = data.Account{Balance: "37", Nonce: 5}
+					return http.StatusOK, nil
+				case process.AddressPath + "02/esdt":
+					valRespond := value.(*data.GenericAPIResponse)
+					valRespond.Data = map[string]interface{}{"esdts": map[string]interface{}{"TKN-123456": "token"}}
+					return http.StatusOK, nil
+				default:
+					return http.StatusNotFound, errors.New("observer offline")
+				}
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		database.NewDisabledElasticSearchConnector(),
+	)
+
+	fields := []string{data.BulkAccountFieldBalance, data.BulkAccountFieldNonce, data.BulkAccountFieldESDTs}
+	results, err := ap.GetBulkAccounts([]string{"02", "04", "01", "03", "invalid", "02"}, fields)
+	require.Nil(t, err)
+	require.Len(t, results, 5)
+
+	require.Empty(t, results["02"].Error)
+	require.Equal(t, "37", *results["02"].Balance)
+	require.Equal(t, uint64(5), *results["02"].Nonce)
+	require.Nil(t, results["02"].Username)
+	require.Equal(t, map[string]interface{}{"TKN-123456": "token"}, results["02"].ESDTs)
+
+	require.Equal(t, process.ErrSendingRequest.Error(), results["04"].Error)
+	require.Equal(t, "no observer in shard 1", results["01"].Error)
+	require.Equal(t, "no observer in shard 1", results["03"].Error)
+	require.True(t, strings.Contains(results["invalid"].Error, process.ErrInvalidAddress.Error()))
+
+	require.Equal(t, map[uint32]int{0: 1, 1: 1}, requestedShards)
+}
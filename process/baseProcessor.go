@@ -2,6 +2,7 @@ package process
 
 import (
 	"bytes"
+	"crypto/tls"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -74,6 +75,24 @@ func NewBaseProcessor(
 	}, nil
 }
 
+// SetTLSClientConfig replaces the http client used for calling the nodes with one that uses the provided TLS
+// configuration when connecting to nodes exposed over https. It should be called before the processor is used
+func (bp *BaseProcessor) SetTLSClientConfig(tlsConfig *tls.Config) error {
+	if tlsConfig == nil {
+		return ErrNilTLSConfig
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	bp.httpClient = &http.Client{
+		Transport: transport,
+		Timeout:   bp.httpClient.Timeout,
+	}
+
+	return nil
+}
+
 // GetShardIDs will return the shard IDs slice
 func (bp *BaseProcessor) GetShardIDs() []uint32 {
 	return bp.shardIDs
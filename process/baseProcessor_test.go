@@ -2,6 +2,8 @@ package process_test
 
 import (
 	"bytes"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -237,6 +239,53 @@ func TestBaseProcessor_CallGetRestEndPointShouldTimeout(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestBaseProcessor_SetTLSClientConfigNilConfigShouldErr(t *testing.T) {
+	t.Parallel()
+
+	bp, _ := process.NewBaseProcessor(
+		5,
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+	)
+
+	err := bp.SetTLSClientConfig(nil)
+	assert.Equal(t, process.ErrNilTLSConfig, err)
+}
+
+func TestBaseProcessor_CallGetRestEndPointOverTLS(t *testing.T) {
+	ts := &testStruct{
+		Nonce: 10000,
+		Name:  "a test struct to be send and received over TLS",
+	}
+	response, _ := json.Marshal(ts)
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		_, _ = rw.Write(response)
+	}))
+	defer server.Close()
+
+	bp, _ := process.NewBaseProcessor(
+		5,
+		&mock.ShardCoordinatorMock{},
+		&mock.ObserversProviderStub{},
+		&mock.ObserversProviderStub{},
+		&mock.PubKeyConverterMock{},
+	)
+
+	certPool := x509.NewCertPool()
+	certPool.AddCert(server.Certificate())
+	err := bp.SetTLSClientConfig(&tls.Config{RootCAs: certPool})
+	require.Nil(t, err)
+
+	tsRecovered := &testStruct{}
+	_, err = bp.CallGetRestEndPoint(server.URL, "/some/path", tsRecovered)
+
+	assert.Nil(t, err)
+	assert.Equal(t, ts, tsRecovered)
+}
+
 func TestBaseProcessor_CallPostRestEndPoint(t *testing.T) {
 	ts := &testStruct{
 		Nonce: 10000,
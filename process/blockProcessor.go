@@ -40,6 +40,11 @@ func (bp *BlockProcessor) GetAtlasBlockByShardIDAndNonce(shardID uint32, nonce u
 	return bp.dbReader.GetAtlasBlockByShardIDAndNonce(shardID, nonce)
 }
 
+// GetSCResultsByTxHash returns the smart contract results generated by a transaction, as stored in the external storage
+func (bp *BlockProcessor) GetSCResultsByTxHash(txHash string) ([]data.DatabaseSCResult, error) {
+	return bp.dbReader.GetSCResultsByTxHash(txHash)
+}
+
 // GetBlockByHash will return the block based on its hash
 func (bp *BlockProcessor) GetBlockByHash(shardID uint32, hash string, withTxs bool) (*data.BlockApiResponse, error) {
 	observers, err := bp.getObserversOrFullHistoryNodes(shardID)
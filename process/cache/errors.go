@@ -19,3 +19,6 @@ var ErrNilGenericApiResponseInCache = errors.New("nil generic api response in ca
 
 // ErrNilGenericApiResponseToStoreInCache signals that the provided generic api response is nil
 var ErrNilGenericApiResponseToStoreInCache = errors.New("nil generic api response to store in cache")
+
+// ErrInvalidMaxEntriesInCache signals that an invalid maximum number of cache entries has been provided
+var ErrInvalidMaxEntriesInCache = errors.New("invalid maximum number of entries in cache")
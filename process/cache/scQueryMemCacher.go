@@ -0,0 +1,106 @@
+package cache
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go/data/vm"
+)
+
+type shardSCQueries struct {
+	blockNonce uint64
+	outputs    map[string]*vm.VMOutputApi
+}
+
+// scQueryMemoryCacher will handle caching the outputs of the smart contract queries. The outputs are grouped by the
+// shard of the contracts and are only valid for the last known block nonce of that shard
+type scQueryMemoryCacher struct {
+	shards             map[uint32]*shardSCQueries
+	maxEntriesPerShard int
+	mutShards          sync.RWMutex
+}
+
+// NewSCQueryMemoryCacher will return a new instance of scQueryMemoryCacher
+func NewSCQueryMemoryCacher(maxEntriesPerShard int) (*scQueryMemoryCacher, error) {
+	if maxEntriesPerShard <= 0 {
+		return nil, ErrInvalidMaxEntriesInCache
+	}
+
+	return &scQueryMemoryCacher{
+		shards:             make(map[uint32]*shardSCQueries),
+		maxEntriesPerShard: maxEntriesPerShard,
+	}, nil
+}
+
+// Load will return the query output stored in cache for the given shard, if found
+func (sqmc *scQueryMemoryCacher) Load(shardID uint32, key string) (*vm.VMOutputApi, bool) {
+	sqmc.mutShards.RLock()
+	defer sqmc.mutShards.RUnlock()
+
+	shard, ok := sqmc.shards[shardID]
+	if !ok {
+		return nil, false
+	}
+
+	vmOutput, ok := shard.outputs[key]
+	return vmOutput, ok
+}
+
+// Store will save the query output in cache, if it was computed at the current block nonce of the shard. The output
+// is dropped if the shard already holds the maximum number of entries
+func (sqmc *scQueryMemoryCacher) Store(shardID uint32, blockNonce uint64, key string, vmOutput *vm.VMOutputApi) {
+	if vmOutput == nil {
+		return
+	}
+
+	sqmc.mutShards.Lock()
+	defer sqmc.mutShards.Unlock()
+
+	shard, ok := sqmc.shards[shardID]
+	if !ok || shard.blockNonce != blockNonce || len(shard.outputs) >= sqmc.maxEntriesPerShard {
+		return
+	}
+
+	shard.outputs[key] = vmOutput
+}
+
+// GetBlockNonce will return the current block nonce of the shard, if known
+func (sqmc *scQueryMemoryCacher) GetBlockNonce(shardID uint32) (uint64, bool) {
+	sqmc.mutShards.RLock()
+	defer sqmc.mutShards.RUnlock()
+
+	shard, ok := sqmc.shards[shardID]
+	if !ok {
+		return 0, false
+	}
+
+	return shard.blockNonce, true
+}
+
+// SetBlockNonce will update the current block nonce of the shard, removing its outputs if the nonce has changed
+func (sqmc *scQueryMemoryCacher) SetBlockNonce(shardID uint32, blockNonce uint64) {
+	sqmc.mutShards.Lock()
+	defer sqmc.mutShards.Unlock()
+
+	shard, ok := sqmc.shards[shardID]
+	if ok && shard.blockNonce == blockNonce {
+		return
+	}
+
+	sqmc.shards[shardID] = &shardSCQueries{
+		blockNonce: blockNonce,
+		outputs:    make(map[string]*vm.VMOutputApi),
+	}
+}
+
+// Clear will remove the outputs of the shard and forget its block nonce, so that nothing is cached until a new nonce
+// is set
+func (sqmc *scQueryMemoryCacher) Clear(shardID uint32) {
+	sqmc.mutShards.Lock()
+	delete(sqmc.shards, shardID)
+	sqmc.mutShards.Unlock()
+}
+
+// IsInterfaceNil will return true if there is no value under the interface
+func (sqmc *scQueryMemoryCacher) IsInterfaceNil() bool {
+	return sqmc == nil
+}
@@ -0,0 +1,106 @@
+package cache_test
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/data/vm"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/cache"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSCQueryMemoryCacher(t *testing.T) {
+	t.Parallel()
+
+	mc, err := cache.NewSCQueryMemoryCacher(0)
+	assert.Nil(t, mc)
+	assert.Equal(t, cache.ErrInvalidMaxEntriesInCache, err)
+
+	mc, err = cache.NewSCQueryMemoryCacher(10)
+	assert.Nil(t, err)
+	assert.False(t, mc.IsInterfaceNil())
+}
+
+func TestSCQueryMemoryCacher_StoreWithoutBlockNonceShouldNotCache(t *testing.T) {
+	t.Parallel()
+
+	mc, _ := cache.NewSCQueryMemoryCacher(10)
+	mc.Store(0, 5, "key", &vm.VMOutputApi{})
+
+	_, found := mc.Load(0, "key")
+	assert.False(t, found)
+	_, known := mc.GetBlockNonce(0)
+	assert.False(t, known)
+}
+
+func TestSCQueryMemoryCacher_StoreAndLoadShouldWork(t *testing.T) {
+	t.Parallel()
+
+	mc, _ := cache.NewSCQueryMemoryCacher(10)
+	vmOutput := &vm.VMOutputApi{ReturnData: [][]byte{{42}}}
+	mc.SetBlockNonce(0, 5)
+	mc.Store(0, 5, "key", vmOutput)
+
+	loaded, found := mc.Load(0, "key")
+	assert.True(t, found)
+	assert.Equal(t, vmOutput, loaded)
+
+	_, found = mc.Load(1, "key")
+	assert.False(t, found)
+
+	mc.SetBlockNonce(0, 5)
+	_, found = mc.Load(0, "key")
+	assert.True(t, found)
+}
+
+func TestSCQueryMemoryCacher_NewBlockNonceShouldInvalidateTheShard(t *testing.T) {
+	t.Parallel()
+
+	mc, _ := cache.NewSCQueryMemoryCacher(10)
+	mc.SetBlockNonce(0, 5)
+	mc.SetBlockNonce(1, 7)
+	mc.Store(0, 5, "key", &vm.VMOutputApi{})
+	mc.Store(1, 7, "key", &vm.VMOutputApi{})
+
+	mc.SetBlockNonce(0, 6)
+	_, found := mc.Load(0, "key")
+	assert.False(t, found)
+	_, found = mc.Load(1, "key")
+	assert.True(t, found)
+
+	// an output computed at the previous block nonce should not be cached anymore
+	mc.Store(0, 5, "key", &vm.VMOutputApi{})
+	_, found = mc.Load(0, "key")
+	assert.False(t, found)
+
+	nonce, known := mc.GetBlockNonce(0)
+	assert.True(t, known)
+	assert.Equal(t, uint64(6), nonce)
+}
+
+func TestSCQueryMemoryCacher_ClearShouldForgetTheShard(t *testing.T) {
+	t.Parallel()
+
+	mc, _ := cache.NewSCQueryMemoryCacher(10)
+	mc.SetBlockNonce(0, 5)
+	mc.Store(0, 5, "key", &vm.VMOutputApi{})
+
+	mc.Clear(0)
+	_, found := mc.Load(0, "key")
+	assert.False(t, found)
+	_, known := mc.GetBlockNonce(0)
+	assert.False(t, known)
+}
+
+func TestSCQueryMemoryCacher_StoreShouldRespectTheMaxEntries(t *testing.T) {
+	t.Parallel()
+
+	mc, _ := cache.NewSCQueryMemoryCacher(1)
+	mc.SetBlockNonce(0, 5)
+	mc.Store(0, 5, "key1", &vm.VMOutputApi{})
+	mc.Store(0, 5, "key2", &vm.VMOutputApi{})
+
+	_, found := mc.Load(0, "key1")
+	assert.True(t, found)
+	_, found = mc.Load(0, "key2")
+	assert.False(t, found)
+}
@@ -0,0 +1,378 @@
+package process
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/vm"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+const minBlockPollingInterval = 100 * time.Millisecond
+
+// networkStatusResponse holds the part of an observer's network status used for detecting the new blocks
+type networkStatusResponse struct {
+	Data struct {
+		Status struct {
+			Nonce *uint64 `json:"erd_nonce"`
+		} `json:"status"`
+	} `json:"data"`
+	Error string `json:"error"`
+}
+
+// polledObserver holds the observer whose network status was last fetched for a shard, along with its block nonce
+type polledObserver struct {
+	observer   *data.NodeData
+	blockNonce uint64
+}
+
+// ArgsCachedSCQueryProcessor holds the arguments needed for creating a CachedSCQueryProcessor
+type ArgsCachedSCQueryProcessor struct {
+	SCQueryHandler       ObserverSCQueryHandler
+	Processor            Processor
+	PubKeyConverter      core.PubkeyConverter
+	Cacher               SCQueryCacheHandler
+	CachedViews          map[string][]string
+	BlockPollingInterval time.Duration
+}
+
+// CachedSCQueryProcessor caches the outputs of the smart contract views allowed by the config. The outputs of a
+// contract are kept until its shard produces a new block, which is detected by polling the network status of the shard.
+// The cacheable views are sent to the polled observer and their outputs are only stored if the observer is still at the
+// polled block after the execution, so that they are stored under the nonce of the block they were computed at
+type CachedSCQueryProcessor struct {
+	scQueryHandler       ObserverSCQueryHandler
+	proc                 Processor
+	pubKeyConverter      core.PubkeyConverter
+	cacher               SCQueryCacheHandler
+	cachedViews          map[string]map[string]struct{}
+	contractsShards      map[string]uint32
+	blockPollingInterval time.Duration
+	polledObservers      map[uint32]polledObserver
+	mutPolledObservers   sync.RWMutex
+	cancelFunc           func()
+	mutCancel            sync.Mutex
+}
+
+// NewCachedSCQueryProcessor creates a new instance of CachedSCQueryProcessor
+func NewCachedSCQueryProcessor(args ArgsCachedSCQueryProcessor) (*CachedSCQueryProcessor, error) {
+	if check.IfNil(args.SCQueryHandler) {
+		return nil, ErrNilSCQueryHandler
+	}
+	if check.IfNil(args.Processor) {
+		return nil, ErrNilCoreProcessor
+	}
+	if check.IfNil(args.PubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+	if check.IfNil(args.Cacher) {
+		return nil, ErrNilSCQueryCacher
+	}
+	if args.BlockPollingInterval < minBlockPollingInterval {
+		return nil, ErrInvalidPollingInterval
+	}
+
+	csqp := &CachedSCQueryProcessor{
+		scQueryHandler:       args.SCQueryHandler,
+		proc:                 args.Processor,
+		pubKeyConverter:      args.PubKeyConverter,
+		cacher:               args.Cacher,
+		cachedViews:          make(map[string]map[string]struct{}),
+		contractsShards:      make(map[string]uint32),
+		blockPollingInterval: args.BlockPollingInterval,
+		polledObservers:      make(map[uint32]polledObserver),
+	}
+
+	err := csqp.loadCachedViews(args.CachedViews)
+	if err != nil {
+		return nil, err
+	}
+
+	return csqp, nil
+}
+
+func (csqp *CachedSCQueryProcessor) loadCachedViews(cachedViews map[string][]string) error {
+	for address, functions := range cachedViews {
+		if len(functions) == 0 {
+			return fmt.Errorf("%w: no function provided for contract %s", ErrInvalidCachedSCQueries, address)
+		}
+
+		addressBytes, err := csqp.pubKeyConverter.Decode(address)
+		if err != nil {
+			return fmt.Errorf("%w: contract %s: %v", ErrInvalidCachedSCQueries, address, err)
+		}
+		shardID, err := csqp.proc.ComputeShardId(addressBytes)
+		if err != nil {
+			return fmt.Errorf("%w: contract %s: %v", ErrInvalidCachedSCQueries, address, err)
+		}
+
+		csqp.contractsShards[address] = shardID
+		csqp.cachedViews[address] = make(map[string]struct{}, len(functions))
+		for _, function := range functions {
+			csqp.cachedViews[address][function] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+// ExecuteQuery returns the cached output of the query, if any, or executes it otherwise
+func (csqp *CachedSCQueryProcessor) ExecuteQuery(query *data.SCQuery) (*vm.VMOutputApi, error) {
+	shardID, key, isCacheable := csqp.getCacheKey(query)
+	if !isCacheable {
+		return csqp.scQueryHandler.ExecuteQuery(query)
+	}
+
+	polled, isPolled := csqp.getPolledObserver(shardID)
+	if !isPolled {
+		return csqp.scQueryHandler.ExecuteQuery(query)
+	}
+
+	vmOutput, found := csqp.cacher.Load(shardID, key)
+	if found {
+		return vmOutput, nil
+	}
+
+	vmOutput, err := csqp.scQueryHandler.ExecuteQueryOnObserver(query, polled.observer)
+	if errors.Is(err, ErrSendingRequest) {
+		return csqp.scQueryHandler.ExecuteQuery(query)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if csqp.isAtPolledBlock(polled) {
+		csqp.cacher.Store(shardID, polled.blockNonce, key, vmOutput)
+	}
+
+	return vmOutput, nil
+}
+
+// ExecuteQueries returns the cached outputs of the queries from the batch and executes the other ones. The cacheable
+// queries are sent to the polled observers of their shards, while the other ones are sent to any observer
+func (csqp *CachedSCQueryProcessor) ExecuteQueries(queries []*data.SCQuery) []*data.SCQueryResult {
+	type shardQueries struct {
+		polled  polledObserver
+		indexes []int
+		keys    []string
+	}
+
+	results := make([]*data.SCQueryResult, len(queries))
+	indexesToExecute := make([]int, 0, len(queries))
+	cacheableQueries := make(map[uint32]*shardQueries)
+	for idx, query := range queries {
+		shardID, key, isCacheable := csqp.getCacheKey(query)
+		polled, isPolled := csqp.getPolledObserver(shardID)
+		if !isCacheable || !isPolled {
+			indexesToExecute = append(indexesToExecute, idx)
+			continue
+		}
+
+		vmOutput, found := csqp.cacher.Load(shardID, key)
+		if found {
+			results[idx] = &data.SCQueryResult{VMOutput: vmOutput}
+			continue
+		}
+
+		_, ok := cacheableQueries[shardID]
+		if !ok {
+			cacheableQueries[shardID] = &shardQueries{polled: polled}
+		}
+		cacheableQueries[shardID].indexes = append(cacheableQueries[shardID].indexes, idx)
+		cacheableQueries[shardID].keys = append(cacheableQueries[shardID].keys, key)
+	}
+
+	for shardID, cacheable := range cacheableQueries {
+		queriesToExecute := make([]*data.SCQuery, 0, len(cacheable.indexes))
+		for _, idx := range cacheable.indexes {
+			queriesToExecute = append(queriesToExecute, queries[idx])
+		}
+
+		executedResults := csqp.scQueryHandler.ExecuteQueriesOnObserver(queriesToExecute, cacheable.polled.observer)
+		isAtPolledBlock := csqp.isAtPolledBlock(cacheable.polled)
+		for i, result := range executedResults {
+			idx := cacheable.indexes[i]
+			if errors.Is(result.Err, ErrSendingRequest) {
+				indexesToExecute = append(indexesToExecute, idx)
+				continue
+			}
+
+			results[idx] = result
+			if result.Err == nil && isAtPolledBlock {
+				csqp.cacher.Store(shardID, cacheable.polled.blockNonce, cacheable.keys[i], result.VMOutput)
+			}
+		}
+	}
+
+	if len(indexesToExecute) == 0 {
+		return results
+	}
+
+	queriesToExecute := make([]*data.SCQuery, 0, len(indexesToExecute))
+	for _, idx := range indexesToExecute {
+		queriesToExecute = append(queriesToExecute, queries[idx])
+	}
+	executedResults := csqp.scQueryHandler.ExecuteQueries(queriesToExecute)
+	for i, result := range executedResults {
+		results[indexesToExecute[i]] = result
+	}
+
+	return results
+}
+
+// getCacheKey returns the shard of the contract and the key of the query, if the queried view is allowed to be cached
+func (csqp *CachedSCQueryProcessor) getCacheKey(query *data.SCQuery) (uint32, string, bool) {
+	functions, ok := csqp.cachedViews[query.ScAddress]
+	if !ok {
+		return 0, "", false
+	}
+	_, ok = functions[query.FuncName]
+	if !ok {
+		return 0, "", false
+	}
+
+	arguments := make([]string, len(query.Arguments))
+	for i, argument := range query.Arguments {
+		arguments[i] = hex.EncodeToString(argument)
+	}
+	key := strings.Join([]string{
+		query.ScAddress,
+		query.FuncName,
+		strings.Join(arguments, "@"),
+		query.CallerAddr,
+		query.CallValue,
+	}, "|")
+
+	return csqp.contractsShards[query.ScAddress], key, true
+}
+
+// StartCacheInvalidation starts polling the block nonces of the shards holding cached contracts, in a background
+// go routine
+func (csqp *CachedSCQueryProcessor) StartCacheInvalidation() {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	csqp.mutCancel.Lock()
+	csqp.cancelFunc = cancel
+	csqp.mutCancel.Unlock()
+
+	go csqp.pollBlockNonces(ctx)
+}
+
+func (csqp *CachedSCQueryProcessor) pollBlockNonces(ctx context.Context) {
+	shardIDs := make(map[uint32]struct{})
+	for _, shardID := range csqp.contractsShards {
+		shardIDs[shardID] = struct{}{}
+	}
+
+	for {
+		for shardID := range shardIDs {
+			csqp.updateBlockNonce(shardID)
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Debug("cached SC queries: closing")
+			return
+		case <-time.After(csqp.blockPollingInterval):
+		}
+	}
+}
+
+// updateBlockNonce sets the current block nonce of the shard in cache. If the nonce cannot be fetched, the cache of
+// the shard is cleared, as its new blocks cannot be detected anymore
+func (csqp *CachedSCQueryProcessor) updateBlockNonce(shardID uint32) {
+	observer, blockNonce, err := csqp.getBlockNonce(shardID)
+	if err != nil {
+		log.Debug("cached SC queries: cannot get the block nonce", "shard", shardID, "error", err)
+		csqp.mutPolledObservers.Lock()
+		delete(csqp.polledObservers, shardID)
+		csqp.mutPolledObservers.Unlock()
+		csqp.cacher.Clear(shardID)
+		return
+	}
+
+	csqp.mutPolledObservers.Lock()
+	csqp.polledObservers[shardID] = polledObserver{observer: observer, blockNonce: blockNonce}
+	csqp.mutPolledObservers.Unlock()
+	csqp.cacher.SetBlockNonce(shardID, blockNonce)
+}
+
+func (csqp *CachedSCQueryProcessor) getPolledObserver(shardID uint32) (polledObserver, bool) {
+	csqp.mutPolledObservers.RLock()
+	defer csqp.mutPolledObservers.RUnlock()
+
+	polled, ok := csqp.polledObservers[shardID]
+	return polled, ok
+}
+
+// isAtPolledBlock returns true if the polled observer is still at the polled block nonce. As the nonces only increase,
+// the queries executed on the observer since the polling were computed at that block
+func (csqp *CachedSCQueryProcessor) isAtPolledBlock(polled polledObserver) bool {
+	blockNonce, err := csqp.getObserverBlockNonce(polled.observer)
+	if err != nil {
+		log.Debug("cached SC queries: cannot get the block nonce", "observer", polled.observer.Address, "error", err)
+		return false
+	}
+
+	return blockNonce == polled.blockNonce
+}
+
+// getBlockNonce returns the first observer of the shard which provided its network status, along with its block nonce
+func (csqp *CachedSCQueryProcessor) getBlockNonce(shardID uint32) (*data.NodeData, uint64, error) {
+	observers, err := csqp.proc.GetObservers(shardID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for _, observer := range observers {
+		blockNonce, errNonce := csqp.getObserverBlockNonce(observer)
+		if errors.Is(errNonce, ErrSendingRequest) {
+			continue
+		}
+		if errNonce != nil {
+			return nil, 0, errNonce
+		}
+
+		return observer, blockNonce, nil
+	}
+
+	return nil, 0, ErrSendingRequest
+}
+
+// getObserverBlockNonce returns the block nonce from the network status of the observer
+func (csqp *CachedSCQueryProcessor) getObserverBlockNonce(observer *data.NodeData) (uint64, error) {
+	var response networkStatusResponse
+	_, err := csqp.proc.CallGetRestEndPoint(observer.Address, NetworkStatusPath, &response)
+	if err != nil || len(response.Error) > 0 {
+		return 0, ErrSendingRequest
+	}
+
+	if response.Data.Status.Nonce == nil {
+		return 0, ErrCannotParseNodeStatusMetrics
+	}
+
+	return *response.Data.Status.Nonce, nil
+}
+
+// Close stops the polling of the block nonces
+func (csqp *CachedSCQueryProcessor) Close() error {
+	csqp.mutCancel.Lock()
+	defer csqp.mutCancel.Unlock()
+
+	if csqp.cancelFunc != nil {
+		csqp.cancelFunc()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (csqp *CachedSCQueryProcessor) IsInterfaceNil() bool {
+	return csqp == nil
+}
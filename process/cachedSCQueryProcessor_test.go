@@ -0,0 +1,299 @@
+package process
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/data/vm"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/cache"
+	"github.com/ElrondNetwork/elrond-proxy-go/process/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const otherScAddress = "erd1qqqqqqqqqqqqqpgqxwakt2g7u9atsnr03gqcgmhcv38pt7mkd94q6shuwt"
+
+type cachedSCQueryProcessorTestContext struct {
+	args             ArgsCachedSCQueryProcessor
+	numQueries       uint32
+	blockNonce       uint64
+	isStatusDown     atomic.Value
+	queriedObservers atomic.Value
+}
+
+func createCachedSCQueryProcessorTestContext() *cachedSCQueryProcessorTestContext {
+	tc := &cachedSCQueryProcessorTestContext{blockNonce: 10}
+	tc.isStatusDown.Store(false)
+	tc.queriedObservers.Store("")
+	cacher, _ := cache.NewSCQueryMemoryCacher(100)
+	executeQuery := func(query *data.SCQuery) (*vm.VMOutputApi, error) {
+		nonce := atomic.AddUint32(&tc.numQueries, 1)
+		if query.FuncName == "fails" {
+			return nil, errors.New("execution failed")
+		}
+
+		return &vm.VMOutputApi{ReturnData: [][]byte{{byte(nonce)}}}, nil
+	}
+	executeQueries := func(queries []*data.SCQuery) []*data.SCQueryResult {
+		results := make([]*data.SCQueryResult, 0, len(queries))
+		for _, query := range queries {
+			vmOutput, err := executeQuery(query)
+			results = append(results, &data.SCQueryResult{VMOutput: vmOutput, Err: err})
+		}
+
+		return results
+	}
+	tc.args = ArgsCachedSCQueryProcessor{
+		SCQueryHandler: &mock.SCQueryHandlerStub{
+			ExecuteQueryCalled:   executeQuery,
+			ExecuteQueriesCalled: executeQueries,
+			ExecuteQueryOnObserverCalled: func(query *data.SCQuery, observer *data.NodeData) (*vm.VMOutputApi, error) {
+				tc.queriedObservers.Store(tc.queriedObservers.Load().(string) + observer.Address + ";")
+				return executeQuery(query)
+			},
+			ExecuteQueriesOnObserverCalled: func(queries []*data.SCQuery, observer *data.NodeData) []*data.SCQueryResult {
+				tc.queriedObservers.Store(tc.queriedObservers.Load().(string) + observer.Address + ";")
+				return executeQueries(queries)
+			},
+		},
+		Processor: &mock.ProcessorStub{
+			ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+				return 1, nil
+			},
+			GetObserversCalled: func(shardId uint32) ([]*data.NodeData, error) {
+				return []*data.NodeData{
+					{Address: "lagging observer", ShardId: shardId},
+					{Address: "observer", ShardId: shardId},
+				}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				if tc.isStatusDown.Load().(bool) || address == "lagging observer" {
+					return 0, errors.New("observer down")
+				}
+
+				nonce := atomic.LoadUint64(&tc.blockNonce)
+				value.(*networkStatusResponse).Data.Status.Nonce = &nonce
+				return 200, nil
+			},
+		},
+		PubKeyConverter:      testPubKeyConverter,
+		Cacher:               cacher,
+		CachedViews:          map[string][]string{dummyScAddress: {"getTotalStaked", "fails"}},
+		BlockPollingInterval: time.Second,
+	}
+
+	return tc
+}
+
+func (tc *cachedSCQueryProcessorTestContext) getNumQueries() uint32 {
+	return atomic.LoadUint32(&tc.numQueries)
+}
+
+func TestNewCachedSCQueryProcessor(t *testing.T) {
+	t.Parallel()
+
+	args := createCachedSCQueryProcessorTestContext().args
+	args.SCQueryHandler = nil
+	_, err := NewCachedSCQueryProcessor(args)
+	require.Equal(t, ErrNilSCQueryHandler, err)
+
+	args = createCachedSCQueryProcessorTestContext().args
+	args.Cacher = nil
+	_, err = NewCachedSCQueryProcessor(args)
+	require.Equal(t, ErrNilSCQueryCacher, err)
+
+	args = createCachedSCQueryProcessorTestContext().args
+	args.BlockPollingInterval = time.Millisecond
+	_, err = NewCachedSCQueryProcessor(args)
+	require.Equal(t, ErrInvalidPollingInterval, err)
+
+	args = createCachedSCQueryProcessorTestContext().args
+	args.CachedViews = map[string][]string{dummyScAddress: {}}
+	_, err = NewCachedSCQueryProcessor(args)
+	require.True(t, errors.Is(err, ErrInvalidCachedSCQueries))
+
+	args = createCachedSCQueryProcessorTestContext().args
+	args.CachedViews = map[string][]string{"invalid address": {"getTotalStaked"}}
+	_, err = NewCachedSCQueryProcessor(args)
+	require.True(t, errors.Is(err, ErrInvalidCachedSCQueries))
+
+	processor, err := NewCachedSCQueryProcessor(createCachedSCQueryProcessorTestContext().args)
+	require.Nil(t, err)
+	require.False(t, processor.IsInterfaceNil())
+}
+
+func TestCachedSCQueryProcessor_ExecuteQuery(t *testing.T) {
+	t.Parallel()
+
+	tc := createCachedSCQueryProcessorTestContext()
+	processor, _ := NewCachedSCQueryProcessor(tc.args)
+	query := &data.SCQuery{ScAddress: dummyScAddress, FuncName: "getTotalStaked", Arguments: [][]byte{{1}}}
+
+	// the block nonce of the shard is not known yet, so nothing is cached
+	_, _ = processor.ExecuteQuery(query)
+	_, _ = processor.ExecuteQuery(query)
+	require.Equal(t, uint32(2), tc.getNumQueries())
+
+	processor.updateBlockNonce(1)
+	first, err := processor.ExecuteQuery(query)
+	require.Nil(t, err)
+	second, err := processor.ExecuteQuery(query)
+	require.Nil(t, err)
+	require.Equal(t, first, second)
+	require.Equal(t, uint32(3), tc.getNumQueries())
+
+	// other arguments, callers, views or contracts are not served from the cached output
+	_, _ = processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress, FuncName: "getTotalStaked", Arguments: [][]byte{{2}}})
+	_, _ = processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress, FuncName: "getTotalStaked", Arguments: [][]byte{{1}}, CallerAddr: otherScAddress})
+	_, _ = processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress, FuncName: "getOther"})
+	_, _ = processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress, FuncName: "getOther"})
+	_, _ = processor.ExecuteQuery(&data.SCQuery{ScAddress: otherScAddress, FuncName: "getTotalStaked"})
+	require.Equal(t, uint32(8), tc.getNumQueries())
+
+	// failed queries are not cached
+	_, err = processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress, FuncName: "fails"})
+	require.NotNil(t, err)
+	_, err = processor.ExecuteQuery(&data.SCQuery{ScAddress: dummyScAddress, FuncName: "fails"})
+	require.NotNil(t, err)
+	require.Equal(t, uint32(10), tc.getNumQueries())
+}
+
+func TestCachedSCQueryProcessor_ExecuteQueryShouldUseThePolledObserver(t *testing.T) {
+	t.Parallel()
+
+	tc := createCachedSCQueryProcessorTestContext()
+	processor, _ := NewCachedSCQueryProcessor(tc.args)
+	query := &data.SCQuery{ScAddress: dummyScAddress, FuncName: "getTotalStaked"}
+
+	processor.updateBlockNonce(1)
+	_, _ = processor.ExecuteQuery(query)
+	_ = processor.ExecuteQueries([]*data.SCQuery{{ScAddress: dummyScAddress, FuncName: "getTotalStaked", Arguments: [][]byte{{1}}}})
+	require.Equal(t, "observer;observer;", tc.queriedObservers.Load().(string))
+}
+
+func TestCachedSCQueryProcessor_PolledObserverDownShouldExecuteWithoutCaching(t *testing.T) {
+	t.Parallel()
+
+	tc := createCachedSCQueryProcessorTestContext()
+	stub := tc.args.SCQueryHandler.(*mock.SCQueryHandlerStub)
+	stub.ExecuteQueryOnObserverCalled = func(query *data.SCQuery, observer *data.NodeData) (*vm.VMOutputApi, error) {
+		return nil, ErrSendingRequest
+	}
+	stub.ExecuteQueriesOnObserverCalled = func(queries []*data.SCQuery, observer *data.NodeData) []*data.SCQueryResult {
+		return []*data.SCQueryResult{{Err: ErrSendingRequest}}
+	}
+	processor, _ := NewCachedSCQueryProcessor(tc.args)
+	query := &data.SCQuery{ScAddress: dummyScAddress, FuncName: "getTotalStaked"}
+
+	processor.updateBlockNonce(1)
+	vmOutput, err := processor.ExecuteQuery(query)
+	require.Nil(t, err)
+	require.NotNil(t, vmOutput)
+	results := processor.ExecuteQueries([]*data.SCQuery{query})
+	require.Nil(t, results[0].Err)
+	require.NotNil(t, results[0].VMOutput)
+	require.Equal(t, uint32(2), tc.getNumQueries())
+}
+
+func TestCachedSCQueryProcessor_NewBlockShouldInvalidateTheCache(t *testing.T) {
+	t.Parallel()
+
+	tc := createCachedSCQueryProcessorTestContext()
+	processor, _ := NewCachedSCQueryProcessor(tc.args)
+	query := &data.SCQuery{ScAddress: dummyScAddress, FuncName: "getTotalStaked"}
+
+	processor.updateBlockNonce(1)
+	first, _ := processor.ExecuteQuery(query)
+	processor.updateBlockNonce(1)
+	cached, _ := processor.ExecuteQuery(query)
+	require.Equal(t, first, cached)
+
+	atomic.StoreUint64(&tc.blockNonce, 11)
+	processor.updateBlockNonce(1)
+	refreshed, _ := processor.ExecuteQuery(query)
+	require.NotEqual(t, first, refreshed)
+	require.Equal(t, uint32(2), tc.getNumQueries())
+
+	// when the new blocks cannot be detected anymore, the outputs are not cached
+	tc.isStatusDown.Store(true)
+	processor.updateBlockNonce(1)
+	_, _ = processor.ExecuteQuery(query)
+	_, _ = processor.ExecuteQuery(query)
+	require.Equal(t, uint32(4), tc.getNumQueries())
+}
+
+func TestCachedSCQueryProcessor_NewBlockDuringTheExecutionShouldNotCache(t *testing.T) {
+	t.Parallel()
+
+	tc := createCachedSCQueryProcessorTestContext()
+	stub := tc.args.SCQueryHandler.(*mock.SCQueryHandlerStub)
+	executeQueryOnObserver := stub.ExecuteQueryOnObserverCalled
+	stub.ExecuteQueryOnObserverCalled = func(query *data.SCQuery, observer *data.NodeData) (*vm.VMOutputApi, error) {
+		atomic.AddUint64(&tc.blockNonce, 1)
+		return executeQueryOnObserver(query, observer)
+	}
+	executeQueriesOnObserver := stub.ExecuteQueriesOnObserverCalled
+	stub.ExecuteQueriesOnObserverCalled = func(queries []*data.SCQuery, observer *data.NodeData) []*data.SCQueryResult {
+		atomic.AddUint64(&tc.blockNonce, 1)
+		return executeQueriesOnObserver(queries, observer)
+	}
+	processor, _ := NewCachedSCQueryProcessor(tc.args)
+	query := &data.SCQuery{ScAddress: dummyScAddress, FuncName: "getTotalStaked"}
+
+	// the outputs may be computed at the new block, while the polled nonce is still the previous one
+	processor.updateBlockNonce(1)
+	_, _ = processor.ExecuteQuery(query)
+	_, _ = processor.ExecuteQuery(query)
+	_ = processor.ExecuteQueries([]*data.SCQuery{query})
+	require.Equal(t, uint32(3), tc.getNumQueries())
+
+	// once the new block is polled, the outputs are cached again
+	stub.ExecuteQueryOnObserverCalled = executeQueryOnObserver
+	processor.updateBlockNonce(1)
+	first, _ := processor.ExecuteQuery(query)
+	cached := processor.ExecuteQueries([]*data.SCQuery{query})
+	require.Equal(t, first, cached[0].VMOutput)
+	require.Equal(t, uint32(4), tc.getNumQueries())
+}
+
+func TestCachedSCQueryProcessor_ExecuteQueries(t *testing.T) {
+	t.Parallel()
+
+	tc := createCachedSCQueryProcessorTestContext()
+	processor, _ := NewCachedSCQueryProcessor(tc.args)
+	processor.updateBlockNonce(1)
+
+	cachedQuery := &data.SCQuery{ScAddress: dummyScAddress, FuncName: "getTotalStaked"}
+	cachedOutput, _ := processor.ExecuteQuery(cachedQuery)
+
+	queries := []*data.SCQuery{
+		{ScAddress: otherScAddress, FuncName: "getTotalStaked"},
+		cachedQuery,
+		{ScAddress: dummyScAddress, FuncName: "getTotalStaked", Arguments: [][]byte{{1}}},
+	}
+	results := processor.ExecuteQueries(queries)
+	require.Len(t, results, 3)
+	require.Equal(t, cachedOutput, results[1].VMOutput)
+	require.Equal(t, uint32(3), tc.getNumQueries())
+
+	results = processor.ExecuteQueries(queries[1:])
+	require.Equal(t, cachedOutput, results[0].VMOutput)
+	require.NotNil(t, results[1].VMOutput)
+	require.Equal(t, uint32(3), tc.getNumQueries())
+}
+
+func TestCachedSCQueryProcessor_StartCacheInvalidationAndClose(t *testing.T) {
+	t.Parallel()
+
+	tc := createCachedSCQueryProcessorTestContext()
+	processor, _ := NewCachedSCQueryProcessor(tc.args)
+
+	processor.StartCacheInvalidation()
+	require.Eventually(t, func() bool {
+		nonce, known := tc.args.Cacher.GetBlockNonce(1)
+		return known && nonce == 10
+	}, time.Second, 10*time.Millisecond)
+	require.Nil(t, processor.Close())
+}
@@ -3,6 +3,7 @@ package database
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/ElrondNetwork/elastic-indexer-go"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
@@ -28,6 +29,43 @@ func convertObjectToBlock(obj object) (*indexer.Block, string, error) {
 	return &block, blockHash, nil
 }
 
+// convertObjectToBlocks returns the blocks of a multi get response, failing if any of them was not found
+func convertObjectToBlocks(obj object) ([]*indexer.Block, error) {
+	docs, ok := obj["docs"].([]interface{})
+	if !ok {
+		return nil, errCannotFindBlockInDb
+	}
+
+	blocks := make([]*indexer.Block, 0, len(docs))
+	for _, doc := range docs {
+		found, _ := doc.(object)["found"].(bool)
+		if !found {
+			return nil, errCannotFindBlockInDb
+		}
+
+		marshalizedBlock, _ := json.Marshal(doc.(object)["_source"])
+		var block indexer.Block
+		err := json.Unmarshal(marshalizedBlock, &block)
+		if err != nil {
+			return nil, errCannotUnmarshalBlock
+		}
+
+		blocks = append(blocks, &block)
+	}
+
+	return blocks, nil
+}
+
+func getNumHits(obj object) int {
+	hits, ok := obj["hits"].(object)
+	if !ok {
+		return 0
+	}
+
+	hitsList, _ := hits["hits"].([]interface{})
+	return len(hitsList)
+}
+
 func convertObjectToTransactions(obj object) ([]data.DatabaseTransaction, error) {
 	hits, ok := obj["hits"].(object)
 	if !ok {
@@ -53,3 +91,41 @@ func convertObjectToTransactions(obj object) ([]data.DatabaseTransaction, error)
 	}
 	return txs, nil
 }
+
+// convertObjectToEvents returns the events of the found logs emitted by the address, with the identifier if provided.
+// The events of a log are stored as an array of objects, so a log can match the query through different events, which
+// is why the events are filtered again
+func convertObjectToEvents(obj object, address string, identifier string) ([]data.DatabaseEvent, error) {
+	hits, ok := obj["hits"].(object)
+	if !ok {
+		return nil, errCannotGetEventsFromBody
+	}
+
+	events := make([]data.DatabaseEvent, 0)
+	for _, h1 := range hits["hits"].([]interface{}) {
+		var source struct {
+			Timestamp time.Duration   `json:"timestamp"`
+			Events    []indexer.Event `json:"events"`
+		}
+		marshalizedSource, _ := json.Marshal(h1.(object)["_source"])
+		err := json.Unmarshal(marshalizedSource, &source)
+		if err != nil {
+			continue
+		}
+
+		txHash := fmt.Sprint(h1.(object)["_id"])
+		for _, event := range source.Events {
+			if event.Address != address || (identifier != "" && event.Identifier != identifier) {
+				continue
+			}
+
+			events = append(events, data.DatabaseEvent{
+				TxHash:    txHash,
+				Timestamp: source.Timestamp,
+				Event:     event,
+			})
+		}
+	}
+
+	return events, nil
+}
@@ -15,7 +15,22 @@ func NewDisabledElasticSearchConnector() *disabledElasticSearchConnector {
 }
 
 // GetTransactionsByAddress will return error because database connection is disabled
-func (desc *disabledElasticSearchConnector) GetTransactionsByAddress(_ string) ([]data.DatabaseTransaction, error) {
+func (desc *disabledElasticSearchConnector) GetTransactionsByAddress(_ string, _ data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error) {
+	return nil, errDatabaseConnectionIsDisabled
+}
+
+// GetTokenTransfers will return error because database connection is disabled
+func (desc *disabledElasticSearchConnector) GetTokenTransfers(_ string, _ data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error) {
+	return nil, errDatabaseConnectionIsDisabled
+}
+
+// GetSCResultsByTxHash will return error because database connection is disabled
+func (desc *disabledElasticSearchConnector) GetSCResultsByTxHash(_ string) ([]data.DatabaseSCResult, error) {
+	return nil, errDatabaseConnectionIsDisabled
+}
+
+// GetEventsByAddress will return error because database connection is disabled
+func (desc *disabledElasticSearchConnector) GetEventsByAddress(_ string, _ string, _ data.TransactionsHistoryOptions) ([]data.DatabaseEvent, error) {
 	return nil, errDatabaseConnectionIsDisabled
 }
 
@@ -2,17 +2,27 @@ package database
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"sort"
+	"time"
 
+	"github.com/ElrondNetwork/elastic-indexer-go"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 	"github.com/elastic/go-elasticsearch/v7"
+	"github.com/elastic/go-elasticsearch/v7/esapi"
 )
 
 const (
-	numTopTransactions           = 20
-	numTransactionFromAMiniblock = 100
+	numTopTransactions        = 20
+	numDocumentsPerScrollPage = 1000
+	scrollKeepAlive           = time.Minute
 )
 
+var log = logger.GetOrCreate("process/database")
+
 type elasticSearchConnector struct {
 	client *elasticsearch.Client
 }
@@ -35,9 +45,18 @@ func NewElasticSearchConnector(url, username, password string) (*elasticSearchCo
 	}, nil
 }
 
-// GetTransactionsByAddress gets transactions TO or FROM the specified address
-func (esc *elasticSearchConnector) GetTransactionsByAddress(address string) ([]data.DatabaseTransaction, error) {
-	decodedBody, err := esc.doSearchRequestTx(address, "transactions", numTopTransactions)
+// GetTransactionsByAddress gets transactions TO or FROM the specified address, paginated, filtered and sorted
+// as requested by the options
+func (esc *elasticSearchConnector) GetTransactionsByAddress(
+	address string,
+	options data.TransactionsHistoryOptions,
+) ([]data.DatabaseTransaction, error) {
+	query, err := transactionsByAddressQuery(address, options)
+	if err != nil {
+		return nil, err
+	}
+
+	decodedBody, err := esc.doSearchRequest(query, "transactions", getHistorySize(options))
 	if err != nil {
 		return nil, err
 	}
@@ -45,7 +64,73 @@ func (esc *elasticSearchConnector) GetTransactionsByAddress(address string) ([]d
 	return convertObjectToTransactions(decodedBody)
 }
 
-// GetAtlasBlockByShardIDAndNonce gets from database a block with the specified shardID and nonce
+// GetTokenTransfers gets the ESDT and NFT transfers of the token from the options, or of any token if none is
+// provided. If the address is not empty, only the transfers sent or received by it are returned
+func (esc *elasticSearchConnector) GetTokenTransfers(
+	address string,
+	options data.TransactionsHistoryOptions,
+) ([]data.DatabaseTransaction, error) {
+	query, err := tokenTransfersQuery(address, options)
+	if err != nil {
+		return nil, err
+	}
+
+	decodedBody, err := esc.doSearchRequest(query, "transactions", getHistorySize(options))
+	if err != nil {
+		return nil, err
+	}
+
+	return convertObjectToTransactions(decodedBody)
+}
+
+// GetSCResultsByTxHash gets the smart contract results generated by the transaction with the given hash
+func (esc *elasticSearchConnector) GetSCResultsByTxHash(txHash string) ([]data.DatabaseSCResult, error) {
+	decodedBody, err := esc.doSearchRequest(scResultsByTxHashQuery(txHash), "transactions", 1)
+	if err != nil {
+		return nil, err
+	}
+
+	txs, err := convertObjectToTransactions(decodedBody)
+	if err != nil {
+		return nil, err
+	}
+	if len(txs) == 0 {
+		return nil, errCannotFindTxInDb
+	}
+
+	scResults := txs[0].SmartContractResults
+	if scResults == nil {
+		scResults = make([]data.DatabaseSCResult, 0)
+	}
+
+	return scResults, nil
+}
+
+// GetEventsByAddress gets the events emitted by the address, optionally filtered by their identifier. The events are
+// read from the logs index, which is not filled by all the indexer versions
+func (esc *elasticSearchConnector) GetEventsByAddress(
+	address string,
+	identifier string,
+	options data.TransactionsHistoryOptions,
+) ([]data.DatabaseEvent, error) {
+	query, err := eventsQuery(address, identifier, options)
+	if err != nil {
+		return nil, err
+	}
+
+	decodedBody, err := esc.doSearchRequest(query, "logs", getHistorySize(options))
+	if errors.Is(err, errIndexNotFound) {
+		return nil, errLogsNotIndexed
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return convertObjectToEvents(decodedBody, address, identifier)
+}
+
+// GetAtlasBlockByShardIDAndNonce gets from database a block with the specified shardID and nonce. The notarized shard
+// blocks are fetched at once, then the transactions of all the miniblocks are fetched with a single scrolled query
 func (esc *elasticSearchConnector) GetAtlasBlockByShardIDAndNonce(shardID uint32, nonce uint64) (data.AtlasBlock, error) {
 	query := blockByNonceAndShardIDQuery(nonce, shardID)
 	decodedBody, err := esc.doSearchRequest(query, "blocks", 1)
@@ -58,18 +143,21 @@ func (esc *elasticSearchConnector) GetAtlasBlockByShardIDAndNonce(shardID uint32
 		return data.AtlasBlock{}, err
 	}
 
-	txs, err := esc.getTxsByMiniblockHashes(metaBlock.MiniBlocksHashes)
+	shardBlocks, err := esc.getBlocksByHashes(metaBlock.NotarizedBlocksHashes)
 	if err != nil {
 		return data.AtlasBlock{}, err
 	}
 
-	transactions, err := esc.getTxsByNotarizedBlockHashes(metaBlock.NotarizedBlocksHashes)
+	miniBlocksHashes := append([]string{}, metaBlock.MiniBlocksHashes...)
+	for _, shardBlock := range shardBlocks {
+		miniBlocksHashes = append(miniBlocksHashes, shardBlock.MiniBlocksHashes...)
+	}
+
+	txs, err := esc.getTxsByMiniblockHashes(miniBlocksHashes)
 	if err != nil {
 		return data.AtlasBlock{}, err
 	}
 
-	txs = append(txs, transactions...)
-
 	return data.AtlasBlock{
 		Nonce:        metaBlock.Nonce,
 		Hash:         metaBlockHash,
@@ -77,46 +165,57 @@ func (esc *elasticSearchConnector) GetAtlasBlockByShardIDAndNonce(shardID uint32
 	}, nil
 }
 
-func (esc *elasticSearchConnector) getTxsByNotarizedBlockHashes(hashes []string) ([]data.DatabaseTransaction, error) {
-	txs := make([]data.DatabaseTransaction, 0)
-	for _, hash := range hashes {
-		query := blockByHashQuery(hash)
-		decodedBody, err := esc.doSearchRequest(query, "blocks", 1)
-		if err != nil {
-			return nil, err
-		}
-
-		shardBlock, _, err := convertObjectToBlock(decodedBody)
-		if err != nil {
-			return nil, err
-		}
+func (esc *elasticSearchConnector) getBlocksByHashes(hashes []string) ([]*indexer.Block, error) {
+	if len(hashes) == 0 {
+		return make([]*indexer.Block, 0), nil
+	}
 
-		transactions, err := esc.getTxsByMiniblockHashes(shardBlock.MiniBlocksHashes)
-		if err != nil {
-			return nil, err
-		}
+	buff, err := encodeQuery(object{"ids": hashes})
+	if err != nil {
+		return nil, err
+	}
 
-		txs = append(txs, transactions...)
+	res, err := esc.client.Mget(
+		&buff,
+		esc.client.Mget.WithIndex("blocks"),
+	)
+	decodedBody, err := decodeResponse(res, err)
+	if err != nil {
+		return nil, err
 	}
-	return txs, nil
+
+	return convertObjectToBlocks(decodedBody)
 }
 
+// getTxsByMiniblockHashes returns all the transactions of the miniblocks, ordered as the miniblocks hashes. The
+// results are scrolled, so that large miniblocks are not truncated
 func (esc *elasticSearchConnector) getTxsByMiniblockHashes(hashes []string) ([]data.DatabaseTransaction, error) {
 	txs := make([]data.DatabaseTransaction, 0)
-	for _, hash := range hashes {
-		query := txsByMiniblockHashQuery(hash)
-		decodedBody, err := esc.doSearchRequest(query, "transactions", numTransactionFromAMiniblock)
-		if err != nil {
-			return nil, err
-		}
+	if len(hashes) == 0 {
+		return txs, nil
+	}
 
-		transactions, err := convertObjectToTransactions(decodedBody)
-		if err != nil {
-			return nil, err
+	err := esc.doScrollRequest(txsByMiniblockHashesQuery(hashes), "transactions", func(decodedBody object) error {
+		transactions, errConvert := convertObjectToTransactions(decodedBody)
+		if errConvert != nil {
+			return errConvert
 		}
 
 		txs = append(txs, transactions...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	miniBlocksIndexes := make(map[string]int, len(hashes))
+	for idx := len(hashes) - 1; idx >= 0; idx-- {
+		miniBlocksIndexes[hashes[idx]] = idx
 	}
+	sort.SliceStable(txs, func(i, j int) bool {
+		return miniBlocksIndexes[txs[i].MBHash] < miniBlocksIndexes[txs[j].MBHash]
+	})
+
 	return txs, nil
 }
 
@@ -131,33 +230,85 @@ func (esc *elasticSearchConnector) doSearchRequest(query object, index string, s
 		esc.client.Search.WithSize(size),
 		esc.client.Search.WithBody(&buff),
 	)
+
+	return decodeResponse(res, err)
+}
+
+// doScrollRequest calls the handler for each page of the results of the query, until all of them are consumed
+func (esc *elasticSearchConnector) doScrollRequest(query object, index string, handler func(decodedBody object) error) error {
+	buff, err := encodeQuery(query)
 	if err != nil {
-		return nil, fmt.Errorf("cannot get data from database: %w", err)
+		return err
+	}
+
+	res, err := esc.client.Search(
+		esc.client.Search.WithIndex(index),
+		esc.client.Search.WithSize(numDocumentsPerScrollPage),
+		esc.client.Search.WithSort("_doc"),
+		esc.client.Search.WithScroll(scrollKeepAlive),
+		esc.client.Search.WithBody(&buff),
+	)
+	decodedBody, err := decodeResponse(res, err)
+	if err != nil {
+		return err
 	}
 
+	// a scroll response can return another scroll ID, so the one of the last response is used and cleared
+	scrollID := getScrollID(decodedBody, "")
 	defer func() {
-		_ = res.Body.Close()
+		esc.clearScroll(scrollID)
 	}()
-	if res.IsError() {
-		return nil, fmt.Errorf("cannot get data from database: %v", res)
+
+	for {
+		err = handler(decodedBody)
+		if err != nil {
+			return err
+		}
+		if getNumHits(decodedBody) < numDocumentsPerScrollPage {
+			return nil
+		}
+
+		buff, err = encodeQuery(object{"scroll_id": scrollID})
+		if err != nil {
+			return err
+		}
+
+		res, err = esc.client.Scroll(
+			esc.client.Scroll.WithScroll(scrollKeepAlive),
+			esc.client.Scroll.WithBody(&buff),
+		)
+		decodedBody, err = decodeResponse(res, err)
+		if err != nil {
+			return err
+		}
+		scrollID = getScrollID(decodedBody, scrollID)
 	}
+}
 
-	var decodedBody map[string]interface{}
-	if err := json.NewDecoder(res.Body).Decode(&decodedBody); err != nil {
-		return nil, err
+func getScrollID(decodedBody object, previousScrollID string) string {
+	scrollID, ok := decodedBody["_scroll_id"].(string)
+	if !ok || len(scrollID) == 0 {
+		return previousScrollID
 	}
 
-	return decodedBody, nil
+	return scrollID
 }
 
-func (esc *elasticSearchConnector) doSearchRequestTx(address string, index string, size int) (object, error) {
-	query := fmt.Sprintf("sender:%s OR receiver:%s", address, address)
-	res, err := esc.client.Search(
-		esc.client.Search.WithIndex(index),
-		esc.client.Search.WithSize(size),
-		esc.client.Search.WithQuery(query),
-		esc.client.Search.WithSort("timestamp:desc"),
-	)
+func (esc *elasticSearchConnector) clearScroll(scrollID string) {
+	if len(scrollID) == 0 {
+		return
+	}
+
+	res, err := esc.client.ClearScroll(esc.client.ClearScroll.WithScrollID(scrollID))
+	if err != nil {
+		log.Debug("cannot clear elastic search scroll", "error", err)
+		return
+	}
+
+	_ = res.Body.Close()
+}
+
+func decodeResponse(res *esapi.Response, err error) (object, error) {
 	if err != nil {
 		return nil, fmt.Errorf("cannot get data from database: %w", err)
 	}
@@ -165,6 +316,9 @@ func (esc *elasticSearchConnector) doSearchRequestTx(address string, index strin
 	defer func() {
 		_ = res.Body.Close()
 	}()
+	if res.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: %v", errIndexNotFound, res)
+	}
 	if res.IsError() {
 		return nil, fmt.Errorf("cannot get data from database: %v", res)
 	}
@@ -177,6 +331,14 @@ func (esc *elasticSearchConnector) doSearchRequestTx(address string, index strin
 	return decodedBody, nil
 }
 
+func getHistorySize(options data.TransactionsHistoryOptions) int {
+	if options.Size == 0 {
+		return numTopTransactions
+	}
+
+	return options.Size
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (esc *elasticSearchConnector) IsInterfaceNil() bool {
 	return esc == nil
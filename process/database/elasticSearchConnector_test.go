@@ -1,10 +1,16 @@
 package database
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
 	"github.com/stretchr/testify/require"
 )
 
@@ -18,7 +24,7 @@ func TestDatabaseReader(t *testing.T) {
 	require.Nil(t, err)
 
 	addr := "erd1ewshdn9yv0wx38xgs5cdhvcq4dz0n7tdlgh8wfj9nxugwmyunnyqpkpzal"
-	txs, err := reader.GetTransactionsByAddress(addr)
+	txs, err := reader.GetTransactionsByAddress(addr, data.TransactionsHistoryOptions{})
 	fmt.Println(txs)
 	require.Nil(t, err)
 }
@@ -36,3 +42,189 @@ func TestDatabaseReader_GetBlockByShardIDAndNonce(t *testing.T) {
 	fmt.Println(block)
 	require.Nil(t, err)
 }
+
+func TestElasticSearchConnector_GetTransactionsByAddressWithLocalStub(t *testing.T) {
+	t.Parallel()
+
+	addr := "erd1ewshdn9yv0wx38xgs5cdhvcq4dz0n7tdlgh8wfj9nxugwmyunnyqpkpzal"
+	esStub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/transactions/_search", r.URL.Path)
+		require.Equal(t, "5", r.URL.Query().Get("size"))
+
+		var query object
+		require.Nil(t, json.NewDecoder(r.Body).Decode(&query))
+		require.Equal(t, []interface{}{float64(1600000000), float64(3)}, query["search_after"])
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"hits":{"hits":[{"_id":"hash-1","_source":{"sender":"` + addr +
+			`","receiver":"erd1receiver","value":"10","gasPrice":10,"gasUsed":100}}]}}`))
+	}))
+	defer esStub.Close()
+
+	reader, err := NewElasticSearchConnector(esStub.URL, "", "")
+	require.Nil(t, err)
+
+	txs, err := reader.GetTransactionsByAddress(addr, data.TransactionsHistoryOptions{Size: 5, Cursor: "1600000000-3"})
+	require.Nil(t, err)
+	require.Len(t, txs, 1)
+	require.Equal(t, "hash-1", txs[0].Hash)
+	require.Equal(t, addr, txs[0].Sender)
+	require.Equal(t, "1000", txs[0].Fee)
+}
+
+func TestElasticSearchConnector_GetSCResultsByTxHashWithLocalStub(t *testing.T) {
+	t.Parallel()
+
+	esStub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/transactions/_search", r.URL.Path)
+
+		var query object
+		require.Nil(t, json.NewDecoder(r.Body).Decode(&query))
+
+		w.Header().Set("Content-Type", "application/json")
+		if query["query"].(object)["match"].(object)["_id"] != "hash-1" {
+			_, _ = w.Write([]byte(`{"hits":{"hits":[]}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"hits":{"hits":[{"_id":"hash-1","_source":{"value":"0","gasPrice":10,"gasUsed":100,` +
+			`"scResults":[{"hash":"scr-1","originalTxHash":"hash-1","value":"5"}]}}]}}`))
+	}))
+	defer esStub.Close()
+
+	reader, err := NewElasticSearchConnector(esStub.URL, "", "")
+	require.Nil(t, err)
+
+	scResults, err := reader.GetSCResultsByTxHash("hash-1")
+	require.Nil(t, err)
+	require.Len(t, scResults, 1)
+	require.Equal(t, "scr-1", scResults[0].Hash)
+	require.Equal(t, "5", scResults[0].Value)
+
+	_, err = reader.GetSCResultsByTxHash("hash-2")
+	require.Equal(t, errCannotFindTxInDb, err)
+}
+
+func TestElasticSearchConnector_GetEventsByAddressWithLocalStub(t *testing.T) {
+	t.Parallel()
+
+	addr := "erd1ewshdn9yv0wx38xgs5cdhvcq4dz0n7tdlgh8wfj9nxugwmyunnyqpkpzal"
+	esStub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/logs/_search", r.URL.Path)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"hits":{"hits":[{"_id":"hash-1","_source":{"timestamp":1600000000,"events":[` +
+			`{"address":"` + addr + `","identifier":"transfer"},` +
+			`{"address":"` + addr + `","identifier":"burn"},` +
+			`{"address":"erd1other","identifier":"transfer"}]}}]}}`))
+	}))
+	defer esStub.Close()
+
+	reader, err := NewElasticSearchConnector(esStub.URL, "", "")
+	require.Nil(t, err)
+
+	events, err := reader.GetEventsByAddress(addr, "transfer", data.TransactionsHistoryOptions{})
+	require.Nil(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, "hash-1", events[0].TxHash)
+	require.Equal(t, "transfer", events[0].Identifier)
+	require.Equal(t, uint64(1600000000), uint64(events[0].Timestamp))
+
+	events, err = reader.GetEventsByAddress(addr, "", data.TransactionsHistoryOptions{})
+	require.Nil(t, err)
+	require.Len(t, events, 2)
+}
+
+func TestElasticSearchConnector_GetEventsByAddressMissingLogsIndexShouldErr(t *testing.T) {
+	t.Parallel()
+
+	esStub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":{"type":"index_not_found_exception","reason":"no such index [logs]"},"status":404}`))
+	}))
+	defer esStub.Close()
+
+	reader, err := NewElasticSearchConnector(esStub.URL, "", "")
+	require.Nil(t, err)
+
+	events, err := reader.GetEventsByAddress(testAddress, "", data.TransactionsHistoryOptions{})
+	require.Nil(t, events)
+	require.Equal(t, errLogsNotIndexed, err)
+}
+
+func TestElasticSearchConnector_GetAtlasBlockByShardIDAndNonceWithLocalStub(t *testing.T) {
+	t.Parallel()
+
+	writeTxs := func(w http.ResponseWriter, scrollID string, miniBlockHash string, numTxs int) {
+		hits := make([]string, 0, numTxs)
+		for i := 0; i < numTxs; i++ {
+			hits = append(hits, fmt.Sprintf(`{"_id":"%s-%d","_source":{"miniBlockHash":"%s","value":"0"}}`, miniBlockHash, i, miniBlockHash))
+		}
+		_, _ = fmt.Fprintf(w, `{"_scroll_id":"%s","hits":{"hits":[%s]}}`, scrollID, strings.Join(hits, ","))
+	}
+
+	numRequests := uint32(0)
+	scrollCleared := uint32(0)
+	esStub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddUint32(&numRequests, 1)
+		w.Header().Set("Content-Type", "application/json")
+
+		var body object
+		_ = json.NewDecoder(r.Body).Decode(&body)
+
+		switch {
+		case r.URL.Path == "/blocks/_search":
+			_, _ = w.Write([]byte(`{"hits":{"hits":[{"_id":"meta","_source":{"nonce":7,"miniBlocksHashes":["mb-meta"],` +
+				`"notarizedBlocksHashes":["shard-0","shard-1"]}}]}}`))
+		case r.URL.Path == "/blocks/_mget":
+			require.Equal(t, []interface{}{"shard-0", "shard-1"}, body["ids"])
+			_, _ = w.Write([]byte(`{"docs":[{"_id":"shard-0","found":true,"_source":{"miniBlocksHashes":["mb-0"]}},` +
+				`{"_id":"shard-1","found":true,"_source":{"miniBlocksHashes":["mb-1"]}}]}`))
+		case r.URL.Path == "/transactions/_search":
+			require.Equal(t, []interface{}{"mb-meta", "mb-0", "mb-1"}, body["query"].(object)["terms"].(object)["miniBlockHash"])
+			writeTxs(w, "scroll", "mb-1", numDocumentsPerScrollPage)
+		case r.URL.Path == "/_search/scroll" && r.Method != http.MethodDelete:
+			require.Equal(t, "scroll", body["scroll_id"])
+			writeTxs(w, "scroll-2", "mb-meta", 2)
+		case r.URL.Path == "/_search/scroll/scroll-2" && r.Method == http.MethodDelete:
+			atomic.AddUint32(&scrollCleared, 1)
+			_, _ = w.Write([]byte(`{"succeeded":true}`))
+		default:
+			require.Fail(t, "unexpected request "+r.Method+" "+r.URL.Path)
+		}
+	}))
+	defer esStub.Close()
+
+	reader, err := NewElasticSearchConnector(esStub.URL, "", "")
+	require.Nil(t, err)
+
+	block, err := reader.GetAtlasBlockByShardIDAndNonce(core.MetachainShardId, 7)
+	require.Nil(t, err)
+	require.Equal(t, "meta", block.Hash)
+	require.Equal(t, uint64(7), block.Nonce)
+	require.Len(t, block.Transactions, numDocumentsPerScrollPage+2)
+	require.Equal(t, "mb-meta", block.Transactions[0].MBHash)
+	require.Equal(t, "mb-1", block.Transactions[2].MBHash)
+	require.Equal(t, uint32(5), atomic.LoadUint32(&numRequests))
+	require.Equal(t, uint32(1), atomic.LoadUint32(&scrollCleared))
+}
+
+func TestElasticSearchConnector_GetAtlasBlockMissingNotarizedBlockShouldErr(t *testing.T) {
+	t.Parallel()
+
+	esStub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/blocks/_search" {
+			_, _ = w.Write([]byte(`{"hits":{"hits":[{"_id":"meta","_source":{"notarizedBlocksHashes":["shard-0"]}}]}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"docs":[{"_id":"shard-0","found":false}]}`))
+	}))
+	defer esStub.Close()
+
+	reader, err := NewElasticSearchConnector(esStub.URL, "", "")
+	require.Nil(t, err)
+
+	_, err = reader.GetAtlasBlockByShardIDAndNonce(core.MetachainShardId, 7)
+	require.Equal(t, errCannotFindBlockInDb, err)
+}
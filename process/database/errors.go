@@ -5,3 +5,18 @@ import "errors"
 var errCannotFindBlockInDb = errors.New("cannot find blocks in database")
 var errCannotUnmarshalBlock = errors.New("cannot unmarshal block")
 var errCannotGetTxsFromBody = errors.New("cannot get transactions from decoded body")
+var errCannotGetEventsFromBody = errors.New("cannot get events from decoded body")
+var errCannotFindTxInDb = errors.New("cannot find transaction in database")
+var errInvalidHistoryCursor = errors.New("invalid transactions history cursor")
+var errNilHyperBlockProvider = errors.New("nil hyperblock provider")
+var errNilNetworkConfigProvider = errors.New("nil network config provider")
+var errNilFinalNonceProvider = errors.New("nil final nonce provider")
+var errNilHyperBlocksStorer = errors.New("nil hyperblocks storer")
+var errInvalidIndexerPollingInterval = errors.New("invalid indexer polling interval")
+var errInvalidNetworkConfig = errors.New("invalid network config")
+var errIndexNotFound = errors.New("index not found in database")
+var errLogsNotIndexed = errors.New("events are not available: the logs index does not exist, so the indexer does not store the transaction logs")
+var errEventsNotIndexed = errors.New("events are not indexed from the hyperblocks")
+var errOnlyHyperBlocksIndexed = errors.New("only the metachain blocks are indexed from the hyperblocks")
+var errTokenTransfersByAddressOnly = errors.New("the token transfers are indexed only by address")
+var errHyperBlockAlreadyIndexed = errors.New("hyperblock already indexed")
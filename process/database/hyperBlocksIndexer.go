@@ -0,0 +1,353 @@
+package database
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+const minIndexerPollingInterval = time.Second
+
+// maxMissingHyperBlocksPerRetry bounds the number of skipped hyperblocks fetched again each time the indexer is idle
+const maxMissingHyperBlocksPerRetry = 10
+
+// ArgsHyperBlocksIndexer holds the arguments needed for creating a hyperblocks indexer
+type ArgsHyperBlocksIndexer struct {
+	HyperBlockProvider    HyperBlockProvider
+	FinalNonceProvider    FinalNonceProvider
+	NetworkConfigProvider NetworkConfigProvider
+	Storer                HyperBlocksStorer
+	StartNonce            uint64
+	PollingInterval       time.Duration
+}
+
+// hyperBlocksIndexer follows the hyperblocks produced by the network and saves them in a storer, so that the
+// proxy can answer the history queries without an external indexer. Only the final hyperblocks are indexed, since the
+// saved ones are never reverted
+type hyperBlocksIndexer struct {
+	hyperBlockProvider    HyperBlockProvider
+	finalNonceProvider    FinalNonceProvider
+	networkConfigProvider NetworkConfigProvider
+	storer                HyperBlocksStorer
+	startNonce            uint64
+	pollingInterval       time.Duration
+
+	genesisTime    uint64
+	roundDuration  uint64
+	lastFinalNonce uint64
+	cancelFunc     func()
+	mutCancel      sync.Mutex
+	wgIndexing     sync.WaitGroup
+}
+
+// NewHyperBlocksIndexer creates a new hyperblocks indexer
+func NewHyperBlocksIndexer(args ArgsHyperBlocksIndexer) (*hyperBlocksIndexer, error) {
+	if args.HyperBlockProvider == nil {
+		return nil, errNilHyperBlockProvider
+	}
+	if args.FinalNonceProvider == nil {
+		return nil, errNilFinalNonceProvider
+	}
+	if args.NetworkConfigProvider == nil {
+		return nil, errNilNetworkConfigProvider
+	}
+	if check.IfNil(args.Storer) {
+		return nil, errNilHyperBlocksStorer
+	}
+	if args.PollingInterval < minIndexerPollingInterval {
+		return nil, errInvalidIndexerPollingInterval
+	}
+
+	return &hyperBlocksIndexer{
+		hyperBlockProvider:    args.HyperBlockProvider,
+		finalNonceProvider:    args.FinalNonceProvider,
+		networkConfigProvider: args.NetworkConfigProvider,
+		storer:                args.Storer,
+		startNonce:            args.StartNonce,
+		pollingInterval:       args.PollingInterval,
+	}, nil
+}
+
+// StartIndexing starts following the hyperblocks in a background go routine
+func (hbi *hyperBlocksIndexer) StartIndexing() {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	hbi.mutCancel.Lock()
+	hbi.cancelFunc = cancel
+	hbi.mutCancel.Unlock()
+
+	hbi.wgIndexing.Add(1)
+	go hbi.indexHyperBlocks(ctx)
+}
+
+func (hbi *hyperBlocksIndexer) indexHyperBlocks(ctx context.Context) {
+	defer hbi.wgIndexing.Done()
+
+	for {
+		hasIndexed := hbi.indexNextHyperBlock()
+		if hasIndexed {
+			select {
+			case <-ctx.Done():
+				log.Debug("hyperblocks indexer: closing")
+				return
+			default:
+				continue
+			}
+		}
+
+		hbi.retryMissingHyperBlocks()
+
+		select {
+		case <-ctx.Done():
+			log.Debug("hyperblocks indexer: closing")
+			return
+		case <-time.After(hbi.pollingInterval):
+		}
+	}
+}
+
+// indexNextHyperBlock saves the hyperblock following the last indexed one, returning false if it is not available yet.
+// If that hyperblock cannot be fetched but the next one can, the former is marked as missing and skipped, so that
+// a single unavailable hyperblock does not stop the indexing
+func (hbi *hyperBlocksIndexer) indexNextHyperBlock() bool {
+	if hbi.roundDuration == 0 {
+		err := hbi.loadNetworkConfig()
+		if err != nil {
+			log.Debug("hyperblocks indexer: cannot get the network config", "error", err)
+			return false
+		}
+	}
+
+	nonce, err := hbi.getNextNonce()
+	if err != nil {
+		log.Warn("hyperblocks indexer: cannot get the last indexed nonce", "error", err)
+		return false
+	}
+	if !hbi.isFinal(nonce) {
+		return false
+	}
+
+	response, err := hbi.hyperBlockProvider.GetHyperBlockByNonce(nonce)
+	if err == nil {
+		return hbi.saveHyperBlock(&response.Data.Hyperblock)
+	}
+	log.Trace("hyperblocks indexer: hyperblock not available", "nonce", nonce, "error", err)
+
+	if !hbi.isFinal(nonce + 1) {
+		return false
+	}
+	response, err = hbi.hyperBlockProvider.GetHyperBlockByNonce(nonce + 1)
+	if err != nil {
+		return false
+	}
+
+	err = hbi.storer.MarkMissingHyperBlock(nonce)
+	if err != nil {
+		log.Warn("hyperblocks indexer: cannot mark hyperblock as missing", "nonce", nonce, "error", err)
+		return false
+	}
+	log.Debug("hyperblocks indexer: skipped missing hyperblock", "nonce", nonce)
+
+	return hbi.saveHyperBlock(&response.Data.Hyperblock)
+}
+
+// retryMissingHyperBlocks tries to fill the gaps left by the skipped hyperblocks
+func (hbi *hyperBlocksIndexer) retryMissingHyperBlocks() {
+	if hbi.roundDuration == 0 {
+		return
+	}
+
+	nonces, err := hbi.storer.GetMissingHyperBlocks()
+	if err != nil {
+		log.Warn("hyperblocks indexer: cannot get the missing hyperblocks", "error", err)
+		return
+	}
+	if len(nonces) > maxMissingHyperBlocksPerRetry {
+		nonces = nonces[:maxMissingHyperBlocksPerRetry]
+	}
+
+	for _, nonce := range nonces {
+		response, errGet := hbi.hyperBlockProvider.GetHyperBlockByNonce(nonce)
+		if errGet != nil {
+			log.Trace("hyperblocks indexer: missing hyperblock still not available", "nonce", nonce, "error", errGet)
+			continue
+		}
+
+		hbi.saveHyperBlock(&response.Data.Hyperblock)
+	}
+}
+
+func (hbi *hyperBlocksIndexer) saveHyperBlock(hyperBlock *data.Hyperblock) bool {
+	err := hbi.storer.SaveHyperBlock(hbi.convertHyperBlock(hyperBlock))
+	if err != nil {
+		log.Warn("hyperblocks indexer: cannot save hyperblock", "nonce", hyperBlock.Nonce, "error", err)
+		return false
+	}
+
+	log.Debug("hyperblocks indexer: indexed hyperblock", "nonce", hyperBlock.Nonce, "num txs", len(hyperBlock.Transactions))
+	return true
+}
+
+// isFinal checks that the hyperblock with the given nonce cannot be reverted anymore. The latest final nonce is only
+// fetched again once the indexing has reached it
+func (hbi *hyperBlocksIndexer) isFinal(nonce uint64) bool {
+	if nonce <= hbi.lastFinalNonce {
+		return true
+	}
+
+	finalNonce, err := hbi.finalNonceProvider.GetLatestFinalHyperblockNonce()
+	if err != nil {
+		log.Debug("hyperblocks indexer: cannot get the latest final nonce", "error", err)
+		return false
+	}
+	hbi.lastFinalNonce = finalNonce
+
+	return nonce <= finalNonce
+}
+
+func (hbi *hyperBlocksIndexer) getNextNonce() (uint64, error) {
+	lastNonce, found, err := hbi.storer.GetLastIndexedNonce()
+	if err != nil {
+		return 0, err
+	}
+	if !found || lastNonce < hbi.startNonce {
+		return hbi.startNonce, nil
+	}
+
+	return lastNonce + 1, nil
+}
+
+func (hbi *hyperBlocksIndexer) loadNetworkConfig() error {
+	response, err := hbi.networkConfigProvider.GetNetworkConfigMetrics()
+	if err != nil {
+		return err
+	}
+
+	responseData, ok := response.Data.(map[string]interface{})
+	if !ok {
+		return errInvalidNetworkConfig
+	}
+	networkConfig, ok := responseData["config"].(map[string]interface{})
+	if !ok {
+		return errInvalidNetworkConfig
+	}
+	genesisTime, ok := networkConfig[core.MetricStartTime].(float64)
+	if !ok {
+		return errInvalidNetworkConfig
+	}
+	roundDuration, ok := networkConfig[core.MetricRoundDuration].(float64)
+	if !ok || roundDuration == 0 {
+		return errInvalidNetworkConfig
+	}
+
+	hbi.genesisTime = uint64(genesisTime)
+	hbi.roundDuration = uint64(roundDuration)
+
+	return nil
+}
+
+// convertHyperBlock converts the transactions of a hyperblock to the format of the external storage. The smart
+// contract results are kept apart, since their original transactions can belong to previous hyperblocks
+func (hbi *hyperBlocksIndexer) convertHyperBlock(hyperBlock *data.Hyperblock) *IndexedHyperBlock {
+	timestamp := time.Duration(hbi.genesisTime + hyperBlock.Round*hbi.roundDuration/1000)
+	indexedHyperBlock := &IndexedHyperBlock{
+		Nonce:        hyperBlock.Nonce,
+		Hash:         hyperBlock.Hash,
+		Timestamp:    timestamp,
+		Transactions: make([]data.DatabaseTransaction, 0, len(hyperBlock.Transactions)),
+		SCResults:    make([]data.DatabaseSCResult, 0),
+	}
+
+	for idx, tx := range hyperBlock.Transactions {
+		if tx.Type == string(transaction.TxTypeUnsigned) {
+			indexedHyperBlock.SCResults = append(indexedHyperBlock.SCResults, convertToSCResult(tx))
+			continue
+		}
+
+		indexedHyperBlock.Transactions = append(indexedHyperBlock.Transactions, convertToDatabaseTransaction(tx, hyperBlock, timestamp, idx))
+	}
+
+	return indexedHyperBlock
+}
+
+// convertToDatabaseTransaction converts a transaction of a hyperblock. The gas used is not known from the hyperblocks,
+// so the gas limit is used instead and the fee is the maximum one
+func convertToDatabaseTransaction(tx *data.FullTransaction, hyperBlock *data.Hyperblock, timestamp time.Duration, searchOrder int) data.DatabaseTransaction {
+	round := tx.Round
+	if round == 0 {
+		round = hyperBlock.Round
+	}
+
+	dbTx := data.DatabaseTransaction{Hash: tx.Hash}
+	dbTx.MBHash = tx.MiniBlockHash
+	dbTx.Nonce = tx.Nonce
+	dbTx.Round = round
+	dbTx.Value = valueOrZero(tx.Value)
+	dbTx.Receiver = tx.Receiver
+	dbTx.Sender = tx.Sender
+	dbTx.ReceiverShard = tx.DestinationShard
+	dbTx.SenderShard = tx.SourceShard
+	dbTx.GasPrice = tx.GasPrice
+	dbTx.GasLimit = tx.GasLimit
+	dbTx.GasUsed = tx.GasLimit
+	dbTx.Data = tx.Data
+	dbTx.Signature = tx.Signature
+	dbTx.Timestamp = timestamp
+	dbTx.Status = string(tx.Status)
+	dbTx.SearchOrder = uint32(searchOrder)
+	dbTx.SenderUserName = tx.SenderUsername
+	dbTx.ReceiverUserName = tx.ReceiverUsername
+	dbTx.Fee = dbTx.CalculateFee()
+
+	return dbTx
+}
+
+func convertToSCResult(tx *data.FullTransaction) data.DatabaseSCResult {
+	return data.DatabaseSCResult{
+		Hash:           tx.Hash,
+		Nonce:          tx.Nonce,
+		GasLimit:       tx.GasLimit,
+		GasPrice:       tx.GasPrice,
+		Value:          valueOrZero(tx.Value),
+		Sender:         tx.Sender,
+		Receiver:       tx.Receiver,
+		Code:           tx.Code,
+		Data:           tx.Data,
+		PreTxHash:      tx.PreviousTransactionHash,
+		OriginalTxHash: tx.OriginalTransactionHash,
+		CodeMetadata:   tx.CodeMetadata,
+		ReturnMessage:  tx.ReturnMessage,
+	}
+}
+
+func valueOrZero(value string) string {
+	if _, ok := big.NewInt(0).SetString(value, 10); !ok {
+		return "0"
+	}
+
+	return value
+}
+
+// Close stops the indexing and waits for the hyperblock being saved, if any, so that the storer can be closed afterwards
+func (hbi *hyperBlocksIndexer) Close() error {
+	hbi.mutCancel.Lock()
+	if hbi.cancelFunc != nil {
+		hbi.cancelFunc()
+	}
+	hbi.mutCancel.Unlock()
+
+	hbi.wgIndexing.Wait()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hbi *hyperBlocksIndexer) IsInterfaceNil() bool {
+	return hbi == nil
+}
@@ -0,0 +1,323 @@
+package database
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+type hyperBlockProviderStub struct {
+	GetHyperBlockByNonceCalled func(nonce uint64) (*data.HyperblockApiResponse, error)
+}
+
+func (hbps *hyperBlockProviderStub) GetHyperBlockByNonce(nonce uint64) (*data.HyperblockApiResponse, error) {
+	return hbps.GetHyperBlockByNonceCalled(nonce)
+}
+
+type finalNonceProviderStub struct {
+	GetLatestFinalHyperblockNonceCalled func() (uint64, error)
+}
+
+func (fnps *finalNonceProviderStub) GetLatestFinalHyperblockNonce() (uint64, error) {
+	return fnps.GetLatestFinalHyperblockNonceCalled()
+}
+
+type networkConfigProviderStub struct {
+	GetNetworkConfigMetricsCalled func() (*data.GenericAPIResponse, error)
+}
+
+func (ncps *networkConfigProviderStub) GetNetworkConfigMetrics() (*data.GenericAPIResponse, error) {
+	return ncps.GetNetworkConfigMetricsCalled()
+}
+
+type hyperBlocksStorerStub struct {
+	mut         sync.Mutex
+	hyperBlocks []*IndexedHyperBlock
+	missing     []uint64
+}
+
+func (hbss *hyperBlocksStorerStub) SaveHyperBlock(hyperBlock *IndexedHyperBlock) error {
+	hbss.mut.Lock()
+	defer hbss.mut.Unlock()
+
+	hbss.hyperBlocks = append(hbss.hyperBlocks, hyperBlock)
+	for idx, nonce := range hbss.missing {
+		if nonce == hyperBlock.Nonce {
+			hbss.missing = append(hbss.missing[:idx], hbss.missing[idx+1:]...)
+			break
+		}
+	}
+
+	return nil
+}
+
+func (hbss *hyperBlocksStorerStub) GetLastIndexedNonce() (uint64, bool, error) {
+	hbss.mut.Lock()
+	defer hbss.mut.Unlock()
+
+	lastNonce := uint64(0)
+	for _, hyperBlock := range hbss.hyperBlocks {
+		if hyperBlock.Nonce > lastNonce {
+			lastNonce = hyperBlock.Nonce
+		}
+	}
+
+	return lastNonce, len(hbss.hyperBlocks) > 0, nil
+}
+
+func (hbss *hyperBlocksStorerStub) MarkMissingHyperBlock(nonce uint64) error {
+	hbss.mut.Lock()
+	defer hbss.mut.Unlock()
+
+	hbss.missing = append(hbss.missing, nonce)
+	return nil
+}
+
+func (hbss *hyperBlocksStorerStub) GetMissingHyperBlocks() ([]uint64, error) {
+	hbss.mut.Lock()
+	defer hbss.mut.Unlock()
+
+	return append([]uint64{}, hbss.missing...), nil
+}
+
+func (hbss *hyperBlocksStorerStub) getHyperBlocks() []*IndexedHyperBlock {
+	hbss.mut.Lock()
+	defer hbss.mut.Unlock()
+
+	return append([]*IndexedHyperBlock{}, hbss.hyperBlocks...)
+}
+
+func (hbss *hyperBlocksStorerStub) Close() error {
+	return nil
+}
+
+func (hbss *hyperBlocksStorerStub) IsInterfaceNil() bool {
+	return hbss == nil
+}
+
+func createArgsHyperBlocksIndexer(lastNonce uint64) ArgsHyperBlocksIndexer {
+	return ArgsHyperBlocksIndexer{
+		HyperBlockProvider: &hyperBlockProviderStub{
+			GetHyperBlockByNonceCalled: func(nonce uint64) (*data.HyperblockApiResponse, error) {
+				if nonce > lastNonce {
+					return nil, errors.New("hyperblock not found")
+				}
+
+				return data.NewHyperblockApiResponse(data.Hyperblock{
+					Nonce: nonce,
+					Round: nonce + 1,
+					Hash:  "hash",
+					Transactions: []*data.FullTransaction{
+						{Type: string(transaction.TxTypeNormal), Hash: "tx", Value: "10", GasPrice: 10, GasLimit: 50000},
+						{Type: string(transaction.TxTypeUnsigned), Hash: "scr", Value: "1", OriginalTransactionHash: "tx"},
+					},
+				}), nil
+			},
+		},
+		FinalNonceProvider: &finalNonceProviderStub{
+			GetLatestFinalHyperblockNonceCalled: func() (uint64, error) {
+				return lastNonce, nil
+			},
+		},
+		NetworkConfigProvider: &networkConfigProviderStub{
+			GetNetworkConfigMetricsCalled: func() (*data.GenericAPIResponse, error) {
+				return &data.GenericAPIResponse{
+					Data: map[string]interface{}{
+						"config": map[string]interface{}{
+							core.MetricStartTime:     float64(1000),
+							core.MetricRoundDuration: float64(6000),
+						},
+					},
+				}, nil
+			},
+		},
+		Storer:          &hyperBlocksStorerStub{},
+		StartNonce:      5,
+		PollingInterval: time.Second,
+	}
+}
+
+func TestNewHyperBlocksIndexer(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsHyperBlocksIndexer(0)
+	args.HyperBlockProvider = nil
+	_, err := NewHyperBlocksIndexer(args)
+	require.Equal(t, errNilHyperBlockProvider, err)
+
+	args = createArgsHyperBlocksIndexer(0)
+	args.FinalNonceProvider = nil
+	_, err = NewHyperBlocksIndexer(args)
+	require.Equal(t, errNilFinalNonceProvider, err)
+
+	args = createArgsHyperBlocksIndexer(0)
+	args.NetworkConfigProvider = nil
+	_, err = NewHyperBlocksIndexer(args)
+	require.Equal(t, errNilNetworkConfigProvider, err)
+
+	args = createArgsHyperBlocksIndexer(0)
+	args.Storer = nil
+	_, err = NewHyperBlocksIndexer(args)
+	require.Equal(t, errNilHyperBlocksStorer, err)
+
+	args = createArgsHyperBlocksIndexer(0)
+	args.PollingInterval = time.Millisecond
+	_, err = NewHyperBlocksIndexer(args)
+	require.Equal(t, errInvalidIndexerPollingInterval, err)
+
+	indexer, err := NewHyperBlocksIndexer(createArgsHyperBlocksIndexer(0))
+	require.Nil(t, err)
+	require.False(t, indexer.IsInterfaceNil())
+}
+
+func TestHyperBlocksIndexer_IndexNextHyperBlock(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsHyperBlocksIndexer(6)
+	storer := args.Storer.(*hyperBlocksStorerStub)
+	indexer, _ := NewHyperBlocksIndexer(args)
+
+	require.True(t, indexer.indexNextHyperBlock())
+	require.True(t, indexer.indexNextHyperBlock())
+	require.False(t, indexer.indexNextHyperBlock())
+
+	hyperBlocks := storer.getHyperBlocks()
+	require.Len(t, hyperBlocks, 2)
+	require.Equal(t, uint64(5), hyperBlocks[0].Nonce)
+	require.Equal(t, uint64(6), hyperBlocks[1].Nonce)
+
+	hyperBlock := hyperBlocks[0]
+	require.Equal(t, time.Duration(1036), hyperBlock.Timestamp)
+	require.Len(t, hyperBlock.Transactions, 1)
+	require.Equal(t, "tx", hyperBlock.Transactions[0].Hash)
+	require.Equal(t, time.Duration(1036), hyperBlock.Transactions[0].Timestamp)
+	require.Equal(t, "500000", hyperBlock.Transactions[0].Fee)
+	require.Len(t, hyperBlock.SCResults, 1)
+	require.Equal(t, "tx", hyperBlock.SCResults[0].OriginalTxHash)
+}
+
+func TestHyperBlocksIndexer_IndexNextHyperBlockShouldNotIndexAfterTheLatestFinalNonce(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsHyperBlocksIndexer(10)
+	finalNonce := uint64(6)
+	numFinalNonceRequests := 0
+	args.FinalNonceProvider = &finalNonceProviderStub{
+		GetLatestFinalHyperblockNonceCalled: func() (uint64, error) {
+			numFinalNonceRequests++
+			return finalNonce, nil
+		},
+	}
+	storer := args.Storer.(*hyperBlocksStorerStub)
+	indexer, _ := NewHyperBlocksIndexer(args)
+
+	require.True(t, indexer.indexNextHyperBlock())
+	require.True(t, indexer.indexNextHyperBlock())
+	require.False(t, indexer.indexNextHyperBlock())
+	require.Len(t, storer.getHyperBlocks(), 2)
+	require.Equal(t, 2, numFinalNonceRequests)
+
+	finalNonce = 7
+	require.True(t, indexer.indexNextHyperBlock())
+	require.Equal(t, uint64(7), storer.getHyperBlocks()[2].Nonce)
+}
+
+func TestHyperBlocksIndexer_IndexNextHyperBlockShouldNotSkipTheLatestFinalHyperBlock(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsHyperBlocksIndexer(10)
+	args.FinalNonceProvider = &finalNonceProviderStub{
+		GetLatestFinalHyperblockNonceCalled: func() (uint64, error) {
+			return 5, nil
+		},
+	}
+	provider := args.HyperBlockProvider.(*hyperBlockProviderStub)
+	getHyperBlock := provider.GetHyperBlockByNonceCalled
+	provider.GetHyperBlockByNonceCalled = func(nonce uint64) (*data.HyperblockApiResponse, error) {
+		if nonce == 5 {
+			return nil, errors.New("hyperblock not available")
+		}
+
+		return getHyperBlock(nonce)
+	}
+	storer := args.Storer.(*hyperBlocksStorerStub)
+	indexer, _ := NewHyperBlocksIndexer(args)
+
+	require.False(t, indexer.indexNextHyperBlock())
+	missing, _ := storer.GetMissingHyperBlocks()
+	require.Empty(t, missing)
+	require.Empty(t, storer.getHyperBlocks())
+}
+
+func TestHyperBlocksIndexer_IndexNextHyperBlockWithoutNetworkConfigShouldNotIndex(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsHyperBlocksIndexer(6)
+	args.NetworkConfigProvider = &networkConfigProviderStub{
+		GetNetworkConfigMetricsCalled: func() (*data.GenericAPIResponse, error) {
+			return nil, errors.New("no observer online")
+		},
+	}
+	indexer, _ := NewHyperBlocksIndexer(args)
+
+	require.False(t, indexer.indexNextHyperBlock())
+	require.Empty(t, args.Storer.(*hyperBlocksStorerStub).getHyperBlocks())
+}
+
+func TestHyperBlocksIndexer_IndexNextHyperBlockShouldSkipAndRetryMissingHyperBlock(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsHyperBlocksIndexer(7)
+	provider := args.HyperBlockProvider.(*hyperBlockProviderStub)
+	getHyperBlock := provider.GetHyperBlockByNonceCalled
+	isNonce6Available := false
+	provider.GetHyperBlockByNonceCalled = func(nonce uint64) (*data.HyperblockApiResponse, error) {
+		if nonce == 6 && !isNonce6Available {
+			return nil, errors.New("hyperblock not available")
+		}
+
+		return getHyperBlock(nonce)
+	}
+	storer := args.Storer.(*hyperBlocksStorerStub)
+	indexer, _ := NewHyperBlocksIndexer(args)
+
+	require.True(t, indexer.indexNextHyperBlock())
+	require.True(t, indexer.indexNextHyperBlock())
+	require.False(t, indexer.indexNextHyperBlock())
+
+	missing, _ := storer.GetMissingHyperBlocks()
+	require.Equal(t, []uint64{6}, missing)
+	require.Len(t, storer.getHyperBlocks(), 2)
+	require.Equal(t, uint64(7), storer.getHyperBlocks()[1].Nonce)
+
+	indexer.retryMissingHyperBlocks()
+	missing, _ = storer.GetMissingHyperBlocks()
+	require.Equal(t, []uint64{6}, missing)
+
+	isNonce6Available = true
+	indexer.retryMissingHyperBlocks()
+	missing, _ = storer.GetMissingHyperBlocks()
+	require.Empty(t, missing)
+	require.Len(t, storer.getHyperBlocks(), 3)
+	require.Equal(t, uint64(6), storer.getHyperBlocks()[2].Nonce)
+}
+
+func TestHyperBlocksIndexer_StartIndexingAndClose(t *testing.T) {
+	t.Parallel()
+
+	args := createArgsHyperBlocksIndexer(20)
+	storer := args.Storer.(*hyperBlocksStorerStub)
+	indexer, _ := NewHyperBlocksIndexer(args)
+
+	indexer.StartIndexing()
+	require.Eventually(t, func() bool {
+		return len(storer.getHyperBlocks()) == 16
+	}, time.Second, 10*time.Millisecond)
+	require.Nil(t, indexer.Close())
+}
@@ -0,0 +1,42 @@
+package database
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// HyperBlockProvider defines what a component which fetches the hyperblocks from the observers should be able to do
+type HyperBlockProvider interface {
+	GetHyperBlockByNonce(nonce uint64) (*data.HyperblockApiResponse, error)
+}
+
+// FinalNonceProvider defines what a component which fetches the nonce of the latest final hyperblock should be able to do
+type FinalNonceProvider interface {
+	GetLatestFinalHyperblockNonce() (uint64, error)
+}
+
+// NetworkConfigProvider defines what a component which fetches the network config from the observers should be able to do
+type NetworkConfigProvider interface {
+	GetNetworkConfigMetrics() (*data.GenericAPIResponse, error)
+}
+
+// HyperBlocksStorer defines what a storage filled by the hyperblocks indexer should be able to do. Saving a hyperblock
+// also clears its missing mark, if any
+type HyperBlocksStorer interface {
+	SaveHyperBlock(hyperBlock *IndexedHyperBlock) error
+	GetLastIndexedNonce() (uint64, bool, error)
+	MarkMissingHyperBlock(nonce uint64) error
+	GetMissingHyperBlocks() ([]uint64, error)
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// IndexedHyperBlock is a hyperblock converted to the external storage format by the hyperblocks indexer
+type IndexedHyperBlock struct {
+	Nonce        uint64
+	Hash         string
+	Timestamp    time.Duration
+	Transactions []data.DatabaseTransaction
+	SCResults    []data.DatabaseSCResult
+}
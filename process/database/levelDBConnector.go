@@ -0,0 +1,398 @@
+package database
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/iterator"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// defaultMaxScannedHistoryKeys bounds the number of address keys walked by a history query, so that a filter matching
+// none of the transactions of an address does not walk its whole history
+const defaultMaxScannedHistoryKeys = 10000
+
+// levelDBConnector answers the history queries from an on-disk index filled by the hyperblocks indexer. It links
+// each address to the hashes of its transactions and each transaction hash to its hyperblock, so that the proxy can
+// serve the address history without any external service
+type levelDBConnector struct {
+	db                    *leveldb.DB
+	maxScannedHistoryKeys int
+}
+
+// NewLevelDBConnector opens the embedded index stored in the given directory, creating it if missing
+func NewLevelDBConnector(path string) (*levelDBConnector, error) {
+	db, err := leveldb.OpenFile(path, nil)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open the embedded index: %w", err)
+	}
+
+	return &levelDBConnector{
+		db:                    db,
+		maxScannedHistoryKeys: defaultMaxScannedHistoryKeys,
+	}, nil
+}
+
+// GetTransactionsByAddress gets transactions TO or FROM the specified address, paginated, filtered and sorted
+// as requested by the options
+func (ldc *levelDBConnector) GetTransactionsByAddress(address string, options data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error) {
+	return ldc.getAddressHistory(address, options, options.Token != "")
+}
+
+// GetTokenTransfers gets the ESDT and NFT transfers sent or received by the address, of the token from the options
+// or of any token if none is provided. The transfers of all the addresses are not indexed, so the address is required
+func (ldc *levelDBConnector) GetTokenTransfers(address string, options data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error) {
+	if address == "" {
+		return nil, errTokenTransfersByAddressOnly
+	}
+
+	return ldc.getAddressHistory(address, options, true)
+}
+
+// getAddressHistory walks the address keys in the requested order, from the range selected by the time filters and
+// the cursor, and keeps the transactions which match the other options. When the page is not filled after walking the
+// maximum number of keys, the transactions found so far are returned along with a HistoryScanLimitError holding the
+// cursor of the last walked key
+func (ldc *levelDBConnector) getAddressHistory(
+	address string,
+	options data.TransactionsHistoryOptions,
+	onlyTokenTransfers bool,
+) ([]data.DatabaseTransaction, error) {
+	keysRange, err := addressHistoryRange(address, options)
+	if err != nil {
+		return nil, err
+	}
+
+	it := ldc.db.NewIterator(keysRange, nil)
+	defer it.Release()
+
+	isDescending := options.Order != data.SortOrderAscending
+	skip := 0
+	if options.Cursor == "" {
+		skip = options.From
+	}
+
+	size := getHistorySize(options)
+	txs := make([]data.DatabaseTransaction, 0)
+	numScannedKeys := 0
+	lastScannedCursor := ""
+	for ok := seekFirst(it, isDescending); ok && len(txs) < size; ok = seekNext(it, isDescending) {
+		if numScannedKeys == ldc.maxScannedHistoryKeys {
+			return txs, &data.HistoryScanLimitError{Cursor: lastScannedCursor}
+		}
+		numScannedKeys++
+		lastScannedCursor = addressKeyCursor(it.Key())
+
+		tx, errGet := ldc.getTransaction(string(it.Value()))
+		if errGet != nil {
+			return nil, errGet
+		}
+		if !matchesHistoryOptions(tx, address, options, onlyTokenTransfers) {
+			continue
+		}
+		if skip > 0 {
+			skip--
+			continue
+		}
+
+		txs = append(txs, *tx)
+	}
+
+	return txs, it.Error()
+}
+
+// addressKeyCursor returns the history cursor of an address key, which ends with the timestamp and the search order
+func addressKeyCursor(key []byte) string {
+	suffix := key[len(key)-12:]
+
+	return fmt.Sprintf("%d-%d", binary.BigEndian.Uint64(suffix[:8]), binary.BigEndian.Uint32(suffix[8:]))
+}
+
+func addressHistoryRange(address string, options data.TransactionsHistoryOptions) (*util.Range, error) {
+	keysRange := util.BytesPrefix(addressKeysPrefix(address))
+	if options.StartTime > 0 {
+		keysRange.Start = addressKey(address, options.StartTime, 0)
+	}
+	if options.EndTime > 0 && options.EndTime < math.MaxUint64 {
+		keysRange.Limit = addressKey(address, options.EndTime+1, 0)
+	}
+	if options.Cursor == "" {
+		return keysRange, nil
+	}
+
+	searchAfter, err := parseHistoryCursor(options.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	cursorKey := addressKey(address, searchAfter[0].(uint64), uint32(searchAfter[1].(uint64)))
+	if options.Order != data.SortOrderAscending {
+		if bytes.Compare(cursorKey, keysRange.Limit) < 0 {
+			keysRange.Limit = cursorKey
+		}
+		return keysRange, nil
+	}
+
+	// all the address keys have the same length, so this is the smallest key following the cursor
+	afterCursorKey := append(cursorKey, 0)
+	if bytes.Compare(afterCursorKey, keysRange.Start) > 0 {
+		keysRange.Start = afterCursorKey
+	}
+
+	return keysRange, nil
+}
+
+func seekFirst(it iterator.Iterator, isDescending bool) bool {
+	if isDescending {
+		return it.Last()
+	}
+
+	return it.First()
+}
+
+func seekNext(it iterator.Iterator, isDescending bool) bool {
+	if isDescending {
+		return it.Prev()
+	}
+
+	return it.Next()
+}
+
+// matchesHistoryOptions applies the filters of the options which are not covered by the keys range, as
+// addAddressCondition and historySQLQuery do
+func matchesHistoryOptions(tx *data.DatabaseTransaction, address string, options data.TransactionsHistoryOptions, onlyTokenTransfers bool) bool {
+	isSender := tx.Sender == address
+	isReceiver := tx.Receiver == address
+	counterparty := options.Counterparty
+
+	switch {
+	case options.Direction == data.TransactionsDirectionOut && !(isSender && (counterparty == "" || tx.Receiver == counterparty)):
+		return false
+	case options.Direction == data.TransactionsDirectionIn && !(isReceiver && (counterparty == "" || tx.Sender == counterparty)):
+		return false
+	case counterparty != "" && !(isSender && tx.Receiver == counterparty) && !(isReceiver && tx.Sender == counterparty):
+		return false
+	case options.Status != "" && tx.Status != options.Status:
+		return false
+	case onlyTokenTransfers && !isTokenTransfer(tx.Data, options.Token):
+		return false
+	default:
+		return true
+	}
+}
+
+// isTokenTransfer checks the data field against the prefixes matched by addTokenTransfersCondition
+func isTokenTransfer(dataField []byte, token string) bool {
+	hexData := getIndexedDataPrefix(dataField)
+	for _, function := range []string{core.BuiltInFunctionESDTTransfer, builtInFunctionESDTNFTTransfer} {
+		prefix := hexDataPrefix(function, token)
+		if len(hexData) >= len(prefix) && hexData[:len(prefix)] == prefix {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (ldc *levelDBConnector) getTransaction(txHash string) (*data.DatabaseTransaction, error) {
+	value, err := ldc.db.Get(transactionKey(txHash), nil)
+	if err == leveldb.ErrNotFound {
+		return nil, errCannotFindTxInDb
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var record levelDBTransaction
+	err = json.Unmarshal(value, &record)
+	if err != nil {
+		return nil, err
+	}
+
+	return &record.Transaction, nil
+}
+
+// GetSCResultsByTxHash gets the smart contract results generated by the transaction with the given hash
+func (ldc *levelDBConnector) GetSCResultsByTxHash(txHash string) ([]data.DatabaseSCResult, error) {
+	found, err := ldc.db.Has(transactionKey(txHash), nil)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errCannotFindTxInDb
+	}
+
+	it := ldc.db.NewIterator(util.BytesPrefix(scResultKeysPrefix(txHash)), nil)
+	defer it.Release()
+
+	scResults := make([]data.DatabaseSCResult, 0)
+	for it.Next() {
+		var scResult data.DatabaseSCResult
+		err = json.Unmarshal(it.Value(), &scResult)
+		if err != nil {
+			return nil, err
+		}
+		scResults = append(scResults, scResult)
+	}
+
+	return scResults, it.Error()
+}
+
+// GetEventsByAddress returns an error, since the hyperblocks do not hold the logs of the transactions
+func (ldc *levelDBConnector) GetEventsByAddress(_ string, _ string, _ data.TransactionsHistoryOptions) ([]data.DatabaseEvent, error) {
+	return nil, errEventsNotIndexed
+}
+
+// GetAtlasBlockByShardIDAndNonce gets the transactions of the hyperblock with the specified nonce
+func (ldc *levelDBConnector) GetAtlasBlockByShardIDAndNonce(shardID uint32, nonce uint64) (data.AtlasBlock, error) {
+	if shardID != core.MetachainShardId {
+		return data.AtlasBlock{}, errOnlyHyperBlocksIndexed
+	}
+
+	value, err := ldc.db.Get(hyperBlockKey(nonce), nil)
+	if err == leveldb.ErrNotFound {
+		return data.AtlasBlock{}, errCannotFindBlockInDb
+	}
+	if err != nil {
+		return data.AtlasBlock{}, err
+	}
+
+	var hyperBlock levelDBHyperBlock
+	err = json.Unmarshal(value, &hyperBlock)
+	if err != nil {
+		return data.AtlasBlock{}, err
+	}
+
+	txs := make([]data.DatabaseTransaction, 0, len(hyperBlock.TxHashes))
+	for _, txHash := range hyperBlock.TxHashes {
+		tx, errGet := ldc.getTransaction(txHash)
+		if errGet != nil {
+			return data.AtlasBlock{}, errGet
+		}
+		txs = append(txs, *tx)
+	}
+
+	return data.AtlasBlock{
+		Nonce:        nonce,
+		Hash:         hyperBlock.Hash,
+		Transactions: txs,
+	}, nil
+}
+
+// SaveHyperBlock saves the transactions and the smart contract results of a hyperblock in a single batch, so that a
+// hyperblock is either fully indexed or not at all. The transactions already indexed from other hyperblocks are skipped
+func (ldc *levelDBConnector) SaveHyperBlock(hyperBlock *IndexedHyperBlock) error {
+	found, err := ldc.db.Has(hyperBlockKey(hyperBlock.Nonce), nil)
+	if err != nil {
+		return err
+	}
+	if found {
+		return errHyperBlockAlreadyIndexed
+	}
+
+	batch := new(leveldb.Batch)
+	indexedHyperBlock := levelDBHyperBlock{
+		Hash:      hyperBlock.Hash,
+		Timestamp: uint64(hyperBlock.Timestamp),
+		TxHashes:  make([]string, 0, len(hyperBlock.Transactions)),
+	}
+	savedTxs := make(map[string]struct{})
+	for _, tx := range hyperBlock.Transactions {
+		_, isSaved := savedTxs[tx.Hash]
+		isIndexed, errHas := ldc.db.Has(transactionKey(tx.Hash), nil)
+		if errHas != nil {
+			return errHas
+		}
+		if isSaved || isIndexed {
+			continue
+		}
+
+		err = putTransaction(batch, hyperBlock.Nonce, tx)
+		if err != nil {
+			return err
+		}
+		savedTxs[tx.Hash] = struct{}{}
+		indexedHyperBlock.TxHashes = append(indexedHyperBlock.TxHashes, tx.Hash)
+	}
+
+	for _, scResult := range hyperBlock.SCResults {
+		value, errMarshal := json.Marshal(scResult)
+		if errMarshal != nil {
+			return errMarshal
+		}
+		batch.Put(scResultKey(scResult.OriginalTxHash, hyperBlock.Nonce, scResult.Hash), value)
+	}
+
+	value, err := json.Marshal(indexedHyperBlock)
+	if err != nil {
+		return err
+	}
+	batch.Delete(missingHyperBlockKey(hyperBlock.Nonce))
+	batch.Put(hyperBlockKey(hyperBlock.Nonce), value)
+
+	return ldc.db.Write(batch, nil)
+}
+
+func putTransaction(batch *leveldb.Batch, hyperBlockNonce uint64, tx data.DatabaseTransaction) error {
+	value, err := json.Marshal(levelDBTransaction{
+		HyperBlockNonce: hyperBlockNonce,
+		Transaction:     tx,
+	})
+	if err != nil {
+		return err
+	}
+
+	batch.Put(transactionKey(tx.Hash), value)
+	batch.Put(addressKey(tx.Sender, uint64(tx.Timestamp), tx.SearchOrder), []byte(tx.Hash))
+	if tx.Receiver != tx.Sender {
+		batch.Put(addressKey(tx.Receiver, uint64(tx.Timestamp), tx.SearchOrder), []byte(tx.Hash))
+	}
+
+	return nil
+}
+
+// GetLastIndexedNonce returns the nonce of the last saved hyperblock, if any
+func (ldc *levelDBConnector) GetLastIndexedNonce() (uint64, bool, error) {
+	it := ldc.db.NewIterator(util.BytesPrefix(hyperBlockKeyPrefix), nil)
+	defer it.Release()
+
+	if !it.Last() {
+		return 0, false, it.Error()
+	}
+
+	return binary.BigEndian.Uint64(it.Key()[len(hyperBlockKeyPrefix):]), true, nil
+}
+
+// MarkMissingHyperBlock records a hyperblock skipped by the indexer
+func (ldc *levelDBConnector) MarkMissingHyperBlock(nonce uint64) error {
+	return ldc.db.Put(missingHyperBlockKey(nonce), []byte{}, nil)
+}
+
+// GetMissingHyperBlocks returns the nonces of the skipped hyperblocks which were not saved since, in ascending order
+func (ldc *levelDBConnector) GetMissingHyperBlocks() ([]uint64, error) {
+	it := ldc.db.NewIterator(util.BytesPrefix(missingHyperBlockKeyPrefix), nil)
+	defer it.Release()
+
+	nonces := make([]uint64, 0)
+	for it.Next() {
+		nonces = append(nonces, binary.BigEndian.Uint64(it.Key()[len(missingHyperBlockKeyPrefix):]))
+	}
+
+	return nonces, it.Error()
+}
+
+// Close closes the embedded index
+func (ldc *levelDBConnector) Close() error {
+	return ldc.db.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ldc *levelDBConnector) IsInterfaceNil() bool {
+	return ldc == nil
+}
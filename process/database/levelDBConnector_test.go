@@ -0,0 +1,234 @@
+package database
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+func createTempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "embedded-index")
+	require.Nil(t, err)
+
+	return dir
+}
+
+func createLevelDBConnectorWithHyperBlocks(t *testing.T, dir string) *levelDBConnector {
+	connector, err := NewLevelDBConnector(dir)
+	require.Nil(t, err)
+
+	for _, hyperBlock := range createTestHyperBlocks() {
+		require.Nil(t, connector.SaveHyperBlock(hyperBlock))
+	}
+
+	return connector
+}
+
+func TestLevelDBConnector_GetTransactionsByAddress(t *testing.T) {
+	t.Parallel()
+
+	dir := createTempDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	connector := createLevelDBConnectorWithHyperBlocks(t, dir)
+	defer func() {
+		_ = connector.Close()
+	}()
+
+	txs, err := connector.GetTransactionsByAddress("alice", data.TransactionsHistoryOptions{})
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx-3", "tx-2", "tx-1"}, getHashes(txs))
+	require.Equal(t, "carol", txs[0].Receiver)
+
+	txs, err = connector.GetTransactionsByAddress("alice", data.TransactionsHistoryOptions{Direction: data.TransactionsDirectionOut, Order: data.SortOrderAscending})
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx-1", "tx-3"}, getHashes(txs))
+
+	txs, err = connector.GetTransactionsByAddress("alice", data.TransactionsHistoryOptions{Counterparty: "bob"})
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx-2", "tx-1"}, getHashes(txs))
+
+	txs, err = connector.GetTransactionsByAddress("alice", data.TransactionsHistoryOptions{Cursor: txs[0].HistoryCursor()})
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx-1"}, getHashes(txs))
+
+	txs, err = connector.GetTransactionsByAddress("alice", data.TransactionsHistoryOptions{Cursor: txs[0].HistoryCursor(), Order: data.SortOrderAscending})
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx-2", "tx-3"}, getHashes(txs))
+
+	txs, err = connector.GetTransactionsByAddress("alice", data.TransactionsHistoryOptions{From: 1, Size: 1})
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx-2"}, getHashes(txs))
+
+	txs, err = connector.GetTransactionsByAddress("alice", data.TransactionsHistoryOptions{StartTime: 101, EndTime: 200})
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx-3"}, getHashes(txs))
+
+	txs, err = connector.GetTransactionsByAddress("alice", data.TransactionsHistoryOptions{EndTime: 100})
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx-2", "tx-1"}, getHashes(txs))
+
+	txs, err = connector.GetTransactionsByAddress("alice", data.TransactionsHistoryOptions{Token: "TKN-123456"})
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx-2"}, getHashes(txs))
+
+	txs, err = connector.GetTransactionsByAddress("dave", data.TransactionsHistoryOptions{})
+	require.Nil(t, err)
+	require.Empty(t, txs)
+
+	_, err = connector.GetTransactionsByAddress("alice", data.TransactionsHistoryOptions{Cursor: "invalid"})
+	require.Equal(t, errInvalidHistoryCursor, err)
+}
+
+func TestLevelDBConnector_GetTransactionsByAddressShouldStopAtTheScanLimit(t *testing.T) {
+	t.Parallel()
+
+	dir := createTempDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	connector := createLevelDBConnectorWithHyperBlocks(t, dir)
+	defer func() {
+		_ = connector.Close()
+	}()
+	connector.maxScannedHistoryKeys = 1
+
+	options := data.TransactionsHistoryOptions{Counterparty: "carol"}
+	txs, err := connector.GetTransactionsByAddress("alice", options)
+	require.Equal(t, &data.HistoryScanLimitError{Cursor: "106-0"}, err)
+	require.Equal(t, []string{"tx-3"}, getHashes(txs))
+
+	options.Cursor = "106-0"
+	txs, err = connector.GetTransactionsByAddress("alice", options)
+	require.Equal(t, &data.HistoryScanLimitError{Cursor: "100-1"}, err)
+	require.Empty(t, txs)
+
+	options.Cursor = "100-1"
+	txs, err = connector.GetTransactionsByAddress("alice", options)
+	require.Nil(t, err)
+	require.Empty(t, txs)
+}
+
+func TestLevelDBConnector_GetTokenTransfers(t *testing.T) {
+	t.Parallel()
+
+	dir := createTempDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	connector := createLevelDBConnectorWithHyperBlocks(t, dir)
+	defer func() {
+		_ = connector.Close()
+	}()
+
+	txs, err := connector.GetTokenTransfers("alice", data.TransactionsHistoryOptions{})
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx-3", "tx-2"}, getHashes(txs))
+
+	txs, err = connector.GetTokenTransfers("alice", data.TransactionsHistoryOptions{Token: "NFT-abcdef"})
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx-3"}, getHashes(txs))
+
+	txs, err = connector.GetTokenTransfers("carol", data.TransactionsHistoryOptions{Token: "TKN-123456"})
+	require.Nil(t, err)
+	require.Empty(t, txs)
+
+	_, err = connector.GetTokenTransfers("", data.TransactionsHistoryOptions{})
+	require.Equal(t, errTokenTransfersByAddressOnly, err)
+}
+
+func TestLevelDBConnector_GetSCResultsByTxHash(t *testing.T) {
+	t.Parallel()
+
+	dir := createTempDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	connector := createLevelDBConnectorWithHyperBlocks(t, dir)
+	defer func() {
+		_ = connector.Close()
+	}()
+
+	scResults, err := connector.GetSCResultsByTxHash("tx-1")
+	require.Nil(t, err)
+	require.Len(t, scResults, 2)
+	require.Equal(t, "scr-1", scResults[0].Hash)
+	require.Equal(t, "6", scResults[1].Value)
+
+	scResults, err = connector.GetSCResultsByTxHash("tx-2")
+	require.Nil(t, err)
+	require.Empty(t, scResults)
+
+	_, err = connector.GetSCResultsByTxHash("missing")
+	require.Equal(t, errCannotFindTxInDb, err)
+}
+
+func TestLevelDBConnector_GetAtlasBlockByShardIDAndNonce(t *testing.T) {
+	t.Parallel()
+
+	dir := createTempDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+	connector := createLevelDBConnectorWithHyperBlocks(t, dir)
+	defer func() {
+		_ = connector.Close()
+	}()
+
+	block, err := connector.GetAtlasBlockByShardIDAndNonce(core.MetachainShardId, 10)
+	require.Nil(t, err)
+	require.Equal(t, "hyperblock-10", block.Hash)
+	require.Equal(t, []string{"tx-1", "tx-2"}, getHashes(block.Transactions))
+
+	_, err = connector.GetAtlasBlockByShardIDAndNonce(core.MetachainShardId, 12)
+	require.Equal(t, errCannotFindBlockInDb, err)
+
+	_, err = connector.GetAtlasBlockByShardIDAndNonce(0, 10)
+	require.Equal(t, errOnlyHyperBlocksIndexed, err)
+}
+
+func TestLevelDBConnector_IndexShouldSurviveRestarts(t *testing.T) {
+	t.Parallel()
+
+	dir := createTempDir(t)
+	defer func() {
+		_ = os.RemoveAll(dir)
+	}()
+
+	connector, err := NewLevelDBConnector(dir)
+	require.Nil(t, err)
+	_, found, err := connector.GetLastIndexedNonce()
+	require.Nil(t, err)
+	require.False(t, found)
+
+	require.Nil(t, connector.SaveHyperBlock(&IndexedHyperBlock{Nonce: 7, Hash: "hash"}))
+	require.Nil(t, connector.MarkMissingHyperBlock(8))
+	require.Nil(t, connector.MarkMissingHyperBlock(9))
+	require.Nil(t, connector.SaveHyperBlock(&IndexedHyperBlock{Nonce: 10, Hash: "hash"}))
+	require.Equal(t, errHyperBlockAlreadyIndexed, connector.SaveHyperBlock(&IndexedHyperBlock{Nonce: 7, Hash: "hash"}))
+	require.Nil(t, connector.Close())
+
+	connector, err = NewLevelDBConnector(dir)
+	require.Nil(t, err)
+	defer func() {
+		_ = connector.Close()
+	}()
+
+	nonce, found, err := connector.GetLastIndexedNonce()
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Equal(t, uint64(10), nonce)
+
+	require.Nil(t, connector.SaveHyperBlock(&IndexedHyperBlock{Nonce: 8, Hash: "hash"}))
+	nonces, err := connector.GetMissingHyperBlocks()
+	require.Nil(t, err)
+	require.Equal(t, []uint64{9}, nonces)
+
+	nonce, _, _ = connector.GetLastIndexedNonce()
+	require.Equal(t, uint64(10), nonce)
+}
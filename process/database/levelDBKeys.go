@@ -0,0 +1,83 @@
+package database
+
+import (
+	"encoding/binary"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// the keys of the embedded index are grouped by prefixes. The numbers inside the keys are big endian encoded, so that
+// the keys of a group are sorted by them
+var (
+	hyperBlockKeyPrefix        = []byte("hb/")
+	missingHyperBlockKeyPrefix = []byte("missing/")
+	transactionKeyPrefix       = []byte("tx/")
+	addressKeyPrefix           = []byte("address/")
+	scResultKeyPrefix          = []byte("scr/")
+)
+
+// levelDBHyperBlock is the value of a hyperblock key: the hashes of its transactions, in the hyperblock order
+type levelDBHyperBlock struct {
+	Hash      string   `json:"hash"`
+	Timestamp uint64   `json:"timestamp"`
+	TxHashes  []string `json:"txHashes"`
+}
+
+// levelDBTransaction is the value of a transaction key
+type levelDBTransaction struct {
+	HyperBlockNonce uint64                   `json:"hyperBlockNonce"`
+	Transaction     data.DatabaseTransaction `json:"transaction"`
+}
+
+func concatKey(parts ...[]byte) []byte {
+	key := make([]byte, 0)
+	for _, part := range parts {
+		key = append(key, part...)
+	}
+
+	return key
+}
+
+func uint64ToBytes(value uint64) []byte {
+	buff := make([]byte, 8)
+	binary.BigEndian.PutUint64(buff, value)
+
+	return buff
+}
+
+func uint32ToBytes(value uint32) []byte {
+	buff := make([]byte, 4)
+	binary.BigEndian.PutUint32(buff, value)
+
+	return buff
+}
+
+func hyperBlockKey(nonce uint64) []byte {
+	return concatKey(hyperBlockKeyPrefix, uint64ToBytes(nonce))
+}
+
+func missingHyperBlockKey(nonce uint64) []byte {
+	return concatKey(missingHyperBlockKeyPrefix, uint64ToBytes(nonce))
+}
+
+func transactionKey(txHash string) []byte {
+	return concatKey(transactionKeyPrefix, []byte(txHash))
+}
+
+// addressKeysPrefix is the prefix of the keys which link an address to its transactions. Those keys end with the
+// timestamp and the search order of the transactions, so they are sorted as the history of the address
+func addressKeysPrefix(address string) []byte {
+	return concatKey(addressKeyPrefix, []byte(address), []byte("/"))
+}
+
+func addressKey(address string, timestamp uint64, searchOrder uint32) []byte {
+	return concatKey(addressKeysPrefix(address), uint64ToBytes(timestamp), uint32ToBytes(searchOrder))
+}
+
+func scResultKeysPrefix(originalTxHash string) []byte {
+	return concatKey(scResultKeyPrefix, []byte(originalTxHash), []byte("/"))
+}
+
+func scResultKey(originalTxHash string, hyperBlockNonce uint64, hash string) []byte {
+	return concatKey(scResultKeysPrefix(originalTxHash), uint64ToBytes(hyperBlockNonce), []byte(hash))
+}
@@ -2,12 +2,26 @@ package database
 
 import (
 	"bytes"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
 )
 
 type object = map[string]interface{}
 
+const builtInFunctionESDTNFTTransfer = "ESDTNFTTransfer"
+
+// transactionDataKeywordField is the exact value of the transactions data field. The indexer templates do not map the
+// data field, so elasticsearch maps it dynamically as an analyzed text field, holding lowercase tokens, with a keyword
+// sub-field which keeps the base64 encoded value as it is, up to 256 characters
+const transactionDataKeywordField = "data.keyword"
+
 func encodeQuery(query object) (bytes.Buffer, error) {
 	var buff bytes.Buffer
 	if err := json.NewEncoder(&buff).Encode(query); err != nil {
@@ -48,12 +62,244 @@ func blockByHashQuery(hash string) object {
 	}
 }
 
-func txsByMiniblockHashQuery(hash string) object {
+func txsByMiniblockHashesQuery(hashes []string) object {
+	return object{
+		"query": object{
+			"terms": object{
+				"miniBlockHash": hashes,
+			},
+		},
+	}
+}
+
+func transactionsByAddressQuery(address string, options data.TransactionsHistoryOptions) (object, error) {
+	filters := []interface{}{
+		addressFilter(address, options.Direction, options.Counterparty),
+	}
+	if options.Token != "" {
+		filters = append(filters, tokenTransfersFilter(options.Token))
+	}
+
+	return historyQuery(append(filters, historyFilters(options)...), options)
+}
+
+// tokenTransfersQuery selects the ESDT and NFT transfers of a token, or of any token if none is provided, optionally
+// restricted to the ones sent or received by an address
+func tokenTransfersQuery(address string, options data.TransactionsHistoryOptions) (object, error) {
+	filters := []interface{}{
+		tokenTransfersFilter(options.Token),
+	}
+	if address != "" {
+		filters = append(filters, addressFilter(address, options.Direction, options.Counterparty))
+	}
+
+	return historyQuery(append(filters, historyFilters(options)...), options)
+}
+
+// eventsQuery selects the logs holding events emitted by an address, optionally with a given identifier. The logs do
+// not hold a status nor a search order, so the status filter is ignored and the search order sort falls back to an
+// unmapped long
+func eventsQuery(address string, identifier string, options data.TransactionsHistoryOptions) (object, error) {
+	filters := []interface{}{
+		matchQuery("events.address", address),
+	}
+	if identifier != "" {
+		filters = append(filters, matchQuery("events.identifier", identifier))
+	}
+	if options.StartTime > 0 || options.EndTime > 0 {
+		filters = append(filters, timestampRangeFilter(options.StartTime, options.EndTime))
+	}
+
+	query, err := historyQuery(filters, options)
+	if err != nil {
+		return nil, err
+	}
+
+	sort := query["sort"].([]interface{})
+	searchOrderSort := sort[1].(object)["searchOrder"].(object)
+	searchOrderSort["unmapped_type"] = "long"
+
+	return query, nil
+}
+
+func historyFilters(options data.TransactionsHistoryOptions) []interface{} {
+	filters := make([]interface{}, 0)
+	if options.Status != "" {
+		filters = append(filters, matchQuery("status", options.Status))
+	}
+	if options.StartTime > 0 || options.EndTime > 0 {
+		filters = append(filters, timestampRangeFilter(options.StartTime, options.EndTime))
+	}
+
+	return filters
+}
+
+// historyQuery sorts and pages the transactions selected by the filters
+func historyQuery(filters []interface{}, options data.TransactionsHistoryOptions) (object, error) {
+	order := options.Order
+	if order == "" {
+		order = data.SortOrderDescending
+	}
+
+	query := object{
+		"query": object{
+			"bool": object{
+				"filter": filters,
+			},
+		},
+		"sort": []interface{}{
+			object{"timestamp": object{"order": order}},
+			object{"searchOrder": object{"order": order}},
+		},
+	}
+
+	if options.Cursor != "" {
+		searchAfter, err := parseHistoryCursor(options.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		query["search_after"] = searchAfter
+	} else if options.From > 0 {
+		query["from"] = options.From
+	}
+
+	return query, nil
+}
+
+func scResultsByTxHashQuery(txHash string) object {
 	return object{
 		"query": object{
 			"match": object{
-				"miniBlockHash": hash,
+				"_id": txHash,
+			},
+		},
+	}
+}
+
+// addressFilter selects the transactions sent or received by the address, optionally restricted to the ones
+// exchanged with a counterparty
+func addressFilter(address string, direction string, counterparty string) object {
+	switch direction {
+	case data.TransactionsDirectionOut:
+		return transferFilter(address, counterparty)
+	case data.TransactionsDirectionIn:
+		return transferFilter(counterparty, address)
+	default:
+		return object{
+			"bool": object{
+				"should": []interface{}{
+					transferFilter(address, counterparty),
+					transferFilter(counterparty, address),
+				},
+				"minimum_should_match": 1,
 			},
+		}
+	}
+}
+
+func transferFilter(sender string, receiver string) object {
+	must := make([]interface{}, 0, 2)
+	if sender != "" {
+		must = append(must, matchQuery("sender", sender))
+	}
+	if receiver != "" {
+		must = append(must, matchQuery("receiver", receiver))
+	}
+
+	return object{
+		"bool": object{
+			"must": must,
+		},
+	}
+}
+
+func timestampRangeFilter(startTime uint64, endTime uint64) object {
+	timestampRange := object{}
+	if startTime > 0 {
+		timestampRange["gte"] = startTime
+	}
+	if endTime > 0 {
+		timestampRange["lte"] = endTime
+	}
+
+	return object{
+		"range": object{
+			"timestamp": timestampRange,
+		},
+	}
+}
+
+func matchQuery(field string, value string) object {
+	return object{
+		"match": object{
+			field: value,
+		},
+	}
+}
+
+// tokenTransfersFilter selects the transactions calling the ESDT or NFT transfer built-in functions for a token, or
+// for any token if none is provided. The receiver of an NFT transfer is held by its data field, so the NFT transfers
+// are only matched on the sender side of the address filter. The transactions having a data field longer than the
+// keyword sub-field limit are not matched
+func tokenTransfersFilter(token string) object {
+	prefixes := make([]interface{}, 0)
+	for _, function := range []string{core.BuiltInFunctionESDTTransfer, builtInFunctionESDTNFTTransfer} {
+		for _, prefix := range transferDataPrefixes(function, token) {
+			prefixes = append(prefixes, object{"prefix": object{transactionDataKeywordField: prefix}})
+		}
+	}
+
+	return object{
+		"bool": object{
+			"should":               prefixes,
+			"minimum_should_match": 1,
 		},
 	}
 }
+
+// transferDataPrefixes returns the possible prefixes of the base64 encoded data field of the calls to a transfer
+// function. When the length of the data prefix is not a multiple of 3, the last encoded character also depends on
+// the following, unknown, byte, so a prefix is returned for each of its possible values
+func transferDataPrefixes(function string, token string) []string {
+	dataPrefix := function + "@"
+	if token != "" {
+		dataPrefix = fmt.Sprintf("%s@%s@", function, hex.EncodeToString([]byte(token)))
+	}
+
+	fullGroupsLength := len(dataPrefix) / 3 * 3
+	encodedFullGroups := base64.StdEncoding.EncodeToString([]byte(dataPrefix[:fullGroupsLength]))
+	remainingBytes := []byte(dataPrefix[fullGroupsLength:])
+	if len(remainingBytes) == 0 {
+		return []string{encodedFullGroups}
+	}
+
+	unknownBits := 6 - len(remainingBytes)*8%6
+	encodedLength := len(remainingBytes)*8/6 + 1
+	prefixes := make([]string, 0, 1<<unknownBits)
+	for bits := 0; bits < 1<<unknownBits; bits++ {
+		group := append(append([]byte{}, remainingBytes...), byte(bits<<(8-unknownBits)))
+		encodedGroup := base64.StdEncoding.EncodeToString(group)
+		prefixes = append(prefixes, encodedFullGroups+encodedGroup[:encodedLength])
+	}
+
+	return prefixes
+}
+
+// parseHistoryCursor returns the sort values of a cursor formatted as <timestamp>-<search order>
+func parseHistoryCursor(cursor string) ([]interface{}, error) {
+	parts := strings.Split(cursor, "-")
+	if len(parts) != 2 {
+		return nil, errInvalidHistoryCursor
+	}
+
+	timestamp, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return nil, errInvalidHistoryCursor
+	}
+	searchOrder, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return nil, errInvalidHistoryCursor
+	}
+
+	return []interface{}{timestamp, searchOrder}, nil
+}
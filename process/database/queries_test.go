@@ -0,0 +1,288 @@
+package database
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/ElrondNetwork/elastic-indexer-go"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testAddress      = "erd1ewshdn9yv0wx38xgs5cdhvcq4dz0n7tdlgh8wfj9nxugwmyunnyqpkpzal"
+	testCounterparty = "erd1ycega644rvjtgtyd8hfzt6hl5ymaa8ml2nhhs5cv045cz5vxm00q022myr"
+)
+
+func TestBlockByNonceAndShardIDQuery(t *testing.T) {
+	t.Parallel()
+
+	query := blockByNonceAndShardIDQuery(7720, 2)
+	require.Equal(t, []interface{}{
+		object{"match": object{"nonce": "7720"}},
+		object{"match": object{"shardId": "2"}},
+	}, query["query"].(object)["bool"].(object)["must"])
+}
+
+func TestBlockByHashQuery(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, object{"query": object{"match": object{"_id": "hash"}}}, blockByHashQuery("hash"))
+}
+
+func TestTxsByMiniblockHashesQuery(t *testing.T) {
+	t.Parallel()
+
+	hashes := []string{"hash-1", "hash-2"}
+	require.Equal(t, object{"query": object{"terms": object{"miniBlockHash": hashes}}}, txsByMiniblockHashesQuery(hashes))
+}
+
+func TestTransactionsByAddressQuery_DefaultOptions(t *testing.T) {
+	t.Parallel()
+
+	query, err := transactionsByAddressQuery(testAddress, data.TransactionsHistoryOptions{})
+	require.Nil(t, err)
+
+	expectedQuery := object{
+		"query": object{
+			"bool": object{
+				"filter": []interface{}{
+					object{
+						"bool": object{
+							"should": []interface{}{
+								object{"bool": object{"must": []interface{}{matchQuery("sender", testAddress)}}},
+								object{"bool": object{"must": []interface{}{matchQuery("receiver", testAddress)}}},
+							},
+							"minimum_should_match": 1,
+						},
+					},
+				},
+			},
+		},
+		"sort": []interface{}{
+			object{"timestamp": object{"order": data.SortOrderDescending}},
+			object{"searchOrder": object{"order": data.SortOrderDescending}},
+		},
+	}
+	require.Equal(t, expectedQuery, query)
+}
+
+func TestTransactionsByAddressQuery_Direction(t *testing.T) {
+	t.Parallel()
+
+	query, _ := transactionsByAddressQuery(testAddress, data.TransactionsHistoryOptions{Direction: data.TransactionsDirectionOut})
+	require.Equal(t, object{"bool": object{"must": []interface{}{matchQuery("sender", testAddress)}}}, getFilters(query)[0])
+
+	query, _ = transactionsByAddressQuery(testAddress, data.TransactionsHistoryOptions{Direction: data.TransactionsDirectionIn})
+	require.Equal(t, object{"bool": object{"must": []interface{}{matchQuery("receiver", testAddress)}}}, getFilters(query)[0])
+}
+
+func TestTransactionsByAddressQuery_Counterparty(t *testing.T) {
+	t.Parallel()
+
+	query, _ := transactionsByAddressQuery(testAddress, data.TransactionsHistoryOptions{
+		Direction:    data.TransactionsDirectionIn,
+		Counterparty: testCounterparty,
+	})
+	require.Equal(t, object{
+		"bool": object{
+			"must": []interface{}{
+				matchQuery("sender", testCounterparty),
+				matchQuery("receiver", testAddress),
+			},
+		},
+	}, getFilters(query)[0])
+
+	query, _ = transactionsByAddressQuery(testAddress, data.TransactionsHistoryOptions{Counterparty: testCounterparty})
+	require.Equal(t, []interface{}{
+		object{"bool": object{"must": []interface{}{matchQuery("sender", testAddress), matchQuery("receiver", testCounterparty)}}},
+		object{"bool": object{"must": []interface{}{matchQuery("sender", testCounterparty), matchQuery("receiver", testAddress)}}},
+	}, getFilters(query)[0].(object)["bool"].(object)["should"])
+}
+
+func TestTransactionsByAddressQuery_Filters(t *testing.T) {
+	t.Parallel()
+
+	query, err := transactionsByAddressQuery(testAddress, data.TransactionsHistoryOptions{
+		Status:    "success",
+		StartTime: 100,
+		EndTime:   200,
+		Token:     "TKN-123456",
+		Order:     data.SortOrderAscending,
+	})
+	require.Nil(t, err)
+
+	filters := getFilters(query)
+	require.Len(t, filters, 4)
+	require.Equal(t, tokenTransfersFilter("TKN-123456"), filters[1])
+	require.Equal(t, matchQuery("status", "success"), filters[2])
+	require.Equal(t, object{"range": object{"timestamp": object{"gte": uint64(100), "lte": uint64(200)}}}, filters[3])
+	require.Equal(t, object{"timestamp": object{"order": data.SortOrderAscending}}, query["sort"].([]interface{})[0])
+
+	query, _ = transactionsByAddressQuery(testAddress, data.TransactionsHistoryOptions{EndTime: 200})
+	require.Equal(t, object{"range": object{"timestamp": object{"lte": uint64(200)}}}, getFilters(query)[1])
+}
+
+func TestTransactionsByAddressQuery_Pagination(t *testing.T) {
+	t.Parallel()
+
+	query, err := transactionsByAddressQuery(testAddress, data.TransactionsHistoryOptions{From: 40})
+	require.Nil(t, err)
+	require.Equal(t, 40, query["from"])
+	require.Nil(t, query["search_after"])
+
+	query, err = transactionsByAddressQuery(testAddress, data.TransactionsHistoryOptions{Cursor: "1600000000-12"})
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{uint64(1600000000), uint64(12)}, query["search_after"])
+	require.Nil(t, query["from"])
+
+	for _, cursor := range []string{"1600000000", "a-1", "1-b", "1-2-3"} {
+		_, err = transactionsByAddressQuery(testAddress, data.TransactionsHistoryOptions{Cursor: cursor})
+		require.Equal(t, errInvalidHistoryCursor, err)
+	}
+}
+
+func TestTokenTransfersQuery(t *testing.T) {
+	t.Parallel()
+
+	query, err := tokenTransfersQuery("", data.TransactionsHistoryOptions{Token: "TKN-123456", Cursor: "100-1"})
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{tokenTransfersFilter("TKN-123456")}, getFilters(query))
+	require.Equal(t, []interface{}{uint64(100), uint64(1)}, query["search_after"])
+
+	query, err = tokenTransfersQuery(testAddress, data.TransactionsHistoryOptions{Direction: data.TransactionsDirectionOut, Status: "success"})
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{
+		tokenTransfersFilter(""),
+		object{"bool": object{"must": []interface{}{matchQuery("sender", testAddress)}}},
+		matchQuery("status", "success"),
+	}, getFilters(query))
+}
+
+// indexTransactionData returns the terms elasticsearch indexes for the data of a transaction saved by the indexer,
+// under the dynamic mapping of a string field: an analyzed text field and a keyword sub-field of up to 256 characters
+func indexTransactionData(t *testing.T, dataField string) map[string][]string {
+	marshalledTx, err := json.Marshal(&indexer.Transaction{Data: []byte(dataField)})
+	require.Nil(t, err)
+
+	doc := object{}
+	require.Nil(t, json.Unmarshal(marshalledTx, &doc))
+	value := doc["data"].(string)
+
+	isNotLetterOrDigit := func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}
+	fields := map[string][]string{
+		"data": strings.FieldsFunc(strings.ToLower(value), isNotLetterOrDigit),
+	}
+	if len(value) <= 256 {
+		fields[transactionDataKeywordField] = []string{value}
+	}
+
+	return fields
+}
+
+func matchesPrefixQueries(filter object, fields map[string][]string) bool {
+	for _, clause := range filter["bool"].(object)["should"].([]interface{}) {
+		for field, prefix := range clause.(object)["prefix"].(object) {
+			for _, term := range fields[field] {
+				if strings.HasPrefix(term, prefix.(string)) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+func TestTokenTransfersFilter(t *testing.T) {
+	t.Parallel()
+
+	filter := tokenTransfersFilter("TKN-123456")
+	matches := func(dataField string) bool {
+		return matchesPrefixQueries(filter, indexTransactionData(t, dataField))
+	}
+
+	require.True(t, matches("ESDTTransfer@544b4e2d313233343536@0a"))
+	require.True(t, matches("ESDTTransfer@544b4e2d313233343536@ff"))
+	require.True(t, matches("ESDTNFTTransfer@544b4e2d313233343536@01@01@aa"))
+	require.False(t, matches("ESDTTransfer@4f544845522d313233343536@0a"))
+	require.False(t, matches("ESDTTransfer@544b4e2d31323334353637@0a"))
+	require.False(t, matches("ESDTNFTTransfer@544b4e2d31323334353637@01@01@aa"))
+	// the data field is too long to be kept by the keyword sub-field
+	require.False(t, matches("ESDTNFTTransfer@544b4e2d313233343536@01@01@aa@"+strings.Repeat("ab", 100)))
+
+	filter = tokenTransfersFilter("")
+	require.True(t, matches("ESDTTransfer@4f544845522d313233343536@0a"))
+	require.True(t, matches("ESDTNFTTransfer@544b4e2d313233343536@01@01@aa"))
+	require.False(t, matches("ESDTTransferX@0a"))
+	require.False(t, matches("transfer"))
+}
+
+func TestTokenTransfersFilter_AnalyzedDataFieldShouldNotMatch(t *testing.T) {
+	t.Parallel()
+
+	fields := indexTransactionData(t, "ESDTTransfer@544b4e2d313233343536@0a")
+	analyzedFields := map[string][]string{transactionDataKeywordField: fields["data"]}
+
+	require.True(t, matchesPrefixQueries(tokenTransfersFilter("TKN-123456"), fields))
+	require.False(t, matchesPrefixQueries(tokenTransfersFilter("TKN-123456"), analyzedFields))
+}
+
+func TestTransferDataPrefixes(t *testing.T) {
+	t.Parallel()
+
+	// "ab@" has 3 bytes
+	require.Equal(t, []string{"YWJA"}, transferDataPrefixes("ab", ""))
+	// 1 remaining byte, the next character depends on 4 unknown bits
+	require.Len(t, transferDataPrefixes("abc", ""), 16)
+	// 2 remaining bytes, the next character depends on 2 unknown bits
+	require.Len(t, transferDataPrefixes("abcd", ""), 4)
+}
+
+func TestEventsQuery(t *testing.T) {
+	t.Parallel()
+
+	query, err := eventsQuery(testAddress, "transfer", data.TransactionsHistoryOptions{From: 10, Order: data.SortOrderAscending})
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{
+		matchQuery("events.address", testAddress),
+		matchQuery("events.identifier", "transfer"),
+	}, getFilters(query))
+	require.Equal(t, 10, query["from"])
+	require.Equal(t, []interface{}{
+		object{"timestamp": object{"order": data.SortOrderAscending}},
+		object{"searchOrder": object{"order": data.SortOrderAscending, "unmapped_type": "long"}},
+	}, query["sort"])
+
+	query, _ = eventsQuery(testAddress, "", data.TransactionsHistoryOptions{Status: "success", StartTime: 10})
+	require.Equal(t, []interface{}{
+		matchQuery("events.address", testAddress),
+		timestampRangeFilter(10, 0),
+	}, getFilters(query))
+}
+
+func TestScResultsByTxHashQuery(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, object{"query": object{"match": object{"_id": "hash"}}}, scResultsByTxHashQuery("hash"))
+}
+
+func TestDatabaseTransaction_HistoryCursorShouldBeParsable(t *testing.T) {
+	t.Parallel()
+
+	tx := data.DatabaseTransaction{}
+	tx.Timestamp = 1600000000
+	tx.SearchOrder = 7
+
+	searchAfter, err := parseHistoryCursor(tx.HistoryCursor())
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{uint64(1600000000), uint64(7)}, searchAfter)
+}
+
+func getFilters(query object) []interface{} {
+	return query["query"].(object)["bool"].(object)["filter"].([]interface{})
+}
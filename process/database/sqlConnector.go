@@ -0,0 +1,277 @@
+package database
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// SQLiteDriver is the name of the embedded SQL database driver
+const SQLiteDriver = "sqlite3"
+
+// sqlConnector answers the history queries from a SQL database filled by the hyperblocks indexer
+type sqlConnector struct {
+	db *sql.DB
+}
+
+// NewSQLConnector opens the SQL database and creates its tables, if missing. The driver has to be registered by
+// the caller
+func NewSQLConnector(driver string, dataSourceName string) (*sqlConnector, error) {
+	db, err := sql.Open(driver, dataSourceName)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open SQL database: %w", err)
+	}
+	if driver == SQLiteDriver {
+		// sqlite allows a single writer, so the connection is shared in order to avoid the database locked errors
+		db.SetMaxOpenConns(1)
+	}
+
+	for _, statement := range sqlSchema {
+		_, err = db.Exec(statement)
+		if err != nil {
+			_ = db.Close()
+			return nil, fmt.Errorf("cannot create SQL database schema: %w", err)
+		}
+	}
+
+	return &sqlConnector{
+		db: db,
+	}, nil
+}
+
+// GetTransactionsByAddress gets transactions TO or FROM the specified address, paginated, filtered and sorted
+// as requested by the options
+func (sc *sqlConnector) GetTransactionsByAddress(address string, options data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error) {
+	statement, args, err := transactionsByAddressSQLQuery(address, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return sc.queryTransactions(statement, args...)
+}
+
+// GetTokenTransfers gets the ESDT and NFT transfers of the token from the options, or of any token if none is
+// provided. If the address is not empty, only the transfers sent or received by it are returned
+func (sc *sqlConnector) GetTokenTransfers(address string, options data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error) {
+	statement, args, err := tokenTransfersSQLQuery(address, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return sc.queryTransactions(statement, args...)
+}
+
+// GetSCResultsByTxHash gets the smart contract results generated by the transaction with the given hash
+func (sc *sqlConnector) GetSCResultsByTxHash(txHash string) ([]data.DatabaseSCResult, error) {
+	var hash string
+	err := sc.db.QueryRow("SELECT hash FROM transactions WHERE hash = $1", txHash).Scan(&hash)
+	if err == sql.ErrNoRows {
+		return nil, errCannotFindTxInDb
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := sc.db.Query("SELECT body FROM sc_results WHERE original_tx_hash = $1 ORDER BY hyperblock_nonce", txHash)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	scResults := make([]data.DatabaseSCResult, 0)
+	for rows.Next() {
+		var body string
+		err = rows.Scan(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var scResult data.DatabaseSCResult
+		err = json.Unmarshal([]byte(body), &scResult)
+		if err != nil {
+			return nil, err
+		}
+		scResults = append(scResults, scResult)
+	}
+
+	return scResults, rows.Err()
+}
+
+// GetEventsByAddress returns an error, since the hyperblocks do not hold the logs of the transactions
+func (sc *sqlConnector) GetEventsByAddress(_ string, _ string, _ data.TransactionsHistoryOptions) ([]data.DatabaseEvent, error) {
+	return nil, errEventsNotIndexed
+}
+
+// GetAtlasBlockByShardIDAndNonce gets the transactions of the hyperblock with the specified nonce
+func (sc *sqlConnector) GetAtlasBlockByShardIDAndNonce(shardID uint32, nonce uint64) (data.AtlasBlock, error) {
+	if shardID != core.MetachainShardId {
+		return data.AtlasBlock{}, errOnlyHyperBlocksIndexed
+	}
+
+	var hash string
+	err := sc.db.QueryRow("SELECT hash FROM hyperblocks WHERE nonce = $1", nonce).Scan(&hash)
+	if err == sql.ErrNoRows {
+		return data.AtlasBlock{}, errCannotFindBlockInDb
+	}
+	if err != nil {
+		return data.AtlasBlock{}, err
+	}
+
+	txs, err := sc.queryTransactions("SELECT hash, body FROM transactions WHERE hyperblock_nonce = $1 ORDER BY search_order", nonce)
+	if err != nil {
+		return data.AtlasBlock{}, err
+	}
+
+	return data.AtlasBlock{
+		Nonce:        nonce,
+		Hash:         hash,
+		Transactions: txs,
+	}, nil
+}
+
+func (sc *sqlConnector) queryTransactions(statement string, args ...interface{}) ([]data.DatabaseTransaction, error) {
+	rows, err := sc.db.Query(statement, args...)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get data from database: %w", err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	txs := make([]data.DatabaseTransaction, 0)
+	for rows.Next() {
+		var hash, body string
+		err = rows.Scan(&hash, &body)
+		if err != nil {
+			return nil, err
+		}
+
+		var tx data.DatabaseTransaction
+		err = json.Unmarshal([]byte(body), &tx)
+		if err != nil {
+			return nil, err
+		}
+		tx.Hash = hash
+		txs = append(txs, tx)
+	}
+
+	return txs, rows.Err()
+}
+
+// SaveHyperBlock saves the transactions and the smart contract results of a hyperblock in a single database
+// transaction, so that a hyperblock is either fully indexed or not at all
+func (sc *sqlConnector) SaveHyperBlock(hyperBlock *IndexedHyperBlock) error {
+	dbTx, err := sc.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	err = saveHyperBlock(dbTx, hyperBlock)
+	if err != nil {
+		_ = dbTx.Rollback()
+		return err
+	}
+
+	return dbTx.Commit()
+}
+
+func saveHyperBlock(dbTx *sql.Tx, hyperBlock *IndexedHyperBlock) error {
+	for _, tx := range hyperBlock.Transactions {
+		body, err := json.Marshal(tx)
+		if err != nil {
+			return err
+		}
+
+		_, err = dbTx.Exec(
+			`INSERT INTO transactions (hash, hyperblock_nonce, search_order, timestamp, sender, receiver, status, data_prefix, body)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9) ON CONFLICT (hash) DO NOTHING`,
+			tx.Hash, hyperBlock.Nonce, tx.SearchOrder, uint64(tx.Timestamp), tx.Sender, tx.Receiver, tx.Status, getIndexedDataPrefix(tx.Data), string(body),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, scResult := range hyperBlock.SCResults {
+		body, err := json.Marshal(scResult)
+		if err != nil {
+			return err
+		}
+
+		_, err = dbTx.Exec(
+			`INSERT INTO sc_results (hash, original_tx_hash, hyperblock_nonce, body) VALUES ($1, $2, $3, $4) ON CONFLICT (hash) DO NOTHING`,
+			scResult.Hash, scResult.OriginalTxHash, hyperBlock.Nonce, string(body),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := dbTx.Exec("DELETE FROM missing_hyperblocks WHERE nonce = $1", hyperBlock.Nonce)
+	if err != nil {
+		return err
+	}
+
+	_, err = dbTx.Exec(
+		"INSERT INTO hyperblocks (nonce, hash, timestamp) VALUES ($1, $2, $3)",
+		hyperBlock.Nonce, hyperBlock.Hash, uint64(hyperBlock.Timestamp),
+	)
+
+	return err
+}
+
+// GetLastIndexedNonce returns the nonce of the last saved hyperblock, if any
+func (sc *sqlConnector) GetLastIndexedNonce() (uint64, bool, error) {
+	var nonce sql.NullInt64
+	err := sc.db.QueryRow("SELECT MAX(nonce) FROM hyperblocks").Scan(&nonce)
+	if err != nil {
+		return 0, false, err
+	}
+
+	return uint64(nonce.Int64), nonce.Valid, nil
+}
+
+// MarkMissingHyperBlock records a hyperblock skipped by the indexer
+func (sc *sqlConnector) MarkMissingHyperBlock(nonce uint64) error {
+	_, err := sc.db.Exec("INSERT INTO missing_hyperblocks (nonce) VALUES ($1) ON CONFLICT (nonce) DO NOTHING", nonce)
+
+	return err
+}
+
+// GetMissingHyperBlocks returns the nonces of the skipped hyperblocks which were not saved since, in ascending order
+func (sc *sqlConnector) GetMissingHyperBlocks() ([]uint64, error) {
+	rows, err := sc.db.Query("SELECT nonce FROM missing_hyperblocks ORDER BY nonce")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	nonces := make([]uint64, 0)
+	for rows.Next() {
+		var nonce uint64
+		err = rows.Scan(&nonce)
+		if err != nil {
+			return nil, err
+		}
+		nonces = append(nonces, nonce)
+	}
+
+	return nonces, rows.Err()
+}
+
+// Close closes the SQL database
+func (sc *sqlConnector) Close() error {
+	return sc.db.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sc *sqlConnector) IsInterfaceNil() bool {
+	return sc == nil
+}
@@ -0,0 +1,216 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestHyperBlocks() []*IndexedHyperBlock {
+	newTx := func(hash string, sender string, receiver string, timestamp uint64, searchOrder uint32, dataField string) data.DatabaseTransaction {
+		tx := data.DatabaseTransaction{Hash: hash}
+		tx.Sender = sender
+		tx.Receiver = receiver
+		tx.Timestamp = time.Duration(timestamp)
+		tx.SearchOrder = searchOrder
+		tx.Status = "success"
+		tx.Data = []byte(dataField)
+		tx.Value = "0"
+		return tx
+	}
+
+	return []*IndexedHyperBlock{
+		{
+			Nonce:     10,
+			Hash:      "hyperblock-10",
+			Timestamp: 100,
+			Transactions: []data.DatabaseTransaction{
+				newTx("tx-1", "alice", "bob", 100, 0, ""),
+				newTx("tx-2", "bob", "alice", 100, 1, "ESDTTransfer@544b4e2d313233343536@0a"),
+			},
+			SCResults: []data.DatabaseSCResult{{Hash: "scr-1", OriginalTxHash: "tx-1", Value: "5"}},
+		},
+		{
+			Nonce:     11,
+			Hash:      "hyperblock-11",
+			Timestamp: 106,
+			Transactions: []data.DatabaseTransaction{
+				newTx("tx-3", "alice", "carol", 106, 0, "ESDTNFTTransfer@4e46542d616263646566@01@01@bob"),
+			},
+			SCResults: []data.DatabaseSCResult{{Hash: "scr-2", OriginalTxHash: "tx-1", Value: "6"}},
+		},
+	}
+}
+
+func createSQLConnectorWithHyperBlocks(t *testing.T) *sqlConnector {
+	connector, err := NewSQLConnector(SQLiteDriver, ":memory:")
+	require.Nil(t, err)
+
+	for _, hyperBlock := range createTestHyperBlocks() {
+		require.Nil(t, connector.SaveHyperBlock(hyperBlock))
+	}
+
+	return connector
+}
+
+func getHashes(txs []data.DatabaseTransaction) []string {
+	hashes := make([]string, 0, len(txs))
+	for _, tx := range txs {
+		hashes = append(hashes, tx.Hash)
+	}
+
+	return hashes
+}
+
+func TestSQLConnector_GetTransactionsByAddress(t *testing.T) {
+	t.Parallel()
+
+	connector := createSQLConnectorWithHyperBlocks(t)
+	defer func() {
+		_ = connector.Close()
+	}()
+
+	txs, err := connector.GetTransactionsByAddress("alice", data.TransactionsHistoryOptions{})
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx-3", "tx-2", "tx-1"}, getHashes(txs))
+	require.Equal(t, "carol", txs[0].Receiver)
+
+	txs, err = connector.GetTransactionsByAddress("alice", data.TransactionsHistoryOptions{Direction: data.TransactionsDirectionOut, Order: data.SortOrderAscending})
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx-1", "tx-3"}, getHashes(txs))
+
+	txs, err = connector.GetTransactionsByAddress("alice", data.TransactionsHistoryOptions{Counterparty: "bob"})
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx-2", "tx-1"}, getHashes(txs))
+
+	txs, err = connector.GetTransactionsByAddress("alice", data.TransactionsHistoryOptions{Cursor: txs[0].HistoryCursor()})
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx-1"}, getHashes(txs))
+
+	txs, err = connector.GetTransactionsByAddress("alice", data.TransactionsHistoryOptions{From: 1, Size: 1})
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx-2"}, getHashes(txs))
+
+	txs, err = connector.GetTransactionsByAddress("alice", data.TransactionsHistoryOptions{StartTime: 101, EndTime: 200})
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx-3"}, getHashes(txs))
+
+	txs, err = connector.GetTransactionsByAddress("alice", data.TransactionsHistoryOptions{Token: "TKN-123456"})
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx-2"}, getHashes(txs))
+
+	_, err = connector.GetTransactionsByAddress("alice", data.TransactionsHistoryOptions{Cursor: "invalid"})
+	require.Equal(t, errInvalidHistoryCursor, err)
+}
+
+func TestSQLConnector_GetTokenTransfers(t *testing.T) {
+	t.Parallel()
+
+	connector := createSQLConnectorWithHyperBlocks(t)
+	defer func() {
+		_ = connector.Close()
+	}()
+
+	txs, err := connector.GetTokenTransfers("", data.TransactionsHistoryOptions{})
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx-3", "tx-2"}, getHashes(txs))
+
+	txs, err = connector.GetTokenTransfers("", data.TransactionsHistoryOptions{Token: "NFT-abcdef"})
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx-3"}, getHashes(txs))
+
+	txs, err = connector.GetTokenTransfers("carol", data.TransactionsHistoryOptions{Token: "TKN-123456"})
+	require.Nil(t, err)
+	require.Empty(t, txs)
+}
+
+func TestSQLConnector_GetSCResultsByTxHash(t *testing.T) {
+	t.Parallel()
+
+	connector := createSQLConnectorWithHyperBlocks(t)
+	defer func() {
+		_ = connector.Close()
+	}()
+
+	scResults, err := connector.GetSCResultsByTxHash("tx-1")
+	require.Nil(t, err)
+	require.Len(t, scResults, 2)
+	require.Equal(t, "scr-1", scResults[0].Hash)
+	require.Equal(t, "6", scResults[1].Value)
+
+	scResults, err = connector.GetSCResultsByTxHash("tx-2")
+	require.Nil(t, err)
+	require.Empty(t, scResults)
+
+	_, err = connector.GetSCResultsByTxHash("missing")
+	require.Equal(t, errCannotFindTxInDb, err)
+}
+
+func TestSQLConnector_GetAtlasBlockByShardIDAndNonce(t *testing.T) {
+	t.Parallel()
+
+	connector := createSQLConnectorWithHyperBlocks(t)
+	defer func() {
+		_ = connector.Close()
+	}()
+
+	block, err := connector.GetAtlasBlockByShardIDAndNonce(core.MetachainShardId, 10)
+	require.Nil(t, err)
+	require.Equal(t, "hyperblock-10", block.Hash)
+	require.Equal(t, []string{"tx-1", "tx-2"}, getHashes(block.Transactions))
+
+	_, err = connector.GetAtlasBlockByShardIDAndNonce(core.MetachainShardId, 12)
+	require.Equal(t, errCannotFindBlockInDb, err)
+
+	_, err = connector.GetAtlasBlockByShardIDAndNonce(0, 10)
+	require.Equal(t, errOnlyHyperBlocksIndexed, err)
+}
+
+func TestSQLConnector_GetLastIndexedNonce(t *testing.T) {
+	t.Parallel()
+
+	connector, err := NewSQLConnector(SQLiteDriver, ":memory:")
+	require.Nil(t, err)
+	defer func() {
+		_ = connector.Close()
+	}()
+
+	_, found, err := connector.GetLastIndexedNonce()
+	require.Nil(t, err)
+	require.False(t, found)
+
+	require.Nil(t, connector.SaveHyperBlock(&IndexedHyperBlock{Nonce: 7, Hash: "hash"}))
+	nonce, found, err := connector.GetLastIndexedNonce()
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Equal(t, uint64(7), nonce)
+
+	err = connector.SaveHyperBlock(&IndexedHyperBlock{Nonce: 7, Hash: "hash"})
+	require.NotNil(t, err)
+}
+
+func TestSQLConnector_MissingHyperBlocks(t *testing.T) {
+	t.Parallel()
+
+	connector, err := NewSQLConnector(SQLiteDriver, ":memory:")
+	require.Nil(t, err)
+	defer func() {
+		_ = connector.Close()
+	}()
+
+	require.Nil(t, connector.MarkMissingHyperBlock(8))
+	require.Nil(t, connector.MarkMissingHyperBlock(6))
+	require.Nil(t, connector.MarkMissingHyperBlock(6))
+	nonces, err := connector.GetMissingHyperBlocks()
+	require.Nil(t, err)
+	require.Equal(t, []uint64{6, 8}, nonces)
+
+	require.Nil(t, connector.SaveHyperBlock(&IndexedHyperBlock{Nonce: 6, Hash: "hash"}))
+	nonces, err = connector.GetMissingHyperBlocks()
+	require.Nil(t, err)
+	require.Equal(t, []uint64{8}, nonces)
+}
@@ -0,0 +1,174 @@
+package database
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// maxIndexedDataPrefixLength is the number of bytes of the data field kept in the data_prefix column, enough for
+// matching the token transfers built-in function calls
+const maxIndexedDataPrefixLength = 128
+
+var sqlSchema = []string{
+	`CREATE TABLE IF NOT EXISTS hyperblocks (
+		nonce BIGINT PRIMARY KEY,
+		hash TEXT NOT NULL,
+		timestamp BIGINT NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS transactions (
+		hash TEXT PRIMARY KEY,
+		hyperblock_nonce BIGINT NOT NULL,
+		search_order INTEGER NOT NULL,
+		timestamp BIGINT NOT NULL,
+		sender TEXT NOT NULL,
+		receiver TEXT NOT NULL,
+		status TEXT NOT NULL,
+		data_prefix TEXT NOT NULL,
+		body TEXT NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS transactions_by_sender ON transactions (sender, timestamp, search_order)`,
+	`CREATE INDEX IF NOT EXISTS transactions_by_receiver ON transactions (receiver, timestamp, search_order)`,
+	`CREATE INDEX IF NOT EXISTS transactions_by_hyperblock ON transactions (hyperblock_nonce, search_order)`,
+	`CREATE TABLE IF NOT EXISTS sc_results (
+		hash TEXT PRIMARY KEY,
+		original_tx_hash TEXT NOT NULL,
+		hyperblock_nonce BIGINT NOT NULL,
+		body TEXT NOT NULL
+	)`,
+	`CREATE INDEX IF NOT EXISTS sc_results_by_original_tx ON sc_results (original_tx_hash)`,
+	`CREATE TABLE IF NOT EXISTS missing_hyperblocks (
+		nonce BIGINT PRIMARY KEY
+	)`,
+}
+
+// sqlQuery gathers the conditions of a query and their arguments. The ? placeholders of the conditions are numbered
+// as they are added, since the postgres driver only accepts numbered placeholders
+type sqlQuery struct {
+	conditions []string
+	args       []interface{}
+}
+
+func (sq *sqlQuery) where(condition string, args ...interface{}) {
+	for _, arg := range args {
+		sq.args = append(sq.args, arg)
+		condition = strings.Replace(condition, "?", fmt.Sprintf("$%d", len(sq.args)), 1)
+	}
+
+	sq.conditions = append(sq.conditions, condition)
+}
+
+func (sq *sqlQuery) whereClause() string {
+	if len(sq.conditions) == 0 {
+		return ""
+	}
+
+	return " WHERE " + strings.Join(sq.conditions, " AND ")
+}
+
+// transactionsByAddressSQLQuery selects the transactions of an address, as transactionsByAddressQuery does
+func transactionsByAddressSQLQuery(address string, options data.TransactionsHistoryOptions) (string, []interface{}, error) {
+	query := &sqlQuery{}
+	addAddressCondition(query, address, options.Direction, options.Counterparty)
+	if options.Token != "" {
+		addTokenTransfersCondition(query, options.Token)
+	}
+
+	return historySQLQuery(query, options)
+}
+
+// tokenTransfersSQLQuery selects the token transfers, optionally of an address, as tokenTransfersQuery does
+func tokenTransfersSQLQuery(address string, options data.TransactionsHistoryOptions) (string, []interface{}, error) {
+	query := &sqlQuery{}
+	addTokenTransfersCondition(query, options.Token)
+	if address != "" {
+		addAddressCondition(query, address, options.Direction, options.Counterparty)
+	}
+
+	return historySQLQuery(query, options)
+}
+
+func addAddressCondition(query *sqlQuery, address string, direction string, counterparty string) {
+	switch {
+	case direction == data.TransactionsDirectionOut && counterparty == "":
+		query.where("sender = ?", address)
+	case direction == data.TransactionsDirectionOut:
+		query.where("sender = ? AND receiver = ?", address, counterparty)
+	case direction == data.TransactionsDirectionIn && counterparty == "":
+		query.where("receiver = ?", address)
+	case direction == data.TransactionsDirectionIn:
+		query.where("sender = ? AND receiver = ?", counterparty, address)
+	case counterparty == "":
+		query.where("(sender = ? OR receiver = ?)", address, address)
+	default:
+		query.where("((sender = ? AND receiver = ?) OR (sender = ? AND receiver = ?))", address, counterparty, counterparty, address)
+	}
+}
+
+func addTokenTransfersCondition(query *sqlQuery, token string) {
+	query.where(
+		"(data_prefix LIKE ? OR data_prefix LIKE ?)",
+		hexDataPrefix(core.BuiltInFunctionESDTTransfer, token)+"%",
+		hexDataPrefix(builtInFunctionESDTNFTTransfer, token)+"%",
+	)
+}
+
+// hexDataPrefix returns the hex encoded prefix of the data field of the calls to a transfer function
+func hexDataPrefix(function string, token string) string {
+	dataPrefix := function + "@"
+	if token != "" {
+		dataPrefix = fmt.Sprintf("%s@%s@", function, hex.EncodeToString([]byte(token)))
+	}
+
+	return hex.EncodeToString([]byte(dataPrefix))
+}
+
+func getIndexedDataPrefix(dataField []byte) string {
+	if len(dataField) > maxIndexedDataPrefixLength {
+		dataField = dataField[:maxIndexedDataPrefixLength]
+	}
+
+	return hex.EncodeToString(dataField)
+}
+
+// historySQLQuery filters, sorts and pages the transactions selected by the query, as historyQuery does
+func historySQLQuery(query *sqlQuery, options data.TransactionsHistoryOptions) (string, []interface{}, error) {
+	if options.Status != "" {
+		query.where("status = ?", options.Status)
+	}
+	if options.StartTime > 0 {
+		query.where("timestamp >= ?", options.StartTime)
+	}
+	if options.EndTime > 0 {
+		query.where("timestamp <= ?", options.EndTime)
+	}
+
+	order, comparison := "DESC", "<"
+	if options.Order == data.SortOrderAscending {
+		order, comparison = "ASC", ">"
+	}
+
+	offset := 0
+	if options.Cursor != "" {
+		searchAfter, err := parseHistoryCursor(options.Cursor)
+		if err != nil {
+			return "", nil, err
+		}
+		query.where(
+			fmt.Sprintf("(timestamp %s ? OR (timestamp = ? AND search_order %s ?))", comparison, comparison),
+			searchAfter[0], searchAfter[0], searchAfter[1],
+		)
+	} else {
+		offset = options.From
+	}
+
+	statement := fmt.Sprintf(
+		"SELECT hash, body FROM transactions%s ORDER BY timestamp %s, search_order %s LIMIT %d OFFSET %d",
+		query.whereClause(), order, order, getHistorySize(options), offset,
+	)
+
+	return statement, query.args, nil
+}
@@ -91,3 +91,46 @@ var ErrNoObserverAvailable = errors.New("no observer available")
 
 // ErrInvalidTokenType signals that the provided token type is invalid
 var ErrInvalidTokenType = errors.New("invalid token type")
+
+// ErrNilTLSConfig signals that a nil TLS configuration has been provided
+var ErrNilTLSConfig = errors.New("nil TLS config")
+
+// ErrInvalidTransactionsHistoryOptions signals that invalid paging, filtering or sorting options have been provided
+var ErrInvalidTransactionsHistoryOptions = errors.New("invalid transactions history options")
+
+// ErrInvalidAccountQueryOptions signals that the account query options do not select a block
+var ErrInvalidAccountQueryOptions = errors.New("invalid account query options")
+
+// ErrInvalidAccountStateRequest signals that the node rejected a request for the account state at a block
+var ErrInvalidAccountStateRequest = errors.New("invalid account state request")
+
+// ErrHistoricalStateNotSupported signals that the node did not return the block an account state was read at, which
+// happens when it does not support reading the state at a given block and returns the current state instead
+var ErrHistoricalStateNotSupported = errors.New("the node does not support reading the account state at a given block")
+
+// ErrUnexpectedBlockInfo signals that the node read an account state at another block than the requested one
+var ErrUnexpectedBlockInfo = errors.New("the account state was read at another block than the requested one")
+
+// ErrInvalidBulkAccountsRequest signals that a bulk accounts request has too many addresses or unknown fields
+var ErrInvalidBulkAccountsRequest = errors.New("invalid bulk accounts request")
+
+// ErrNilSCQueryHandler signals that a nil smart contract queries handler has been provided
+var ErrNilSCQueryHandler = errors.New("nil smart contract queries handler")
+
+// ErrNilSCQueryCacher signals that a nil smart contract queries cacher has been provided
+var ErrNilSCQueryCacher = errors.New("nil smart contract queries cacher")
+
+// ErrInvalidCachedSCQueries signals that the smart contract views allowed to be cached are not properly configured
+var ErrInvalidCachedSCQueries = errors.New("invalid cached smart contract queries")
+
+// ErrInvalidPollingInterval signals that an invalid polling interval has been provided
+var ErrInvalidPollingInterval = errors.New("invalid polling interval")
+
+// ErrNoABIForContract signals that no ABI has been registered for a contract
+var ErrNoABIForContract = errors.New("no ABI registered for contract")
+
+// ErrNilVMOutput signals that a nil VM output has been provided
+var ErrNilVMOutput = errors.New("nil VM output")
+
+// ErrSCQueryNotSuccessful signals that a smart contract query did not succeed, so it has no values to decode
+var ErrSCQueryNotSuccessful = errors.New("smart contract query not successful")
@@ -3,6 +3,7 @@ package process
 import (
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/crypto"
+	"github.com/ElrondNetwork/elrond-go/data/vm"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 	"github.com/ElrondNetwork/elrond-proxy-go/observer"
@@ -29,7 +30,10 @@ type Processor interface {
 
 // ExternalStorageConnector defines what a external storage connector should be able to do
 type ExternalStorageConnector interface {
-	GetTransactionsByAddress(address string) ([]data.DatabaseTransaction, error)
+	GetTransactionsByAddress(address string, options data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error)
+	GetTokenTransfers(address string, options data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error)
+	GetSCResultsByTxHash(txHash string) ([]data.DatabaseSCResult, error)
+	GetEventsByAddress(address string, identifier string, options data.TransactionsHistoryOptions) ([]data.DatabaseEvent, error)
 	GetAtlasBlockByShardIDAndNonce(shardID uint32, nonce uint64) (data.AtlasBlock, error)
 	IsInterfaceNil() bool
 }
@@ -59,3 +63,28 @@ type GenericApiResponseCacheHandler interface {
 	Store(response *data.GenericAPIResponse)
 	IsInterfaceNil() bool
 }
+
+// SCQueryCacheHandler will define what a real smart contract queries cacher should do
+type SCQueryCacheHandler interface {
+	Load(shardID uint32, key string) (*vm.VMOutputApi, bool)
+	Store(shardID uint32, blockNonce uint64, key string, vmOutput *vm.VMOutputApi)
+	GetBlockNonce(shardID uint32) (uint64, bool)
+	SetBlockNonce(shardID uint32, blockNonce uint64)
+	Clear(shardID uint32)
+	IsInterfaceNil() bool
+}
+
+// SCQueryHandler defines what a smart contract queries executor should be able to do
+type SCQueryHandler interface {
+	ExecuteQuery(query *data.SCQuery) (*vm.VMOutputApi, error)
+	ExecuteQueries(queries []*data.SCQuery) []*data.SCQueryResult
+	IsInterfaceNil() bool
+}
+
+// ObserverSCQueryHandler defines a smart contract queries executor which is also able to send the queries to a given
+// observer
+type ObserverSCQueryHandler interface {
+	SCQueryHandler
+	ExecuteQueryOnObserver(query *data.SCQuery, observer *data.NodeData) (*vm.VMOutputApi, error)
+	ExecuteQueriesOnObserver(queries []*data.SCQuery, observer *data.NodeData) []*data.SCQueryResult
+}
@@ -6,7 +6,22 @@ type ElasticSearchConnectorMock struct {
 }
 
 // GetTransactionsByAddress -
-func (escm *ElasticSearchConnectorMock) GetTransactionsByAddress(_ string) ([]data.DatabaseTransaction, error) {
+func (escm *ElasticSearchConnectorMock) GetTransactionsByAddress(_ string, _ data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error) {
+	return nil, nil
+}
+
+// GetTokenTransfers -
+func (escm *ElasticSearchConnectorMock) GetTokenTransfers(_ string, _ data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error) {
+	return nil, nil
+}
+
+// GetSCResultsByTxHash -
+func (escm *ElasticSearchConnectorMock) GetSCResultsByTxHash(_ string) ([]data.DatabaseSCResult, error) {
+	return nil, nil
+}
+
+// GetEventsByAddress -
+func (escm *ElasticSearchConnectorMock) GetEventsByAddress(_ string, _ string, _ data.TransactionsHistoryOptions) ([]data.DatabaseEvent, error) {
 	return nil, nil
 }
 
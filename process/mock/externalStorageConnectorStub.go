@@ -3,19 +3,49 @@ package mock
 import "github.com/ElrondNetwork/elrond-proxy-go/data"
 
 type ExternalStorageConnectorStub struct {
-	GetTransactionsByAddressCalled       func(address string) ([]data.DatabaseTransaction, error)
+	GetTransactionsByAddressCalled       func(address string, options data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error)
+	GetTokenTransfersCalled              func(address string, options data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error)
+	GetSCResultsByTxHashCalled           func(txHash string) ([]data.DatabaseSCResult, error)
+	GetEventsByAddressCalled             func(address string, identifier string, options data.TransactionsHistoryOptions) ([]data.DatabaseEvent, error)
 	GetAtlasBlockByShardIDAndNonceCalled func(shardID uint32, nonce uint64) (data.AtlasBlock, error)
 }
 
 // GetTransactionsByAddress -
-func (e *ExternalStorageConnectorStub) GetTransactionsByAddress(address string) ([]data.DatabaseTransaction, error) {
+func (e *ExternalStorageConnectorStub) GetTransactionsByAddress(address string, options data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error) {
 	if e.GetTransactionsByAddressCalled != nil {
-		return e.GetTransactionsByAddressCalled(address)
+		return e.GetTransactionsByAddressCalled(address, options)
 	}
 
 	return []data.DatabaseTransaction{{Fee: "0"}}, nil
 }
 
+// GetTokenTransfers -
+func (e *ExternalStorageConnectorStub) GetTokenTransfers(address string, options data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error) {
+	if e.GetTokenTransfersCalled != nil {
+		return e.GetTokenTransfersCalled(address, options)
+	}
+
+	return nil, nil
+}
+
+// GetSCResultsByTxHash -
+func (e *ExternalStorageConnectorStub) GetSCResultsByTxHash(txHash string) ([]data.DatabaseSCResult, error) {
+	if e.GetSCResultsByTxHashCalled != nil {
+		return e.GetSCResultsByTxHashCalled(txHash)
+	}
+
+	return nil, nil
+}
+
+// GetEventsByAddress -
+func (e *ExternalStorageConnectorStub) GetEventsByAddress(address string, identifier string, options data.TransactionsHistoryOptions) ([]data.DatabaseEvent, error) {
+	if e.GetEventsByAddressCalled != nil {
+		return e.GetEventsByAddressCalled(address, identifier, options)
+	}
+
+	return nil, nil
+}
+
 // GetAtlasBlockByShardIDAndNonce -
 func (e *ExternalStorageConnectorStub) GetAtlasBlockByShardIDAndNonce(shardID uint32, nonce uint64) (data.AtlasBlock, error) {
 	if e.GetAtlasBlockByShardIDAndNonceCalled != nil {
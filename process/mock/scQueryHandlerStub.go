@@ -0,0 +1,40 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go/data/vm"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+)
+
+// SCQueryHandlerStub -
+type SCQueryHandlerStub struct {
+	ExecuteQueryCalled   func(query *data.SCQuery) (*vm.VMOutputApi, error)
+	ExecuteQueriesCalled func(queries []*data.SCQuery) []*data.SCQueryResult
+
+	ExecuteQueryOnObserverCalled   func(query *data.SCQuery, observer *data.NodeData) (*vm.VMOutputApi, error)
+	ExecuteQueriesOnObserverCalled func(queries []*data.SCQuery, observer *data.NodeData) []*data.SCQueryResult
+}
+
+// ExecuteQuery -
+func (sqhs *SCQueryHandlerStub) ExecuteQuery(query *data.SCQuery) (*vm.VMOutputApi, error) {
+	return sqhs.ExecuteQueryCalled(query)
+}
+
+// ExecuteQueries -
+func (sqhs *SCQueryHandlerStub) ExecuteQueries(queries []*data.SCQuery) []*data.SCQueryResult {
+	return sqhs.ExecuteQueriesCalled(queries)
+}
+
+// ExecuteQueryOnObserver -
+func (sqhs *SCQueryHandlerStub) ExecuteQueryOnObserver(query *data.SCQuery, observer *data.NodeData) (*vm.VMOutputApi, error) {
+	return sqhs.ExecuteQueryOnObserverCalled(query, observer)
+}
+
+// ExecuteQueriesOnObserver -
+func (sqhs *SCQueryHandlerStub) ExecuteQueriesOnObserver(queries []*data.SCQuery, observer *data.NodeData) []*data.SCQueryResult {
+	return sqhs.ExecuteQueriesOnObserverCalled(queries, observer)
+}
+
+// IsInterfaceNil -
+func (sqhs *SCQueryHandlerStub) IsInterfaceNil() bool {
+	return sqhs == nil
+}
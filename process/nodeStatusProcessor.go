@@ -245,6 +245,16 @@ func (nsp *NodeStatusProcessor) getNodeStatusMetrics(shardID uint32) (*data.Gene
 
 // GetLatestFullySynchronizedHyperblockNonce will compute nonce of the latest hyperblock that can be returned
 func (nsp *NodeStatusProcessor) GetLatestFullySynchronizedHyperblockNonce() (uint64, error) {
+	return nsp.getLatestHyperblockNonce(getNonceFromMetachainStatus)
+}
+
+// GetLatestFinalHyperblockNonce will compute the nonce of the latest hyperblock which cannot be reverted anymore: its
+// metachain block is final and all the shards have notarized it
+func (nsp *NodeStatusProcessor) GetLatestFinalHyperblockNonce() (uint64, error) {
+	return nsp.getLatestHyperblockNonce(getFinalNonceFromMetachainStatus)
+}
+
+func (nsp *NodeStatusProcessor) getLatestHyperblockNonce(metachainNonceGetter func(nodeStatusData interface{}) (uint64, bool)) (uint64, error) {
 	shardsIDs, err := nsp.getShardsIDs()
 	if err != nil {
 		return 0, err
@@ -264,7 +274,7 @@ func (nsp *NodeStatusProcessor) GetLatestFullySynchronizedHyperblockNonce() (uin
 		var nonce uint64
 		var ok bool
 		if shardID == core.MetachainShardId {
-			nonce, ok = getNonceFromMetachainStatus(nodeStatusResponse.Data)
+			nonce, ok = metachainNonceGetter(nodeStatusResponse.Data)
 		} else {
 			nonce, ok = getNonceFromShardStatus(nodeStatusResponse.Data)
 		}
@@ -326,6 +336,15 @@ func getNonceFromMetachainStatus(nodeStatusData interface{}) (uint64, bool) {
 	return getUint(metric), true
 }
 
+func getFinalNonceFromMetachainStatus(nodeStatusData interface{}) (uint64, bool) {
+	metric, ok := getMetric(nodeStatusData, core.MetricHighestFinalBlock)
+	if !ok {
+		return 0, false
+	}
+
+	return getUint(metric), true
+}
+
 func getMetric(nodeStatusData interface{}, metric string) (interface{}, bool) {
 	metricsMapI, ok := nodeStatusData.(map[string]interface{})
 	if !ok {
@@ -208,7 +208,8 @@ func TestNodeStatusProcessor_GetLatestBlockNonce(t *testing.T) {
 			} else {
 				localMap = map[string]interface{}{
 					"metrics": map[string]interface{}{
-						core.MetricNonce: 122,
+						core.MetricNonce:             122,
+						core.MetricHighestFinalBlock: 120,
 					},
 				}
 			}
@@ -226,6 +227,10 @@ func TestNodeStatusProcessor_GetLatestBlockNonce(t *testing.T) {
 	nonce, err := nodeStatusProc.GetLatestFullySynchronizedHyperblockNonce()
 	require.NoError(t, err)
 	require.Equal(t, uint64(122), nonce)
+
+	nonce, err = nodeStatusProc.GetLatestFinalHyperblockNonce()
+	require.NoError(t, err)
+	require.Equal(t, uint64(120), nonce)
 }
 
 func TestNodeStatusProcessor_GetAllIssuedEDTsGetObserversFailedShouldErr(t *testing.T) {
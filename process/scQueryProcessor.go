@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"net/http"
+	"sync"
 
 	"github.com/ElrondNetwork/elrond-go/core"
 	"github.com/ElrondNetwork/elrond-go/core/check"
@@ -14,6 +15,8 @@ import (
 // SCQueryServicePath defines the get values path at which the nodes answer
 const SCQueryServicePath = "/vm-values/query"
 
+const maxSCQueriesConcurrentRequests = 20
+
 // SCQueryProcessor is able to process smart contract queries
 type SCQueryProcessor struct {
 	proc            Processor
@@ -37,21 +40,113 @@ func NewSCQueryProcessor(proc Processor, pubKeyConverter core.PubkeyConverter) (
 
 // ExecuteQuery resolves the request by sending the request to the right observer and replies back the answer
 func (scQueryProcessor *SCQueryProcessor) ExecuteQuery(query *data.SCQuery) (*vm.VMOutputApi, error) {
-	addressBytes, err := scQueryProcessor.pubKeyConverter.Decode(query.ScAddress)
+	shardID, err := scQueryProcessor.computeQueryShardID(query)
 	if err != nil {
 		return nil, err
 	}
 
-	shardID, err := scQueryProcessor.proc.ComputeShardId(addressBytes)
+	observers, err := scQueryProcessor.proc.GetObservers(shardID)
 	if err != nil {
 		return nil, err
 	}
 
-	observers, err := scQueryProcessor.proc.GetObservers(shardID)
+	return scQueryProcessor.executeQueryOnObservers(query, shardID, observers)
+}
+
+// ExecuteQueries resolves a batch of queries. The queries are grouped by the shard of their contract and sent
+// concurrently to the observers. The results are in the order of the queries, each one holding its own error
+func (scQueryProcessor *SCQueryProcessor) ExecuteQueries(queries []*data.SCQuery) []*data.SCQueryResult {
+	results := make([]*data.SCQueryResult, len(queries))
+	queriesIndexesByShard := make(map[uint32][]int)
+	for idx, query := range queries {
+		results[idx] = &data.SCQueryResult{}
+		shardID, err := scQueryProcessor.computeQueryShardID(query)
+		if err != nil {
+			results[idx].Err = err
+			continue
+		}
+
+		queriesIndexesByShard[shardID] = append(queriesIndexesByShard[shardID], idx)
+	}
+
+	semaphore := make(chan struct{}, maxSCQueriesConcurrentRequests)
+	wg := &sync.WaitGroup{}
+	for shardID, indexes := range queriesIndexesByShard {
+		observers, err := scQueryProcessor.proc.GetObservers(shardID)
+		if err != nil {
+			for _, idx := range indexes {
+				results[idx].Err = err
+			}
+			continue
+		}
+
+		scQueryProcessor.executeQueriesConcurrently(queries, indexes, results, shardID, observers, semaphore, wg)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// ExecuteQueryOnObserver sends the query only to the given observer
+func (scQueryProcessor *SCQueryProcessor) ExecuteQueryOnObserver(query *data.SCQuery, observer *data.NodeData) (*vm.VMOutputApi, error) {
+	return scQueryProcessor.executeQueryOnObservers(query, observer.ShardId, []*data.NodeData{observer})
+}
+
+// ExecuteQueriesOnObserver sends a batch of queries concurrently, only to the given observer. The results are in the
+// order of the queries, each one holding its own error
+func (scQueryProcessor *SCQueryProcessor) ExecuteQueriesOnObserver(queries []*data.SCQuery, observer *data.NodeData) []*data.SCQueryResult {
+	results := make([]*data.SCQueryResult, len(queries))
+	indexes := make([]int, len(queries))
+	for idx := range queries {
+		results[idx] = &data.SCQueryResult{}
+		indexes[idx] = idx
+	}
+
+	semaphore := make(chan struct{}, maxSCQueriesConcurrentRequests)
+	wg := &sync.WaitGroup{}
+	scQueryProcessor.executeQueriesConcurrently(queries, indexes, results, observer.ShardId, []*data.NodeData{observer}, semaphore, wg)
+	wg.Wait()
+
+	return results
+}
+
+func (scQueryProcessor *SCQueryProcessor) executeQueriesConcurrently(
+	queries []*data.SCQuery,
+	indexes []int,
+	results []*data.SCQueryResult,
+	shardID uint32,
+	observers []*data.NodeData,
+	semaphore chan struct{},
+	wg *sync.WaitGroup,
+) {
+	for _, idx := range indexes {
+		semaphore <- struct{}{}
+		wg.Add(1)
+		go func(query *data.SCQuery, result *data.SCQueryResult) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			result.VMOutput, result.Err = scQueryProcessor.executeQueryOnObservers(query, shardID, observers)
+		}(queries[idx], results[idx])
+	}
+}
+
+func (scQueryProcessor *SCQueryProcessor) computeQueryShardID(query *data.SCQuery) (uint32, error) {
+	addressBytes, err := scQueryProcessor.pubKeyConverter.Decode(query.ScAddress)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
+	return scQueryProcessor.proc.ComputeShardId(addressBytes)
+}
+
+func (scQueryProcessor *SCQueryProcessor) executeQueryOnObservers(
+	query *data.SCQuery,
+	shardID uint32,
+	observers []*data.NodeData,
+) (*vm.VMOutputApi, error) {
 	for _, observer := range observers {
 		request := scQueryProcessor.createRequestFromQuery(query)
 		response := &data.ResponseVmValue{}
@@ -95,3 +190,8 @@ func (scQueryProcessor *SCQueryProcessor) createRequestFromQuery(query *data.SCQ
 
 	return request
 }
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (scQueryProcessor *SCQueryProcessor) IsInterfaceNil() bool {
+	return scQueryProcessor == nil
+}
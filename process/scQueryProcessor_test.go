@@ -1,8 +1,11 @@
 package process
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
 	"net/http"
+	"sync"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/core/pubkeyConverter"
@@ -175,3 +178,105 @@ func TestSCQueryProcessor_ExecuteQueryFailsOnBadRequestWithExplicitErrorShouldEr
 	require.Empty(t, value)
 	require.Equal(t, errExpected, err)
 }
+
+func TestSCQueryProcessor_ExecuteQueries(t *testing.T) {
+	t.Parallel()
+
+	errObservers := errors.New("no observer in shard")
+	mutCalls := sync.Mutex{}
+	getObserversCalls := make(map[uint32]int)
+	processor, _ := NewSCQueryProcessor(&mock.ProcessorStub{
+		ComputeShardIdCalled: func(addressBuff []byte) (uint32, error) {
+			return uint32(addressBuff[0] % 3), nil
+		},
+		GetObserversCalled: func(shardId uint32) ([]*data.NodeData, error) {
+			mutCalls.Lock()
+			getObserversCalls[shardId]++
+			mutCalls.Unlock()
+
+			if shardId == 2 {
+				return nil, errObservers
+			}
+
+			return []*data.NodeData{{Address: "observer", ShardId: shardId}}, nil
+		},
+		CallPostRestEndPointCalled: func(address string, path string, dataValue interface{}, response interface{}) (int, error) {
+			request := dataValue.(data.VmValueRequest)
+			if request.FuncName == "fails" {
+				response.(*data.ResponseVmValue).Error = "function failed"
+				return http.StatusBadRequest, nil
+			}
+
+			response.(*data.ResponseVmValue).Data.Data = &vm.VMOutputApi{
+				ReturnData: [][]byte{[]byte(request.FuncName)},
+			}
+			return http.StatusOK, nil
+		},
+	}, testPubKeyConverter)
+
+	queries := make([]*data.SCQuery, 0)
+	expectedShards := make([]uint32, 0)
+	for i := 0; i < 6; i++ {
+		address := bytes.Repeat([]byte{byte(i)}, 32)
+		funcName := fmt.Sprintf("func%d", i)
+		if i == 3 {
+			funcName = "fails"
+		}
+		queries = append(queries, &data.SCQuery{ScAddress: testPubKeyConverter.Encode(address), FuncName: funcName})
+		expectedShards = append(expectedShards, uint32(i%3))
+	}
+	queries = append(queries, &data.SCQuery{ScAddress: "invalid address"})
+
+	results := processor.ExecuteQueries(queries)
+	require.Len(t, results, len(queries))
+	for i := 0; i < 6; i++ {
+		switch {
+		case expectedShards[i] == 2:
+			require.Equal(t, errObservers, results[i].Err)
+		case i == 3:
+			require.Equal(t, "function failed", results[i].Err.Error())
+		default:
+			require.Nil(t, results[i].Err)
+			require.Equal(t, queries[i].FuncName, string(results[i].VMOutput.ReturnData[0]))
+		}
+	}
+	require.NotNil(t, results[6].Err)
+	require.Equal(t, map[uint32]int{0: 1, 1: 1, 2: 1}, getObserversCalls)
+}
+
+func TestSCQueryProcessor_ExecuteQueriesOnObserver(t *testing.T) {
+	t.Parallel()
+
+	mutCalls := sync.Mutex{}
+	queriedObservers := make(map[string]int)
+	processor, _ := NewSCQueryProcessor(&mock.ProcessorStub{
+		GetObserversCalled: func(shardId uint32) ([]*data.NodeData, error) {
+			require.Fail(t, "should not have fetched the observers")
+			return nil, nil
+		},
+		CallPostRestEndPointCalled: func(address string, path string, dataValue interface{}, response interface{}) (int, error) {
+			mutCalls.Lock()
+			queriedObservers[address]++
+			mutCalls.Unlock()
+
+			request := dataValue.(data.VmValueRequest)
+			response.(*data.ResponseVmValue).Data.Data = &vm.VMOutputApi{
+				ReturnData: [][]byte{[]byte(request.FuncName)},
+			}
+			return http.StatusOK, nil
+		},
+	}, testPubKeyConverter)
+	observer := &data.NodeData{Address: "polled observer", ShardId: 1}
+
+	vmOutput, err := processor.ExecuteQueryOnObserver(&data.SCQuery{FuncName: "func"}, observer)
+	require.Nil(t, err)
+	require.Equal(t, "func", string(vmOutput.ReturnData[0]))
+
+	results := processor.ExecuteQueriesOnObserver([]*data.SCQuery{{FuncName: "func0"}, {FuncName: "func1"}}, observer)
+	require.Len(t, results, 2)
+	for i, result := range results {
+		require.Nil(t, result.Err)
+		require.Equal(t, fmt.Sprintf("func%d", i), string(result.VMOutput.ReturnData[0]))
+	}
+	require.Equal(t, map[string]int{"polled observer": 3}, queriedObservers)
+}
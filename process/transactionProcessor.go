@@ -30,6 +30,10 @@ const MultipleTransactionsPath = "/transaction/send-multiple"
 // TransactionCostPath defines the transaction's cost path of the node
 const TransactionCostPath = "/transaction/cost"
 
+// TransactionsPoolPath defines the transactions pool path of the node, asking only for the hashes of the transactions.
+// The route is not exposed by the pinned elrond-go v1.1.29 nodes, whose pools cannot be read
+const TransactionsPoolPath = "/transaction/pool?fields=hash"
+
 // UnknownStatusTx defines the response that should be received from an observer when transaction status is unknown
 const UnknownStatusTx = "unknown"
 
@@ -354,6 +358,74 @@ func (tp *TransactionProcessor) TransactionCostRequest(tx *data.Transaction) (*d
 	return nil, ErrSendingRequest
 }
 
+// GetTransactionsPool returns the transactions found in the pools of the observers from all shards. The transactions
+// of the shards are interleaved, so that truncating the lists does not favour any shard. A shard whose observers cannot
+// be reached is skipped
+func (tp *TransactionProcessor) GetTransactionsPool() (*data.TransactionsPool, error) {
+	regularTxs := make([][]data.WrappedTransaction, 0)
+	scResults := make([][]data.WrappedTransaction, 0)
+	rewards := make([][]data.WrappedTransaction, 0)
+	for _, shardID := range tp.proc.GetShardIDs() {
+		shardTxsPool, err := tp.getTransactionsPoolFromShard(shardID)
+		if err != nil {
+			log.Debug("cannot get transactions pool", "shard", shardID, "error", err)
+			continue
+		}
+
+		regularTxs = append(regularTxs, shardTxsPool.RegularTransactions)
+		scResults = append(scResults, shardTxsPool.SmartContractResults)
+		rewards = append(rewards, shardTxsPool.Rewards)
+	}
+
+	if len(regularTxs) == 0 {
+		return nil, ErrSendingRequest
+	}
+
+	return &data.TransactionsPool{
+		RegularTransactions:  interleaveTransactions(regularTxs),
+		SmartContractResults: interleaveTransactions(scResults),
+		Rewards:              interleaveTransactions(rewards),
+	}, nil
+}
+
+// interleaveTransactions merges the lists by taking one transaction from each list in turn
+func interleaveTransactions(lists [][]data.WrappedTransaction) []data.WrappedTransaction {
+	interleaved := make([]data.WrappedTransaction, 0)
+	for i := 0; ; i++ {
+		hasRemainingTxs := false
+		for _, list := range lists {
+			if i < len(list) {
+				interleaved = append(interleaved, list[i])
+				hasRemainingTxs = true
+			}
+		}
+		if !hasRemainingTxs {
+			return interleaved
+		}
+	}
+}
+
+func (tp *TransactionProcessor) getTransactionsPoolFromShard(shardID uint32) (*data.TransactionsPool, error) {
+	observers, err := tp.proc.GetObservers(shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, observer := range observers {
+		txsPoolResponse := &data.TransactionsPoolApiResponse{}
+		_, err = tp.proc.CallGetRestEndPoint(observer.Address, TransactionsPoolPath, txsPoolResponse)
+		if err != nil {
+			log.Trace("cannot get transactions pool", "address", observer.Address, "error", err)
+			continue
+		}
+
+		log.Trace("transactions pool request", "shard", shardID, "observer", observer.Address)
+		return &txsPoolResponse.Data.Transactions, nil
+	}
+
+	return nil, ErrSendingRequest
+}
+
 // GetTransaction should return a transaction from observer
 func (tp *TransactionProcessor) GetTransaction(txHash string, withResults bool) (*data.FullTransaction, error) {
 	tx, err := tp.getTxFromObservers(txHash, requestTypeFullHistoryNodes, withResults)
@@ -697,16 +769,27 @@ func (tp *TransactionProcessor) checkTransactionFields(tx *data.Transaction) err
 // ComputeTransactionHash will compute the hash of a given transaction
 // TODO move to node
 func (tp *TransactionProcessor) ComputeTransactionHash(tx *data.Transaction) (string, error) {
+	return ComputeTransactionHash(tx, tp.pubKeyConverter, tp.marshalizer, tp.hasher)
+}
+
+// ComputeTransactionHash will compute the hash of a given transaction using the provided components. It does not
+// need any observer, so it can be used offline
+func ComputeTransactionHash(
+	tx *data.Transaction,
+	pubKeyConverter core.PubkeyConverter,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+) (string, error) {
 	valueBig, ok := big.NewInt(0).SetString(tx.Value, 10)
 	if !ok {
 		return "", ErrInvalidTransactionValueField
 	}
-	receiverAddress, err := tp.pubKeyConverter.Decode(tx.Receiver)
+	receiverAddress, err := pubKeyConverter.Decode(tx.Receiver)
 	if err != nil {
 		return "", ErrInvalidAddress
 	}
 
-	senderAddress, err := tp.pubKeyConverter.Decode(tx.Sender)
+	senderAddress, err := pubKeyConverter.Decode(tx.Sender)
 	if err != nil {
 		return "", ErrInvalidAddress
 	}
@@ -729,7 +812,7 @@ func (tp *TransactionProcessor) ComputeTransactionHash(tx *data.Transaction) (st
 		Signature: signatureBytes,
 	}
 
-	txHash, err := core.CalculateHash(tp.marshalizer, tp.hasher, regularTx)
+	txHash, err := core.CalculateHash(marshalizer, hasher, regularTx)
 	if err != nil {
 		return "", nil
 	}
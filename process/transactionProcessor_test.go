@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/hex"
 	"errors"
+	"fmt"
 	"math/big"
 	"net/http"
 	"sync/atomic"
@@ -1172,3 +1173,118 @@ func TestTransactionProcessor_GetTransactionWithEventsFirstFromDstShardAndAfterS
 	assert.Equal(t, expectedNonce, tx.Nonce)
 	assert.Equal(t, 3, len(tx.ScResults))
 }
+
+func TestTransactionProcessor_GetTransactionsPoolShouldMergeShardsAndSkipUnavailableObservers(t *testing.T) {
+	t.Parallel()
+
+	addrObs0 := "observer0"
+	addrObs1 := "observer1"
+	addrObs2 := "observer2"
+
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{0, 1}
+			},
+			GetObserversCalled: func(shardId uint32) ([]*data.NodeData, error) {
+				if shardId == 0 {
+					return []*data.NodeData{
+						{Address: addrObs0, ShardId: 0},
+						{Address: addrObs1, ShardId: 0},
+					}, nil
+				}
+
+				return []*data.NodeData{
+					{Address: addrObs2, ShardId: 1},
+				}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				assert.Equal(t, process.TransactionsPoolPath, path)
+				if address == addrObs0 {
+					return http.StatusRequestTimeout, errors.New("timeout")
+				}
+
+				txsPoolResponse := value.(*data.TransactionsPoolApiResponse)
+				txsPoolResponse.Data.Transactions.RegularTransactions = []data.WrappedTransaction{
+					{TxFields: map[string]interface{}{"hash": address}},
+				}
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		hasher,
+		marshalizer,
+	)
+
+	txsPool, err := tp.GetTransactionsPool()
+	require.Nil(t, err)
+	require.Len(t, txsPool.RegularTransactions, 2)
+	assert.Equal(t, addrObs1, txsPool.RegularTransactions[0].TxFields["hash"])
+	assert.Equal(t, addrObs2, txsPool.RegularTransactions[1].TxFields["hash"])
+}
+
+func TestTransactionProcessor_GetTransactionsPoolShouldInterleaveTheShards(t *testing.T) {
+	t.Parallel()
+
+	wrapTxs := func(hashes ...string) []data.WrappedTransaction {
+		wrappedTxs := make([]data.WrappedTransaction, 0, len(hashes))
+		for _, hash := range hashes {
+			wrappedTxs = append(wrappedTxs, data.WrappedTransaction{TxFields: map[string]interface{}{"hash": hash}})
+		}
+		return wrappedTxs
+	}
+	poolsPerObserver := map[string][]data.WrappedTransaction{
+		"observer0": wrapTxs("a0", "a1", "a2"),
+		"observer1": wrapTxs("b0"),
+		"observer2": wrapTxs("c0", "c1"),
+	}
+
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{0, 1, 2}
+			},
+			GetObserversCalled: func(shardId uint32) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: fmt.Sprintf("observer%d", shardId), ShardId: shardId}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				txsPoolResponse := value.(*data.TransactionsPoolApiResponse)
+				txsPoolResponse.Data.Transactions.RegularTransactions = poolsPerObserver[address]
+				return http.StatusOK, nil
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		hasher,
+		marshalizer,
+	)
+
+	txsPool, err := tp.GetTransactionsPool()
+	require.Nil(t, err)
+	assert.Equal(t, wrapTxs("a0", "b0", "c0", "a1", "c1", "a2"), txsPool.RegularTransactions)
+	assert.Empty(t, txsPool.SmartContractResults)
+}
+
+func TestTransactionProcessor_GetTransactionsPoolNoShardAvailableShouldErr(t *testing.T) {
+	t.Parallel()
+
+	tp, _ := process.NewTransactionProcessor(
+		&mock.ProcessorStub{
+			GetShardIDsCalled: func() []uint32 {
+				return []uint32{0}
+			},
+			GetObserversCalled: func(shardId uint32) ([]*data.NodeData, error) {
+				return []*data.NodeData{{Address: "observer0", ShardId: 0}}, nil
+			},
+			CallGetRestEndPointCalled: func(address string, path string, value interface{}) (int, error) {
+				return http.StatusNotFound, errors.New("not found")
+			},
+		},
+		&mock.PubKeyConverterMock{},
+		hasher,
+		marshalizer,
+	)
+
+	txsPool, err := tp.GetTransactionsPool()
+	assert.Nil(t, txsPool)
+	assert.Equal(t, process.ErrSendingRequest, err)
+}
@@ -5,6 +5,9 @@ import (
 	"net/http"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/data/state/factory"
+	hasherFactory "github.com/ElrondNetwork/elrond-go/hashing/factory"
+	marshalFactory "github.com/ElrondNetwork/elrond-go/marshal/factory"
 	"github.com/ElrondNetwork/elrond-proxy-go/api"
 	"github.com/ElrondNetwork/elrond-proxy-go/config"
 	"github.com/ElrondNetwork/elrond-proxy-go/rosetta/configuration"
@@ -17,27 +20,113 @@ import (
 
 var log = logger.GetOrCreate("rosetta")
 
-// CreateServer creates a HTTP server
-func CreateServer(elrondFacade api.ElrondProxyHandler, generalConfig *config.Config, port int) (*http.Server, error) {
-	elrondProvider, err := provider.NewElrondProvider(elrondFacade)
+// NetworkArgs holds the facade and the config of a network served by the rosetta server
+type NetworkArgs struct {
+	Facade        api.ElrondProxyHandler
+	GeneralConfig *config.Config
+}
+
+// CreateServer creates a HTTP server serving one or more networks. The requests are routed by their network identifier
+func CreateServer(networks []NetworkArgs, port int) (*http.Server, error) {
+	if len(networks) == 0 {
+		return nil, ErrNoNetwork
+	}
+
+	router := newNetworkRouter()
+	for _, network := range networks {
+		elrondProvider, err := provider.NewElrondProvider(network.Facade)
+		if err != nil {
+			log.Error("cannot create elrond provider", "err", err)
+			return nil, err
+		}
+
+		networkConfig, err := elrondProvider.GetNetworkConfig()
+		if err != nil {
+			log.Error("cannot get network config", "err", err)
+			return nil, err
+		}
+
+		cfg := configuration.LoadConfiguration(networkConfig, network.GeneralConfig)
+		err = configuration.DetectGenesisBlockHash(cfg, network.GeneralConfig, elrondProvider)
+		if err != nil {
+			log.Error("cannot detect the genesis block", "network", cfg.Network.Network, "err", err)
+			return nil, err
+		}
+
+		handler, err := createNetworkHandler(elrondProvider, cfg, networkConfig)
+		if err != nil {
+			return nil, err
+		}
+
+		err = router.addNetwork(cfg.Network, handler)
+		if err != nil {
+			log.Error("cannot serve network", "network", cfg.Network.Network, "err", err)
+			return nil, err
+		}
+	}
+
+	return createHTTPServer(router, port), nil
+}
+
+// CreateOfflineServer creates a HTTP server that does not use any observer. The network config is the provided static one
+// and only the endpoints which do not need the network state are served
+func CreateOfflineServer(generalConfig *config.Config, offlineConfig *config.RosettaOfflineConfig, port int) (*http.Server, error) {
+	pubKeyConverter, err := factory.NewPubkeyConverter(generalConfig.AddressPubkeyConverter)
+	if err != nil {
+		return nil, err
+	}
+	marshalizer, err := marshalFactory.NewMarshalizer(generalConfig.Marshalizer.Type)
+	if err != nil {
+		return nil, err
+	}
+	hasher, err := hasherFactory.NewHasher(generalConfig.Hasher.Type)
 	if err != nil {
-		log.Error("cannot create elrond provider", "err", err)
 		return nil, err
 	}
 
-	networkConfig, err := elrondProvider.GetNetworkConfig()
+	networkConfig := &provider.NetworkConfig{
+		ChainID:        offlineConfig.ChainID,
+		Denomination:   offlineConfig.Denomination,
+		GasPerDataByte: offlineConfig.GasPerDataByte,
+		MinGasPrice:    offlineConfig.MinGasPrice,
+		MinGasLimit:    offlineConfig.MinGasLimit,
+		MinTxVersion:   offlineConfig.MinTxVersion,
+	}
+	offlineProvider, err := provider.NewOfflineElrondProvider(networkConfig, pubKeyConverter, marshalizer, hasher)
 	if err != nil {
-		log.Error("cannot get network config", "err", err)
+		log.Error("cannot create offline elrond provider", "err", err)
 		return nil, err
 	}
 
 	cfg := configuration.LoadConfiguration(networkConfig, generalConfig)
+	cfg.Peers = make([]*types.Peer, 0)
+	cfg.IsOffline = true
 
+	handler, err := createNetworkHandler(offlineProvider, cfg, networkConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	router := newNetworkRouter()
+	err = router.addNetwork(cfg.Network, handler)
+	if err != nil {
+		return nil, err
+	}
+
+	return createHTTPServer(router, port), nil
+}
+
+// createNetworkHandler creates the rosetta services of a network
+func createNetworkHandler(
+	elrondProvider provider.ElrondProviderHandler,
+	cfg *configuration.Configuration,
+	networkConfig *provider.NetworkConfig,
+) (http.Handler, error) {
 	// The asserter automatically rejects incorrectly formatted
 	// requests.
 	asserterServer, err := asserter.NewServer(
 		services.SupportedOperationTypes,
-		false,
+		true,
 		[]*types.NetworkIdentifier{
 			cfg.Network,
 		},
@@ -84,21 +173,39 @@ func CreateServer(elrondFacade api.ElrondProxyHandler, generalConfig *config.Con
 		asserterServer,
 	)
 
+	// Create search service
+	searchAPIService := services.NewSearchAPIService(elrondProvider, cfg, networkConfig)
+	searchAPIController := server.NewSearchAPIController(
+		searchAPIService,
+		asserterServer,
+	)
+
+	// Create events service
+	eventsAPIService := services.NewEventsAPIService(elrondProvider, cfg)
+	eventsAPIController := server.NewEventsAPIController(
+		eventsAPIService,
+		asserterServer,
+	)
+
 	router := server.NewRouter(
 		networkAPIController,
 		accountAPIController,
 		blockAPIController,
 		constructionAPIController,
 		mempoolAPIController,
+		searchAPIController,
+		eventsAPIController,
 	)
 
-	loggedRouter := server.LoggerMiddleware(router)
+	return router, nil
+}
+
+func createHTTPServer(handler http.Handler, port int) *http.Server {
+	loggedRouter := server.LoggerMiddleware(handler)
 	corsRouter := server.CorsMiddleware(loggedRouter)
 
-	httpServer := &http.Server{
+	return &http.Server{
 		Addr:    fmt.Sprintf(":%d", port),
 		Handler: corsRouter,
 	}
-
-	return httpServer, nil
 }
@@ -2,8 +2,12 @@ package configuration
 
 import (
 	"encoding/hex"
+	"errors"
+	"fmt"
 
+	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-proxy-go/config"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
 	"github.com/ElrondNetwork/elrond-proxy-go/rosetta/provider"
 	"github.com/coinbase/rosetta-sdk-go/types"
 )
@@ -19,18 +23,147 @@ const (
 	// GenesisBlockHash is const that will keep genesis block hash in hex format
 	GenesisBlockHashMainnet = "cd229e4ad2753708e4bab01d7f249affe29441829524c9529e84d51b6d12f2a7"
 	TestnetGenesisBlock     = "0000000000000000000000000000000000000000000000000000000000000000"
+
+	// DefaultGenesisBlockIndex is the index of the genesis block, if not configured
+	DefaultGenesisBlockIndex = 1
+	// DefaultOldestBlockLookback is the number of blocks reported as available before the latest one, if not configured
+	DefaultOldestBlockLookback = 200
+	// DefaultDelegationGasLimit is the gas limit of the delegation calls, if not configured, as recommended for the
+	// delegation contracts
+	DefaultDelegationGasLimit = 12000000
+	// DefaultClaimRewardsGasLimit is the gas limit of the claimRewards delegation call, if not configured
+	DefaultClaimRewardsGasLimit = 6000000
 )
 
+var log = logger.GetOrCreate("rosetta/configuration")
+
+// ErrCannotDetectGenesisBlockHash signals that the genesis block hash is not configured and cannot be fetched
+var ErrCannotDetectGenesisBlockHash = errors.New("cannot detect the genesis block hash, set the GenesisBlockHash in the rosetta config")
+
+// GenesisBlockProvider defines what is needed to detect the genesis block
+type GenesisBlockProvider interface {
+	GetBlockByNonce(nonce int64) (*data.Hyperblock, error)
+}
+
 // Configuration is structure used for rosetta provider configuration
 type Configuration struct {
 	Network                *types.NetworkIdentifier
 	Currency               *types.Currency
 	GenesisBlockIdentifier *types.BlockIdentifier
 	Peers                  []*types.Peer
+	ESDTCurrencies         []*types.Currency
+	OldestBlockLookback    uint64
+	DelegationGasLimits    config.RosettaDelegationGasLimitsConfig
+	IsOffline              bool
+}
+
+// GetESDTCurrency returns the currency of a tracked ESDT token
+func (c *Configuration) GetESDTCurrency(tokenIdentifier string) (*types.Currency, bool) {
+	for _, currency := range c.ESDTCurrencies {
+		if currency.Symbol == tokenIdentifier {
+			return currency, true
+		}
+	}
+
+	return nil, false
 }
 
 //LoadConfiguration will load configuration
 func LoadConfiguration(networkConfig *provider.NetworkConfig, generalConfig *config.Config) *Configuration {
+	rosettaConfig := generalConfig.Rosetta
+
+	esdtCurrencies := make([]*types.Currency, len(rosettaConfig.ESDTCurrencies))
+	for idx, esdtCurrency := range rosettaConfig.ESDTCurrencies {
+		esdtCurrencies[idx] = &types.Currency{
+			Symbol:   esdtCurrency.Identifier,
+			Decimals: esdtCurrency.Decimals,
+		}
+	}
+
+	decimals := int32(NumDecimals)
+	if networkConfig.Denomination > 0 {
+		decimals = int32(networkConfig.Denomination)
+	}
+	if rosettaConfig.CurrencyDecimals > 0 {
+		decimals = rosettaConfig.CurrencyDecimals
+	}
+
+	symbol := TestnetElrondSymbol
+	genesisBlockHash := TestnetGenesisBlock
+	if networkConfig.ChainID == MainnetChainID {
+		symbol = MainnetElrondSymbol
+		genesisBlockHash = GenesisBlockHashMainnet
+	}
+	if len(rosettaConfig.CurrencySymbol) > 0 {
+		symbol = rosettaConfig.CurrencySymbol
+	}
+	if len(rosettaConfig.GenesisBlockHash) > 0 {
+		genesisBlockHash = rosettaConfig.GenesisBlockHash
+	}
+
+	genesisBlockIndex := int64(DefaultGenesisBlockIndex)
+	if rosettaConfig.GenesisBlockIndex != nil {
+		genesisBlockIndex = *rosettaConfig.GenesisBlockIndex
+	}
+
+	oldestBlockLookback := uint64(DefaultOldestBlockLookback)
+	if rosettaConfig.OldestBlockLookback > 0 {
+		oldestBlockLookback = rosettaConfig.OldestBlockLookback
+	}
+
+	return &Configuration{
+		Network: &types.NetworkIdentifier{
+			Blockchain: BlockchainName,
+			Network:    networkConfig.ChainID,
+		},
+		Currency: &types.Currency{
+			Symbol:   symbol,
+			Decimals: decimals,
+		},
+		GenesisBlockIdentifier: &types.BlockIdentifier{
+			Index: genesisBlockIndex,
+			Hash:  genesisBlockHash,
+		},
+		Peers:               loadPeers(generalConfig),
+		ESDTCurrencies:      esdtCurrencies,
+		OldestBlockLookback: oldestBlockLookback,
+		DelegationGasLimits: loadDelegationGasLimits(rosettaConfig.DelegationGasLimits),
+	}
+}
+
+func loadDelegationGasLimits(gasLimits config.RosettaDelegationGasLimitsConfig) config.RosettaDelegationGasLimitsConfig {
+	valueOrDefault := func(value uint64, defaultValue uint64) uint64 {
+		if value > 0 {
+			return value
+		}
+		return defaultValue
+	}
+
+	return config.RosettaDelegationGasLimitsConfig{
+		Delegate:          valueOrDefault(gasLimits.Delegate, DefaultDelegationGasLimit),
+		UnDelegate:        valueOrDefault(gasLimits.UnDelegate, DefaultDelegationGasLimit),
+		Withdraw:          valueOrDefault(gasLimits.Withdraw, DefaultDelegationGasLimit),
+		ClaimRewards:      valueOrDefault(gasLimits.ClaimRewards, DefaultClaimRewardsGasLimit),
+		ReDelegateRewards: valueOrDefault(gasLimits.ReDelegateRewards, DefaultDelegationGasLimit),
+	}
+}
+
+func loadPeers(generalConfig *config.Config) []*types.Peer {
+	if len(generalConfig.Rosetta.Peers) > 0 {
+		peers := make([]*types.Peer, len(generalConfig.Rosetta.Peers))
+		for idx, peerConfig := range generalConfig.Rosetta.Peers {
+			peers[idx] = &types.Peer{
+				PeerID: peerConfig.PeerID,
+				Metadata: map[string]interface{}{
+					"address": peerConfig.Address,
+					"shardID": peerConfig.ShardID,
+				},
+			}
+		}
+
+		return peers
+	}
+
 	peers := make([]*types.Peer, len(generalConfig.Observers))
 	for idx, observer := range generalConfig.Observers {
 		peer := &types.Peer{
@@ -43,39 +176,28 @@ func LoadConfiguration(networkConfig *provider.NetworkConfig, generalConfig *con
 		peers[idx] = peer
 	}
 
-	switch networkConfig.ChainID {
-	case MainnetChainID:
-		return &Configuration{
-			Network: &types.NetworkIdentifier{
-				Blockchain: BlockchainName,
-				Network:    networkConfig.ChainID,
-			},
-			Currency: &types.Currency{
-				Symbol:   MainnetElrondSymbol,
-				Decimals: NumDecimals,
-			},
-			GenesisBlockIdentifier: &types.BlockIdentifier{
-				Index: 1,
-				Hash:  GenesisBlockHashMainnet,
-			},
-			Peers: peers,
-		}
-	default:
-		// other testnets
-		return &Configuration{
-			Network: &types.NetworkIdentifier{
-				Blockchain: BlockchainName,
-				Network:    networkConfig.ChainID,
-			},
-			Currency: &types.Currency{
-				Symbol:   TestnetElrondSymbol,
-				Decimals: NumDecimals,
-			},
-			GenesisBlockIdentifier: &types.BlockIdentifier{
-				Index: 1,
-				Hash:  TestnetGenesisBlock,
-			},
-			Peers: peers,
-		}
+	return peers
+}
+
+// DetectGenesisBlockHash sets the hash of the genesis block to the hash of the metachain block with the genesis index,
+// unless the hash is configured. If the block cannot be fetched, the known mainnet hash is kept, while on the other
+// networks an error is returned, as there is no known hash to fall back to
+func DetectGenesisBlockHash(cfg *Configuration, generalConfig *config.Config, blockProvider GenesisBlockProvider) error {
+	if len(generalConfig.Rosetta.GenesisBlockHash) > 0 {
+		return nil
+	}
+
+	genesisBlock, err := blockProvider.GetBlockByNonce(cfg.GenesisBlockIdentifier.Index)
+	if err == nil {
+		cfg.GenesisBlockIdentifier.Hash = genesisBlock.Hash
+		return nil
 	}
+	if cfg.Network.Network != MainnetChainID {
+		return fmt.Errorf("%w: index %d, %v", ErrCannotDetectGenesisBlockHash, cfg.GenesisBlockIdentifier.Index, err)
+	}
+
+	log.Warn("cannot detect the genesis block hash, the mainnet one is used",
+		"index", cfg.GenesisBlockIdentifier.Index, "hash", cfg.GenesisBlockIdentifier.Hash, "error", err.Error())
+
+	return nil
 }
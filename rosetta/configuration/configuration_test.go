@@ -0,0 +1,133 @@
+package configuration
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/config"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/rosetta/provider"
+	"github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/stretchr/testify/require"
+)
+
+type genesisBlockProviderStub struct {
+	GetBlockByNonceCalled func(nonce int64) (*data.Hyperblock, error)
+}
+
+func (gbps *genesisBlockProviderStub) GetBlockByNonce(nonce int64) (*data.Hyperblock, error) {
+	return gbps.GetBlockByNonceCalled(nonce)
+}
+
+func TestLoadConfiguration_Defaults(t *testing.T) {
+	t.Parallel()
+
+	generalConfig := &config.Config{
+		Observers: []*data.NodeData{{ShardId: 0, Address: "http://observer"}},
+	}
+
+	cfg := LoadConfiguration(&provider.NetworkConfig{ChainID: MainnetChainID}, generalConfig)
+	require.Equal(t, &types.Currency{Symbol: MainnetElrondSymbol, Decimals: NumDecimals}, cfg.Currency)
+	require.Equal(t, &types.BlockIdentifier{Index: DefaultGenesisBlockIndex, Hash: GenesisBlockHashMainnet}, cfg.GenesisBlockIdentifier)
+	require.Equal(t, uint64(DefaultOldestBlockLookback), cfg.OldestBlockLookback)
+	require.Equal(t, config.RosettaDelegationGasLimitsConfig{
+		Delegate:          DefaultDelegationGasLimit,
+		UnDelegate:        DefaultDelegationGasLimit,
+		Withdraw:          DefaultDelegationGasLimit,
+		ClaimRewards:      DefaultClaimRewardsGasLimit,
+		ReDelegateRewards: DefaultDelegationGasLimit,
+	}, cfg.DelegationGasLimits)
+	require.Len(t, cfg.Peers, 1)
+	require.Equal(t, "http://observer", cfg.Peers[0].Metadata["address"])
+
+	cfg = LoadConfiguration(&provider.NetworkConfig{ChainID: "T", Denomination: 6}, generalConfig)
+	require.Equal(t, &types.NetworkIdentifier{Blockchain: BlockchainName, Network: "T"}, cfg.Network)
+	require.Equal(t, &types.Currency{Symbol: TestnetElrondSymbol, Decimals: 6}, cfg.Currency)
+	require.Equal(t, TestnetGenesisBlock, cfg.GenesisBlockIdentifier.Hash)
+}
+
+func TestLoadConfiguration_Configured(t *testing.T) {
+	t.Parallel()
+
+	genesisBlockIndex := int64(5)
+	generalConfig := &config.Config{
+		Observers: []*data.NodeData{{ShardId: 0, Address: "http://observer"}},
+		Rosetta: config.RosettaConfig{
+			GenesisBlockIndex:   &genesisBlockIndex,
+			GenesisBlockHash:    "genesis",
+			CurrencySymbol:      "DEV",
+			CurrencyDecimals:    8,
+			OldestBlockLookback: 50,
+			Peers:               []config.RosettaPeerConfig{{PeerID: "peer", Address: "http://peer", ShardID: 1}},
+			DelegationGasLimits: config.RosettaDelegationGasLimitsConfig{Delegate: 15000000, ClaimRewards: 7000000},
+		},
+	}
+
+	cfg := LoadConfiguration(&provider.NetworkConfig{ChainID: "D", Denomination: 18}, generalConfig)
+	require.Equal(t, &types.Currency{Symbol: "DEV", Decimals: 8}, cfg.Currency)
+	require.Equal(t, &types.BlockIdentifier{Index: 5, Hash: "genesis"}, cfg.GenesisBlockIdentifier)
+	require.Equal(t, uint64(50), cfg.OldestBlockLookback)
+	require.Equal(t, uint64(15000000), cfg.DelegationGasLimits.Delegate)
+	require.Equal(t, uint64(7000000), cfg.DelegationGasLimits.ClaimRewards)
+	require.Equal(t, uint64(DefaultDelegationGasLimit), cfg.DelegationGasLimits.Withdraw)
+	require.Equal(t, []*types.Peer{
+		{
+			PeerID:   "peer",
+			Metadata: map[string]interface{}{"address": "http://peer", "shardID": uint32(1)},
+		},
+	}, cfg.Peers)
+}
+
+func TestDetectGenesisBlockHash(t *testing.T) {
+	t.Parallel()
+
+	generalConfig := &config.Config{}
+	cfg := LoadConfiguration(&provider.NetworkConfig{ChainID: "D"}, generalConfig)
+	blockProvider := &genesisBlockProviderStub{
+		GetBlockByNonceCalled: func(nonce int64) (*data.Hyperblock, error) {
+			require.Equal(t, int64(DefaultGenesisBlockIndex), nonce)
+			return &data.Hyperblock{Nonce: uint64(nonce), Hash: "detected"}, nil
+		},
+	}
+
+	err := DetectGenesisBlockHash(cfg, generalConfig, blockProvider)
+	require.Nil(t, err)
+	require.Equal(t, "detected", cfg.GenesisBlockIdentifier.Hash)
+
+	// the genesis block index can be 0
+	genesisBlockIndex := int64(0)
+	generalConfig.Rosetta.GenesisBlockIndex = &genesisBlockIndex
+	cfg = LoadConfiguration(&provider.NetworkConfig{ChainID: "D"}, generalConfig)
+	blockProvider.GetBlockByNonceCalled = func(nonce int64) (*data.Hyperblock, error) {
+		require.Equal(t, int64(0), nonce)
+		return &data.Hyperblock{Nonce: uint64(nonce), Hash: "genesis"}, nil
+	}
+	err = DetectGenesisBlockHash(cfg, generalConfig, blockProvider)
+	require.Nil(t, err)
+	require.Equal(t, &types.BlockIdentifier{Index: 0, Hash: "genesis"}, cfg.GenesisBlockIdentifier)
+
+	// without a known hash to fall back to, an unavailable block is an error
+	blockProvider.GetBlockByNonceCalled = func(nonce int64) (*data.Hyperblock, error) {
+		return nil, errors.New("offline")
+	}
+	cfg = LoadConfiguration(&provider.NetworkConfig{ChainID: "D"}, generalConfig)
+	err = DetectGenesisBlockHash(cfg, generalConfig, blockProvider)
+	require.True(t, errors.Is(err, ErrCannotDetectGenesisBlockHash))
+
+	// the mainnet hash is kept when the block cannot be fetched
+	cfg = LoadConfiguration(&provider.NetworkConfig{ChainID: MainnetChainID}, generalConfig)
+	err = DetectGenesisBlockHash(cfg, generalConfig, blockProvider)
+	require.Nil(t, err)
+	require.Equal(t, GenesisBlockHashMainnet, cfg.GenesisBlockIdentifier.Hash)
+
+	// a configured hash is not detected
+	generalConfig.Rosetta.GenesisBlockHash = "configured"
+	cfg = LoadConfiguration(&provider.NetworkConfig{ChainID: "D"}, generalConfig)
+	blockProvider.GetBlockByNonceCalled = func(nonce int64) (*data.Hyperblock, error) {
+		require.Fail(t, "should not fetch the genesis block")
+		return nil, nil
+	}
+	err = DetectGenesisBlockHash(cfg, generalConfig, blockProvider)
+	require.Nil(t, err)
+	require.Equal(t, "configured", cfg.GenesisBlockIdentifier.Hash)
+}
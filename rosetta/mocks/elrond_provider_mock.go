@@ -7,17 +7,25 @@ import (
 
 // ElrondProviderMock -
 type ElrondProviderMock struct {
-	GetNetworkConfigCalled             func() (*provider.NetworkConfig, error)
-	GetLatestBlockDataCalled           func() (*provider.BlockData, error)
-	GetBlockByNonceCalled              func(nonce int64) (*data.Hyperblock, error)
-	GetBlockByHashCalled               func(hash string) (*data.Hyperblock, error)
-	GetAccountCalled                   func(address string) (*data.Account, error)
-	EncodeAddressCalled                func(address []byte) (string, error)
-	SendTxCalled                       func(tx *data.Transaction) (string, error)
-	ComputeTransactionHashCalled       func(tx *data.Transaction) (string, error)
-	CalculateBlockTimestampUnixCalled  func(round uint64) int64
-	GetTransactionByHashFromPoolCalled func(txHash string) (*data.FullTransaction, bool)
-	DecodeAddressCalled                func(address string) ([]byte, error)
+	GetNetworkConfigCalled              func() (*provider.NetworkConfig, error)
+	GetLatestBlockDataCalled            func() (*provider.BlockData, error)
+	GetLatestFinalBlockNonceCalled      func() (uint64, error)
+	GetBlockByNonceCalled               func(nonce int64) (*data.Hyperblock, error)
+	GetBlockByHashCalled                func(hash string) (*data.Hyperblock, error)
+	GetAccountCalled                    func(address string) (*data.Account, error)
+	GetESDTBalanceCalled                func(address string, tokenIdentifier string) (string, error)
+	GetAccountAtRootHashCalled          func(address string, rootHash string) (*data.Account, error)
+	GetESDTBalanceAtRootHashCalled      func(address string, tokenIdentifier string, rootHash string) (string, error)
+	GetStateRootHashForAddressCalled    func(address string, hyperblock *data.Hyperblock) (string, error)
+	EncodeAddressCalled                 func(address []byte) (string, error)
+	SendTxCalled                        func(tx *data.Transaction) (string, error)
+	ComputeTransactionHashCalled        func(tx *data.Transaction) (string, error)
+	CalculateBlockTimestampUnixCalled   func(round uint64) int64
+	GetTransactionByHashFromPoolCalled  func(txHash string) (*data.FullTransaction, bool)
+	GetTransactionsHashesFromPoolCalled func() ([]string, error)
+	GetTransactionByHashCalled          func(txHash string, sender string) (*data.FullTransaction, error)
+	GetTransactionsByAddressCalled      func(address string) ([]data.DatabaseTransaction, error)
+	DecodeAddressCalled                 func(address string) ([]byte, error)
 }
 
 // GetNetworkConfig -
@@ -37,6 +45,15 @@ func (epm *ElrondProviderMock) GetLatestBlockData() (*provider.BlockData, error)
 	return nil, nil
 }
 
+// GetLatestFinalBlockNonce -
+func (epm *ElrondProviderMock) GetLatestFinalBlockNonce() (uint64, error) {
+	if epm.GetLatestFinalBlockNonceCalled != nil {
+		return epm.GetLatestFinalBlockNonceCalled()
+	}
+
+	return 0, nil
+}
+
 // GetBlockByNonce -
 func (epm *ElrondProviderMock) GetBlockByNonce(nonce int64) (*data.Hyperblock, error) {
 	if epm.GetBlockByNonceCalled != nil {
@@ -46,7 +63,10 @@ func (epm *ElrondProviderMock) GetBlockByNonce(nonce int64) (*data.Hyperblock, e
 }
 
 // GetBlockByHash -
-func (epm *ElrondProviderMock) GetBlockByHash(_ string) (*data.Hyperblock, error) {
+func (epm *ElrondProviderMock) GetBlockByHash(hash string) (*data.Hyperblock, error) {
+	if epm.GetBlockByHashCalled != nil {
+		return epm.GetBlockByHashCalled(hash)
+	}
 	return nil, nil
 }
 
@@ -58,6 +78,14 @@ func (epm *ElrondProviderMock) GetAccount(address string) (*data.Account, error)
 	return nil, nil
 }
 
+// GetESDTBalance -
+func (epm *ElrondProviderMock) GetESDTBalance(address string, tokenIdentifier string) (string, error) {
+	if epm.GetESDTBalanceCalled != nil {
+		return epm.GetESDTBalanceCalled(address, tokenIdentifier)
+	}
+	return "", nil
+}
+
 // EncodeAddress -
 func (epm *ElrondProviderMock) EncodeAddress(pubkey []byte) (string, error) {
 	if epm.EncodeAddressCalled != nil {
@@ -95,6 +123,38 @@ func (epm *ElrondProviderMock) GetTransactionByHashFromPool(txHash string) (*dat
 	return nil, false
 }
 
+// GetAccountAtRootHash -
+func (epm *ElrondProviderMock) GetAccountAtRootHash(address string, rootHash string) (*data.Account, error) {
+	if epm.GetAccountAtRootHashCalled != nil {
+		return epm.GetAccountAtRootHashCalled(address, rootHash)
+	}
+	return nil, nil
+}
+
+// GetESDTBalanceAtRootHash -
+func (epm *ElrondProviderMock) GetESDTBalanceAtRootHash(address string, tokenIdentifier string, rootHash string) (string, error) {
+	if epm.GetESDTBalanceAtRootHashCalled != nil {
+		return epm.GetESDTBalanceAtRootHashCalled(address, tokenIdentifier, rootHash)
+	}
+	return "0", nil
+}
+
+// GetStateRootHashForAddress -
+func (epm *ElrondProviderMock) GetStateRootHashForAddress(address string, hyperblock *data.Hyperblock) (string, error) {
+	if epm.GetStateRootHashForAddressCalled != nil {
+		return epm.GetStateRootHashForAddressCalled(address, hyperblock)
+	}
+	return "", nil
+}
+
+// GetTransactionsHashesFromPool -
+func (epm *ElrondProviderMock) GetTransactionsHashesFromPool() ([]string, error) {
+	if epm.GetTransactionsHashesFromPoolCalled != nil {
+		return epm.GetTransactionsHashesFromPoolCalled()
+	}
+	return make([]string, 0), nil
+}
+
 // DecodeAddress -
 func (epm *ElrondProviderMock) DecodeAddress(address string) ([]byte, error) {
 	if epm.DecodeAddressCalled != nil {
@@ -102,3 +162,19 @@ func (epm *ElrondProviderMock) DecodeAddress(address string) ([]byte, error) {
 	}
 	return nil, nil
 }
+
+// GetTransactionByHash -
+func (epm *ElrondProviderMock) GetTransactionByHash(txHash string, sender string) (*data.FullTransaction, error) {
+	if epm.GetTransactionByHashCalled != nil {
+		return epm.GetTransactionByHashCalled(txHash, sender)
+	}
+	return nil, nil
+}
+
+// GetTransactionsByAddress -
+func (epm *ElrondProviderMock) GetTransactionsByAddress(address string) ([]data.DatabaseTransaction, error) {
+	if epm.GetTransactionsByAddressCalled != nil {
+		return epm.GetTransactionsByAddressCalled(address)
+	}
+	return nil, nil
+}
@@ -0,0 +1,88 @@
+package rosetta
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/rosetta/services"
+	"github.com/coinbase/rosetta-sdk-go/server"
+	"github.com/coinbase/rosetta-sdk-go/types"
+)
+
+const (
+	networkListPath = "/network/list"
+
+	// maxRequestBodySize is the maximum size of a request body, which is read before routing the request
+	maxRequestBodySize = 1 << 20
+)
+
+var (
+	// ErrDuplicatedNetwork signals that two of the served networks have the same network identifier
+	ErrDuplicatedNetwork = errors.New("duplicated network identifier")
+
+	// ErrNoNetwork signals that no network was provided to the rosetta server
+	ErrNoNetwork = errors.New("no network provided")
+)
+
+type networkRequest struct {
+	NetworkIdentifier *types.NetworkIdentifier `json:"network_identifier"`
+}
+
+// networkRouter dispatches each request to the handler of the network given by its network identifier
+type networkRouter struct {
+	networks []*types.NetworkIdentifier
+	handlers map[string]http.Handler
+}
+
+func newNetworkRouter() *networkRouter {
+	return &networkRouter{
+		networks: make([]*types.NetworkIdentifier, 0),
+		handlers: make(map[string]http.Handler),
+	}
+}
+
+func (nr *networkRouter) addNetwork(network *types.NetworkIdentifier, handler http.Handler) error {
+	key := types.Hash(network)
+	if _, exists := nr.handlers[key]; exists {
+		return ErrDuplicatedNetwork
+	}
+
+	nr.networks = append(nr.networks, network)
+	nr.handlers[key] = handler
+
+	return nil
+}
+
+// ServeHTTP answers the /network/list requests with all the networks and routes the other requests
+func (nr *networkRouter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == networkListPath {
+		server.EncodeJSONResponse(&types.NetworkListResponse{NetworkIdentifiers: nr.networks}, http.StatusOK, w)
+		return
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestBodySize))
+	if err != nil {
+		server.EncodeJSONResponse(services.ErrInvalidInputParam, http.StatusBadRequest, w)
+		return
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	request := &networkRequest{}
+	err = json.Unmarshal(body, request)
+	if err != nil || request.NetworkIdentifier == nil {
+		// the asserter of any network rejects the request, with the details of the validation
+		nr.handlers[types.Hash(nr.networks[0])].ServeHTTP(w, r)
+		return
+	}
+
+	handler, ok := nr.handlers[types.Hash(request.NetworkIdentifier)]
+	if !ok {
+		server.EncodeJSONResponse(services.ErrUnsupportedNetwork, http.StatusInternalServerError, w)
+		return
+	}
+
+	handler.ServeHTTP(w, r)
+}
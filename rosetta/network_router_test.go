@@ -0,0 +1,99 @@
+package rosetta
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/rosetta/services"
+	"github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/stretchr/testify/require"
+)
+
+func createNetworkHandlerStub(name string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		w.Header().Set("network", name)
+		_, _ = w.Write(body)
+	})
+}
+
+func doRouterRequest(router http.Handler, path string, request interface{}) *httptest.ResponseRecorder {
+	body, _ := json.Marshal(request)
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, path, bytes.NewReader(body)))
+
+	return recorder
+}
+
+func TestNetworkRouter_AddNetworkDuplicatedShouldErr(t *testing.T) {
+	t.Parallel()
+
+	router := newNetworkRouter()
+	network := &types.NetworkIdentifier{Blockchain: "Elrond", Network: "1"}
+
+	require.Nil(t, router.addNetwork(network, createNetworkHandlerStub("mainnet")))
+	require.Equal(t, ErrDuplicatedNetwork, router.addNetwork(network, createNetworkHandlerStub("mainnet")))
+}
+
+func TestNetworkRouter_ServeHTTP(t *testing.T) {
+	t.Parallel()
+
+	mainnet := &types.NetworkIdentifier{Blockchain: "Elrond", Network: "1"}
+	devnet := &types.NetworkIdentifier{Blockchain: "Elrond", Network: "D"}
+	router := newNetworkRouter()
+	require.Nil(t, router.addNetwork(mainnet, createNetworkHandlerStub("mainnet")))
+	require.Nil(t, router.addNetwork(devnet, createNetworkHandlerStub("devnet")))
+
+	recorder := doRouterRequest(router, networkListPath, &types.MetadataRequest{})
+	require.Equal(t, http.StatusOK, recorder.Code)
+	networkList := &types.NetworkListResponse{}
+	require.Nil(t, json.Unmarshal(recorder.Body.Bytes(), networkList))
+	require.Equal(t, []*types.NetworkIdentifier{mainnet, devnet}, networkList.NetworkIdentifiers)
+
+	request := &types.NetworkRequest{NetworkIdentifier: devnet}
+	recorder = doRouterRequest(router, "/network/status", request)
+	require.Equal(t, "devnet", recorder.Header().Get("network"))
+	forwardedRequest := &types.NetworkRequest{}
+	require.Nil(t, json.Unmarshal(recorder.Body.Bytes(), forwardedRequest))
+	require.Equal(t, request, forwardedRequest)
+
+	recorder = doRouterRequest(router, "/network/status", &types.NetworkRequest{NetworkIdentifier: mainnet})
+	require.Equal(t, "mainnet", recorder.Header().Get("network"))
+
+	recorder = doRouterRequest(router, "/network/status", &types.NetworkRequest{
+		NetworkIdentifier: &types.NetworkIdentifier{Blockchain: "Elrond", Network: "T"},
+	})
+	require.Equal(t, http.StatusInternalServerError, recorder.Code)
+	rosettaErr := &types.Error{}
+	require.Nil(t, json.Unmarshal(recorder.Body.Bytes(), rosettaErr))
+	require.Equal(t, services.ErrUnsupportedNetwork, rosettaErr)
+}
+
+func TestNetworkRouter_ServeHTTPTooLargeBodyShouldErr(t *testing.T) {
+	t.Parallel()
+
+	router := newNetworkRouter()
+	require.Nil(t, router.addNetwork(&types.NetworkIdentifier{Blockchain: "Elrond", Network: "1"}, createNetworkHandlerStub("mainnet")))
+
+	body := bytes.Repeat([]byte("a"), maxRequestBodySize+1)
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/network/status", bytes.NewReader(body)))
+
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+	require.Empty(t, recorder.Header().Get("network"))
+	rosettaErr := &types.Error{}
+	require.Nil(t, json.Unmarshal(recorder.Body.Bytes(), rosettaErr))
+	require.Equal(t, services.ErrInvalidInputParam, rosettaErr)
+}
+
+func TestCreateServerWithoutNetworksShouldErr(t *testing.T) {
+	t.Parallel()
+
+	httpServer, err := CreateServer(nil, 8080)
+	require.Nil(t, httpServer)
+	require.Equal(t, ErrNoNetwork, err)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/ElrondNetwork/elrond-proxy-go/api"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 	"github.com/ElrondNetwork/elrond-proxy-go/facade"
+	"github.com/ElrondNetwork/elrond-proxy-go/process"
 )
 
 // ElrondProvider is able to process requests
@@ -17,17 +18,27 @@ type ElrondProvider struct {
 	client                    ElrondProxyClient
 	genesisTime               uint64
 	roundDurationMilliseconds uint64
+	submittedTxs              *submittedTransactions
+	isTxsPoolAvailable        bool
 }
 
 const (
 	MaxRetriesGetNetworkConfig = 20
 	DelayBetweenRetries        = 5 * time.Second
+
+	// MaxHyperblocksToSearchShardBlock is the maximum number of previous hyperblocks searched for the latest
+	// notarized block of a shard, when the requested hyperblock does not notarize any block of that shard
+	MaxHyperblocksToSearchShardBlock = 50
 )
 
 var (
 	log = logger.GetOrCreate("rosetta/provider")
 	// ErrInvalidElrondProxyHandler signals that provided elrond proxy handler is not a elrond proxy provider
 	ErrInvalidElrondProxyHandler = errors.New("invalid elrond proxy handler")
+	// ErrShardBlockNotFound signals that no notarized block of the requested shard could be found
+	ErrShardBlockNotFound = errors.New("no notarized block found for the shard")
+	// ErrStateRootHashNotAvailable signals that the observers did not provide the state root hash of a block
+	ErrStateRootHashNotAvailable = errors.New("state root hash not available")
 
 	_ ElrondProxyClient = (*facade.ElrondProxyFacade)(nil)
 )
@@ -40,7 +51,8 @@ func NewElrondProvider(elrondFacade api.ElrondProxyHandler) (*ElrondProvider, er
 	}
 
 	elrondProvider := &ElrondProvider{
-		client: elrondProxy,
+		client:       elrondProxy,
+		submittedTxs: newSubmittedTransactions(SubmittedTxTimeToLive, MaxTrackedSubmittedTxs),
 	}
 
 	err := elrondProvider.initializeElrondProvider()
@@ -73,6 +85,13 @@ func (ep *ElrondProvider) initializeElrondProvider() error {
 	ep.genesisTime = networkConfig.StartTime
 	ep.roundDurationMilliseconds = networkConfig.RoundDuration
 
+	_, err = ep.client.GetTransactionsPool()
+	ep.isTxsPoolAvailable = err == nil
+	if !ep.isTxsPoolAvailable {
+		log.Warn("the observers do not expose their transactions pool (/transaction/pool), "+
+			"the mempool will only hold the transactions sent through this proxy", "error", err.Error())
+	}
+
 	return nil
 }
 
@@ -144,6 +163,11 @@ func (ep *ElrondProvider) GetLatestBlockData() (*BlockData, error) {
 	}, nil
 }
 
+// GetLatestFinalBlockNonce will return the nonce of the latest block which cannot be reverted anymore
+func (ep *ElrondProvider) GetLatestFinalBlockNonce() (uint64, error) {
+	return ep.client.GetLatestFinalHyperblockNonce()
+}
+
 // GetBlockByNonce will return a block by nonce
 func (ep *ElrondProvider) GetBlockByNonce(nonce int64) (*data.Hyperblock, error) {
 	blockResponse, err := ep.client.GetHyperBlockByNonce(uint64(nonce))
@@ -189,6 +213,130 @@ func (ep *ElrondProvider) GetAccount(address string) (*data.Account, error) {
 	return ep.client.GetAccount(address)
 }
 
+// GetAccountAtRootHash will return the state of an account at the given state root hash
+func (ep *ElrondProvider) GetAccountAtRootHash(address string, rootHash string) (*data.Account, error) {
+	return ep.client.GetAccountAtRootHash(address, rootHash)
+}
+
+// GetESDTBalance will return the balance of an ESDT token owned by an address
+func (ep *ElrondProvider) GetESDTBalance(address string, tokenIdentifier string) (string, error) {
+	tokenResponse, err := ep.client.GetESDTTokenData(address, tokenIdentifier)
+
+	return ep.parseESDTBalance(address, tokenIdentifier, tokenResponse, err)
+}
+
+// GetESDTBalanceAtRootHash will return the balance of an ESDT token owned by an address at the given state root hash
+func (ep *ElrondProvider) GetESDTBalanceAtRootHash(address string, tokenIdentifier string, rootHash string) (string, error) {
+	tokenResponse, err := ep.client.GetESDTTokenDataAtRootHash(address, tokenIdentifier, rootHash)
+
+	return ep.parseESDTBalance(address, tokenIdentifier, tokenResponse, err)
+}
+
+func (ep *ElrondProvider) parseESDTBalance(
+	address string,
+	tokenIdentifier string,
+	tokenResponse *data.GenericAPIResponse,
+	err error,
+) (string, error) {
+	if err != nil {
+		log.Warn("cannot get esdt token data", "address", address, "token", tokenIdentifier,
+			"error", err.Error())
+
+		return "", err
+	}
+
+	if tokenResponse.Error != "" {
+		log.Warn("cannot get esdt token data", "address", address, "token", tokenIdentifier,
+			"error", tokenResponse.Error)
+
+		return "", errors.New(tokenResponse.Error)
+	}
+
+	responseBytes, err := json.Marshal(tokenResponse.Data)
+	if err != nil {
+		return "", err
+	}
+
+	tokenData := &data.ESDTTokenResponseData{}
+	err = json.Unmarshal(responseBytes, tokenData)
+	if err != nil {
+		return "", err
+	}
+
+	if tokenData.TokenData == nil || tokenData.TokenData.Balance == "" {
+		// the nodes return an empty token data for the tokens not owned by the address
+		return "0", nil
+	}
+
+	return tokenData.TokenData.Balance, nil
+}
+
+// GetStateRootHashForAddress will return the state root hash of the address' shard at the provided hyperblock. That
+// is the root hash of the latest block of the shard notarized up to that hyperblock
+func (ep *ElrondProvider) GetStateRootHashForAddress(address string, hyperblock *data.Hyperblock) (string, error) {
+	shardID, err := ep.client.GetShardIDForAddress(address)
+	if err != nil {
+		return "", err
+	}
+
+	if shardID == MetachainID {
+		return ep.getBlockStateRootHash(shardID, hyperblock.Nonce)
+	}
+
+	currentHyperblock := hyperblock
+	shardBlockNonce, found := getLatestNotarizedShardBlockNonce(currentHyperblock, shardID)
+	for idx := 0; !found && idx < MaxHyperblocksToSearchShardBlock && currentHyperblock.Nonce > 0; idx++ {
+		currentHyperblock, err = ep.GetBlockByNonce(int64(currentHyperblock.Nonce - 1))
+		if err != nil {
+			return "", err
+		}
+
+		shardBlockNonce, found = getLatestNotarizedShardBlockNonce(currentHyperblock, shardID)
+	}
+	if !found {
+		return "", ErrShardBlockNotFound
+	}
+
+	return ep.getBlockStateRootHash(shardID, shardBlockNonce)
+}
+
+func (ep *ElrondProvider) getBlockStateRootHash(shardID uint32, nonce uint64) (string, error) {
+	blockResponse, err := ep.client.GetBlockByNonce(shardID, nonce, false)
+	if err != nil {
+		log.Warn("cannot get block", "shard", shardID, "nonce", nonce, "error", err.Error())
+
+		return "", err
+	}
+
+	if blockResponse.Error != "" {
+		log.Warn("cannot get block", "shard", shardID, "nonce", nonce, "error", blockResponse.Error)
+
+		return "", errors.New(blockResponse.Error)
+	}
+
+	if blockResponse.Data.Block.StateRootHash == "" {
+		return "", ErrStateRootHashNotAvailable
+	}
+
+	return blockResponse.Data.Block.StateRootHash, nil
+}
+
+func getLatestNotarizedShardBlockNonce(hyperblock *data.Hyperblock, shardID uint32) (uint64, bool) {
+	nonce := uint64(0)
+	found := false
+	for _, shardBlock := range hyperblock.ShardBlocks {
+		if shardBlock.Shard != shardID {
+			continue
+		}
+		if !found || shardBlock.Nonce > nonce {
+			nonce = shardBlock.Nonce
+			found = true
+		}
+	}
+
+	return nonce, found
+}
+
 // ComputeTransactionHash will compute hash of provided transaction
 func (ep *ElrondProvider) ComputeTransactionHash(tx *data.Transaction) (string, error) {
 	return ep.client.ComputeTransactionHash(tx)
@@ -221,6 +369,8 @@ func (ep *ElrondProvider) SendTx(tx *data.Transaction) (string, error) {
 		return "", err
 	}
 
+	ep.submittedTxs.add(hash)
+
 	return hash, nil
 }
 
@@ -246,6 +396,74 @@ func (ep *ElrondProvider) GetTransactionByHashFromPool(txHash string) (*data.Ful
 	return tx, true
 }
 
+// GetTransactionByHash will return a transaction from the observers. The sender is optional, but it avoids asking
+// all the shards when provided
+func (ep *ElrondProvider) GetTransactionByHash(txHash string, sender string) (*data.FullTransaction, error) {
+	var tx *data.FullTransaction
+	var err error
+	if sender == "" {
+		tx, err = ep.client.GetTransaction(txHash, false)
+	} else {
+		tx, _, err = ep.client.GetTransactionByHashAndSenderAddress(txHash, sender, false)
+	}
+	if err != nil {
+		log.Debug("elrond provider: cannot get transaction by hash", "hash", txHash, "error", err.Error())
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+// GetTransactionsByAddress will return the latest transactions sent or received by an address, as indexed in the
+// external storage (Elasticsearch)
+func (ep *ElrondProvider) GetTransactionsByAddress(address string) ([]data.DatabaseTransaction, error) {
+	return ep.client.GetTransactions(address, data.TransactionsHistoryOptions{Size: process.MaxTransactionsHistorySize})
+}
+
+// GetTransactionsHashesFromPool will return the hashes of the transactions recently sent through the provider, followed
+// by the deduplicated hashes of the transactions found in the observers' pools, so that truncating the list keeps the
+// sent ones. If the observers do not expose their pools, only the recently sent transactions are returned
+func (ep *ElrondProvider) GetTransactionsHashesFromPool() ([]string, error) {
+	txsHashes := make([]string, 0)
+	seenHashes := make(map[string]struct{})
+	appendHash := func(txHash string) {
+		if _, found := seenHashes[txHash]; found {
+			return
+		}
+
+		seenHashes[txHash] = struct{}{}
+		txsHashes = append(txsHashes, txHash)
+	}
+
+	for _, txHash := range ep.submittedTxs.getAll() {
+		appendHash(txHash)
+	}
+	if !ep.isTxsPoolAvailable {
+		return txsHashes, nil
+	}
+
+	txsPool, err := ep.client.GetTransactionsPool()
+	if err != nil {
+		return nil, err
+	}
+
+	poolHashes := make([]string, 0)
+	for _, wrappedTxs := range [][]data.WrappedTransaction{txsPool.RegularTransactions, txsPool.SmartContractResults, txsPool.Rewards} {
+		for _, wrappedTx := range wrappedTxs {
+			txHash, ok := wrappedTx.TxFields["hash"].(string)
+			if !ok || txHash == "" {
+				continue
+			}
+
+			poolHashes = append(poolHashes, txHash)
+			appendHash(txHash)
+		}
+	}
+	ep.submittedTxs.remove(poolHashes)
+
+	return txsHashes, nil
+}
+
 func isTxFromPool(tx *data.FullTransaction) bool {
 	acceptedTxStatuses := []transaction.TxStatus{transaction.TxStatusPending}
 	for idx := 0; idx < len(acceptedTxStatuses); idx++ {
@@ -207,6 +207,58 @@ func TestElrondProvider_GetAccount(t *testing.T) {
 	assert.Equal(t, &data.Account{Address: accountAddr}, accountRet)
 }
 
+func TestElrondProvider_GetESDTBalance(t *testing.T) {
+	t.Parallel()
+
+	accountAddr := "addr-addr"
+	elrondProxyMock := &mock.ElrondProxyClientMock{
+		GetNetworkConfigMetricsCalled: func() (*data.GenericAPIResponse, error) {
+			return &data.GenericAPIResponse{
+				Data: map[string]interface{}{
+					"config": map[string]interface{}{
+						"erd_chain_id": "1",
+					},
+				},
+			}, nil
+		},
+		GetESDTTokenDataCalled: func(address string, key string) (*data.GenericAPIResponse, error) {
+			switch key {
+			case "TKN-0102":
+				return &data.GenericAPIResponse{
+					Data: map[string]interface{}{
+						"tokenData": map[string]interface{}{
+							"tokenIdentifier": key,
+							"balance":         "1000",
+						},
+					},
+				}, nil
+			case "ERR-0304":
+				return &data.GenericAPIResponse{Error: "observer error"}, nil
+			default:
+				return &data.GenericAPIResponse{
+					Data: map[string]interface{}{
+						"tokenData": map[string]interface{}{},
+					},
+				}, nil
+			}
+		},
+	}
+
+	elrondProvider, _ := NewElrondProvider(elrondProxyMock)
+
+	balance, err := elrondProvider.GetESDTBalance(accountAddr, "TKN-0102")
+	assert.Nil(t, err)
+	assert.Equal(t, "1000", balance)
+
+	balance, err = elrondProvider.GetESDTBalance(accountAddr, "ABC-0506")
+	assert.Nil(t, err)
+	assert.Equal(t, "0", balance)
+
+	balance, err = elrondProvider.GetESDTBalance(accountAddr, "ERR-0304")
+	assert.Equal(t, errors.New("observer error"), err)
+	assert.Equal(t, "", balance)
+}
+
 func TestElrondProvider_ComputeTransactionHash(t *testing.T) {
 	t.Parallel()
 
@@ -363,3 +415,230 @@ func TestElrondProvider_GetTransactionByHashFromPool_TxInPool(t *testing.T) {
 	assert.Equal(t, &data.FullTransaction{Status: transaction.TxStatusPending}, tx)
 	assert.True(t, isInPool)
 }
+
+func TestElrondProvider_GetTransactionsHashesFromPool(t *testing.T) {
+	t.Parallel()
+
+	wrapTx := func(hash string) data.WrappedTransaction {
+		return data.WrappedTransaction{TxFields: map[string]interface{}{"hash": hash}}
+	}
+	elrondProxyMock := &mock.ElrondProxyClientMock{
+		GetNetworkConfigMetricsCalled: func() (*data.GenericAPIResponse, error) {
+			return &data.GenericAPIResponse{
+				Data: map[string]interface{}{
+					"config": map[string]interface{}{
+						"erd_chain_id": "1",
+					},
+				},
+			}, nil
+		},
+		SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+			return 0, string(tx.Data), nil
+		},
+		GetTransactionsPoolCalled: func() (*data.TransactionsPool, error) {
+			return &data.TransactionsPool{
+				// cross shard transactions are found in the pools of both shards
+				RegularTransactions:  []data.WrappedTransaction{wrapTx("hash1"), wrapTx("hash2"), wrapTx("hash1")},
+				SmartContractResults: []data.WrappedTransaction{wrapTx("hash3"), {}},
+			}, nil
+		},
+	}
+
+	elrondProvider, _ := NewElrondProvider(elrondProxyMock)
+	_, _ = elrondProvider.SendTx(&data.Transaction{Data: []byte("hash2")})
+	_, _ = elrondProvider.SendTx(&data.Transaction{Data: []byte("submitted")})
+
+	txsHashes, err := elrondProvider.GetTransactionsHashesFromPool()
+	assert.Nil(t, err)
+	// the sent transactions come first, so that they are kept when the mempool is truncated
+	assert.Len(t, txsHashes, 4)
+	assert.ElementsMatch(t, []string{"hash2", "submitted"}, txsHashes[:2])
+	assert.Equal(t, []string{"hash1", "hash3"}, txsHashes[2:])
+	assert.Equal(t, []string{"submitted"}, elrondProvider.submittedTxs.getAll())
+}
+
+func TestElrondProvider_GetTransactionsHashesFromPoolNotExposedShouldReturnTheSubmittedTransactions(t *testing.T) {
+	t.Parallel()
+
+	numPoolCalls := 0
+	elrondProxyMock := &mock.ElrondProxyClientMock{
+		GetNetworkConfigMetricsCalled: func() (*data.GenericAPIResponse, error) {
+			return &data.GenericAPIResponse{
+				Data: map[string]interface{}{
+					"config": map[string]interface{}{
+						"erd_chain_id": "1",
+					},
+				},
+			}, nil
+		},
+		SendTransactionCalled: func(tx *data.Transaction) (int, string, error) {
+			return 0, string(tx.Data), nil
+		},
+		GetTransactionsPoolCalled: func() (*data.TransactionsPool, error) {
+			numPoolCalls++
+			return nil, errors.New("404 page not found")
+		},
+	}
+
+	elrondProvider, _ := NewElrondProvider(elrondProxyMock)
+
+	txsHashes, err := elrondProvider.GetTransactionsHashesFromPool()
+	assert.Nil(t, err)
+	assert.Empty(t, txsHashes)
+
+	_, _ = elrondProvider.SendTx(&data.Transaction{Data: []byte("submitted")})
+	txsHashes, err = elrondProvider.GetTransactionsHashesFromPool()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"submitted"}, txsHashes)
+	// the pools are only requested once, at startup
+	assert.Equal(t, 1, numPoolCalls)
+}
+
+func TestElrondProvider_GetTransactionsHashesFromPoolErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("pool error")
+	numPoolCalls := 0
+	elrondProxyMock := &mock.ElrondProxyClientMock{
+		GetNetworkConfigMetricsCalled: func() (*data.GenericAPIResponse, error) {
+			return &data.GenericAPIResponse{
+				Data: map[string]interface{}{
+					"config": map[string]interface{}{
+						"erd_chain_id": "1",
+					},
+				},
+			}, nil
+		},
+		GetTransactionsPoolCalled: func() (*data.TransactionsPool, error) {
+			numPoolCalls++
+			if numPoolCalls == 1 {
+				return &data.TransactionsPool{}, nil
+			}
+
+			return nil, expectedErr
+		},
+	}
+
+	elrondProvider, _ := NewElrondProvider(elrondProxyMock)
+
+	txsHashes, err := elrondProvider.GetTransactionsHashesFromPool()
+	assert.Equal(t, expectedErr, err)
+	assert.Nil(t, txsHashes)
+}
+
+func TestElrondProvider_GetStateRootHashForAddressShouldSearchPreviousHyperblocks(t *testing.T) {
+	t.Parallel()
+
+	shardID := uint32(1)
+	elrondProxyMock := &mock.ElrondProxyClientMock{
+		GetNetworkConfigMetricsCalled: func() (*data.GenericAPIResponse, error) {
+			return &data.GenericAPIResponse{
+				Data: map[string]interface{}{
+					"config": map[string]interface{}{
+						"erd_chain_id": "1",
+					},
+				},
+			}, nil
+		},
+		GetShardIDForAddressCalled: func(address string) (uint32, error) {
+			return shardID, nil
+		},
+		GetHyperBlockByNonceCalled: func(nonce uint64) (*data.HyperblockApiResponse, error) {
+			assert.Equal(t, uint64(9), nonce)
+
+			response := &data.HyperblockApiResponse{}
+			response.Data.Hyperblock = data.Hyperblock{
+				Nonce: 9,
+				ShardBlocks: []*data.NotarizedBlock{
+					{Shard: shardID, Nonce: 20},
+					{Shard: shardID, Nonce: 21},
+					{Shard: 0, Nonce: 30},
+				},
+			}
+			return response, nil
+		},
+		GetBlockByNonceCalled: func(shard uint32, nonce uint64, withTxs bool) (*data.BlockApiResponse, error) {
+			assert.Equal(t, shardID, shard)
+			assert.Equal(t, uint64(21), nonce)
+
+			response := &data.BlockApiResponse{}
+			response.Data.Block.StateRootHash = "root-hash-21"
+			return response, nil
+		},
+	}
+
+	elrondProvider, _ := NewElrondProvider(elrondProxyMock)
+
+	// hyperblock 10 does not notarize any block of the address' shard
+	rootHash, err := elrondProvider.GetStateRootHashForAddress("address", &data.Hyperblock{
+		Nonce: 10,
+		ShardBlocks: []*data.NotarizedBlock{
+			{Shard: 0, Nonce: 31},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "root-hash-21", rootHash)
+}
+
+func TestElrondProvider_GetStateRootHashForAddressInMetachain(t *testing.T) {
+	t.Parallel()
+
+	elrondProxyMock := &mock.ElrondProxyClientMock{
+		GetNetworkConfigMetricsCalled: func() (*data.GenericAPIResponse, error) {
+			return &data.GenericAPIResponse{
+				Data: map[string]interface{}{
+					"config": map[string]interface{}{
+						"erd_chain_id": "1",
+					},
+				},
+			}, nil
+		},
+		GetShardIDForAddressCalled: func(address string) (uint32, error) {
+			return MetachainID, nil
+		},
+		GetBlockByNonceCalled: func(shard uint32, nonce uint64, withTxs bool) (*data.BlockApiResponse, error) {
+			assert.Equal(t, uint32(MetachainID), shard)
+			assert.Equal(t, uint64(10), nonce)
+
+			return &data.BlockApiResponse{}, nil
+		},
+	}
+
+	elrondProvider, _ := NewElrondProvider(elrondProxyMock)
+
+	rootHash, err := elrondProvider.GetStateRootHashForAddress("address", &data.Hyperblock{Nonce: 10})
+	assert.Equal(t, ErrStateRootHashNotAvailable, err)
+	assert.Empty(t, rootHash)
+}
+
+func TestElrondProvider_GetTransactionByHash(t *testing.T) {
+	t.Parallel()
+
+	elrondProxyMock := &mock.ElrondProxyClientMock{
+		GetNetworkConfigMetricsCalled: func() (*data.GenericAPIResponse, error) {
+			return &data.GenericAPIResponse{
+				Data: map[string]interface{}{
+					"config": map[string]interface{}{
+						"erd_chain_id": "1",
+					},
+				},
+			}, nil
+		},
+		GetTransactionCalled: func(hash string) (*data.FullTransaction, error) {
+			return &data.FullTransaction{Hash: hash}, nil
+		},
+		GetTransactionByHashAndSenderAddressCalled: func(hash string, sndAddr string) (*data.FullTransaction, int, error) {
+			return &data.FullTransaction{Hash: hash, Sender: sndAddr}, 0, nil
+		},
+	}
+
+	elrondProvider, _ := NewElrondProvider(elrondProxyMock)
+
+	tx, err := elrondProvider.GetTransactionByHash("hash", "")
+	assert.Nil(t, err)
+	assert.Equal(t, &data.FullTransaction{Hash: "hash"}, tx)
+
+	tx, err = elrondProvider.GetTransactionByHash("hash", "sender")
+	assert.Nil(t, err)
+	assert.Equal(t, &data.FullTransaction{Hash: "hash", Sender: "sender"}, tx)
+}
@@ -10,6 +10,10 @@ type ElrondProxyClient interface {
 	GetNetworkConfigMetrics() (*data.GenericAPIResponse, error)
 	GetBlockByNonce(shardID uint32, nonce uint64, withTxs bool) (*data.BlockApiResponse, error)
 	GetAccount(address string) (*data.Account, error)
+	GetAccountAtRootHash(address string, rootHash string) (*data.Account, error)
+	GetShardIDForAddress(address string) (uint32, error)
+	GetESDTTokenData(address string, key string) (*data.GenericAPIResponse, error)
+	GetESDTTokenDataAtRootHash(address string, key string, rootHash string) (*data.GenericAPIResponse, error)
 
 	GetHyperBlockByNonce(nonce uint64) (*data.HyperblockApiResponse, error)
 	GetHyperBlockByHash(hash string) (*data.HyperblockApiResponse, error)
@@ -17,8 +21,13 @@ type ElrondProxyClient interface {
 	SendTransaction(tx *data.Transaction) (int, string, error)
 	ComputeTransactionHash(tx *data.Transaction) (string, error)
 	GetTransactionByHashAndSenderAddress(txHash string, sndAddr string, withResults bool) (*data.FullTransaction, int, error)
+	GetTransaction(txHash string, withResults bool) (*data.FullTransaction, error)
+	GetTransactions(address string, options data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error)
+
+	GetTransactionsPool() (*data.TransactionsPool, error)
 
 	GetLatestFullySynchronizedHyperblockNonce() (uint64, error)
+	GetLatestFinalHyperblockNonce() (uint64, error)
 	GetAddressConverter() (core.PubkeyConverter, error)
 }
 
@@ -26,13 +35,21 @@ type ElrondProxyClient interface {
 type ElrondProviderHandler interface {
 	GetNetworkConfig() (*NetworkConfig, error)
 	GetLatestBlockData() (*BlockData, error)
+	GetLatestFinalBlockNonce() (uint64, error)
 	GetBlockByNonce(nonce int64) (*data.Hyperblock, error)
 	GetBlockByHash(hash string) (*data.Hyperblock, error)
 	GetAccount(address string) (*data.Account, error)
+	GetAccountAtRootHash(address string, rootHash string) (*data.Account, error)
+	GetESDTBalance(address string, tokenIdentifier string) (string, error)
+	GetESDTBalanceAtRootHash(address string, tokenIdentifier string, rootHash string) (string, error)
+	GetStateRootHashForAddress(address string, hyperblock *data.Hyperblock) (string, error)
 	EncodeAddress(address []byte) (string, error)
 	DecodeAddress(address string) ([]byte, error)
 	SendTx(tx *data.Transaction) (string, error)
 	CalculateBlockTimestampUnix(round uint64) int64
 	ComputeTransactionHash(tx *data.Transaction) (string, error)
 	GetTransactionByHashFromPool(txHash string) (*data.FullTransaction, bool)
+	GetTransactionsHashesFromPool() ([]string, error)
+	GetTransactionByHash(txHash string, sender string) (*data.FullTransaction, error)
+	GetTransactionsByAddress(address string) ([]data.DatabaseTransaction, error)
 }
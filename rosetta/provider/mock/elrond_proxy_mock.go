@@ -10,14 +10,22 @@ type ElrondProxyClientMock struct {
 	GetNetworkConfigMetricsCalled                   func() (*data.GenericAPIResponse, error)
 	GetBlockByNonceCalled                           func(shardID uint32, nonce uint64, withTxs bool) (*data.BlockApiResponse, error)
 	GetAccountCalled                                func(address string) (*data.Account, error)
+	GetESDTTokenDataCalled                          func(address string, key string) (*data.GenericAPIResponse, error)
+	GetAccountAtRootHashCalled                      func(address string, rootHash string) (*data.Account, error)
+	GetShardIDForAddressCalled                      func(address string) (uint32, error)
+	GetESDTTokenDataAtRootHashCalled                func(address string, key string, rootHash string) (*data.GenericAPIResponse, error)
 	GetHyperBlockByNonceCalled                      func(nonce uint64) (*data.HyperblockApiResponse, error)
 	GetHyperBlockByHashCalled                       func(hash string) (*data.HyperblockApiResponse, error)
 	SendTransactionCalled                           func(tx *data.Transaction) (int, string, error)
 	SimulateTransactionCalled                       func(tx *data.Transaction) (*data.ResponseTransactionSimulation, error)
 	GetAddressConverterCalled                       func() (core.PubkeyConverter, error)
 	GetLatestFullySynchronizedHyperblockNonceCalled func() (uint64, error)
+	GetLatestFinalHyperblockNonceCalled             func() (uint64, error)
 	ComputeTransactionHashCalled                    func(tx *data.Transaction) (string, error)
 	GetTransactionByHashAndSenderAddressCalled      func(hash string, sndAddr string) (*data.FullTransaction, int, error)
+	GetTransactionCalled                            func(hash string) (*data.FullTransaction, error)
+	GetTransactionsPoolCalled                       func() (*data.TransactionsPool, error)
+	GetTransactionsCalled                           func(address string, options data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error)
 }
 
 // GetNetworkConfigMetrics -
@@ -49,6 +57,38 @@ func (epcm *ElrondProxyClientMock) GetAccount(address string) (*data.Account, er
 	return nil, nil
 }
 
+// GetAccountAtRootHash -
+func (epcm *ElrondProxyClientMock) GetAccountAtRootHash(address string, rootHash string) (*data.Account, error) {
+	if epcm.GetAccountAtRootHashCalled != nil {
+		return epcm.GetAccountAtRootHashCalled(address, rootHash)
+	}
+	return nil, nil
+}
+
+// GetShardIDForAddress -
+func (epcm *ElrondProxyClientMock) GetShardIDForAddress(address string) (uint32, error) {
+	if epcm.GetShardIDForAddressCalled != nil {
+		return epcm.GetShardIDForAddressCalled(address)
+	}
+	return 0, nil
+}
+
+// GetESDTTokenDataAtRootHash -
+func (epcm *ElrondProxyClientMock) GetESDTTokenDataAtRootHash(address string, key string, rootHash string) (*data.GenericAPIResponse, error) {
+	if epcm.GetESDTTokenDataAtRootHashCalled != nil {
+		return epcm.GetESDTTokenDataAtRootHashCalled(address, key, rootHash)
+	}
+	return nil, nil
+}
+
+// GetESDTTokenData -
+func (epcm *ElrondProxyClientMock) GetESDTTokenData(address string, key string) (*data.GenericAPIResponse, error) {
+	if epcm.GetESDTTokenDataCalled != nil {
+		return epcm.GetESDTTokenDataCalled(address, key)
+	}
+	return nil, nil
+}
+
 // GetHyperBlockByNonce -
 func (epcm *ElrondProxyClientMock) GetHyperBlockByNonce(nonce uint64) (*data.HyperblockApiResponse, error) {
 	if epcm.GetHyperBlockByNonceCalled != nil {
@@ -97,6 +137,14 @@ func (epcm *ElrondProxyClientMock) GetLatestFullySynchronizedHyperblockNonce() (
 	return 0, nil
 }
 
+// GetLatestFinalHyperblockNonce -
+func (epcm *ElrondProxyClientMock) GetLatestFinalHyperblockNonce() (uint64, error) {
+	if epcm.GetLatestFinalHyperblockNonceCalled != nil {
+		return epcm.GetLatestFinalHyperblockNonceCalled()
+	}
+	return 0, nil
+}
+
 // GetTransactionByHashAndSenderAddress -
 func (epcm *ElrondProxyClientMock) GetTransactionByHashAndSenderAddress(
 	hash string,
@@ -108,3 +156,27 @@ func (epcm *ElrondProxyClientMock) GetTransactionByHashAndSenderAddress(
 	}
 	return nil, 0, nil
 }
+
+// GetTransaction -
+func (epcm *ElrondProxyClientMock) GetTransaction(hash string, _ bool) (*data.FullTransaction, error) {
+	if epcm.GetTransactionCalled != nil {
+		return epcm.GetTransactionCalled(hash)
+	}
+	return nil, nil
+}
+
+// GetTransactionsPool -
+func (epcm *ElrondProxyClientMock) GetTransactionsPool() (*data.TransactionsPool, error) {
+	if epcm.GetTransactionsPoolCalled != nil {
+		return epcm.GetTransactionsPoolCalled()
+	}
+	return &data.TransactionsPool{}, nil
+}
+
+// GetTransactions -
+func (epcm *ElrondProxyClientMock) GetTransactions(address string, options data.TransactionsHistoryOptions) ([]data.DatabaseTransaction, error) {
+	if epcm.GetTransactionsCalled != nil {
+		return epcm.GetTransactionsCalled(address, options)
+	}
+	return nil, nil
+}
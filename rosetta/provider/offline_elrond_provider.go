@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"errors"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/ElrondNetwork/elrond-go/hashing"
+	"github.com/ElrondNetwork/elrond-go/marshal"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/process"
+)
+
+var (
+	// ErrOfflineMode signals that an operation which requires the observers has been called in offline mode
+	ErrOfflineMode = errors.New("operation not available in offline mode")
+	// ErrNilNetworkConfig signals that a nil network config has been provided
+	ErrNilNetworkConfig = errors.New("nil network config")
+	// ErrNilPubKeyConverter signals that a nil public key converter has been provided
+	ErrNilPubKeyConverter = errors.New("nil public key converter")
+	// ErrNilMarshalizer signals that a nil marshalizer has been provided
+	ErrNilMarshalizer = errors.New("nil marshalizer")
+	// ErrNilHasher signals that a nil hasher has been provided
+	ErrNilHasher = errors.New("nil hasher")
+)
+
+// OfflineElrondProvider is able to process the requests that do not need the observers, using a static network config
+type OfflineElrondProvider struct {
+	networkConfig   *NetworkConfig
+	pubKeyConverter core.PubkeyConverter
+	marshalizer     marshal.Marshalizer
+	hasher          hashing.Hasher
+}
+
+// NewOfflineElrondProvider will create a new instance of OfflineElrondProvider
+func NewOfflineElrondProvider(
+	networkConfig *NetworkConfig,
+	pubKeyConverter core.PubkeyConverter,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+) (*OfflineElrondProvider, error) {
+	if networkConfig == nil {
+		return nil, ErrNilNetworkConfig
+	}
+	if check.IfNil(pubKeyConverter) {
+		return nil, ErrNilPubKeyConverter
+	}
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
+
+	return &OfflineElrondProvider{
+		networkConfig:   networkConfig,
+		pubKeyConverter: pubKeyConverter,
+		marshalizer:     marshalizer,
+		hasher:          hasher,
+	}, nil
+}
+
+// GetNetworkConfig will return the static network config
+func (oep *OfflineElrondProvider) GetNetworkConfig() (*NetworkConfig, error) {
+	return oep.networkConfig, nil
+}
+
+// GetLatestBlockData returns ErrOfflineMode
+func (oep *OfflineElrondProvider) GetLatestBlockData() (*BlockData, error) {
+	return nil, ErrOfflineMode
+}
+
+// GetLatestFinalBlockNonce returns ErrOfflineMode
+func (oep *OfflineElrondProvider) GetLatestFinalBlockNonce() (uint64, error) {
+	return 0, ErrOfflineMode
+}
+
+// GetBlockByNonce returns ErrOfflineMode
+func (oep *OfflineElrondProvider) GetBlockByNonce(_ int64) (*data.Hyperblock, error) {
+	return nil, ErrOfflineMode
+}
+
+// GetBlockByHash returns ErrOfflineMode
+func (oep *OfflineElrondProvider) GetBlockByHash(_ string) (*data.Hyperblock, error) {
+	return nil, ErrOfflineMode
+}
+
+// GetAccount returns ErrOfflineMode
+func (oep *OfflineElrondProvider) GetAccount(_ string) (*data.Account, error) {
+	return nil, ErrOfflineMode
+}
+
+// GetAccountAtRootHash returns ErrOfflineMode
+func (oep *OfflineElrondProvider) GetAccountAtRootHash(_ string, _ string) (*data.Account, error) {
+	return nil, ErrOfflineMode
+}
+
+// GetESDTBalanceAtRootHash returns ErrOfflineMode
+func (oep *OfflineElrondProvider) GetESDTBalanceAtRootHash(_ string, _ string, _ string) (string, error) {
+	return "", ErrOfflineMode
+}
+
+// GetStateRootHashForAddress returns ErrOfflineMode
+func (oep *OfflineElrondProvider) GetStateRootHashForAddress(_ string, _ *data.Hyperblock) (string, error) {
+	return "", ErrOfflineMode
+}
+
+// GetESDTBalance returns ErrOfflineMode
+func (oep *OfflineElrondProvider) GetESDTBalance(_ string, _ string) (string, error) {
+	return "", ErrOfflineMode
+}
+
+// EncodeAddress will encode an address
+func (oep *OfflineElrondProvider) EncodeAddress(address []byte) (string, error) {
+	return oep.pubKeyConverter.Encode(address), nil
+}
+
+// DecodeAddress will decode an address
+func (oep *OfflineElrondProvider) DecodeAddress(address string) ([]byte, error) {
+	return oep.pubKeyConverter.Decode(address)
+}
+
+// SendTx returns ErrOfflineMode
+func (oep *OfflineElrondProvider) SendTx(_ *data.Transaction) (string, error) {
+	return "", ErrOfflineMode
+}
+
+// CalculateBlockTimestampUnix returns 0 as the genesis time is not known offline
+func (oep *OfflineElrondProvider) CalculateBlockTimestampUnix(_ uint64) int64 {
+	return 0
+}
+
+// ComputeTransactionHash will compute hash of provided transaction
+func (oep *OfflineElrondProvider) ComputeTransactionHash(tx *data.Transaction) (string, error) {
+	return process.ComputeTransactionHash(tx, oep.pubKeyConverter, oep.marshalizer, oep.hasher)
+}
+
+// GetTransactionByHashFromPool returns false as no pool can be queried offline
+func (oep *OfflineElrondProvider) GetTransactionByHashFromPool(_ string) (*data.FullTransaction, bool) {
+	return nil, false
+}
+
+// GetTransactionByHash returns ErrOfflineMode
+func (oep *OfflineElrondProvider) GetTransactionByHash(_ string, _ string) (*data.FullTransaction, error) {
+	return nil, ErrOfflineMode
+}
+
+// GetTransactionsByAddress returns ErrOfflineMode
+func (oep *OfflineElrondProvider) GetTransactionsByAddress(_ string) ([]data.DatabaseTransaction, error) {
+	return nil, ErrOfflineMode
+}
+
+// GetTransactionsHashesFromPool returns ErrOfflineMode
+func (oep *OfflineElrondProvider) GetTransactionsHashesFromPool() ([]string, error) {
+	return nil, ErrOfflineMode
+}
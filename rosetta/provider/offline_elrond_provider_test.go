@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/state/factory"
+	hasherFactory "github.com/ElrondNetwork/elrond-go/hashing/factory"
+	marshalFactory "github.com/ElrondNetwork/elrond-go/marshal/factory"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createOfflineProviderComponents() (core.PubkeyConverter, *NetworkConfig) {
+	pubKeyConverter, _ := factory.NewPubkeyConverter(config.PubkeyConfig{
+		Length: 32,
+		Type:   "bech32",
+	})
+
+	return pubKeyConverter, &NetworkConfig{
+		ChainID:     "1",
+		MinGasPrice: 1000000000,
+		MinGasLimit: 50000,
+	}
+}
+
+func TestNewOfflineElrondProvider_NilComponentsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pubKeyConverter, networkConfig := createOfflineProviderComponents()
+	marshalizer, _ := marshalFactory.NewMarshalizer("gogo protobuf")
+	hasher, _ := hasherFactory.NewHasher("blake2b")
+
+	oep, err := NewOfflineElrondProvider(nil, pubKeyConverter, marshalizer, hasher)
+	assert.Nil(t, oep)
+	assert.Equal(t, ErrNilNetworkConfig, err)
+
+	oep, err = NewOfflineElrondProvider(networkConfig, nil, marshalizer, hasher)
+	assert.Nil(t, oep)
+	assert.Equal(t, ErrNilPubKeyConverter, err)
+
+	oep, err = NewOfflineElrondProvider(networkConfig, pubKeyConverter, nil, hasher)
+	assert.Nil(t, oep)
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	oep, err = NewOfflineElrondProvider(networkConfig, pubKeyConverter, marshalizer, nil)
+	assert.Nil(t, oep)
+	assert.Equal(t, ErrNilHasher, err)
+}
+
+func TestOfflineElrondProvider_ShouldWorkWithoutObservers(t *testing.T) {
+	t.Parallel()
+
+	pubKeyConverter, networkConfig := createOfflineProviderComponents()
+	marshalizer, _ := marshalFactory.NewMarshalizer("gogo protobuf")
+	hasher, _ := hasherFactory.NewHasher("blake2b")
+	oep, err := NewOfflineElrondProvider(networkConfig, pubKeyConverter, marshalizer, hasher)
+	require.Nil(t, err)
+
+	providedNetworkConfig, err := oep.GetNetworkConfig()
+	assert.Nil(t, err)
+	assert.Equal(t, networkConfig, providedNetworkConfig)
+
+	addrBytes, _ := hex.DecodeString("7c3f38ab6d2f961de7e5ad914cdbd0b6361b5ddb53d504b5297bfa4c901fc1d8")
+	address, err := oep.EncodeAddress(addrBytes)
+	assert.Nil(t, err)
+	assert.Equal(t, "erd10sln32md97tpmel94kg5ek7skcmpkhwm202sfdff00ayeyqlc8vqpajkz5", address)
+
+	decodedAddr, err := oep.DecodeAddress(address)
+	assert.Nil(t, err)
+	assert.Equal(t, addrBytes, decodedAddr)
+
+	txHash, err := oep.ComputeTransactionHash(&data.Transaction{
+		Value:    "1",
+		Receiver: address,
+		Sender:   address,
+		GasPrice: networkConfig.MinGasPrice,
+		GasLimit: networkConfig.MinGasLimit,
+		ChainID:  networkConfig.ChainID,
+		Version:  1,
+	})
+	assert.Nil(t, err)
+	assert.Len(t, txHash, 64)
+}
+
+func TestOfflineElrondProvider_OnlineOperationsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	pubKeyConverter, networkConfig := createOfflineProviderComponents()
+	marshalizer, _ := marshalFactory.NewMarshalizer("gogo protobuf")
+	hasher, _ := hasherFactory.NewHasher("blake2b")
+	oep, _ := NewOfflineElrondProvider(networkConfig, pubKeyConverter, marshalizer, hasher)
+
+	_, err := oep.GetLatestBlockData()
+	assert.Equal(t, ErrOfflineMode, err)
+	_, err = oep.GetBlockByNonce(1)
+	assert.Equal(t, ErrOfflineMode, err)
+	_, err = oep.GetBlockByHash("hash")
+	assert.Equal(t, ErrOfflineMode, err)
+	_, err = oep.GetAccount("address")
+	assert.Equal(t, ErrOfflineMode, err)
+	_, err = oep.GetESDTBalance("address", "TKN-abcdef")
+	assert.Equal(t, ErrOfflineMode, err)
+	_, err = oep.SendTx(&data.Transaction{})
+	assert.Equal(t, ErrOfflineMode, err)
+	_, err = oep.GetTransactionsHashesFromPool()
+	assert.Equal(t, ErrOfflineMode, err)
+	_, ok := oep.GetTransactionByHashFromPool("hash")
+	assert.False(t, ok)
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// SubmittedTxTimeToLive is the time a transaction sent through the provider is reported as pending,
+	// unless an observer's pool reports it in the meantime
+	SubmittedTxTimeToLive = time.Minute
+	// MaxTrackedSubmittedTxs is the maximum number of sent transactions that are tracked at once
+	MaxTrackedSubmittedTxs = 1000
+)
+
+// submittedTransactions keeps the hashes of the recently sent transactions
+type submittedTransactions struct {
+	mut         sync.Mutex
+	sendTimes   map[string]time.Time
+	timeToLive  time.Duration
+	maxTracked  int
+	getTimeFunc func() time.Time
+}
+
+func newSubmittedTransactions(timeToLive time.Duration, maxTracked int) *submittedTransactions {
+	return &submittedTransactions{
+		sendTimes:   make(map[string]time.Time),
+		timeToLive:  timeToLive,
+		maxTracked:  maxTracked,
+		getTimeFunc: time.Now,
+	}
+}
+
+func (st *submittedTransactions) add(txHash string) {
+	st.mut.Lock()
+	defer st.mut.Unlock()
+
+	st.removeExpired()
+	if len(st.sendTimes) >= st.maxTracked {
+		st.removeOldest()
+	}
+
+	st.sendTimes[txHash] = st.getTimeFunc()
+}
+
+// remove stops tracking the provided hashes, as they are already reported by the observers
+func (st *submittedTransactions) remove(txsHashes []string) {
+	st.mut.Lock()
+	defer st.mut.Unlock()
+
+	for _, txHash := range txsHashes {
+		delete(st.sendTimes, txHash)
+	}
+}
+
+func (st *submittedTransactions) getAll() []string {
+	st.mut.Lock()
+	defer st.mut.Unlock()
+
+	st.removeExpired()
+	txsHashes := make([]string, 0, len(st.sendTimes))
+	for txHash := range st.sendTimes {
+		txsHashes = append(txsHashes, txHash)
+	}
+
+	return txsHashes
+}
+
+func (st *submittedTransactions) removeExpired() {
+	now := st.getTimeFunc()
+	for txHash, sendTime := range st.sendTimes {
+		if now.Sub(sendTime) > st.timeToLive {
+			delete(st.sendTimes, txHash)
+		}
+	}
+}
+
+func (st *submittedTransactions) removeOldest() {
+	oldestHash := ""
+	var oldestTime time.Time
+	for txHash, sendTime := range st.sendTimes {
+		if oldestHash == "" || sendTime.Before(oldestTime) {
+			oldestHash = txHash
+			oldestTime = sendTime
+		}
+	}
+
+	delete(st.sendTimes, oldestHash)
+}
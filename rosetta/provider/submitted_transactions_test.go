@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubmittedTransactions_ExpiredTransactionsShouldBeRemoved(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+	st := newSubmittedTransactions(time.Minute, 10)
+	st.getTimeFunc = func() time.Time {
+		return now
+	}
+
+	st.add("hash1")
+	now = now.Add(30 * time.Second)
+	st.add("hash2")
+	assert.ElementsMatch(t, []string{"hash1", "hash2"}, st.getAll())
+
+	now = now.Add(45 * time.Second)
+	assert.Equal(t, []string{"hash2"}, st.getAll())
+}
+
+func TestSubmittedTransactions_AddOverCapacityShouldRemoveTheOldest(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+	st := newSubmittedTransactions(time.Hour, 2)
+	st.getTimeFunc = func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+
+	st.add("hash1")
+	st.add("hash2")
+	st.add("hash3")
+	assert.ElementsMatch(t, []string{"hash2", "hash3"}, st.getAll())
+
+	st.remove([]string{"hash2", "missing"})
+	assert.Equal(t, []string{"hash3"}, st.getAll())
+}
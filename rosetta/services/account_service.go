@@ -2,7 +2,11 @@ package services
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/process"
 	"github.com/ElrondNetwork/elrond-proxy-go/rosetta/configuration"
 	"github.com/ElrondNetwork/elrond-proxy-go/rosetta/provider"
 	"github.com/coinbase/rosetta-sdk-go/server"
@@ -27,32 +31,39 @@ func (aas *accountAPIService) AccountBalance(
 	_ context.Context,
 	request *types.AccountBalanceRequest,
 ) (*types.AccountBalanceResponse, *types.Error) {
-	// TODO cannot return balance at a specific nonce right now
+	if aas.config.IsOffline {
+		return nil, ErrOfflineMode
+	}
+
 	if request.AccountIdentifier.Address == "" {
 		return nil, ErrInvalidAccountAddress
 	}
 
-	latestBlockData, err := aas.elrondProvider.GetLatestBlockData()
-	if err != nil {
-		return nil, wrapErr(ErrUnableToGetBlock, err)
+	address := request.AccountIdentifier.Address
+	blockIdentifier, rootHash, errBlock := aas.getBlockAndStateRootHash(address, request.BlockIdentifier)
+	if errBlock != nil {
+		return nil, errBlock
 	}
 
-	account, err := aas.elrondProvider.GetAccount(request.AccountIdentifier.Address)
+	var account *data.Account
+	var err error
+	if rootHash == "" {
+		account, err = aas.elrondProvider.GetAccount(address)
+	} else {
+		account, err = aas.elrondProvider.GetAccountAtRootHash(address, rootHash)
+	}
 	if err != nil {
-		return nil, wrapErr(ErrUnableToGetAccount, err)
+		return nil, wrapAccountErr(err)
+	}
+
+	balances, errBalances := aas.getBalances(address, account, request.Currencies, rootHash)
+	if errBalances != nil {
+		return nil, errBalances
 	}
 
 	response := &types.AccountBalanceResponse{
-		BlockIdentifier: &types.BlockIdentifier{
-			Index: int64(latestBlockData.Nonce),
-			Hash:  latestBlockData.Hash,
-		},
-		Balances: []*types.Amount{
-			{
-				Value:    account.Balance,
-				Currency: aas.config.Currency,
-			},
-		},
+		BlockIdentifier: blockIdentifier,
+		Balances:        balances,
 		Metadata: map[string]interface{}{
 			"nonce": account.Nonce,
 		},
@@ -61,6 +72,122 @@ func (aas *accountAPIService) AccountBalance(
 	return response, nil
 }
 
+// getBlockAndStateRootHash returns the block the balances are computed at. If no block is requested, the latest block
+// is returned together with an empty root hash, meaning the current state. Otherwise, the state root hash of the
+// address' shard at the requested block is returned
+func (aas *accountAPIService) getBlockAndStateRootHash(
+	address string,
+	partialBlockIdentifier *types.PartialBlockIdentifier,
+) (*types.BlockIdentifier, string, *types.Error) {
+	if partialBlockIdentifier == nil || (partialBlockIdentifier.Index == nil && partialBlockIdentifier.Hash == nil) {
+		latestBlockData, err := aas.elrondProvider.GetLatestBlockData()
+		if err != nil {
+			return nil, "", wrapErr(ErrUnableToGetBlock, err)
+		}
+
+		return &types.BlockIdentifier{
+			Index: int64(latestBlockData.Nonce),
+			Hash:  latestBlockData.Hash,
+		}, "", nil
+	}
+
+	hyperblock, errBlock := aas.getHyperblock(partialBlockIdentifier)
+	if errBlock != nil {
+		return nil, "", errBlock
+	}
+
+	rootHash, err := aas.elrondProvider.GetStateRootHashForAddress(address, hyperblock)
+	if err != nil {
+		return nil, "", wrapAccountErr(err)
+	}
+
+	return &types.BlockIdentifier{
+		Index: int64(hyperblock.Nonce),
+		Hash:  hyperblock.Hash,
+	}, rootHash, nil
+}
+
+func (aas *accountAPIService) getHyperblock(partialBlockIdentifier *types.PartialBlockIdentifier) (*data.Hyperblock, *types.Error) {
+	if partialBlockIdentifier.Index == nil {
+		hyperblock, err := aas.elrondProvider.GetBlockByHash(*partialBlockIdentifier.Hash)
+		if err != nil {
+			return nil, wrapErr(ErrUnableToGetBlock, err)
+		}
+
+		return hyperblock, nil
+	}
+
+	hyperblock, err := aas.elrondProvider.GetBlockByNonce(*partialBlockIdentifier.Index)
+	if err != nil {
+		return nil, wrapErr(ErrUnableToGetBlock, err)
+	}
+
+	if partialBlockIdentifier.Hash != nil && *partialBlockIdentifier.Hash != hyperblock.Hash {
+		return nil, wrapErr(ErrUnableToGetBlock, fmt.Errorf("block %d has the hash %s", hyperblock.Nonce, hyperblock.Hash))
+	}
+
+	return hyperblock, nil
+}
+
+// getBalances returns the balances of the requested currencies, at the provided root hash if not empty. If no
+// currency is requested, the balances of the native currency and of all the tracked ESDT tokens are returned
+func (aas *accountAPIService) getBalances(
+	address string,
+	account *data.Account,
+	currencies []*types.Currency,
+	rootHash string,
+) ([]*types.Amount, *types.Error) {
+	if len(currencies) == 0 {
+		currencies = append([]*types.Currency{aas.config.Currency}, aas.config.ESDTCurrencies...)
+	}
+
+	balances := make([]*types.Amount, 0, len(currencies))
+	for _, currency := range currencies {
+		if currency.Symbol == aas.config.Currency.Symbol {
+			balances = append(balances, &types.Amount{
+				Value:    account.Balance,
+				Currency: aas.config.Currency,
+			})
+			continue
+		}
+
+		esdtCurrency, ok := aas.config.GetESDTCurrency(currency.Symbol)
+		if !ok {
+			return nil, wrapErr(ErrUnsupportedCurrency, fmt.Errorf("currency %s is not tracked", currency.Symbol))
+		}
+
+		balance, err := aas.getESDTBalance(address, esdtCurrency.Symbol, rootHash)
+		if err != nil {
+			return nil, wrapAccountErr(err)
+		}
+
+		balances = append(balances, &types.Amount{
+			Value:    balance,
+			Currency: esdtCurrency,
+		})
+	}
+
+	return balances, nil
+}
+
+func (aas *accountAPIService) getESDTBalance(address string, tokenIdentifier string, rootHash string) (string, error) {
+	if rootHash == "" {
+		return aas.elrondProvider.GetESDTBalance(address, tokenIdentifier)
+	}
+
+	return aas.elrondProvider.GetESDTBalanceAtRootHash(address, tokenIdentifier, rootHash)
+}
+
+// wrapAccountErr wraps the error of an account request. The observers which do not provide the state root hash of the
+// blocks or the block info of the account states cannot answer historical balance lookups
+func wrapAccountErr(err error) *types.Error {
+	if errors.Is(err, provider.ErrStateRootHashNotAvailable) || errors.Is(err, process.ErrHistoricalStateNotSupported) {
+		return wrapErr(ErrHistoricalBalanceNotSupported, err)
+	}
+
+	return wrapErr(ErrUnableToGetAccount, err)
+}
+
 // AccountCoins implements the /account/coins endpoint.
 func (aas *accountAPIService) AccountCoins(_ context.Context, _ *types.AccountCoinsRequest) (*types.AccountCoinsResponse, *types.Error) {
 	return nil, ErrNotImplemented
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/process"
 	"github.com/ElrondNetwork/elrond-proxy-go/rosetta/configuration"
 	"github.com/ElrondNetwork/elrond-proxy-go/rosetta/mocks"
 	"github.com/ElrondNetwork/elrond-proxy-go/rosetta/provider"
@@ -34,7 +35,9 @@ func TestAccountAPIService_AccountBalance(t *testing.T) {
 			}, nil
 		},
 	}
-	cfg := &configuration.Configuration{}
+	cfg := &configuration.Configuration{
+		Currency: &types.Currency{Symbol: "eGLD", Decimals: 18},
+	}
 
 	accountAPIService := NewAccountAPIService(elrondProviderMock, cfg)
 	assert.NotNil(t, accountAPIService)
@@ -57,3 +60,174 @@ func TestAccountAPIService_AccountBalance(t *testing.T) {
 	assert.Equal(t, lastestBlockHash, accountBalanceResponse.BlockIdentifier.Hash)
 	assert.Equal(t, int64(latestBlockNonce), accountBalanceResponse.BlockIdentifier.Index)
 }
+
+func TestAccountAPIService_AccountBalanceWithESDTCurrencies(t *testing.T) {
+	t.Parallel()
+
+	address := "erd13lx7zldumunqvf74g5z407gwl5r35jha06rjzc32qcujamknzdgsnt2yvn"
+	esdtBalances := map[string]string{
+		"TKN-0102": "100",
+		"ABC-0304": "0",
+	}
+	elrondProviderMock := &mocks.ElrondProviderMock{
+		GetAccountCalled: func(address string) (*data.Account, error) {
+			return &data.Account{
+				Address: address,
+				Balance: "1234",
+			}, nil
+		},
+		GetLatestBlockDataCalled: func() (*provider.BlockData, error) {
+			return &provider.BlockData{}, nil
+		},
+		GetESDTBalanceCalled: func(addr string, tokenIdentifier string) (string, error) {
+			assert.Equal(t, address, addr)
+			return esdtBalances[tokenIdentifier], nil
+		},
+	}
+	nativeCurrency := &types.Currency{Symbol: "eGLD", Decimals: 18}
+	firstToken := &types.Currency{Symbol: "TKN-0102", Decimals: 6}
+	secondToken := &types.Currency{Symbol: "ABC-0304", Decimals: 18}
+	cfg := &configuration.Configuration{
+		Currency:       nativeCurrency,
+		ESDTCurrencies: []*types.Currency{firstToken, secondToken},
+	}
+	accountAPIService := NewAccountAPIService(elrondProviderMock, cfg)
+
+	// all the tracked currencies should be returned if none is requested
+	response, err := accountAPIService.AccountBalance(context.Background(), &types.AccountBalanceRequest{
+		AccountIdentifier: &types.AccountIdentifier{Address: address},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []*types.Amount{
+		{Value: "1234", Currency: nativeCurrency},
+		{Value: "100", Currency: firstToken},
+		{Value: "0", Currency: secondToken},
+	}, response.Balances)
+
+	response, err = accountAPIService.AccountBalance(context.Background(), &types.AccountBalanceRequest{
+		AccountIdentifier: &types.AccountIdentifier{Address: address},
+		Currencies:        []*types.Currency{{Symbol: "TKN-0102", Decimals: 6}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []*types.Amount{{Value: "100", Currency: firstToken}}, response.Balances)
+
+	response, err = accountAPIService.AccountBalance(context.Background(), &types.AccountBalanceRequest{
+		AccountIdentifier: &types.AccountIdentifier{Address: address},
+		Currencies:        []*types.Currency{{Symbol: "UNKNOWN-0506"}},
+	})
+	assert.Nil(t, response)
+	assert.Equal(t, ErrUnsupportedCurrency.Code, err.Code)
+}
+
+func TestAccountAPIService_AccountBalanceAtBlock(t *testing.T) {
+	t.Parallel()
+
+	address := "erd13lx7zldumunqvf74g5z407gwl5r35jha06rjzc32qcujamknzdgsnt2yvn"
+	blockNonce := int64(10)
+	blockHash := "hash10"
+	rootHash := "root-hash"
+	hyperblock := &data.Hyperblock{
+		Nonce: uint64(blockNonce),
+		Hash:  blockHash,
+	}
+	elrondProviderMock := &mocks.ElrondProviderMock{
+		GetAccountCalled: func(address string) (*data.Account, error) {
+			assert.Fail(t, "should have fetched the account at root hash")
+			return nil, nil
+		},
+		GetBlockByNonceCalled: func(nonce int64) (*data.Hyperblock, error) {
+			assert.Equal(t, blockNonce, nonce)
+			return hyperblock, nil
+		},
+		GetBlockByHashCalled: func(hash string) (*data.Hyperblock, error) {
+			assert.Equal(t, blockHash, hash)
+			return hyperblock, nil
+		},
+		GetStateRootHashForAddressCalled: func(addr string, hb *data.Hyperblock) (string, error) {
+			assert.Equal(t, address, addr)
+			assert.Equal(t, hyperblock, hb)
+			return rootHash, nil
+		},
+		GetAccountAtRootHashCalled: func(addr string, providedRootHash string) (*data.Account, error) {
+			assert.Equal(t, rootHash, providedRootHash)
+			return &data.Account{
+				Address: addr,
+				Nonce:   3,
+				Balance: "500",
+			}, nil
+		},
+		GetESDTBalanceAtRootHashCalled: func(addr string, tokenIdentifier string, providedRootHash string) (string, error) {
+			assert.Equal(t, rootHash, providedRootHash)
+			return "7", nil
+		},
+	}
+	cfg := &configuration.Configuration{
+		Currency:       &types.Currency{Symbol: "eGLD", Decimals: 18},
+		ESDTCurrencies: []*types.Currency{{Symbol: "TKN-0102", Decimals: 6}},
+	}
+	accountAPIService := NewAccountAPIService(elrondProviderMock, cfg)
+
+	expectedBlockIdentifier := &types.BlockIdentifier{Index: blockNonce, Hash: blockHash}
+	partialBlockIdentifiers := []*types.PartialBlockIdentifier{
+		{Index: &blockNonce},
+		{Hash: &blockHash},
+		{Index: &blockNonce, Hash: &blockHash},
+	}
+	for _, partialBlockIdentifier := range partialBlockIdentifiers {
+		accountBalanceResponse, err := accountAPIService.AccountBalance(context.Background(), &types.AccountBalanceRequest{
+			AccountIdentifier: &types.AccountIdentifier{Address: address},
+			BlockIdentifier:   partialBlockIdentifier,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, expectedBlockIdentifier, accountBalanceResponse.BlockIdentifier)
+		assert.Equal(t, "500", accountBalanceResponse.Balances[0].Value)
+		assert.Equal(t, "7", accountBalanceResponse.Balances[1].Value)
+		assert.Equal(t, uint64(3), accountBalanceResponse.Metadata["nonce"])
+	}
+
+	wrongHash := "another-hash"
+	accountBalanceResponse, err := accountAPIService.AccountBalance(context.Background(), &types.AccountBalanceRequest{
+		AccountIdentifier: &types.AccountIdentifier{Address: address},
+		BlockIdentifier:   &types.PartialBlockIdentifier{Index: &blockNonce, Hash: &wrongHash},
+	})
+	assert.Nil(t, accountBalanceResponse)
+	assert.Equal(t, ErrUnableToGetBlock.Code, err.Code)
+}
+
+func TestAccountAPIService_AccountBalanceAtBlockWithoutHistoricalSupportShouldErr(t *testing.T) {
+	t.Parallel()
+
+	blockNonce := int64(10)
+	elrondProviderMock := &mocks.ElrondProviderMock{
+		GetBlockByNonceCalled: func(nonce int64) (*data.Hyperblock, error) {
+			return &data.Hyperblock{Nonce: uint64(nonce), Hash: "hash"}, nil
+		},
+		GetStateRootHashForAddressCalled: func(addr string, hb *data.Hyperblock) (string, error) {
+			return "", provider.ErrStateRootHashNotAvailable
+		},
+	}
+	cfg := &configuration.Configuration{
+		Currency: &types.Currency{Symbol: "eGLD", Decimals: 18},
+	}
+	accountAPIService := NewAccountAPIService(elrondProviderMock, cfg)
+
+	accountBalanceResponse, err := accountAPIService.AccountBalance(context.Background(), &types.AccountBalanceRequest{
+		AccountIdentifier: &types.AccountIdentifier{Address: "erd1xxxxxx"},
+		BlockIdentifier:   &types.PartialBlockIdentifier{Index: &blockNonce},
+	})
+	assert.Nil(t, accountBalanceResponse)
+	assert.Equal(t, ErrHistoricalBalanceNotSupported.Code, err.Code)
+
+	elrondProviderMock.GetStateRootHashForAddressCalled = func(addr string, hb *data.Hyperblock) (string, error) {
+		return "root hash", nil
+	}
+	elrondProviderMock.GetAccountAtRootHashCalled = func(addr string, rootHash string) (*data.Account, error) {
+		return nil, process.ErrHistoricalStateNotSupported
+	}
+	accountBalanceResponse, err = accountAPIService.AccountBalance(context.Background(), &types.AccountBalanceRequest{
+		AccountIdentifier: &types.AccountIdentifier{Address: "erd1xxxxxx"},
+		BlockIdentifier:   &types.PartialBlockIdentifier{Index: &blockNonce},
+	})
+	assert.Nil(t, accountBalanceResponse)
+	assert.Equal(t, ErrHistoricalBalanceNotSupported.Code, err.Code)
+}
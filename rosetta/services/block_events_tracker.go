@@ -0,0 +1,141 @@
+package services
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/rosetta/configuration"
+	"github.com/ElrondNetwork/elrond-proxy-go/rosetta/provider"
+	"github.com/coinbase/rosetta-sdk-go/types"
+)
+
+// maxTrackedBlockEvents is the maximum number of events kept in memory
+const maxTrackedBlockEvents = 10 * int(NumBlocksToGet)
+
+// blockEventsTracker follows the chain of hyperblocks and records the blocks added to and removed from it
+type blockEventsTracker struct {
+	elrondProvider provider.ElrondProviderHandler
+	config         *configuration.Configuration
+
+	mut           sync.Mutex
+	chain         []*types.BlockIdentifier
+	nextNonce     uint64
+	events        []*types.BlockEvent
+	firstSequence int64
+}
+
+func newBlockEventsTracker(elrondProvider provider.ElrondProviderHandler, cfg *configuration.Configuration) *blockEventsTracker {
+	return &blockEventsTracker{
+		elrondProvider: elrondProvider,
+		config:         cfg,
+		chain:          make([]*types.BlockIdentifier, 0),
+		events:         make([]*types.BlockEvent, 0),
+	}
+}
+
+// sync follows the chain up to the latest hyperblock, fetching at most MaxBlockEventsLimit hyperblocks. A
+// hyperblock which does not link to the tracked tip through its PrevBlockHash means that the tip was reorganized
+func (bet *blockEventsTracker) sync() error {
+	latestBlockData, err := bet.elrondProvider.GetLatestBlockData()
+	if err != nil {
+		return err
+	}
+
+	bet.mut.Lock()
+	defer bet.mut.Unlock()
+
+	if bet.nextNonce == 0 {
+		bet.nextNonce = uint64(bet.config.GenesisBlockIdentifier.Index)
+		if latestBlockData.Nonce > bet.nextNonce+bet.config.OldestBlockLookback {
+			bet.nextNonce = latestBlockData.Nonce - bet.config.OldestBlockLookback
+		}
+	}
+
+	for numFetched := 0; numFetched < MaxBlockEventsLimit && bet.nextNonce <= latestBlockData.Nonce; numFetched++ {
+		hyperblock, errGet := bet.elrondProvider.GetBlockByNonce(int64(bet.nextNonce))
+		if errGet != nil {
+			return errGet
+		}
+
+		tip := bet.getTip()
+		if tip != nil && hyperblock.PrevBlockHash != tip.Hash {
+			bet.chain = bet.chain[:len(bet.chain)-1]
+			bet.nextNonce = uint64(tip.Index)
+			bet.addEvent(tip, types.REMOVED)
+			continue
+		}
+
+		blockIdentifier := &types.BlockIdentifier{
+			Index: int64(hyperblock.Nonce),
+			Hash:  hyperblock.Hash,
+		}
+		bet.chain = append(bet.chain, blockIdentifier)
+		if len(bet.chain) > int(NumBlocksToGet) {
+			bet.chain = bet.chain[1:]
+		}
+		bet.nextNonce = hyperblock.Nonce + 1
+		bet.addEvent(blockIdentifier, types.ADDED)
+	}
+
+	return nil
+}
+
+func (bet *blockEventsTracker) getTip() *types.BlockIdentifier {
+	if len(bet.chain) == 0 {
+		return nil
+	}
+
+	return bet.chain[len(bet.chain)-1]
+}
+
+func (bet *blockEventsTracker) addEvent(blockIdentifier *types.BlockIdentifier, eventType types.BlockEventType) {
+	bet.events = append(bet.events, &types.BlockEvent{
+		Sequence:        bet.firstSequence + int64(len(bet.events)),
+		BlockIdentifier: blockIdentifier,
+		Type:            eventType,
+	})
+
+	if len(bet.events) > maxTrackedBlockEvents {
+		bet.events = bet.events[1:]
+		bet.firstSequence++
+	}
+}
+
+// getEvents returns at most limit events starting with the offset sequence. If no offset is provided, the
+// latest events are returned
+func (bet *blockEventsTracker) getEvents(offset *int64, limit int64) (*types.EventsBlocksResponse, error) {
+	bet.mut.Lock()
+	defer bet.mut.Unlock()
+
+	numEvents := int64(len(bet.events))
+	start := numEvents - limit
+	if offset != nil {
+		if *offset < bet.firstSequence {
+			return nil, fmt.Errorf("events before sequence %d are no longer available", bet.firstSequence)
+		}
+		start = *offset - bet.firstSequence
+	}
+	if start < 0 {
+		start = 0
+	}
+	if start > numEvents {
+		start = numEvents
+	}
+	end := start + limit
+	if end > numEvents {
+		end = numEvents
+	}
+
+	events := make([]*types.BlockEvent, end-start)
+	copy(events, bet.events[start:end])
+
+	maxSequence := int64(0)
+	if numEvents > 0 {
+		maxSequence = bet.events[numEvents-1].Sequence
+	}
+
+	return &types.EventsBlocksResponse{
+		MaxSequence: maxSequence,
+		Events:      events,
+	}, nil
+}
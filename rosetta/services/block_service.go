@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"sync/atomic"
 
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 	"github.com/ElrondNetwork/elrond-proxy-go/rosetta/configuration"
@@ -12,7 +13,12 @@ import (
 
 type blockAPIService struct {
 	elrondProvider provider.ElrondProviderHandler
+	config         *configuration.Configuration
 	txsParser      *transactionsParser
+	blocksCache    *parsedBlocksCache
+	// latestFinalNonce is the last known final hyperblock nonce. Only the blocks up to it are cached, as the blocks
+	// above it can still be reorganized
+	latestFinalNonce uint64
 }
 
 // NewBlockAPIService will create a new instance of blockAPIService
@@ -23,7 +29,9 @@ func NewBlockAPIService(
 ) server.BlockAPIServicer {
 	return &blockAPIService{
 		elrondProvider: elrondProvider,
+		config:         cfg,
 		txsParser:      newTransactionParser(elrondProvider, cfg, networkConfig),
+		blocksCache:    newParsedBlocksCache(MaxCachedBlocks),
 	}
 }
 
@@ -32,6 +40,10 @@ func (bas *blockAPIService) Block(
 	_ context.Context,
 	request *types.BlockRequest,
 ) (*types.BlockResponse, *types.Error) {
+	if bas.config.IsOffline {
+		return nil, ErrOfflineMode
+	}
+
 	if request.BlockIdentifier.Index != nil {
 		return bas.getBlockByNonce(*request.BlockIdentifier.Index)
 	}
@@ -44,21 +56,61 @@ func (bas *blockAPIService) Block(
 }
 
 func (bas *blockAPIService) getBlockByNonce(nonce int64) (*types.BlockResponse, *types.Error) {
+	cachedBlock, ok := bas.blocksCache.getByNonce(nonce)
+	if ok {
+		return cachedBlock, nil
+	}
+
 	hyperBlock, err := bas.elrondProvider.GetBlockByNonce(nonce)
 	if err != nil {
 		return nil, wrapErr(ErrUnableToGetBlock, err)
 	}
 
-	return bas.parseHyperBlock(hyperBlock)
+	return bas.parseAndCacheHyperBlock(hyperBlock)
 }
 
 func (bas *blockAPIService) getBlockByHash(hash string) (*types.BlockResponse, *types.Error) {
+	cachedBlock, ok := bas.blocksCache.getByHash(hash)
+	if ok {
+		return cachedBlock, nil
+	}
+
 	hyperBlock, err := bas.elrondProvider.GetBlockByHash(hash)
 	if err != nil {
 		return nil, wrapErr(ErrUnableToGetBlock, err)
 	}
 
-	return bas.parseHyperBlock(hyperBlock)
+	return bas.parseAndCacheHyperBlock(hyperBlock)
+}
+
+// parseAndCacheHyperBlock parses a hyperblock fetched from the observers. Every fetched block invalidates the cached
+// blocks it conflicts with, but only the final ones are cached
+func (bas *blockAPIService) parseAndCacheHyperBlock(hyperBlock *data.Hyperblock) (*types.BlockResponse, *types.Error) {
+	blockResponse, errParse := bas.parseHyperBlock(hyperBlock)
+	if errParse != nil {
+		return nil, errParse
+	}
+
+	bas.blocksCache.invalidateConflicts(blockResponse.Block)
+	if bas.isFinalBlock(hyperBlock.Nonce) {
+		bas.blocksCache.put(blockResponse)
+	}
+
+	return blockResponse, nil
+}
+
+func (bas *blockAPIService) isFinalBlock(nonce uint64) bool {
+	if nonce <= atomic.LoadUint64(&bas.latestFinalNonce) {
+		return true
+	}
+
+	latestFinalNonce, err := bas.elrondProvider.GetLatestFinalBlockNonce()
+	if err != nil {
+		return false
+	}
+	atomic.StoreUint64(&bas.latestFinalNonce, latestFinalNonce)
+
+	return nonce <= latestFinalNonce
 }
 
 func (bas *blockAPIService) parseHyperBlock(hyperBlock *data.Hyperblock) (*types.BlockResponse, *types.Error) {
@@ -93,5 +145,9 @@ func (bas *blockAPIService) BlockTransaction(
 	_ context.Context,
 	_ *types.BlockTransactionRequest,
 ) (*types.BlockTransactionResponse, *types.Error) {
+	if bas.config.IsOffline {
+		return nil, ErrOfflineMode
+	}
+
 	return nil, ErrNotImplemented
 }
@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
@@ -50,6 +51,9 @@ func TestBlockAPIService_BlockByIndex(t *testing.T) {
 	}
 
 	elrondProviderMock := &mocks.ElrondProviderMock{
+		GetLatestBlockDataCalled: func() (*provider.BlockData, error) {
+			return &provider.BlockData{Nonce: uint64(blockIndex)}, nil
+		},
 		GetBlockByNonceCalled: func(nonce int64) (*data.Hyperblock, error) {
 			return &data.Hyperblock{
 				Nonce:         uint64(blockIndex),
@@ -193,3 +197,98 @@ func TestBlockAPIService_BlockByIndex(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, expectedBlock, blockResponse.Block)
 }
+
+func createHyperblocksProviderMock(latestFinalNonce *uint64, hashes map[int64]string, numFetches *int) *mocks.ElrondProviderMock {
+	getHyperblock := func(nonce int64) *data.Hyperblock {
+		*numFetches++
+		return &data.Hyperblock{
+			Nonce:         uint64(nonce),
+			Hash:          hashes[nonce],
+			PrevBlockHash: hashes[nonce-1],
+		}
+	}
+
+	return &mocks.ElrondProviderMock{
+		GetLatestFinalBlockNonceCalled: func() (uint64, error) {
+			return *latestFinalNonce, nil
+		},
+		GetBlockByNonceCalled: func(nonce int64) (*data.Hyperblock, error) {
+			return getHyperblock(nonce), nil
+		},
+		GetBlockByHashCalled: func(hash string) (*data.Hyperblock, error) {
+			for nonce, blockHash := range hashes {
+				if blockHash == hash {
+					return getHyperblock(nonce), nil
+				}
+			}
+			return nil, errors.New("block not found")
+		},
+	}
+}
+
+func TestBlockAPIService_BlockShouldCacheOnlyFinalBlocks(t *testing.T) {
+	t.Parallel()
+
+	latestNonce := uint64(5)
+	hashes := map[int64]string{4: "hash-4", 5: "hash-5", 6: "hash-6"}
+	numFetches := 0
+	elrondProviderMock := createHyperblocksProviderMock(&latestNonce, hashes, &numFetches)
+	blockAPIService := NewBlockAPIService(elrondProviderMock, &configuration.Configuration{}, &provider.NetworkConfig{})
+
+	nonce := int64(5)
+	hash := "hash-5"
+	for i := 0; i < 2; i++ {
+		blockResponse, err := blockAPIService.Block(context.Background(), &types.BlockRequest{
+			BlockIdentifier: &types.PartialBlockIdentifier{Index: &nonce},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, "hash-5", blockResponse.Block.BlockIdentifier.Hash)
+	}
+	blockResponse, err := blockAPIService.Block(context.Background(), &types.BlockRequest{
+		BlockIdentifier: &types.PartialBlockIdentifier{Hash: &hash},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, nonce, blockResponse.Block.BlockIdentifier.Index)
+	assert.Equal(t, 1, numFetches)
+
+	// blocks above the final nonce can still be reorganized, so they should be fetched every time
+	nonce = 6
+	for i := 0; i < 2; i++ {
+		_, err = blockAPIService.Block(context.Background(), &types.BlockRequest{
+			BlockIdentifier: &types.PartialBlockIdentifier{Index: &nonce},
+		})
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, 3, numFetches)
+}
+
+func TestBlockAPIService_BlockShouldInvalidateReorganizedBlocks(t *testing.T) {
+	t.Parallel()
+
+	latestNonce := uint64(10)
+	hashes := map[int64]string{3: "hash-3", 4: "hash-4", 5: "hash-5"}
+	numFetches := 0
+	elrondProviderMock := createHyperblocksProviderMock(&latestNonce, hashes, &numFetches)
+	blockAPIService := NewBlockAPIService(elrondProviderMock, &configuration.Configuration{}, &provider.NetworkConfig{})
+
+	getBlock := func(nonce int64) *types.Block {
+		blockResponse, err := blockAPIService.Block(context.Background(), &types.BlockRequest{
+			BlockIdentifier: &types.PartialBlockIdentifier{Index: &nonce},
+		})
+		assert.Nil(t, err)
+		return blockResponse.Block
+	}
+
+	getBlock(4)
+	getBlock(5)
+	assert.Equal(t, 2, numFetches)
+
+	// block 3 was reorganized, so the cached blocks built on top of the old one are removed
+	hashes[3] = "hash-3-reorg"
+	hashes[4] = "hash-4-reorg"
+	hashes[5] = "hash-5-reorg"
+	getBlock(3)
+	assert.Equal(t, "hash-4-reorg", getBlock(4).BlockIdentifier.Hash)
+	assert.Equal(t, "hash-5-reorg", getBlock(5).BlockIdentifier.Hash)
+	assert.Equal(t, 5, numFetches)
+}
@@ -0,0 +1,120 @@
+package services
+
+import (
+	"sync"
+
+	"github.com/coinbase/rosetta-sdk-go/types"
+)
+
+// parsedBlocksCache holds the parsed rosetta blocks, by nonce and by hash. When full, the oldest added block is evicted
+type parsedBlocksCache struct {
+	mut           sync.RWMutex
+	capacity      int
+	blocksByNonce map[int64]*types.BlockResponse
+	noncesByHash  map[string]int64
+	nonces        []int64
+}
+
+func newParsedBlocksCache(capacity int) *parsedBlocksCache {
+	return &parsedBlocksCache{
+		capacity:      capacity,
+		blocksByNonce: make(map[int64]*types.BlockResponse),
+		noncesByHash:  make(map[string]int64),
+		nonces:        make([]int64, 0, capacity),
+	}
+}
+
+func (pbc *parsedBlocksCache) getByNonce(nonce int64) (*types.BlockResponse, bool) {
+	pbc.mut.RLock()
+	defer pbc.mut.RUnlock()
+
+	block, ok := pbc.blocksByNonce[nonce]
+	return block, ok
+}
+
+func (pbc *parsedBlocksCache) getByHash(hash string) (*types.BlockResponse, bool) {
+	pbc.mut.RLock()
+	defer pbc.mut.RUnlock()
+
+	nonce, ok := pbc.noncesByHash[hash]
+	if !ok {
+		return nil, false
+	}
+
+	block, ok := pbc.blocksByNonce[nonce]
+	return block, ok
+}
+
+func (pbc *parsedBlocksCache) put(blockResponse *types.BlockResponse) {
+	pbc.mut.Lock()
+	defer pbc.mut.Unlock()
+
+	nonce := blockResponse.Block.BlockIdentifier.Index
+	if _, exists := pbc.blocksByNonce[nonce]; exists {
+		return
+	}
+
+	if len(pbc.nonces) >= pbc.capacity {
+		pbc.remove(pbc.nonces[0])
+	}
+
+	pbc.blocksByNonce[nonce] = blockResponse
+	pbc.noncesByHash[blockResponse.Block.BlockIdentifier.Hash] = nonce
+	pbc.nonces = append(pbc.nonces, nonce)
+}
+
+// invalidateConflicts removes the cached blocks which do not belong to the same chain as the provided block. A
+// conflicting block and all the cached blocks above it are removed, as they were reorganized
+func (pbc *parsedBlocksCache) invalidateConflicts(block *types.Block) {
+	pbc.mut.Lock()
+	defer pbc.mut.Unlock()
+
+	nonce := block.BlockIdentifier.Index
+	cachedBlock, ok := pbc.blocksByNonce[nonce]
+	if ok && cachedBlock.Block.BlockIdentifier.Hash != block.BlockIdentifier.Hash {
+		pbc.removeFrom(nonce)
+		return
+	}
+
+	parentBlock, ok := pbc.blocksByNonce[nonce-1]
+	if ok && block.ParentBlockIdentifier != nil && parentBlock.Block.BlockIdentifier.Hash != block.ParentBlockIdentifier.Hash {
+		pbc.removeFrom(nonce - 1)
+		return
+	}
+
+	childBlock, ok := pbc.blocksByNonce[nonce+1]
+	if ok && childBlock.Block.ParentBlockIdentifier != nil && childBlock.Block.ParentBlockIdentifier.Hash != block.BlockIdentifier.Hash {
+		pbc.removeFrom(nonce + 1)
+	}
+}
+
+func (pbc *parsedBlocksCache) removeFrom(nonce int64) {
+	for cachedNonce := range pbc.blocksByNonce {
+		if cachedNonce >= nonce {
+			pbc.remove(cachedNonce)
+		}
+	}
+}
+
+func (pbc *parsedBlocksCache) remove(nonce int64) {
+	block, ok := pbc.blocksByNonce[nonce]
+	if !ok {
+		return
+	}
+
+	delete(pbc.blocksByNonce, nonce)
+	delete(pbc.noncesByHash, block.Block.BlockIdentifier.Hash)
+	for idx, cachedNonce := range pbc.nonces {
+		if cachedNonce == nonce {
+			pbc.nonces = append(pbc.nonces[:idx], pbc.nonces[idx+1:]...)
+			break
+		}
+	}
+}
+
+func (pbc *parsedBlocksCache) len() int {
+	pbc.mut.RLock()
+	defer pbc.mut.RUnlock()
+
+	return len(pbc.blocksByNonce)
+}
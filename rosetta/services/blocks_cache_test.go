@@ -0,0 +1,82 @@
+package services
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/stretchr/testify/require"
+)
+
+func createCachedBlock(nonce int64, hash string, parentHash string) *types.BlockResponse {
+	return &types.BlockResponse{
+		Block: &types.Block{
+			BlockIdentifier:       &types.BlockIdentifier{Index: nonce, Hash: hash},
+			ParentBlockIdentifier: &types.BlockIdentifier{Index: nonce - 1, Hash: parentHash},
+		},
+	}
+}
+
+func TestParsedBlocksCache_PutAndGet(t *testing.T) {
+	t.Parallel()
+
+	cache := newParsedBlocksCache(2)
+	block := createCachedBlock(1, "hash-1", "hash-0")
+	cache.put(block)
+
+	cachedBlock, ok := cache.getByNonce(1)
+	require.True(t, ok)
+	require.Equal(t, block, cachedBlock)
+	cachedBlock, ok = cache.getByHash("hash-1")
+	require.True(t, ok)
+	require.Equal(t, block, cachedBlock)
+
+	_, ok = cache.getByNonce(2)
+	require.False(t, ok)
+	_, ok = cache.getByHash("hash-2")
+	require.False(t, ok)
+}
+
+func TestParsedBlocksCache_PutShouldEvictTheOldestBlock(t *testing.T) {
+	t.Parallel()
+
+	cache := newParsedBlocksCache(2)
+	for nonce := int64(1); nonce <= 3; nonce++ {
+		cache.put(createCachedBlock(nonce, fmt.Sprintf("hash-%d", nonce), fmt.Sprintf("hash-%d", nonce-1)))
+	}
+
+	require.Equal(t, 2, cache.len())
+	_, ok := cache.getByNonce(1)
+	require.False(t, ok)
+	_, ok = cache.getByHash("hash-1")
+	require.False(t, ok)
+	_, ok = cache.getByNonce(3)
+	require.True(t, ok)
+}
+
+func TestParsedBlocksCache_InvalidateConflicts(t *testing.T) {
+	t.Parallel()
+
+	cache := newParsedBlocksCache(10)
+	for nonce := int64(1); nonce <= 5; nonce++ {
+		cache.put(createCachedBlock(nonce, fmt.Sprintf("hash-%d", nonce), fmt.Sprintf("hash-%d", nonce-1)))
+	}
+
+	// a block of the same chain does not invalidate anything
+	cache.invalidateConflicts(createCachedBlock(6, "hash-6", "hash-5").Block)
+	require.Equal(t, 5, cache.len())
+
+	// a block with another parent invalidates its cached parent and the blocks above it
+	cache.invalidateConflicts(createCachedBlock(6, "hash-6", "hash-5-reorg").Block)
+	require.Equal(t, 4, cache.len())
+
+	// another block with the same nonce invalidates the cached one and the blocks above it
+	cache.invalidateConflicts(createCachedBlock(3, "hash-3-reorg", "hash-2").Block)
+	require.Equal(t, 2, cache.len())
+	_, ok := cache.getByHash("hash-3")
+	require.False(t, ok)
+
+	// a block which is not the parent of the cached child invalidates the child and the blocks above it
+	cache.invalidateConflicts(createCachedBlock(0, "hash-0-reorg", "").Block)
+	require.Equal(t, 0, cache.len())
+}
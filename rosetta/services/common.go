@@ -3,6 +3,15 @@ package services
 // SupportedOperationTypes is a list of the supported operations.
 var SupportedOperationTypes = []string{
 	opTransfer, opFee, opReward, opScResult, opInvalid,
+	opStake, opUnStake, opUnBond,
+	opDelegate, opUnDelegate, opWithdraw, opClaimRewards, opReDelegateRewards,
+}
+
+// constructionOperationTypes is the list of the operations which can be constructed. The validator operations are
+// only reported by the data API
+var constructionOperationTypes = []string{
+	opTransfer,
+	opDelegate, opUnDelegate, opWithdraw, opClaimRewards, opReDelegateRewards,
 }
 
 type objectsMap map[string]interface{}
@@ -3,6 +3,25 @@ package services
 const (
 	NumBlocksToGet = uint64(200)
 
+	// GasLimitESDTTransfer is the gas consumed by the ESDTTransfer built-in function, besides the move balance cost
+	GasLimitESDTTransfer = uint64(200000)
+
+	// MaxMempoolTransactions is the maximum number of transactions identifiers returned by the mempool endpoint
+	MaxMempoolTransactions = 1000
+
+	// MaxSearchTransactionsLimit is the maximum number of transactions returned by the search endpoint
+	MaxSearchTransactionsLimit = 100
+
+	// maxSearchConcurrentRequests is the maximum number of transactions fetched in parallel from the observers while
+	// searching the transactions of an address
+	maxSearchConcurrentRequests = 10
+
+	// MaxCachedBlocks is the maximum number of parsed blocks kept in memory
+	MaxCachedBlocks = 1000
+
+	// MaxBlockEventsLimit is the maximum number of events returned by the events endpoint
+	MaxBlockEventsLimit = 100
+
 	RosettaVersion = "1.4.5"
 	NodeVersion    = "1.1.0"
 
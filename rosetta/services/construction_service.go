@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/big"
 
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 	"github.com/ElrondNetwork/elrond-proxy-go/rosetta/configuration"
@@ -44,7 +45,7 @@ func (cas *constructionAPIService) ConstructionPreprocess(
 		return nil, err
 	}
 
-	options, err := getOptionsFromOperations(request.Operations)
+	options, err := cas.getOptionsFromOperations(request.Operations)
 	if err != nil {
 		return nil, err
 	}
@@ -70,6 +71,9 @@ func (cas *constructionAPIService) ConstructionPreprocess(
 		options["gasPrice"] = request.Metadata["gasPrice"]
 	}
 	if request.Metadata["data"] != nil {
+		if _, isDelegation := options["data"]; isDelegation {
+			return nil, wrapErr(ErrInvalidInputParam, errors.New("data field cannot be provided for delegation operations"))
+		}
 		options["data"] = request.Metadata["data"]
 	}
 
@@ -79,17 +83,24 @@ func (cas *constructionAPIService) ConstructionPreprocess(
 }
 
 func (cas *constructionAPIService) checkOperationsAndMeta(ops []*types.Operation, meta map[string]interface{}) *types.Error {
-	if len(ops) == 0 {
-		return wrapErr(ErrConstructionCheck, errors.New("invalid number of operations"))
+	if err := checkOperationsStructure(ops); err != nil {
+		return wrapErr(ErrConstructionCheck, err)
 	}
 
 	for _, op := range ops {
 		if !checkOperationsType(op) {
 			return wrapErr(ErrConstructionCheck, errors.New("unsupported operation type"))
 		}
-		if op.Amount.Currency.Symbol != cas.config.Currency.Symbol {
+		// the fee operation of the relayer has no amount, checkOperationsStructure already made sure of that
+		if op.Amount == nil {
+			continue
+		}
+		if op.Amount.Currency == nil || !cas.isSupportedCurrency(op.Amount.Currency) {
 			return wrapErr(ErrConstructionCheck, errors.New("unsupported currency symbol"))
 		}
+		if op.Amount.Currency.Symbol != ops[0].Amount.Currency.Symbol {
+			return wrapErr(ErrConstructionCheck, errors.New("operations with different currencies"))
+		}
 	}
 
 	if meta["gasLimit"] != nil {
@@ -106,8 +117,63 @@ func (cas *constructionAPIService) checkOperationsAndMeta(ops []*types.Operation
 	return nil
 }
 
+// checkOperationsStructure checks that the operations describe a transfer between a sender and a receiver,
+// optionally followed by the fee operation of the relayer paying for the transaction
+func checkOperationsStructure(ops []*types.Operation) error {
+	if len(ops) < 2 || len(ops) > 3 {
+		return errors.New("invalid number of operations")
+	}
+
+	for _, op := range ops {
+		if op.Account == nil || len(op.Account.Address) == 0 {
+			return errors.New("missing operation account")
+		}
+	}
+
+	sender, receiver := ops[0], ops[1]
+	if sender.Type != receiver.Type || sender.Type == opFee {
+		return errors.New("invalid operations types")
+	}
+	if sender.Amount == nil || receiver.Amount == nil || sender.Amount.Currency == nil || receiver.Amount.Currency == nil {
+		return errors.New("missing operation amount")
+	}
+	if sender.Amount.Value != negateValue(receiver.Amount.Value) {
+		return errors.New("sender and receiver amounts do not match")
+	}
+
+	if len(ops) == 2 {
+		return nil
+	}
+
+	relayer := ops[2]
+	if relayer.Type != opFee {
+		return errors.New("the third operation can only be the fee operation of the relayer")
+	}
+	if relayer.Amount != nil {
+		return errors.New("the relayer operation cannot have an amount")
+	}
+	if relayer.Account.Address == sender.Account.Address {
+		return errors.New("the relayer cannot be the sender")
+	}
+
+	return nil
+}
+
+func (cas *constructionAPIService) isSupportedCurrency(currency *types.Currency) bool {
+	if currency.Symbol == cas.config.Currency.Symbol {
+		return true
+	}
+
+	_, ok := cas.config.GetESDTCurrency(currency.Symbol)
+	return ok
+}
+
 func checkOperationsType(op *types.Operation) bool {
-	for _, supOp := range SupportedOperationTypes {
+	if op.Type == opFee {
+		return op.Amount == nil
+	}
+
+	for _, supOp := range constructionOperationTypes {
 		if supOp == op.Type {
 			return true
 		}
@@ -116,15 +182,35 @@ func checkOperationsType(op *types.Operation) bool {
 	return false
 }
 
-func getOptionsFromOperations(ops []*types.Operation) (objectsMap, *types.Error) {
-	if len(ops) < 2 {
-		return nil, wrapErr(ErrConstructionCheck, errors.New("invalid number of operations"))
+func (cas *constructionAPIService) getOptionsFromOperations(ops []*types.Operation) (objectsMap, *types.Error) {
+	if err := checkOperationsStructure(ops); err != nil {
+		return nil, wrapErr(ErrConstructionCheck, err)
 	}
 	options := make(objectsMap)
 	options["sender"] = ops[0].Account.Address
 	options["receiver"] = ops[1].Account.Address
 	options["type"] = ops[0].Type
 	options["value"] = ops[1].Amount.Value
+	if len(ops) == 3 {
+		options[relayerKey] = ops[2].Account.Address
+	}
+
+	currencySymbol := ops[1].Amount.Currency.Symbol
+	if currencySymbol != cas.config.Currency.Symbol {
+		options["tokenIdentifier"] = currencySymbol
+	}
+
+	if isDelegationOperation(ops[0].Type) {
+		if currencySymbol != cas.config.Currency.Symbol {
+			return nil, wrapErr(ErrConstructionCheck, errors.New("delegation operations require the native currency"))
+		}
+
+		dataField, err := createDelegationData(ops[0])
+		if err != nil {
+			return nil, err
+		}
+		options["data"] = dataField
+	}
 
 	return options, nil
 }
@@ -134,6 +220,10 @@ func (cas *constructionAPIService) ConstructionMetadata(
 	_ context.Context,
 	request *types.ConstructionMetadataRequest,
 ) (*types.ConstructionMetadataResponse, *types.Error) {
+	if cas.config.IsOffline {
+		return nil, ErrOfflineMode
+	}
+
 	txType, ok := request.Options["type"].(string)
 	if !ok {
 		return nil, wrapErr(ErrInvalidInputParam, errors.New("invalid operation type"))
@@ -144,7 +234,7 @@ func (cas *constructionAPIService) ConstructionMetadata(
 		return nil, errS
 	}
 
-	suggestedFee, gasPrice, gasLimit, errS := computeSuggestedFeeAndGas(txType, request.Options, cas.networkConfig)
+	suggestedFee, gasPrice, gasLimit, errS := computeSuggestedFeeAndGas(txType, request.Options, cas.networkConfig, cas.config.DelegationGasLimits)
 	if errS != nil {
 		return nil, errS
 	}
@@ -152,6 +242,24 @@ func (cas *constructionAPIService) ConstructionMetadata(
 	metadata["gasLimit"] = gasLimit
 	metadata["gasPrice"] = gasPrice
 
+	if _, isRelayed := metadata[relayerKey]; isRelayed {
+		// the relayer pays the fee of the whole relayed transaction
+		userTx, err := createTransaction(metadata)
+		if err != nil {
+			return nil, wrapErr(ErrMalformedValue, err)
+		}
+
+		relayerGasLimit, err := computeRelayerGasLimit(userTx, cas.elrondProvider, cas.networkConfig)
+		if err != nil {
+			return nil, wrapErr(ErrMalformedValue, err)
+		}
+
+		suggestedFee = big.NewInt(0).Mul(
+			big.NewInt(0).SetUint64(gasPrice),
+			big.NewInt(0).SetUint64(relayerGasLimit),
+		)
+	}
+
 	return &types.ConstructionMetadataResponse{
 		Metadata: metadata,
 		SuggestedFee: []*types.Amount{
@@ -181,6 +289,16 @@ func (cas *constructionAPIService) computeMetadata(options objectsMap) (objectsM
 		return nil, wrapErr(ErrMalformedValue, errors.New("value missing"))
 	}
 
+	esdtTransferData, isESDTTransfer, errESDT := getESDTTransferDataFromOptions(options)
+	if errESDT != nil {
+		return nil, errESDT
+	}
+	if isESDTTransfer {
+		// the tokens are transferred by the ESDTTransfer built-in function, without moving any native balance
+		metadata["data"] = []byte(esdtTransferData)
+		metadata["value"] = "0"
+	}
+
 	metadata["chainID"] = cas.networkConfig.ChainID
 	metadata["version"] = cas.networkConfig.MinTxVersion
 
@@ -200,6 +318,21 @@ func (cas *constructionAPIService) computeMetadata(options objectsMap) (objectsM
 
 	metadata["nonce"] = account.Nonce
 
+	if relayerI, isRelayed := options[relayerKey]; isRelayed {
+		relayer, ok := relayerI.(string)
+		if !ok {
+			return nil, wrapErr(ErrMalformedValue, errors.New("relayer address is invalid"))
+		}
+
+		relayerAccount, err := cas.elrondProvider.GetAccount(relayer)
+		if err != nil {
+			return nil, wrapErr(ErrUnableToGetAccount, err)
+		}
+
+		metadata[relayerKey] = relayer
+		metadata[relayerNonceKey] = relayerAccount.Nonce
+	}
+
 	return metadata, nil
 }
 
@@ -212,11 +345,19 @@ func (cas *constructionAPIService) ConstructionPayloads(
 		return nil, err
 	}
 
-	erdTx, err := createTransaction(request)
+	erdTx, err := createTransaction(request.Metadata)
 	if err != nil {
 		return nil, wrapErr(ErrMalformedValue, err)
 	}
 
+	relayedMetadata, isRelayed, err := getRelayedTxMetadata(request.Metadata)
+	if err != nil {
+		return nil, wrapErr(ErrMalformedValue, err)
+	}
+	if isRelayed {
+		return cas.createRelayedTxPayloads(erdTx, relayedMetadata)
+	}
+
 	mtx, err := json.Marshal(erdTx)
 	if err != nil {
 		return nil, wrapErr(ErrMalformedValue, err)
@@ -238,6 +379,55 @@ func (cas *constructionAPIService) ConstructionPayloads(
 	}, nil
 }
 
+// createRelayedTxPayloads returns the payload of the user transaction, to be signed by the sender. Since the relayed
+// transaction embeds the signed user transaction, the payload of the relayer is only returned once the signature of
+// the sender is provided in the metadata
+func (cas *constructionAPIService) createRelayedTxPayloads(
+	userTx *data.Transaction,
+	relayedMetadata *relayedTxMetadata,
+) (*types.ConstructionPayloadsResponse, *types.Error) {
+	userTxBytes, err := json.Marshal(userTx)
+	if err != nil {
+		return nil, wrapErr(ErrMalformedValue, err)
+	}
+
+	payloads := []*types.SigningPayload{
+		{
+			AccountIdentifier: &types.AccountIdentifier{
+				Address: userTx.Sender,
+			},
+			SignatureType: types.Ed25519,
+			Bytes:         userTxBytes,
+		},
+	}
+
+	userTx.Signature = relayedMetadata.InnerSignature
+	relayedTx, err := createRelayedTransaction(userTx, relayedMetadata, cas.elrondProvider, cas.networkConfig)
+	if err != nil {
+		return nil, wrapErr(ErrMalformedValue, err)
+	}
+
+	relayedTxBytes, err := json.Marshal(relayedTx)
+	if err != nil {
+		return nil, wrapErr(ErrMalformedValue, err)
+	}
+
+	if len(userTx.Signature) > 0 {
+		payloads = append(payloads, &types.SigningPayload{
+			AccountIdentifier: &types.AccountIdentifier{
+				Address: relayedTx.Sender,
+			},
+			SignatureType: types.Ed25519,
+			Bytes:         relayedTxBytes,
+		})
+	}
+
+	return &types.ConstructionPayloadsResponse{
+		UnsignedTransaction: hex.EncodeToString(relayedTxBytes),
+		Payloads:            payloads,
+	}, nil
+}
+
 // ConstructionParse will check if a transaction is correctly formatted
 func (cas *constructionAPIService) ConstructionParse(
 	_ context.Context,
@@ -255,6 +445,18 @@ func (cas *constructionAPIService) ConstructionParse(
 				Address: elrondTx.Sender,
 			},
 		}
+
+		userTx, isRelayed := parseRelayedTxData(elrondTx.Data, cas.elrondProvider)
+		if isRelayed {
+			signers = []*types.AccountIdentifier{
+				{
+					Address: userTx.Sender,
+				},
+				{
+					Address: elrondTx.Sender,
+				},
+			}
+		}
 	}
 
 	return &types.ConstructionParseResponse{
@@ -263,10 +465,10 @@ func (cas *constructionAPIService) ConstructionParse(
 	}, nil
 }
 
-func createTransaction(request *types.ConstructionPayloadsRequest) (*data.Transaction, error) {
+func createTransaction(metadata objectsMap) (*data.Transaction, error) {
 	tx := &data.Transaction{}
 
-	requestMetadataBytes, err := json.Marshal(request.Metadata)
+	requestMetadataBytes, err := json.Marshal(metadata)
 	if err != nil {
 		return nil, err
 	}
@@ -304,12 +506,20 @@ func (cas *constructionAPIService) ConstructionCombine(
 		return nil, wrapErr(ErrMalformedValue, err)
 	}
 
-	if len(request.Signatures) != 1 {
-		return nil, ErrInvalidInputParam
-	}
+	userTx, isRelayed := parseRelayedTxData(elrondTx.Data, cas.elrondProvider)
+	if isRelayed {
+		errCombine := combineRelayedTxSignatures(elrondTx, userTx, request.Signatures)
+		if errCombine != nil {
+			return nil, wrapErr(ErrInvalidInputParam, errCombine)
+		}
+	} else {
+		if len(request.Signatures) != 1 {
+			return nil, ErrInvalidInputParam
+		}
 
-	txSignature := hex.EncodeToString(request.Signatures[0].Bytes)
-	elrondTx.Signature = txSignature
+		txSignature := hex.EncodeToString(request.Signatures[0].Bytes)
+		elrondTx.Signature = txSignature
+	}
 
 	signedTxBytes, err := json.Marshal(elrondTx)
 	if err != nil {
@@ -323,6 +533,42 @@ func (cas *constructionAPIService) ConstructionCombine(
 	}, nil
 }
 
+// combineRelayedTxSignatures sets the signature of the relayer on the relayed transaction. The signature of the
+// sender, if provided, has to be the one already embedded in the relayed transaction
+func combineRelayedTxSignatures(relayedTx *data.Transaction, userTx *data.Transaction, signatures []*types.Signature) error {
+	if len(userTx.Signature) == 0 {
+		return errors.New("the inner transaction is not signed by the sender: call /construction/payloads again, " +
+			"with the signature of the sender as innerSignature in the metadata, and sign the payload of the relayer")
+	}
+	if len(signatures) == 0 || len(signatures) > 2 {
+		return errors.New("invalid number of signatures")
+	}
+
+	relayerSignature := ""
+	for _, signature := range signatures {
+		txSignature := hex.EncodeToString(signature.Bytes)
+
+		switch getSignerAddress(signature) {
+		case relayedTx.Sender:
+			relayerSignature = txSignature
+		case userTx.Sender:
+			if txSignature != userTx.Signature {
+				return errors.New("the signature of the sender does not match the inner transaction")
+			}
+		default:
+			return errors.New("unexpected signer")
+		}
+	}
+
+	if len(relayerSignature) == 0 {
+		return errors.New("missing relayer signature")
+	}
+
+	relayedTx.Signature = relayerSignature
+
+	return nil
+}
+
 // ConstructionDerive returns a bech32 address from public key bytes
 func (cas *constructionAPIService) ConstructionDerive(
 	_ context.Context,
@@ -373,6 +619,10 @@ func (cas *constructionAPIService) ConstructionSubmit(
 	_ context.Context,
 	request *types.ConstructionSubmitRequest,
 ) (*types.TransactionIdentifierResponse, *types.Error) {
+	if cas.config.IsOffline {
+		return nil, ErrOfflineMode
+	}
+
 	elrondTx, err := getTxFromRequest(request.SignedTransaction)
 	if err != nil {
 		return nil, wrapErr(ErrMalformedValue, err)
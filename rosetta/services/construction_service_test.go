@@ -1,10 +1,14 @@
 package services
 
 import (
+	"bytes"
 	"context"
+	"encoding/hex"
 	"fmt"
+	"math/big"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/vm"
 	"github.com/ElrondNetwork/elrond-proxy-go/config"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 	"github.com/ElrondNetwork/elrond-proxy-go/rosetta/configuration"
@@ -450,3 +454,414 @@ func TestConstructionAPIService_ConstructionSubmit(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, txHash, response.TransactionIdentifier.Hash)
 }
+
+func TestConstructionAPIService_ESDTTransfer(t *testing.T) {
+	t.Parallel()
+
+	networkCfg := &provider.NetworkConfig{
+		GasPerDataByte: 1,
+		MinGasPrice:    10,
+		MinGasLimit:    100,
+		ChainID:        "local-testnet",
+		MinTxVersion:   1,
+	}
+	generalConfig := &config.Config{
+		Rosetta: config.RosettaConfig{
+			ESDTCurrencies: []config.ESDTCurrencyConfig{{Identifier: "TKN-0102", Decimals: 6}},
+		},
+	}
+	cfg := configuration.LoadConfiguration(networkCfg, generalConfig)
+	esdtCurrency, ok := cfg.GetESDTCurrency("TKN-0102")
+	require.True(t, ok)
+
+	senderAddr := "senderAddr"
+	receiverAddr := "receiverAddr"
+	elrondProvider := &mocks.ElrondProviderMock{
+		GetAccountCalled: func(address string) (*data.Account, error) {
+			return &data.Account{Address: senderAddr, Nonce: 7}, nil
+		},
+	}
+	constructionAPIService := NewConstructionAPIService(elrondProvider, cfg, networkCfg)
+
+	operations := []*types.Operation{
+		{
+			OperationIdentifier: &types.OperationIdentifier{Index: 0},
+			Type:                opTransfer,
+			Account:             &types.AccountIdentifier{Address: senderAddr},
+			Amount:              &types.Amount{Value: "-1000", Currency: esdtCurrency},
+		},
+		{
+			OperationIdentifier: &types.OperationIdentifier{Index: 1},
+			RelatedOperations:   []*types.OperationIdentifier{{Index: 0}},
+			Type:                opTransfer,
+			Account:             &types.AccountIdentifier{Address: receiverAddr},
+			Amount:              &types.Amount{Value: "1000", Currency: esdtCurrency},
+		},
+	}
+
+	preprocessResponse, err := constructionAPIService.ConstructionPreprocess(context.Background(),
+		&types.ConstructionPreprocessRequest{Operations: operations},
+	)
+	require.Nil(t, err)
+	require.Equal(t, "TKN-0102", preprocessResponse.Options["tokenIdentifier"])
+
+	metadataResponse, err := constructionAPIService.ConstructionMetadata(context.Background(),
+		&types.ConstructionMetadataRequest{Options: preprocessResponse.Options},
+	)
+	require.Nil(t, err)
+
+	expectedData := "ESDTTransfer@544b4e2d30313032@03e8"
+	expectedGasLimit := networkCfg.MinGasLimit + uint64(len(expectedData)) + GasLimitESDTTransfer
+	require.Equal(t, []byte(expectedData), metadataResponse.Metadata["data"])
+	require.Equal(t, "0", metadataResponse.Metadata["value"])
+	require.Equal(t, expectedGasLimit, metadataResponse.Metadata["gasLimit"])
+	require.Equal(t, cfg.Currency, metadataResponse.SuggestedFee[0].Currency)
+
+	payloadsResponse, err := constructionAPIService.ConstructionPayloads(context.Background(),
+		&types.ConstructionPayloadsRequest{
+			Operations: operations,
+			Metadata:   metadataResponse.Metadata,
+		},
+	)
+	require.Nil(t, err)
+
+	parseResponse, err := constructionAPIService.ConstructionParse(context.Background(),
+		&types.ConstructionParseRequest{
+			Signed:      false,
+			Transaction: payloadsResponse.UnsignedTransaction,
+		},
+	)
+	require.Nil(t, err)
+	require.Equal(t, operations, parseResponse.Operations)
+
+	// operations with untracked tokens should be rejected
+	operations[0].Amount.Currency = &types.Currency{Symbol: "UNKNOWN-0304"}
+	operations[1].Amount.Currency = &types.Currency{Symbol: "UNKNOWN-0304"}
+	_, err = constructionAPIService.ConstructionPreprocess(context.Background(),
+		&types.ConstructionPreprocessRequest{Operations: operations},
+	)
+	require.Equal(t, ErrConstructionCheck.Code, err.Code)
+}
+
+func TestConstructionAPIService_OfflineModeShouldRejectOnlineEndpoints(t *testing.T) {
+	t.Parallel()
+
+	elrondProviderMock := &mocks.ElrondProviderMock{
+		GetAccountCalled: func(address string) (*data.Account, error) {
+			require.Fail(t, "should not have been called")
+			return nil, nil
+		},
+		SendTxCalled: func(tx *data.Transaction) (string, error) {
+			require.Fail(t, "should not have been called")
+			return "", nil
+		},
+	}
+	cfg := &configuration.Configuration{
+		IsOffline: true,
+	}
+	constructionAPIService := NewConstructionAPIService(elrondProviderMock, cfg, &provider.NetworkConfig{})
+
+	metadataResponse, err := constructionAPIService.ConstructionMetadata(context.Background(), &types.ConstructionMetadataRequest{})
+	require.Nil(t, metadataResponse)
+	require.Equal(t, ErrOfflineMode, err)
+
+	submitResponse, err := constructionAPIService.ConstructionSubmit(context.Background(), &types.ConstructionSubmitRequest{})
+	require.Nil(t, submitResponse)
+	require.Equal(t, ErrOfflineMode, err)
+}
+
+func TestConstructionAPIService_Delegation(t *testing.T) {
+	t.Parallel()
+
+	networkCfg := &provider.NetworkConfig{
+		GasPerDataByte: 1,
+		MinGasPrice:    10,
+		MinGasLimit:    100,
+		ChainID:        "local-testnet",
+		MinTxVersion:   1,
+	}
+	cfg := configuration.LoadConfiguration(networkCfg, &config.Config{})
+
+	senderAddr := "senderAddr"
+	delegationAddr := "delegationAddr"
+	elrondProvider := &mocks.ElrondProviderMock{
+		GetAccountCalled: func(address string) (*data.Account, error) {
+			return &data.Account{Address: senderAddr, Nonce: 7}, nil
+		},
+		DecodeAddressCalled: func(address string) ([]byte, error) {
+			if address == delegationAddr {
+				return vm.FirstDelegationSCAddress, nil
+			}
+			return make([]byte, 32), nil
+		},
+	}
+	constructionAPIService := NewConstructionAPIService(elrondProvider, cfg, networkCfg)
+
+	operations := []*types.Operation{
+		{
+			OperationIdentifier: &types.OperationIdentifier{Index: 0},
+			Type:                opUnDelegate,
+			Account:             &types.AccountIdentifier{Address: senderAddr},
+			Amount:              &types.Amount{Value: "0", Currency: cfg.Currency},
+			Metadata:            map[string]interface{}{undelegatedValueKey: "1000"},
+		},
+		{
+			OperationIdentifier: &types.OperationIdentifier{Index: 1},
+			RelatedOperations:   []*types.OperationIdentifier{{Index: 0}},
+			Type:                opUnDelegate,
+			Account:             &types.AccountIdentifier{Address: delegationAddr},
+			Amount:              &types.Amount{Value: "0", Currency: cfg.Currency},
+		},
+	}
+
+	preprocessResponse, err := constructionAPIService.ConstructionPreprocess(context.Background(),
+		&types.ConstructionPreprocessRequest{Operations: operations},
+	)
+	require.Nil(t, err)
+	require.Equal(t, "unDelegate@03e8", preprocessResponse.Options["data"])
+
+	metadataResponse, err := constructionAPIService.ConstructionMetadata(context.Background(),
+		&types.ConstructionMetadataRequest{Options: preprocessResponse.Options},
+	)
+	require.Nil(t, err)
+	require.Equal(t, []byte("unDelegate@03e8"), metadataResponse.Metadata["data"])
+	require.Equal(t, "0", metadataResponse.Metadata["value"])
+	require.Equal(t, cfg.DelegationGasLimits.UnDelegate, metadataResponse.Metadata["gasLimit"])
+
+	payloadsResponse, err := constructionAPIService.ConstructionPayloads(context.Background(),
+		&types.ConstructionPayloadsRequest{
+			Operations: operations,
+			Metadata:   metadataResponse.Metadata,
+		},
+	)
+	require.Nil(t, err)
+
+	parseResponse, err := constructionAPIService.ConstructionParse(context.Background(),
+		&types.ConstructionParseRequest{
+			Signed:      false,
+			Transaction: payloadsResponse.UnsignedTransaction,
+		},
+	)
+	require.Nil(t, err)
+	require.Equal(t, operations, parseResponse.Operations)
+
+	// validator operations cannot be constructed
+	operations[0].Type = opStake
+	operations[1].Type = opStake
+	_, err = constructionAPIService.ConstructionPreprocess(context.Background(),
+		&types.ConstructionPreprocessRequest{Operations: operations},
+	)
+	require.Equal(t, ErrConstructionCheck.Code, err.Code)
+}
+
+func TestConstructionAPIService_RelayedTransaction(t *testing.T) {
+	t.Parallel()
+
+	networkCfg := &provider.NetworkConfig{
+		GasPerDataByte: 1,
+		MinGasPrice:    10,
+		MinGasLimit:    100,
+		ChainID:        "local-testnet",
+		MinTxVersion:   1,
+	}
+	cfg := configuration.LoadConfiguration(networkCfg, &config.Config{})
+
+	senderAddr := "senderAddr"
+	receiverAddr := "receiverAddr"
+	relayerAddr := "relayerAddr"
+	elrondProvider := &mocks.ElrondProviderMock{
+		GetAccountCalled: func(address string) (*data.Account, error) {
+			if address == relayerAddr {
+				return &data.Account{Address: relayerAddr, Nonce: 12}, nil
+			}
+			return &data.Account{Address: address, Nonce: 7}, nil
+		},
+		DecodeAddressCalled: func(address string) ([]byte, error) {
+			return []byte(address), nil
+		},
+		EncodeAddressCalled: func(address []byte) (string, error) {
+			return string(address), nil
+		},
+	}
+	constructionAPIService := NewConstructionAPIService(elrondProvider, cfg, networkCfg)
+
+	operations := []*types.Operation{
+		{
+			OperationIdentifier: &types.OperationIdentifier{Index: 0},
+			Type:                opTransfer,
+			Account:             &types.AccountIdentifier{Address: senderAddr},
+			Amount:              &types.Amount{Value: "-1000", Currency: cfg.Currency},
+		},
+		{
+			OperationIdentifier: &types.OperationIdentifier{Index: 1},
+			RelatedOperations:   []*types.OperationIdentifier{{Index: 0}},
+			Type:                opTransfer,
+			Account:             &types.AccountIdentifier{Address: receiverAddr},
+			Amount:              &types.Amount{Value: "1000", Currency: cfg.Currency},
+		},
+		{
+			OperationIdentifier: &types.OperationIdentifier{Index: 2},
+			Type:                opFee,
+			Account:             &types.AccountIdentifier{Address: relayerAddr},
+		},
+	}
+
+	preprocessResponse, err := constructionAPIService.ConstructionPreprocess(context.Background(),
+		&types.ConstructionPreprocessRequest{Operations: operations},
+	)
+	require.Nil(t, err)
+	require.Equal(t, relayerAddr, preprocessResponse.Options[relayerKey])
+
+	metadataResponse, err := constructionAPIService.ConstructionMetadata(context.Background(),
+		&types.ConstructionMetadataRequest{Options: preprocessResponse.Options},
+	)
+	require.Nil(t, err)
+	require.Equal(t, relayerAddr, metadataResponse.Metadata[relayerKey])
+	require.Equal(t, uint64(12), metadataResponse.Metadata[relayerNonceKey])
+	require.Equal(t, uint64(7), metadataResponse.Metadata["nonce"])
+
+	// the sender signs first
+	payloadsResponse, err := constructionAPIService.ConstructionPayloads(context.Background(),
+		&types.ConstructionPayloadsRequest{Operations: operations, Metadata: metadataResponse.Metadata},
+	)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(payloadsResponse.Payloads))
+	require.Equal(t, senderAddr, payloadsResponse.Payloads[0].AccountIdentifier.Address)
+
+	parseResponse, err := constructionAPIService.ConstructionParse(context.Background(),
+		&types.ConstructionParseRequest{Signed: false, Transaction: payloadsResponse.UnsignedTransaction},
+	)
+	require.Nil(t, err)
+	require.Equal(t, operations, parseResponse.Operations)
+
+	_, err = constructionAPIService.ConstructionCombine(context.Background(),
+		&types.ConstructionCombineRequest{
+			UnsignedTransaction: payloadsResponse.UnsignedTransaction,
+			Signatures: []*types.Signature{
+				{SigningPayload: payloadsResponse.Payloads[0], Bytes: []byte("sender-signature")},
+			},
+		},
+	)
+	require.Equal(t, ErrInvalidInputParam.Code, err.Code)
+
+	// then the relayer signs the relayed transaction embedding the signed user transaction
+	senderSignature := bytes.Repeat([]byte{1}, signatureLength)
+	metadataResponse.Metadata[innerSignatureKey] = hex.EncodeToString(senderSignature)
+	payloadsResponse, err = constructionAPIService.ConstructionPayloads(context.Background(),
+		&types.ConstructionPayloadsRequest{Operations: operations, Metadata: metadataResponse.Metadata},
+	)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(payloadsResponse.Payloads))
+	require.Equal(t, relayerAddr, payloadsResponse.Payloads[1].AccountIdentifier.Address)
+
+	relayedTx, errTx := getTxFromRequest(payloadsResponse.UnsignedTransaction)
+	require.Nil(t, errTx)
+	require.Equal(t, relayerAddr, relayedTx.Sender)
+	require.Equal(t, senderAddr, relayedTx.Receiver)
+	require.Equal(t, uint64(12), relayedTx.Nonce)
+	require.Equal(t, "0", relayedTx.Value)
+	suggestedFee := big.NewInt(0).SetUint64(relayedTx.GasLimit * relayedTx.GasPrice)
+	require.Equal(t, suggestedFee.String(), metadataResponse.SuggestedFee[0].Value)
+
+	parseResponse, err = constructionAPIService.ConstructionParse(context.Background(),
+		&types.ConstructionParseRequest{Signed: false, Transaction: payloadsResponse.UnsignedTransaction},
+	)
+	require.Nil(t, err)
+	require.Equal(t, operations, parseResponse.Operations)
+
+	_, err = constructionAPIService.ConstructionCombine(context.Background(),
+		&types.ConstructionCombineRequest{
+			UnsignedTransaction: payloadsResponse.UnsignedTransaction,
+			Signatures: []*types.Signature{
+				{SigningPayload: payloadsResponse.Payloads[0], Bytes: []byte("other-signature")},
+				{SigningPayload: payloadsResponse.Payloads[1], Bytes: []byte("relayer-signature")},
+			},
+		},
+	)
+	require.Equal(t, ErrInvalidInputParam.Code, err.Code)
+
+	combineResponse, err := constructionAPIService.ConstructionCombine(context.Background(),
+		&types.ConstructionCombineRequest{
+			UnsignedTransaction: payloadsResponse.UnsignedTransaction,
+			Signatures: []*types.Signature{
+				{SigningPayload: payloadsResponse.Payloads[0], Bytes: senderSignature},
+				{SigningPayload: payloadsResponse.Payloads[1], Bytes: []byte("relayer-signature")},
+			},
+		},
+	)
+	require.Nil(t, err)
+
+	signedTx, errTx := getTxFromRequest(combineResponse.SignedTransaction)
+	require.Nil(t, errTx)
+	require.Equal(t, hex.EncodeToString([]byte("relayer-signature")), signedTx.Signature)
+
+	parseResponse, err = constructionAPIService.ConstructionParse(context.Background(),
+		&types.ConstructionParseRequest{Signed: true, Transaction: combineResponse.SignedTransaction},
+	)
+	require.Nil(t, err)
+	require.Equal(t, operations, parseResponse.Operations)
+	require.Equal(t, []*types.AccountIdentifier{{Address: senderAddr}, {Address: relayerAddr}}, parseResponse.AccountIdentifierSigners)
+}
+
+func TestConstructionAPIService_InvalidOperationsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	networkCfg := &provider.NetworkConfig{MinGasPrice: 10, MinGasLimit: 100}
+	cfg := configuration.LoadConfiguration(networkCfg, &config.Config{})
+	constructionAPIService := NewConstructionAPIService(&mocks.ElrondProviderMock{}, cfg, networkCfg)
+
+	createOperations := func() []*types.Operation {
+		return []*types.Operation{
+			{
+				OperationIdentifier: &types.OperationIdentifier{Index: 0},
+				Type:                opTransfer,
+				Account:             &types.AccountIdentifier{Address: "senderAddr"},
+				Amount:              &types.Amount{Value: "-1000", Currency: cfg.Currency},
+			},
+			{
+				OperationIdentifier: &types.OperationIdentifier{Index: 1},
+				Type:                opTransfer,
+				Account:             &types.AccountIdentifier{Address: "receiverAddr"},
+				Amount:              &types.Amount{Value: "1000", Currency: cfg.Currency},
+			},
+			{
+				OperationIdentifier: &types.OperationIdentifier{Index: 2},
+				Type:                opFee,
+				Account:             &types.AccountIdentifier{Address: "relayerAddr"},
+			},
+		}
+	}
+
+	invalidOperations := map[string][]*types.Operation{
+		"single operation": createOperations()[:1],
+		"too many operations": append(createOperations(), &types.Operation{
+			OperationIdentifier: &types.OperationIdentifier{Index: 3},
+			Type:                opFee,
+			Account:             &types.AccountIdentifier{Address: "otherAddr"},
+		}),
+	}
+
+	operations := createOperations()
+	operations[1].Amount.Value = "999"
+	invalidOperations["amounts mismatch"] = operations
+
+	operations = createOperations()
+	operations[2].Type = opTransfer
+	invalidOperations["third operation is not a fee"] = operations
+
+	operations = createOperations()
+	operations[2].Amount = &types.Amount{Value: "-10", Currency: cfg.Currency}
+	invalidOperations["relayer with amount"] = operations
+
+	operations = createOperations()
+	operations[2].Account.Address = "senderAddr"
+	invalidOperations["relayer is the sender"] = operations
+
+	for name, ops := range invalidOperations {
+		_, err := constructionAPIService.ConstructionPreprocess(context.Background(),
+			&types.ConstructionPreprocessRequest{Operations: ops},
+		)
+		require.NotNil(t, err, name)
+		require.Equal(t, ErrConstructionCheck.Code, err.Code, name)
+	}
+}
@@ -0,0 +1,182 @@
+package services
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/ElrondNetwork/elrond-proxy-go/config"
+	"github.com/coinbase/rosetta-sdk-go/types"
+)
+
+const (
+	opStake             = "Stake"
+	opUnStake           = "UnStake"
+	opUnBond            = "UnBond"
+	opDelegate          = "Delegate"
+	opUnDelegate        = "UnDelegate"
+	opWithdraw          = "Withdraw"
+	opClaimRewards      = "ClaimRewards"
+	opReDelegateRewards = "ReDelegateRewards"
+
+	// undelegatedValueKey is the operation metadata key holding the value of an unDelegate call
+	undelegatedValueKey = "undelegatedValue"
+)
+
+// validatorOperations maps the functions of the validator system smart contract to operation types
+var validatorOperations = map[string]string{
+	"stake":   opStake,
+	"unStake": opUnStake,
+	"unBond":  opUnBond,
+}
+
+// delegationOperations maps the functions of the delegation system smart contracts to operation types
+var delegationOperations = map[string]string{
+	"delegate":          opDelegate,
+	"unDelegate":        opUnDelegate,
+	"withdraw":          opWithdraw,
+	"claimRewards":      opClaimRewards,
+	"reDelegateRewards": opReDelegateRewards,
+}
+
+var metachainIdentifier = []byte{255}
+
+var nonDelegationSystemSCAddresses = [][]byte{
+	vm.StakingSCAddress,
+	vm.ValidatorSCAddress,
+	vm.ESDTSCAddress,
+	vm.GovernanceSCAddress,
+	vm.DelegationManagerSCAddress,
+}
+
+// systemSCCall holds a staking or delegation call decoded from the data field of a transaction
+type systemSCCall struct {
+	operationType    string
+	undelegatedValue *big.Int
+}
+
+func (call *systemSCCall) metadata() map[string]interface{} {
+	if call.undelegatedValue == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		undelegatedValueKey: call.undelegatedValue.String(),
+	}
+}
+
+// parseSystemSCCall decodes the data field of a transaction sent to the validator or to a delegation system smart
+// contract, given the decoded address of the receiver
+func parseSystemSCCall(receiver []byte, dataField []byte) (*systemSCCall, bool) {
+	arguments := strings.Split(string(dataField), dataFieldSeparator)
+
+	var opType string
+	var ok bool
+	switch {
+	case bytes.Equal(receiver, vm.ValidatorSCAddress):
+		opType, ok = validatorOperations[arguments[0]]
+	case isDelegationSCAddress(receiver):
+		opType, ok = delegationOperations[arguments[0]]
+	}
+	if !ok {
+		return nil, false
+	}
+
+	call := &systemSCCall{
+		operationType: opType,
+	}
+	if opType != opUnDelegate {
+		return call, true
+	}
+
+	if len(arguments) != 2 {
+		return nil, false
+	}
+	valueBytes, err := hex.DecodeString(arguments[1])
+	if err != nil {
+		return nil, false
+	}
+	call.undelegatedValue = big.NewInt(0).SetBytes(valueBytes)
+
+	return call, true
+}
+
+// isDelegationSCAddress returns true for the delegation contracts created by the delegation manager. These are
+// system smart contracts on the metachain, besides the ones with hard-coded addresses
+func isDelegationSCAddress(address []byte) bool {
+	if core.IsEmptyAddress(address) || !core.IsSmartContractOnMetachain(metachainIdentifier, address) {
+		return false
+	}
+
+	for _, systemSCAddress := range nonDelegationSystemSCAddresses {
+		if bytes.Equal(address, systemSCAddress) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isDelegationOperation(opType string) bool {
+	for _, delegationOpType := range delegationOperations {
+		if delegationOpType == opType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getDelegationGasLimit returns the configured gas limit of a delegation call
+func getDelegationGasLimit(opType string, gasLimits config.RosettaDelegationGasLimitsConfig) (uint64, bool) {
+	switch opType {
+	case opDelegate:
+		return gasLimits.Delegate, true
+	case opUnDelegate:
+		return gasLimits.UnDelegate, true
+	case opWithdraw:
+		return gasLimits.Withdraw, true
+	case opClaimRewards:
+		return gasLimits.ClaimRewards, true
+	case opReDelegateRewards:
+		return gasLimits.ReDelegateRewards, true
+	default:
+		return 0, false
+	}
+}
+
+// createDelegationData returns the data field of the delegation call described by the first operation of a
+// construction request
+func createDelegationData(op *types.Operation) (string, *types.Error) {
+	for function, opType := range delegationOperations {
+		if opType != op.Type {
+			continue
+		}
+		if opType != opUnDelegate {
+			return function, nil
+		}
+
+		value, ok := big.NewInt(0).SetString(fmt.Sprintf("%v", op.Metadata[undelegatedValueKey]), 10)
+		if !ok || value.Sign() <= 0 {
+			return "", wrapErr(ErrMalformedValue, errors.New("invalid undelegated value"))
+		}
+
+		return function + dataFieldSeparator + hex.EncodeToString(value.Bytes()), nil
+	}
+
+	return "", wrapErr(ErrConstructionCheck, fmt.Errorf("operation type %s cannot be constructed", op.Type))
+}
+
+// negateValue returns the negated representation of a non-negative value
+func negateValue(value string) string {
+	if value == "0" {
+		return value
+	}
+
+	return "-" + value
+}
@@ -0,0 +1,66 @@
+package services
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/vm"
+	"github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSystemSCCall(t *testing.T) {
+	t.Parallel()
+
+	userAddress := bytes.Repeat([]byte{1}, 32)
+	secondDelegationSCAddress := append([]byte{}, vm.FirstDelegationSCAddress...)
+	secondDelegationSCAddress[28] = 2
+
+	call, ok := parseSystemSCCall(vm.FirstDelegationSCAddress, []byte("delegate"))
+	require.True(t, ok)
+	require.Equal(t, &systemSCCall{operationType: opDelegate}, call)
+
+	call, ok = parseSystemSCCall(secondDelegationSCAddress, []byte("claimRewards"))
+	require.True(t, ok)
+	require.Equal(t, opClaimRewards, call.operationType)
+
+	call, ok = parseSystemSCCall(vm.FirstDelegationSCAddress, []byte("unDelegate@03e8"))
+	require.True(t, ok)
+	require.Equal(t, &systemSCCall{operationType: opUnDelegate, undelegatedValue: big.NewInt(1000)}, call)
+	require.Equal(t, map[string]interface{}{undelegatedValueKey: "1000"}, call.metadata())
+
+	call, ok = parseSystemSCCall(vm.ValidatorSCAddress, []byte("unBond@aa"))
+	require.True(t, ok)
+	require.Equal(t, opUnBond, call.operationType)
+
+	_, ok = parseSystemSCCall(vm.FirstDelegationSCAddress, []byte("unDelegate@xyz"))
+	require.False(t, ok)
+	_, ok = parseSystemSCCall(vm.ValidatorSCAddress, []byte("delegate"))
+	require.False(t, ok)
+	_, ok = parseSystemSCCall(vm.DelegationManagerSCAddress, []byte("delegate"))
+	require.False(t, ok)
+	_, ok = parseSystemSCCall(userAddress, []byte("delegate"))
+	require.False(t, ok)
+}
+
+func TestCreateDelegationData(t *testing.T) {
+	t.Parallel()
+
+	dataField, err := createDelegationData(&types.Operation{Type: opReDelegateRewards})
+	require.Nil(t, err)
+	require.Equal(t, "reDelegateRewards", dataField)
+
+	dataField, err = createDelegationData(&types.Operation{
+		Type:     opUnDelegate,
+		Metadata: map[string]interface{}{undelegatedValueKey: "1000"},
+	})
+	require.Nil(t, err)
+	require.Equal(t, "unDelegate@03e8", dataField)
+
+	_, err = createDelegationData(&types.Operation{Type: opUnDelegate})
+	require.Equal(t, ErrMalformedValue.Code, err.Code)
+
+	_, err = createDelegationData(&types.Operation{Type: opStake})
+	require.Equal(t, ErrConstructionCheck.Code, err.Code)
+}
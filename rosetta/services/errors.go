@@ -101,6 +101,36 @@ var (
 		Message:   "cannot parse pool transaction",
 		Retriable: false,
 	}
+	ErrUnsupportedCurrency = &types.Error{
+		Code:      19,
+		Message:   "unsupported currency",
+		Retriable: false,
+	}
+	ErrUnableToGetMempool = &types.Error{
+		Code:      20,
+		Message:   "unable to get mempool",
+		Retriable: true,
+	}
+	ErrOfflineMode = &types.Error{
+		Code:      21,
+		Message:   "endpoint not available in offline mode",
+		Retriable: false,
+	}
+	ErrUnableToSearchTransactions = &types.Error{
+		Code:      22,
+		Message:   "unable to search transactions",
+		Retriable: true,
+	}
+	ErrUnsupportedNetwork = &types.Error{
+		Code:      23,
+		Message:   "unsupported network identifier",
+		Retriable: false,
+	}
+	ErrHistoricalBalanceNotSupported = &types.Error{
+		Code:      24,
+		Message:   "the observers do not support historical balance lookups",
+		Retriable: false,
+	}
 
 	Errors = []*types.Error{
 		ErrUnableToGetChainID,
@@ -121,6 +151,12 @@ var (
 		ErrTransactionIsNotInPool,
 		ErrCannotParsePoolTransaction,
 		ErrInvalidInputParam,
+		ErrUnsupportedCurrency,
+		ErrUnableToGetMempool,
+		ErrOfflineMode,
+		ErrUnableToSearchTransactions,
+		ErrUnsupportedNetwork,
+		ErrHistoricalBalanceNotSupported,
 	}
 )
 
@@ -0,0 +1,76 @@
+package services
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/coinbase/rosetta-sdk-go/types"
+)
+
+const dataFieldSeparator = "@"
+
+// esdtTransfer holds the details of an ESDT transfer encoded in the data field of a transaction
+type esdtTransfer struct {
+	tokenIdentifier string
+	value           *big.Int
+}
+
+// parseESDTTransfer extracts the ESDT transfer encoded in a data field such as ESDTTransfer@<token hex>@<value hex>
+func parseESDTTransfer(dataField []byte) (*esdtTransfer, bool) {
+	tokens := strings.Split(string(dataField), dataFieldSeparator)
+	if len(tokens) < 3 || tokens[0] != core.BuiltInFunctionESDTTransfer {
+		return nil, false
+	}
+
+	tokenIdentifier, err := hex.DecodeString(tokens[1])
+	if err != nil || len(tokenIdentifier) == 0 {
+		return nil, false
+	}
+
+	valueBytes, err := hex.DecodeString(tokens[2])
+	if err != nil {
+		return nil, false
+	}
+
+	return &esdtTransfer{
+		tokenIdentifier: string(tokenIdentifier),
+		value:           big.NewInt(0).SetBytes(valueBytes),
+	}, true
+}
+
+// createESDTTransferData returns the data field of a transaction transferring the given value of an ESDT token
+func createESDTTransferData(tokenIdentifier string, value *big.Int) string {
+	return strings.Join([]string{
+		core.BuiltInFunctionESDTTransfer,
+		hex.EncodeToString([]byte(tokenIdentifier)),
+		hex.EncodeToString(value.Bytes()),
+	}, dataFieldSeparator)
+}
+
+// getESDTTransferDataFromOptions returns the data field of the ESDT transfer described by the construction options.
+// The returned flag is false if the options describe a transfer of the native currency
+func getESDTTransferDataFromOptions(options objectsMap) (string, bool, *types.Error) {
+	tokenIdentifierI, ok := options["tokenIdentifier"]
+	if !ok {
+		return "", false, nil
+	}
+
+	tokenIdentifier, ok := tokenIdentifierI.(string)
+	if !ok || len(tokenIdentifier) == 0 {
+		return "", false, wrapErr(ErrMalformedValue, errors.New("invalid token identifier"))
+	}
+	if _, hasData := options["data"]; hasData {
+		return "", false, wrapErr(ErrInvalidInputParam, errors.New("data field cannot be provided for ESDT transfers"))
+	}
+
+	value, ok := big.NewInt(0).SetString(fmt.Sprintf("%v", options["value"]), 10)
+	if !ok || value.Sign() <= 0 {
+		return "", false, wrapErr(ErrMalformedValue, errors.New("invalid ESDT transfer value"))
+	}
+
+	return createESDTTransferData(tokenIdentifier, value), true, nil
+}
@@ -0,0 +1,50 @@
+package services
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/rosetta/configuration"
+	"github.com/ElrondNetwork/elrond-proxy-go/rosetta/provider"
+	"github.com/coinbase/rosetta-sdk-go/server"
+	"github.com/coinbase/rosetta-sdk-go/types"
+)
+
+type eventsAPIService struct {
+	config        *configuration.Configuration
+	eventsTracker *blockEventsTracker
+}
+
+// NewEventsAPIService will create a new instance of eventsAPIService
+func NewEventsAPIService(elrondProvider provider.ElrondProviderHandler, cfg *configuration.Configuration) server.EventsAPIServicer {
+	return &eventsAPIService{
+		config:        cfg,
+		eventsTracker: newBlockEventsTracker(elrondProvider, cfg),
+	}
+}
+
+// EventsBlocks implements the /events/blocks endpoint
+func (eas *eventsAPIService) EventsBlocks(
+	_ context.Context,
+	request *types.EventsBlocksRequest,
+) (*types.EventsBlocksResponse, *types.Error) {
+	if eas.config.IsOffline {
+		return nil, ErrOfflineMode
+	}
+
+	limit := int64(MaxBlockEventsLimit)
+	if request.Limit != nil && *request.Limit > 0 && *request.Limit < limit {
+		limit = *request.Limit
+	}
+
+	err := eas.eventsTracker.sync()
+	if err != nil {
+		return nil, wrapErr(ErrUnableToGetBlock, err)
+	}
+
+	response, err := eas.eventsTracker.getEvents(request.Offset, limit)
+	if err != nil {
+		return nil, wrapErr(ErrInvalidInputParam, err)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,139 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/rosetta/configuration"
+	"github.com/ElrondNetwork/elrond-proxy-go/rosetta/mocks"
+	"github.com/ElrondNetwork/elrond-proxy-go/rosetta/provider"
+	"github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/stretchr/testify/require"
+)
+
+type testChain struct {
+	mut    sync.Mutex
+	hashes []string
+}
+
+func (tc *testChain) extend(fork string, numBlocks int) {
+	tc.mut.Lock()
+	defer tc.mut.Unlock()
+
+	for i := 0; i < numBlocks; i++ {
+		tc.hashes = append(tc.hashes, fmt.Sprintf("%s-%d", fork, len(tc.hashes)+1))
+	}
+}
+
+func (tc *testChain) reorg(fromNonce int, fork string) {
+	tc.mut.Lock()
+	numBlocks := len(tc.hashes) - fromNonce + 1
+	tc.hashes = tc.hashes[:fromNonce-1]
+	tc.mut.Unlock()
+
+	tc.extend(fork, numBlocks)
+}
+
+func (tc *testChain) createProviderMock() *mocks.ElrondProviderMock {
+	return &mocks.ElrondProviderMock{
+		GetLatestBlockDataCalled: func() (*provider.BlockData, error) {
+			tc.mut.Lock()
+			defer tc.mut.Unlock()
+
+			return &provider.BlockData{Nonce: uint64(len(tc.hashes))}, nil
+		},
+		GetBlockByNonceCalled: func(nonce int64) (*data.Hyperblock, error) {
+			tc.mut.Lock()
+			defer tc.mut.Unlock()
+
+			hyperblock := &data.Hyperblock{
+				Nonce: uint64(nonce),
+				Hash:  tc.hashes[nonce-1],
+			}
+			if nonce > 1 {
+				hyperblock.PrevBlockHash = tc.hashes[nonce-2]
+			}
+
+			return hyperblock, nil
+		},
+	}
+}
+
+func createEventsTestConfiguration(oldestBlockLookback uint64) *configuration.Configuration {
+	return &configuration.Configuration{
+		GenesisBlockIdentifier: &types.BlockIdentifier{Index: 1},
+		OldestBlockLookback:    oldestBlockLookback,
+	}
+}
+
+func requireBlockEvent(t *testing.T, event *types.BlockEvent, sequence int64, eventType types.BlockEventType, nonce int64, hash string) {
+	require.Equal(t, &types.BlockEvent{
+		Sequence:        sequence,
+		BlockIdentifier: &types.BlockIdentifier{Index: nonce, Hash: hash},
+		Type:            eventType,
+	}, event)
+}
+
+func TestEventsAPIService_EventsBlocksOfflineShouldErr(t *testing.T) {
+	t.Parallel()
+
+	eventsService := NewEventsAPIService(&mocks.ElrondProviderMock{}, &configuration.Configuration{IsOffline: true})
+
+	response, err := eventsService.EventsBlocks(context.Background(), &types.EventsBlocksRequest{})
+	require.Nil(t, response)
+	require.Equal(t, ErrOfflineMode, err)
+}
+
+func TestEventsAPIService_EventsBlocksShouldHandleReorgs(t *testing.T) {
+	t.Parallel()
+
+	chain := &testChain{}
+	chain.extend("a", 3)
+	eventsService := NewEventsAPIService(chain.createProviderMock(), createEventsTestConfiguration(200))
+
+	response, err := eventsService.EventsBlocks(context.Background(), &types.EventsBlocksRequest{})
+	require.Nil(t, err)
+	require.Equal(t, int64(2), response.MaxSequence)
+	require.Len(t, response.Events, 3)
+	requireBlockEvent(t, response.Events[0], 0, types.ADDED, 1, "a-1")
+	requireBlockEvent(t, response.Events[2], 2, types.ADDED, 3, "a-3")
+
+	chain.reorg(2, "b")
+	chain.extend("b", 1)
+
+	offset := int64(3)
+	response, err = eventsService.EventsBlocks(context.Background(), &types.EventsBlocksRequest{Offset: &offset})
+	require.Nil(t, err)
+	require.Equal(t, int64(7), response.MaxSequence)
+	require.Len(t, response.Events, 5)
+	requireBlockEvent(t, response.Events[0], 3, types.REMOVED, 3, "a-3")
+	requireBlockEvent(t, response.Events[1], 4, types.REMOVED, 2, "a-2")
+	requireBlockEvent(t, response.Events[2], 5, types.ADDED, 2, "b-2")
+	requireBlockEvent(t, response.Events[3], 6, types.ADDED, 3, "b-3")
+	requireBlockEvent(t, response.Events[4], 7, types.ADDED, 4, "b-4")
+
+	limit := int64(2)
+	response, err = eventsService.EventsBlocks(context.Background(), &types.EventsBlocksRequest{Limit: &limit})
+	require.Nil(t, err)
+	require.Equal(t, int64(7), response.MaxSequence)
+	require.Len(t, response.Events, 2)
+	requireBlockEvent(t, response.Events[0], 6, types.ADDED, 3, "b-3")
+	requireBlockEvent(t, response.Events[1], 7, types.ADDED, 4, "b-4")
+}
+
+func TestEventsAPIService_EventsBlocksShouldStartFromTheOldestBlock(t *testing.T) {
+	t.Parallel()
+
+	chain := &testChain{}
+	chain.extend("a", 60)
+	eventsService := NewEventsAPIService(chain.createProviderMock(), createEventsTestConfiguration(50))
+
+	offset := int64(0)
+	response, err := eventsService.EventsBlocks(context.Background(), &types.EventsBlocksRequest{Offset: &offset})
+	require.Nil(t, err)
+	require.Equal(t, int64(50), response.MaxSequence)
+	requireBlockEvent(t, response.Events[0], 0, types.ADDED, 10, "a-10")
+}
@@ -11,6 +11,7 @@ import (
 
 type mempoolAPIService struct {
 	elrondProvider provider.ElrondProviderHandler
+	config         *configuration.Configuration
 	txsParser      *transactionsParser
 }
 
@@ -22,13 +23,36 @@ func NewMempoolApiService(
 ) server.MempoolAPIServicer {
 	return &mempoolAPIService{
 		elrondProvider: elrondProvider,
+		config:         cfg,
 		txsParser:      newTransactionParser(elrondProvider, cfg, networkConfig),
 	}
 }
 
-// Mempool is not implemented yet
+// Mempool will return the identifiers of the transactions that are pending in the observers' pools
 func (mas *mempoolAPIService) Mempool(context.Context, *types.NetworkRequest) (*types.MempoolResponse, *types.Error) {
-	return nil, ErrNotImplemented
+	if mas.config.IsOffline {
+		return nil, ErrOfflineMode
+	}
+
+	txsHashes, err := mas.elrondProvider.GetTransactionsHashesFromPool()
+	if err != nil {
+		return nil, wrapErr(ErrUnableToGetMempool, err)
+	}
+
+	if len(txsHashes) > MaxMempoolTransactions {
+		txsHashes = txsHashes[:MaxMempoolTransactions]
+	}
+
+	txsIdentifiers := make([]*types.TransactionIdentifier, 0, len(txsHashes))
+	for _, txHash := range txsHashes {
+		txsIdentifiers = append(txsIdentifiers, &types.TransactionIdentifier{
+			Hash: txHash,
+		})
+	}
+
+	return &types.MempoolResponse{
+		TransactionIdentifiers: txsIdentifiers,
+	}, nil
 }
 
 // MempoolTransaction will return operations for a transaction that is in pool
@@ -36,6 +60,10 @@ func (mas *mempoolAPIService) MempoolTransaction(
 	_ context.Context,
 	request *types.MempoolTransactionRequest,
 ) (*types.MempoolTransactionResponse, *types.Error) {
+	if mas.config.IsOffline {
+		return nil, ErrOfflineMode
+	}
+
 	tx, ok := mas.elrondProvider.GetTransactionByHashFromPool(request.TransactionIdentifier.Hash)
 	if !ok {
 		return nil, ErrTransactionIsNotInPool
@@ -2,6 +2,8 @@ package services
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/data/transaction"
@@ -111,3 +113,57 @@ func TestMempoolAPIService_MempoolTransaction(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, expectedRosettaTx, txResponse.Transaction)
 }
+
+func TestMempoolAPIService_Mempool(t *testing.T) {
+	t.Parallel()
+
+	elrondProviderMock := &mocks.ElrondProviderMock{
+		GetTransactionsHashesFromPoolCalled: func() ([]string, error) {
+			return []string{"hash1", "hash2"}, nil
+		},
+	}
+	mempoolApiService := NewMempoolApiService(elrondProviderMock, &configuration.Configuration{}, &provider.NetworkConfig{})
+
+	mempoolResponse, err := mempoolApiService.Mempool(context.Background(), &types.NetworkRequest{})
+	require.Nil(t, err)
+	require.Equal(t, &types.MempoolResponse{
+		TransactionIdentifiers: []*types.TransactionIdentifier{
+			{Hash: "hash1"},
+			{Hash: "hash2"},
+		},
+	}, mempoolResponse)
+}
+
+func TestMempoolAPIService_MempoolShouldCapTheNumberOfTransactions(t *testing.T) {
+	t.Parallel()
+
+	elrondProviderMock := &mocks.ElrondProviderMock{
+		GetTransactionsHashesFromPoolCalled: func() ([]string, error) {
+			txsHashes := make([]string, MaxMempoolTransactions+10)
+			for idx := range txsHashes {
+				txsHashes[idx] = fmt.Sprintf("hash%d", idx)
+			}
+			return txsHashes, nil
+		},
+	}
+	mempoolApiService := NewMempoolApiService(elrondProviderMock, &configuration.Configuration{}, &provider.NetworkConfig{})
+
+	mempoolResponse, err := mempoolApiService.Mempool(context.Background(), &types.NetworkRequest{})
+	require.Nil(t, err)
+	require.Len(t, mempoolResponse.TransactionIdentifiers, MaxMempoolTransactions)
+}
+
+func TestMempoolAPIService_MempoolProviderErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	elrondProviderMock := &mocks.ElrondProviderMock{
+		GetTransactionsHashesFromPoolCalled: func() ([]string, error) {
+			return nil, errors.New("local error")
+		},
+	}
+	mempoolApiService := NewMempoolApiService(elrondProviderMock, &configuration.Configuration{}, &provider.NetworkConfig{})
+
+	mempoolResponse, err := mempoolApiService.Mempool(context.Background(), &types.NetworkRequest{})
+	require.Nil(t, mempoolResponse)
+	require.Equal(t, ErrUnableToGetMempool.Code, err.Code)
+}
@@ -40,6 +40,10 @@ func (nas *networkAPIService) NetworkStatus(
 	_ context.Context,
 	_ *types.NetworkRequest,
 ) (*types.NetworkStatusResponse, *types.Error) {
+	if nas.config.IsOffline {
+		return nil, ErrOfflineMode
+	}
+
 	latestBlockData, err := nas.elrondProvider.GetLatestBlockData()
 	if err != nil {
 		return nil, wrapErr(ErrUnableToGetNodeStatus, err)
@@ -67,10 +71,10 @@ func (nas *networkAPIService) NetworkStatus(
 }
 
 func (nas *networkAPIService) getOldestBlock(latestBlockNonce uint64) (*provider.BlockData, error) {
-	oldestBlockNonce := uint64(1)
+	oldestBlockNonce := uint64(nas.config.GenesisBlockIdentifier.Index)
 
-	if latestBlockNonce > NumBlocksToGet {
-		oldestBlockNonce = latestBlockNonce - NumBlocksToGet
+	if latestBlockNonce > oldestBlockNonce+nas.config.OldestBlockLookback {
+		oldestBlockNonce = latestBlockNonce - nas.config.OldestBlockLookback
 	}
 
 	block, err := nas.elrondProvider.GetBlockByNonce(int64(oldestBlockNonce))
@@ -106,8 +110,9 @@ func (nas *networkAPIService) NetworkOptions(
 					Successful: false,
 				},
 			},
-			OperationTypes: SupportedOperationTypes,
-			Errors:         Errors,
+			OperationTypes:          SupportedOperationTypes,
+			Errors:                  Errors,
+			HistoricalBalanceLookup: true,
 		},
 	}, nil
 }
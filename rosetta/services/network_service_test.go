@@ -63,8 +63,9 @@ func TestNetworkAPIService_NetworkOptions(t *testing.T) {
 					Successful: false,
 				},
 			},
-			OperationTypes: SupportedOperationTypes,
-			Errors:         Errors,
+			OperationTypes:          SupportedOperationTypes,
+			Errors:                  Errors,
+			HistoricalBalanceLookup: true,
 		},
 	}, networkOptions)
 }
@@ -86,7 +87,7 @@ func TestNetworkAPIService_NetworkStatus(t *testing.T) {
 		GetBlockByNonceCalled: func(nonce int64) (*data.Hyperblock, error) {
 			return &data.Hyperblock{
 				Hash:  oldestBlockHash,
-				Nonce: uint64(oldestBlockNonce),
+				Nonce: uint64(nonce),
 			}, nil
 		},
 	}
@@ -95,6 +96,7 @@ func TestNetworkAPIService_NetworkStatus(t *testing.T) {
 			Index: 1,
 			Hash:  configuration.GenesisBlockHashMainnet,
 		},
+		OldestBlockLookback: 200,
 		Peers: []*types.Peer{
 			{
 				PeerID: "bla-bla-bla",
@@ -120,3 +122,17 @@ func TestNetworkAPIService_NetworkStatus(t *testing.T) {
 		Peers:      cfg.Peers,
 	}, networkStatusResponse)
 }
+
+func TestNetworkAPIService_NetworkStatusOfflineModeShouldErr(t *testing.T) {
+	t.Parallel()
+
+	elrondProviderMock := &mocks.ElrondProviderMock{}
+	cfg := &configuration.Configuration{
+		IsOffline: true,
+	}
+	networkAPIService := NewNetworkAPIService(elrondProviderMock, cfg)
+
+	networkStatusResponse, err := networkAPIService.NetworkStatus(context.Background(), nil)
+	assert.Nil(t, networkStatusResponse)
+	assert.Equal(t, ErrOfflineMode, err)
+}
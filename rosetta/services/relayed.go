@@ -0,0 +1,231 @@
+package services
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/core"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/rosetta/provider"
+	"github.com/coinbase/rosetta-sdk-go/types"
+)
+
+const (
+	relayerKey        = "relayer"
+	relayerNonceKey   = "relayerNonce"
+	innerSignatureKey = "innerSignature"
+
+	// ed25519 signatures always have 64 bytes, so the size of a relayed data field is known before signing
+	signatureLength = 64
+)
+
+var errInvalidTxValue = errors.New("invalid transaction value")
+
+// relayedTxMetadata holds the relayer fields of the construction metadata
+type relayedTxMetadata struct {
+	Relayer        string `json:"relayer"`
+	RelayerNonce   uint64 `json:"relayerNonce"`
+	InnerSignature string `json:"innerSignature"`
+}
+
+// getRelayedTxMetadata returns the relayer fields of the construction metadata, if the transaction is relayed
+func getRelayedTxMetadata(metadata objectsMap) (*relayedTxMetadata, bool, error) {
+	if _, ok := metadata[relayerKey]; !ok {
+		return nil, false, nil
+	}
+
+	metadataBytes, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, false, err
+	}
+
+	relayedMetadata := &relayedTxMetadata{}
+	err = json.Unmarshal(metadataBytes, relayedMetadata)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(relayedMetadata.Relayer) == 0 {
+		return nil, false, errors.New("empty relayer address")
+	}
+
+	return relayedMetadata, true, nil
+}
+
+// createRelayedTransaction wraps the user transaction into a transaction sent, and paid for, by the relayer
+func createRelayedTransaction(
+	userTx *data.Transaction,
+	relayedMetadata *relayedTxMetadata,
+	elrondProvider provider.ElrondProviderHandler,
+	networkConfig *provider.NetworkConfig,
+) (*data.Transaction, error) {
+	relayedData, err := createRelayedTxData(userTx, elrondProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.Transaction{
+		Nonce:    relayedMetadata.RelayerNonce,
+		Value:    "0",
+		Receiver: userTx.Sender,
+		Sender:   relayedMetadata.Relayer,
+		GasPrice: userTx.GasPrice,
+		GasLimit: userTx.GasLimit + computeMoveBalanceGasLimit(relayedData, networkConfig),
+		Data:     relayedData,
+		ChainID:  userTx.ChainID,
+		Version:  userTx.Version,
+	}, nil
+}
+
+// computeRelayerGasLimit returns the gas limit of the relayed transaction, which has to be exactly the gas limit of
+// the user transaction plus the move balance cost of the relayed one
+func computeRelayerGasLimit(
+	userTx *data.Transaction,
+	elrondProvider provider.ElrondProviderHandler,
+	networkConfig *provider.NetworkConfig,
+) (uint64, error) {
+	signedUserTx := *userTx
+	signedUserTx.Signature = hex.EncodeToString(make([]byte, signatureLength))
+
+	relayedData, err := createRelayedTxData(&signedUserTx, elrondProvider)
+	if err != nil {
+		return 0, err
+	}
+
+	return userTx.GasLimit + computeMoveBalanceGasLimit(relayedData, networkConfig), nil
+}
+
+func computeMoveBalanceGasLimit(dataField []byte, networkConfig *provider.NetworkConfig) uint64 {
+	return networkConfig.MinGasLimit + networkConfig.GasPerDataByte*uint64(len(dataField))
+}
+
+// createRelayedTxData returns the data field of a relayed transaction: relayedTx@<hex encoded user transaction>
+func createRelayedTxData(userTx *data.Transaction, elrondProvider provider.ElrondProviderHandler) ([]byte, error) {
+	nodeTx, err := toNodeTransaction(userTx, elrondProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeTxBytes, err := json.Marshal(nodeTx)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(fmt.Sprintf("%s@%s", core.RelayedTransaction, hex.EncodeToString(nodeTxBytes))), nil
+}
+
+// parseRelayedTxData returns the user transaction held by the data field of a relayed transaction, if any
+func parseRelayedTxData(dataField []byte, elrondProvider provider.ElrondProviderHandler) (*data.Transaction, bool) {
+	prefix := core.RelayedTransaction + "@"
+	if !strings.HasPrefix(string(dataField), prefix) {
+		return nil, false
+	}
+
+	nodeTxBytes, err := hex.DecodeString(strings.TrimPrefix(string(dataField), prefix))
+	if err != nil {
+		return nil, false
+	}
+
+	nodeTx := &transaction.Transaction{}
+	err = json.Unmarshal(nodeTxBytes, nodeTx)
+	if err != nil {
+		return nil, false
+	}
+
+	userTx, err := fromNodeTransaction(nodeTx, elrondProvider)
+	if err != nil {
+		return nil, false
+	}
+
+	return userTx, true
+}
+
+func toNodeTransaction(tx *data.Transaction, elrondProvider provider.ElrondProviderHandler) (*transaction.Transaction, error) {
+	value, ok := big.NewInt(0).SetString(tx.Value, 10)
+	if !ok {
+		return nil, errInvalidTxValue
+	}
+	receiver, err := elrondProvider.DecodeAddress(tx.Receiver)
+	if err != nil {
+		return nil, err
+	}
+	sender, err := elrondProvider.DecodeAddress(tx.Sender)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := hex.DecodeString(tx.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	return &transaction.Transaction{
+		Nonce:       tx.Nonce,
+		Value:       value,
+		RcvAddr:     receiver,
+		RcvUserName: tx.ReceiverUsername,
+		SndAddr:     sender,
+		SndUserName: tx.SenderUsername,
+		GasPrice:    tx.GasPrice,
+		GasLimit:    tx.GasLimit,
+		Data:        tx.Data,
+		ChainID:     []byte(tx.ChainID),
+		Version:     tx.Version,
+		Signature:   signature,
+		Options:     tx.Options,
+	}, nil
+}
+
+func fromNodeTransaction(tx *transaction.Transaction, elrondProvider provider.ElrondProviderHandler) (*data.Transaction, error) {
+	if tx.Value == nil {
+		return nil, errInvalidTxValue
+	}
+	receiver, err := elrondProvider.EncodeAddress(tx.RcvAddr)
+	if err != nil {
+		return nil, err
+	}
+	sender, err := elrondProvider.EncodeAddress(tx.SndAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &data.Transaction{
+		Nonce:            tx.Nonce,
+		Value:            tx.Value.String(),
+		Receiver:         receiver,
+		Sender:           sender,
+		SenderUsername:   tx.SndUserName,
+		ReceiverUsername: tx.RcvUserName,
+		GasPrice:         tx.GasPrice,
+		GasLimit:         tx.GasLimit,
+		Data:             tx.Data,
+		Signature:        hex.EncodeToString(tx.Signature),
+		ChainID:          string(tx.ChainID),
+		Version:          tx.Version,
+		Options:          tx.Options,
+	}, nil
+}
+
+// createRelayerOperation returns the operation of the account paying the fee of a relayed transaction
+func createRelayerOperation(index int64, relayer string) *types.Operation {
+	return &types.Operation{
+		OperationIdentifier: &types.OperationIdentifier{
+			Index: index,
+		},
+		Type: opFee,
+		Account: &types.AccountIdentifier{
+			Address: relayer,
+		},
+	}
+}
+
+func getSignerAddress(signature *types.Signature) string {
+	if signature.SigningPayload == nil || signature.SigningPayload.AccountIdentifier == nil {
+		return ""
+	}
+
+	return signature.SigningPayload.AccountIdentifier.Address
+}
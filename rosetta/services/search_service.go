@@ -0,0 +1,239 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/rosetta/configuration"
+	"github.com/ElrondNetwork/elrond-proxy-go/rosetta/provider"
+	"github.com/coinbase/rosetta-sdk-go/server"
+	"github.com/coinbase/rosetta-sdk-go/types"
+)
+
+type searchAPIService struct {
+	elrondProvider provider.ElrondProviderHandler
+	config         *configuration.Configuration
+	txsParser      *transactionsParser
+}
+
+// NewSearchAPIService will create a new instance of searchAPIService
+func NewSearchAPIService(
+	elrondProvider provider.ElrondProviderHandler,
+	cfg *configuration.Configuration,
+	networkConfig *provider.NetworkConfig,
+) server.SearchAPIServicer {
+	return &searchAPIService{
+		elrondProvider: elrondProvider,
+		config:         cfg,
+		txsParser:      newTransactionParser(elrondProvider, cfg, networkConfig),
+	}
+}
+
+// SearchTransactions implements the /search/transactions endpoint. The transactions of an address are the ones
+// indexed in Elasticsearch, so searching by address requires the external storage connector
+func (sas *searchAPIService) SearchTransactions(
+	_ context.Context,
+	request *types.SearchTransactionsRequest,
+) (*types.SearchTransactionsResponse, *types.Error) {
+	if sas.config.IsOffline {
+		return nil, ErrOfflineMode
+	}
+	if request.Operator != nil && *request.Operator != types.AND {
+		return nil, wrapErr(ErrInvalidInputParam, errors.New("only the and operator is supported"))
+	}
+
+	candidates, errCandidates := sas.getCandidateTransactions(request)
+	if errCandidates != nil {
+		return nil, errCandidates
+	}
+
+	blockTxs := make([]*types.BlockTransaction, 0, len(candidates))
+	for _, eTx := range candidates {
+		if eTx.HyperblockHash == "" {
+			// not yet notarized by the metachain
+			continue
+		}
+		if request.MaxBlock != nil && int64(eTx.HyperblockNonce) > *request.MaxBlock {
+			continue
+		}
+
+		rosettaTx, ok := sas.txsParser.parseTx(eTx, false)
+		if !ok || !matchesSearchConditions(rosettaTx, request) {
+			continue
+		}
+
+		blockTxs = append(blockTxs, &types.BlockTransaction{
+			BlockIdentifier: &types.BlockIdentifier{
+				Index: int64(eTx.HyperblockNonce),
+				Hash:  eTx.HyperblockHash,
+			},
+			Transaction: rosettaTx,
+		})
+	}
+
+	sort.SliceStable(blockTxs, func(i, j int) bool {
+		return blockTxs[i].BlockIdentifier.Index > blockTxs[j].BlockIdentifier.Index
+	})
+
+	return paginateBlockTransactions(blockTxs, request.Offset, request.Limit), nil
+}
+
+// getCandidateTransactions returns the transactions identified by the hash and the address conditions of the request
+func (sas *searchAPIService) getCandidateTransactions(request *types.SearchTransactionsRequest) ([]*data.FullTransaction, *types.Error) {
+	address := getSearchAddress(request)
+	if address == "" {
+		if request.TransactionIdentifier == nil {
+			// the transactions cannot be searched only by their operations, as they are not indexed by them
+			return nil, wrapErr(ErrInvalidInputParam, errors.New("an account, an address or a transaction identifier must be provided"))
+		}
+
+		eTx, err := sas.elrondProvider.GetTransactionByHash(request.TransactionIdentifier.Hash, "")
+		if err != nil {
+			return nil, wrapErr(ErrUnableToSearchTransactions, err)
+		}
+
+		return []*data.FullTransaction{eTx}, nil
+	}
+
+	dbTxs, err := sas.elrondProvider.GetTransactionsByAddress(address)
+	if err != nil {
+		return nil, wrapErr(ErrUnableToSearchTransactions, err)
+	}
+
+	if request.TransactionIdentifier != nil {
+		dbTxs = filterDatabaseTransactionsByHash(dbTxs, request.TransactionIdentifier.Hash)
+	}
+
+	candidates, err := sas.getFullTransactions(dbTxs)
+	if err != nil {
+		return nil, wrapErr(ErrUnableToSearchTransactions, err)
+	}
+
+	return candidates, nil
+}
+
+// getFullTransactions fetches the indexed transactions from the observers, as the hyperblock of a transaction is only
+// known by them. The number of indexed transactions is bounded by the history size and they are fetched in parallel
+func (sas *searchAPIService) getFullTransactions(dbTxs []data.DatabaseTransaction) ([]*data.FullTransaction, error) {
+	fullTxs := make([]*data.FullTransaction, len(dbTxs))
+	errs := make([]error, len(dbTxs))
+
+	semaphore := make(chan struct{}, maxSearchConcurrentRequests)
+	wg := sync.WaitGroup{}
+	for idx := range dbTxs {
+		semaphore <- struct{}{}
+		wg.Add(1)
+		go func(index int) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			fullTxs[index], errs[index] = sas.elrondProvider.GetTransactionByHash(dbTxs[index].Hash, dbTxs[index].Sender)
+		}(idx)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return fullTxs, nil
+}
+
+func filterDatabaseTransactionsByHash(dbTxs []data.DatabaseTransaction, hash string) []data.DatabaseTransaction {
+	filtered := make([]data.DatabaseTransaction, 0, 1)
+	for _, dbTx := range dbTxs {
+		if dbTx.Hash == hash {
+			filtered = append(filtered, dbTx)
+		}
+	}
+
+	return filtered
+}
+
+func getSearchAddress(request *types.SearchTransactionsRequest) string {
+	if request.AccountIdentifier != nil {
+		return request.AccountIdentifier.Address
+	}
+	if request.Address != nil {
+		return *request.Address
+	}
+
+	return ""
+}
+
+// matchesSearchConditions checks the operation conditions of the request. A condition is met if at least one
+// operation of the transaction meets it. Invalid transactions, which only consume the fee, are not successful
+func matchesSearchConditions(tx *types.Transaction, request *types.SearchTransactionsRequest) bool {
+	if request.Type != nil && !hasOperation(tx, func(op *types.Operation) bool {
+		return op.Type == *request.Type
+	}) {
+		return false
+	}
+	if request.Status != nil && !hasOperation(tx, func(op *types.Operation) bool {
+		return op.Status != nil && *op.Status == *request.Status
+	}) {
+		return false
+	}
+	if request.Currency != nil && !hasOperation(tx, func(op *types.Operation) bool {
+		return op.Amount != nil && types.Hash(op.Amount.Currency) == types.Hash(request.Currency)
+	}) {
+		return false
+	}
+	if request.Success != nil {
+		isFailed := hasOperation(tx, func(op *types.Operation) bool {
+			return op.Type == opInvalid || (op.Status != nil && *op.Status == OpStatusFailed)
+		})
+		if isFailed == *request.Success {
+			return false
+		}
+	}
+
+	return true
+}
+
+func hasOperation(tx *types.Transaction, condition func(op *types.Operation) bool) bool {
+	for _, op := range tx.Operations {
+		if condition(op) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func paginateBlockTransactions(blockTxs []*types.BlockTransaction, offset *int64, limit *int64) *types.SearchTransactionsResponse {
+	totalCount := int64(len(blockTxs))
+	start := int64(0)
+	if offset != nil && *offset > 0 {
+		start = *offset
+	}
+	if start > totalCount {
+		start = totalCount
+	}
+
+	numTxs := int64(MaxSearchTransactionsLimit)
+	if limit != nil && *limit > 0 && *limit < numTxs {
+		numTxs = *limit
+	}
+	end := start + numTxs
+	if end > totalCount {
+		end = totalCount
+	}
+
+	response := &types.SearchTransactionsResponse{
+		Transactions: blockTxs[start:end],
+		TotalCount:   totalCount,
+	}
+	if end < totalCount {
+		response.NextOffset = &end
+	}
+
+	return response
+}
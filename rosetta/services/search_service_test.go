@@ -0,0 +1,263 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elastic-indexer-go"
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-proxy-go/data"
+	"github.com/ElrondNetwork/elrond-proxy-go/rosetta/configuration"
+	"github.com/ElrondNetwork/elrond-proxy-go/rosetta/mocks"
+	"github.com/ElrondNetwork/elrond-proxy-go/rosetta/provider"
+	"github.com/coinbase/rosetta-sdk-go/types"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	searchTestSender   = "erd18f33a94auxr4v8v23wu8gwv7mzf408jsskktvj4lcmcrv4v5jmqs5x3kdn"
+	searchTestReceiver = "erd1uml89f3lqqfxan67dnnlytd0r3mz3v684zxdhqq60gs5u7qa9yjqa5dgqp"
+)
+
+func createSearchTestService(txs map[string]*data.FullTransaction) (*searchAPIService, *configuration.Configuration) {
+	elrondProviderMock := &mocks.ElrondProviderMock{
+		GetTransactionsByAddressCalled: func(address string) ([]data.DatabaseTransaction, error) {
+			dbTxs := make([]data.DatabaseTransaction, 0)
+			for _, tx := range txs {
+				if tx.Sender == address || tx.Receiver == address {
+					dbTxs = append(dbTxs, data.DatabaseTransaction{
+						Hash:        tx.Hash,
+						Transaction: indexer.Transaction{Sender: tx.Sender, Receiver: tx.Receiver},
+					})
+				}
+			}
+			return dbTxs, nil
+		},
+		GetTransactionByHashCalled: func(txHash string, _ string) (*data.FullTransaction, error) {
+			tx, ok := txs[txHash]
+			if !ok {
+				return nil, errors.New("transaction not found")
+			}
+			return tx, nil
+		},
+	}
+	networkCfg := &provider.NetworkConfig{
+		GasPerDataByte: 1,
+		MinGasPrice:    10,
+		MinGasLimit:    100,
+	}
+	cfg := &configuration.Configuration{
+		Currency: &types.Currency{Symbol: "eGLD", Decimals: 18},
+	}
+
+	return NewSearchAPIService(elrondProviderMock, cfg, networkCfg).(*searchAPIService), cfg
+}
+
+func createSearchTestTransactions() map[string]*data.FullTransaction {
+	return map[string]*data.FullTransaction{
+		"hash-1": {
+			Hash:            "hash-1",
+			Type:            string(transaction.TxTypeNormal),
+			Sender:          searchTestSender,
+			Receiver:        searchTestReceiver,
+			Value:           "10",
+			GasLimit:        100,
+			GasPrice:        10,
+			HyperblockNonce: 1,
+			HyperblockHash:  "hyperblock-1",
+		},
+		"hash-2": {
+			Hash:            "hash-2",
+			Type:            string(transaction.TxTypeInvalid),
+			Sender:          searchTestSender,
+			Receiver:        searchTestReceiver,
+			Value:           "10",
+			GasLimit:        100,
+			GasPrice:        10,
+			HyperblockNonce: 3,
+			HyperblockHash:  "hyperblock-3",
+		},
+		"hash-3": {
+			Hash:            "hash-3",
+			Type:            string(transaction.TxTypeNormal),
+			Sender:          searchTestReceiver,
+			Receiver:        searchTestSender,
+			Value:           "20",
+			GasLimit:        100,
+			GasPrice:        10,
+			HyperblockNonce: 2,
+			HyperblockHash:  "hyperblock-2",
+		},
+		"hash-4": {
+			Hash:     "hash-4",
+			Type:     string(transaction.TxTypeNormal),
+			Sender:   searchTestSender,
+			Receiver: searchTestReceiver,
+			Value:    "30",
+			GasLimit: 100,
+			GasPrice: 10,
+		},
+	}
+}
+
+func getSearchResultHashes(response *types.SearchTransactionsResponse) []string {
+	hashes := make([]string, 0, len(response.Transactions))
+	for _, blockTx := range response.Transactions {
+		hashes = append(hashes, blockTx.Transaction.TransactionIdentifier.Hash)
+	}
+
+	return hashes
+}
+
+func TestSearchAPIService_SearchTransactionsOfflineShouldErr(t *testing.T) {
+	t.Parallel()
+
+	searchService, cfg := createSearchTestService(createSearchTestTransactions())
+	cfg.IsOffline = true
+
+	response, err := searchService.SearchTransactions(context.Background(), &types.SearchTransactionsRequest{})
+	require.Nil(t, response)
+	require.Equal(t, ErrOfflineMode, err)
+}
+
+func TestSearchAPIService_SearchTransactionsInvalidRequestShouldErr(t *testing.T) {
+	t.Parallel()
+
+	searchService, _ := createSearchTestService(createSearchTestTransactions())
+
+	response, err := searchService.SearchTransactions(context.Background(), &types.SearchTransactionsRequest{})
+	require.Nil(t, response)
+	require.Equal(t, ErrInvalidInputParam.Code, err.Code)
+
+	operator := types.OR
+	response, err = searchService.SearchTransactions(context.Background(), &types.SearchTransactionsRequest{
+		Operator:              &operator,
+		TransactionIdentifier: &types.TransactionIdentifier{Hash: "hash-1"},
+	})
+	require.Nil(t, response)
+	require.Equal(t, ErrInvalidInputParam.Code, err.Code)
+}
+
+func TestSearchAPIService_SearchTransactionsByHash(t *testing.T) {
+	t.Parallel()
+
+	searchService, _ := createSearchTestService(createSearchTestTransactions())
+
+	response, err := searchService.SearchTransactions(context.Background(), &types.SearchTransactionsRequest{
+		TransactionIdentifier: &types.TransactionIdentifier{Hash: "hash-3"},
+	})
+	require.Nil(t, err)
+	require.Equal(t, int64(1), response.TotalCount)
+	require.Equal(t, &types.BlockIdentifier{Index: 2, Hash: "hyperblock-2"}, response.Transactions[0].BlockIdentifier)
+	require.Equal(t, "hash-3", response.Transactions[0].Transaction.TransactionIdentifier.Hash)
+
+	response, err = searchService.SearchTransactions(context.Background(), &types.SearchTransactionsRequest{
+		TransactionIdentifier: &types.TransactionIdentifier{Hash: "missing"},
+	})
+	require.Nil(t, response)
+	require.Equal(t, ErrUnableToSearchTransactions.Code, err.Code)
+}
+
+func TestSearchAPIService_SearchTransactionsByAccountShouldSkipNotNotarizedAndSortByBlock(t *testing.T) {
+	t.Parallel()
+
+	searchService, _ := createSearchTestService(createSearchTestTransactions())
+
+	response, err := searchService.SearchTransactions(context.Background(), &types.SearchTransactionsRequest{
+		AccountIdentifier: &types.AccountIdentifier{Address: searchTestSender},
+	})
+	require.Nil(t, err)
+	require.Equal(t, int64(3), response.TotalCount)
+	require.Equal(t, []string{"hash-2", "hash-3", "hash-1"}, getSearchResultHashes(response))
+	require.Nil(t, response.NextOffset)
+}
+
+func TestSearchAPIService_SearchTransactionsByAccountObserverErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	searchService, _ := createSearchTestService(createSearchTestTransactions())
+	elrondProviderMock := searchService.elrondProvider.(*mocks.ElrondProviderMock)
+	elrondProviderMock.GetTransactionByHashCalled = func(txHash string, _ string) (*data.FullTransaction, error) {
+		return nil, errors.New("observer offline")
+	}
+
+	response, err := searchService.SearchTransactions(context.Background(), &types.SearchTransactionsRequest{
+		AccountIdentifier: &types.AccountIdentifier{Address: searchTestReceiver},
+	})
+	require.Nil(t, response)
+	require.Equal(t, ErrUnableToSearchTransactions.Code, err.Code)
+}
+
+func TestSearchAPIService_SearchTransactionsFilters(t *testing.T) {
+	t.Parallel()
+
+	searchService, _ := createSearchTestService(createSearchTestTransactions())
+	address := searchTestSender
+
+	opType := opInvalid
+	response, err := searchService.SearchTransactions(context.Background(), &types.SearchTransactionsRequest{
+		Address: &address,
+		Type:    &opType,
+	})
+	require.Nil(t, err)
+	require.Equal(t, []string{"hash-2"}, getSearchResultHashes(response))
+
+	success := true
+	response, err = searchService.SearchTransactions(context.Background(), &types.SearchTransactionsRequest{
+		Address: &address,
+		Success: &success,
+	})
+	require.Nil(t, err)
+	require.Equal(t, []string{"hash-3", "hash-1"}, getSearchResultHashes(response))
+
+	maxBlock := int64(1)
+	response, err = searchService.SearchTransactions(context.Background(), &types.SearchTransactionsRequest{
+		Address:  &address,
+		MaxBlock: &maxBlock,
+	})
+	require.Nil(t, err)
+	require.Equal(t, []string{"hash-1"}, getSearchResultHashes(response))
+
+	response, err = searchService.SearchTransactions(context.Background(), &types.SearchTransactionsRequest{
+		Address:  &address,
+		Currency: &types.Currency{Symbol: "TKN-123456", Decimals: 18},
+	})
+	require.Nil(t, err)
+	require.Equal(t, int64(0), response.TotalCount)
+
+	status := OpStatusSuccess
+	response, err = searchService.SearchTransactions(context.Background(), &types.SearchTransactionsRequest{
+		Address:               &address,
+		Status:                &status,
+		Currency:              &types.Currency{Symbol: "eGLD", Decimals: 18},
+		TransactionIdentifier: &types.TransactionIdentifier{Hash: "hash-1"},
+	})
+	require.Nil(t, err)
+	require.Equal(t, []string{"hash-1"}, getSearchResultHashes(response))
+}
+
+func TestSearchAPIService_SearchTransactionsPagination(t *testing.T) {
+	t.Parallel()
+
+	searchService, _ := createSearchTestService(createSearchTestTransactions())
+	limit := int64(2)
+
+	response, err := searchService.SearchTransactions(context.Background(), &types.SearchTransactionsRequest{
+		AccountIdentifier: &types.AccountIdentifier{Address: searchTestSender},
+		Limit:             &limit,
+	})
+	require.Nil(t, err)
+	require.Equal(t, int64(3), response.TotalCount)
+	require.Equal(t, []string{"hash-2", "hash-3"}, getSearchResultHashes(response))
+	require.Equal(t, int64(2), *response.NextOffset)
+
+	response, err = searchService.SearchTransactions(context.Background(), &types.SearchTransactionsRequest{
+		AccountIdentifier: &types.AccountIdentifier{Address: searchTestSender},
+		Limit:             &limit,
+		Offset:            response.NextOffset,
+	})
+	require.Nil(t, err)
+	require.Equal(t, []string{"hash-1"}, getSearchResultHashes(response))
+	require.Nil(t, response.NextOffset)
+}
@@ -4,24 +4,30 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/ElrondNetwork/elrond-proxy-go/config"
 	"github.com/ElrondNetwork/elrond-proxy-go/rosetta/provider"
 	"github.com/coinbase/rosetta-sdk-go/types"
 )
 
-func computeSuggestedFeeAndGas(txType string, options objectsMap, networkConfig *provider.NetworkConfig) (*big.Int, uint64, uint64, *types.Error) {
+func computeSuggestedFeeAndGas(
+	txType string,
+	options objectsMap,
+	networkConfig *provider.NetworkConfig,
+	delegationGasLimits config.RosettaDelegationGasLimitsConfig,
+) (*big.Int, uint64, uint64, *types.Error) {
 	var gasLimit, gasPrice uint64
 
 	if gasLimitI, ok := options["gasLimit"]; ok {
 		gasLimit = getUint64Value(gasLimitI)
 
-		err := checkProvidedGasLimit(gasLimit, txType, options, networkConfig)
+		err := checkProvidedGasLimit(gasLimit, txType, options, networkConfig, delegationGasLimits)
 		if err != nil {
 			return nil, 0, 0, err
 		}
 
 	} else {
 		// if gas limit is not provided, we estimate it
-		estimatedGasLimit, err := estimateGasLimit(txType, networkConfig, options)
+		estimatedGasLimit, err := estimateGasLimit(txType, networkConfig, delegationGasLimits, options)
 		if err != nil {
 			return nil, 0, 0, err
 		}
@@ -83,24 +89,46 @@ func adjustTxFeeWithFeeMultiplier(
 	return result, gasPrice
 }
 
-func estimateGasLimit(operationType string, networkConfig *provider.NetworkConfig, options objectsMap) (uint64, *types.Error) {
+func estimateGasLimit(
+	operationType string,
+	networkConfig *provider.NetworkConfig,
+	delegationGasLimits config.RosettaDelegationGasLimitsConfig,
+	options objectsMap,
+) (uint64, *types.Error) {
 	gasForDataField := uint64(0)
 	if dataFieldI, ok := options["data"]; ok {
 		dataField := fmt.Sprintf("%v", dataFieldI)
 		gasForDataField = networkConfig.GasPerDataByte * uint64(len(dataField))
 	}
 
-	switch operationType {
-	case opTransfer:
+	esdtTransferData, isESDTTransfer, err := getESDTTransferDataFromOptions(options)
+	if err != nil {
+		return 0, err
+	}
+	if isESDTTransfer {
+		gasForDataField = networkConfig.GasPerDataByte*uint64(len(esdtTransferData)) + GasLimitESDTTransfer
+	}
+
+	if operationType == opTransfer {
 		return networkConfig.MinGasLimit + gasForDataField, nil
-	default:
-		//  we do not support this yet other operation types, but we might support it in the future
-		return 0, ErrNotImplemented
 	}
+	delegationGasLimit, isDelegation := getDelegationGasLimit(operationType, delegationGasLimits)
+	if isDelegation {
+		return delegationGasLimit, nil
+	}
+
+	//  we do not support this yet other operation types, but we might support it in the future
+	return 0, ErrNotImplemented
 }
 
-func checkProvidedGasLimit(providedGasLimit uint64, txType string, options objectsMap, networkConfig *provider.NetworkConfig) *types.Error {
-	estimatedGasLimit, err := estimateGasLimit(txType, networkConfig, options)
+func checkProvidedGasLimit(
+	providedGasLimit uint64,
+	txType string,
+	options objectsMap,
+	networkConfig *provider.NetworkConfig,
+	delegationGasLimits config.RosettaDelegationGasLimitsConfig,
+) *types.Error {
+	estimatedGasLimit, err := estimateGasLimit(txType, networkConfig, delegationGasLimits, options)
 	if err != nil {
 		return err
 	}
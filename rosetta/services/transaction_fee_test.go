@@ -4,6 +4,7 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-proxy-go/config"
 	"github.com/ElrondNetwork/elrond-proxy-go/rosetta/provider"
 	"github.com/stretchr/testify/assert"
 )
@@ -24,18 +25,25 @@ func TestEstimateGasLimit(t *testing.T) {
 	}
 
 	expectedGasLimit := minGasLimit + uint64(len(dataField))*gasPerDataByte
+	gasLimits := config.RosettaDelegationGasLimitsConfig{ClaimRewards: 6000}
 
-	gasLimit, err := estimateGasLimit(opTransfer, networkConfig, options)
+	gasLimit, err := estimateGasLimit(opTransfer, networkConfig, gasLimits, options)
 	assert.Nil(t, err)
 	assert.Equal(t, expectedGasLimit, gasLimit)
 
-	gasLimit, err = estimateGasLimit(opTransfer, networkConfig, nil)
+	gasLimit, err = estimateGasLimit(opTransfer, networkConfig, gasLimits, nil)
 	assert.Nil(t, err)
 	assert.Equal(t, minGasLimit, gasLimit)
 
 	// unsupported operation type you cannot estimate gasLimit for a reward operation
 	// reward operation can be generated only by the network not by a user
-	gasLimit, err = estimateGasLimit(opReward, networkConfig, nil)
+	gasLimit, err = estimateGasLimit(opReward, networkConfig, gasLimits, nil)
+	assert.Equal(t, ErrNotImplemented, err)
+	assert.Equal(t, uint64(0), gasLimit)
+	gasLimit, err = estimateGasLimit(opClaimRewards, networkConfig, gasLimits, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(6000), gasLimit)
+	gasLimit, err = estimateGasLimit(opStake, networkConfig, gasLimits, nil)
 	assert.Equal(t, ErrNotImplemented, err)
 	assert.Equal(t, uint64(0), gasLimit)
 }
@@ -55,13 +63,13 @@ func TestProvidedGasLimit(t *testing.T) {
 		"data": dataField,
 	}
 
-	err := checkProvidedGasLimit(uint64(900), opTransfer, options, networkConfig)
+	err := checkProvidedGasLimit(uint64(900), opTransfer, options, networkConfig, config.RosettaDelegationGasLimitsConfig{})
 	assert.Equal(t, ErrInsufficientGasLimit, err)
 
-	err = checkProvidedGasLimit(uint64(900), opReward, options, networkConfig)
+	err = checkProvidedGasLimit(uint64(900), opReward, options, networkConfig, config.RosettaDelegationGasLimitsConfig{})
 	assert.Equal(t, ErrNotImplemented, err)
 
-	err = checkProvidedGasLimit(uint64(9000), opTransfer, options, networkConfig)
+	err = checkProvidedGasLimit(uint64(9000), opTransfer, options, networkConfig, config.RosettaDelegationGasLimitsConfig{})
 	assert.Nil(t, err)
 }
 
@@ -104,7 +112,7 @@ func TestComputeSuggestedFeeAndGas(t *testing.T) {
 		"gasPrice": providedGasPrice,
 	}
 
-	suggestedFee, gasPrice, gasLimit, err := computeSuggestedFeeAndGas(opTransfer, options, networkConfig)
+	suggestedFee, gasPrice, gasLimit, err := computeSuggestedFeeAndGas(opTransfer, options, networkConfig, config.RosettaDelegationGasLimitsConfig{})
 	assert.Nil(t, err)
 	assert.Equal(t, minGasLimit, gasLimit)
 	assert.Equal(t, big.NewInt(10000), suggestedFee)
@@ -112,18 +120,18 @@ func TestComputeSuggestedFeeAndGas(t *testing.T) {
 
 	// err provided gas price is too low
 	options["gasPrice"] = 1
-	_, _, _, err = computeSuggestedFeeAndGas(opTransfer, options, networkConfig)
+	_, _, _, err = computeSuggestedFeeAndGas(opTransfer, options, networkConfig, config.RosettaDelegationGasLimitsConfig{})
 	assert.Equal(t, ErrGasPriceTooLow, err)
 
 	// err provided gas limit is too low
 	options["gasPrice"] = minGasPrice
 	options["gasLimit"] = 1
-	_, _, _, err = computeSuggestedFeeAndGas(opTransfer, options, networkConfig)
+	_, _, _, err = computeSuggestedFeeAndGas(opTransfer, options, networkConfig, config.RosettaDelegationGasLimitsConfig{})
 	assert.Equal(t, ErrInsufficientGasLimit, err)
 
 	delete(options, "gasLimit")
 	options["gasPrice"] = minGasPrice
-	_, _, _, err = computeSuggestedFeeAndGas(opReward, options, networkConfig)
+	_, _, _, err = computeSuggestedFeeAndGas(opReward, options, networkConfig, config.RosettaDelegationGasLimitsConfig{})
 	assert.Equal(t, ErrNotImplemented, err)
 
 	//check with fee multiplier
@@ -132,7 +140,7 @@ func TestComputeSuggestedFeeAndGas(t *testing.T) {
 	options["feeMultiplier"] = 1.1
 	expectedSuggestedFee := big.NewInt(11000)
 	expectedGasPrice := uint64(11)
-	suggestedFee, gasPrice, gasLimit, err = computeSuggestedFeeAndGas(opTransfer, options, networkConfig)
+	suggestedFee, gasPrice, gasLimit, err = computeSuggestedFeeAndGas(opTransfer, options, networkConfig, config.RosettaDelegationGasLimitsConfig{})
 	assert.Nil(t, err)
 	assert.Equal(t, minGasLimit, gasLimit)
 	assert.Equal(t, expectedSuggestedFee, suggestedFee)
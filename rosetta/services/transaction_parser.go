@@ -58,6 +58,11 @@ func (tp *transactionsParser) parseTx(eTx *data.FullTransaction, isInPool bool)
 }
 
 func (tp *transactionsParser) createRosettaTxFromUnsignedTx(eTx *data.FullTransaction) (*types.Transaction, bool) {
+	esdtTx, ok := tp.createRosettaTxFromESDTUnsignedTx(eTx)
+	if ok {
+		return esdtTx, true
+	}
+
 	// TODO check if we have a SCR that calls another contract
 	if eTx.Value == "0" {
 		return nil, false
@@ -75,6 +80,55 @@ func (tp *transactionsParser) createRosettaTxFromUnsignedTx(eTx *data.FullTransa
 	}
 }
 
+// createRosettaTxFromESDTUnsignedTx handles the SCRs holding ESDT transfers, which debit their sender and credit their
+// receiver, whatever their shards. These SCRs are issued by the contracts transferring tokens, as the transfers signed
+// by the users are executed by the transaction itself, in both shards
+func (tp *transactionsParser) createRosettaTxFromESDTUnsignedTx(eTx *data.FullTransaction) (*types.Transaction, bool) {
+	transfer, currency, ok := tp.getTrackedESDTTransfer(eTx.Data)
+	if !ok {
+		return nil, false
+	}
+
+	return &types.Transaction{
+		TransactionIdentifier: &types.TransactionIdentifier{
+			Hash: eTx.Hash,
+		},
+		Operations: []*types.Operation{
+			{
+				OperationIdentifier: &types.OperationIdentifier{
+					Index: 0,
+				},
+				Type:   opScResult,
+				Status: &OpStatusSuccess,
+				Account: &types.AccountIdentifier{
+					Address: eTx.Sender,
+				},
+				Amount: &types.Amount{
+					Value:    "-" + transfer.value.String(),
+					Currency: currency,
+				},
+			},
+			{
+				OperationIdentifier: &types.OperationIdentifier{
+					Index: 1,
+				},
+				RelatedOperations: []*types.OperationIdentifier{
+					{Index: 0},
+				},
+				Type:   opScResult,
+				Status: &OpStatusSuccess,
+				Account: &types.AccountIdentifier{
+					Address: eTx.Receiver,
+				},
+				Amount: &types.Amount{
+					Value:    transfer.value.String(),
+					Currency: currency,
+				},
+			},
+		},
+	}, true
+}
+
 func (tp *transactionsParser) createRosettaTxWithGasRefund(eTx *data.FullTransaction) (*types.Transaction, bool) {
 	return &types.Transaction{
 		TransactionIdentifier: &types.TransactionIdentifier{
@@ -172,21 +226,30 @@ func (tp *transactionsParser) createRosettaTxFromMoveBalance(eTx *data.FullTrans
 
 	operations := make([]*types.Operation, 0)
 
-	// check if transaction has value
-	if eTx.Value != "0" {
+	opType := opTransfer
+	var opMetadata map[string]interface{}
+	call, isSystemSCCall := tp.getSystemSCCall(eTx.Receiver, eTx.Data)
+	if isSystemSCCall {
+		opType = call.operationType
+		opMetadata = call.metadata()
+	}
+
+	// check if transaction has value or is a staking or delegation call
+	if eTx.Value != "0" || isSystemSCCall {
 		operations = append(operations, &types.Operation{
 			OperationIdentifier: &types.OperationIdentifier{
 				Index: 0,
 			},
-			Type:   opTransfer,
+			Type:   opType,
 			Status: &OpStatusSuccess,
 			Account: &types.AccountIdentifier{
 				Address: eTx.Sender,
 			},
 			Amount: &types.Amount{
-				Value:    "-" + eTx.Value,
+				Value:    negateValue(eTx.Value),
 				Currency: tp.config.Currency,
 			},
+			Metadata: opMetadata,
 		})
 
 		operations = append(operations, &types.Operation{
@@ -196,7 +259,7 @@ func (tp *transactionsParser) createRosettaTxFromMoveBalance(eTx *data.FullTrans
 			RelatedOperations: []*types.OperationIdentifier{
 				{Index: 0},
 			},
-			Type:   opTransfer,
+			Type:   opType,
 			Status: &OpStatusSuccess,
 			Account: &types.AccountIdentifier{
 				Address: eTx.Receiver,
@@ -208,11 +271,14 @@ func (tp *transactionsParser) createRosettaTxFromMoveBalance(eTx *data.FullTrans
 		})
 	}
 
+	// check if transaction transfers a tracked ESDT token
+	operations = append(operations, tp.createESDTTransferOperations(eTx, int64(len(operations)))...)
+
 	// check if transaction has fee and transaction is not in pool
 	if eTx.GasLimit != 0 && !isInPool {
 		operations = append(operations, &types.Operation{
 			OperationIdentifier: &types.OperationIdentifier{
-				Index: 2,
+				Index: int64(len(operations)),
 			},
 			Type:   opFee,
 			Status: &OpStatusSuccess,
@@ -233,21 +299,122 @@ func (tp *transactionsParser) createRosettaTxFromMoveBalance(eTx *data.FullTrans
 	return tx
 }
 
+// createESDTTransferOperations returns the operations of an ESDT transfer, if the transaction holds one of a tracked
+// token. A cross-shard transfer signed by a user is executed by the transaction itself in the destination shard, so
+// both the sender and the receiver are handled here
+func (tp *transactionsParser) createESDTTransferOperations(eTx *data.FullTransaction, startIndex int64) []*types.Operation {
+	transfer, currency, ok := tp.getTrackedESDTTransfer(eTx.Data)
+	if !ok || eTx.Status == transaction.TxStatusFail {
+		return nil
+	}
+
+	return []*types.Operation{
+		{
+			OperationIdentifier: &types.OperationIdentifier{
+				Index: startIndex,
+			},
+			Type:   opTransfer,
+			Status: &OpStatusSuccess,
+			Account: &types.AccountIdentifier{
+				Address: eTx.Sender,
+			},
+			Amount: &types.Amount{
+				Value:    "-" + transfer.value.String(),
+				Currency: currency,
+			},
+		},
+		{
+			OperationIdentifier: &types.OperationIdentifier{
+				Index: startIndex + 1,
+			},
+			RelatedOperations: []*types.OperationIdentifier{
+				{Index: startIndex},
+			},
+			Type:   opTransfer,
+			Status: &OpStatusSuccess,
+			Account: &types.AccountIdentifier{
+				Address: eTx.Receiver,
+			},
+			Amount: &types.Amount{
+				Value:    transfer.value.String(),
+				Currency: currency,
+			},
+		},
+	}
+}
+
+// getTrackedESDTTransfer returns the ESDT transfer held by a data field, along with its currency, if the token is tracked
+func (tp *transactionsParser) getTrackedESDTTransfer(dataField []byte) (*esdtTransfer, *types.Currency, bool) {
+	transfer, ok := parseESDTTransfer(dataField)
+	if !ok {
+		return nil, nil, false
+	}
+
+	currency, ok := tp.config.GetESDTCurrency(transfer.tokenIdentifier)
+	if !ok {
+		return nil, nil, false
+	}
+
+	return transfer, currency, true
+}
+
+// getSystemSCCall returns the staking or delegation call held by a transaction, if any
+func (tp *transactionsParser) getSystemSCCall(receiver string, dataField []byte) (*systemSCCall, bool) {
+	if len(dataField) == 0 {
+		return nil, false
+	}
+
+	receiverBytes, err := tp.elrondProvider.DecodeAddress(receiver)
+	if err != nil {
+		return nil, false
+	}
+
+	return parseSystemSCCall(receiverBytes, dataField)
+}
+
 func (tp *transactionsParser) createOperationsFromPreparedTx(tx *data.Transaction) []*types.Operation {
+	userTx, isRelayed := parseRelayedTxData(tx.Data, tp.elrondProvider)
+	if !isRelayed {
+		return tp.createTransferOperationsFromPreparedTx(tx)
+	}
+
+	operations := tp.createTransferOperationsFromPreparedTx(userTx)
+
+	return append(operations, createRelayerOperation(int64(len(operations)), tx.Sender))
+}
+
+func (tp *transactionsParser) createTransferOperationsFromPreparedTx(tx *data.Transaction) []*types.Operation {
 	operations := make([]*types.Operation, 0)
 
+	value := tx.Value
+	currency := tp.config.Currency
+	transfer, esdtCurrency, isESDTTransfer := tp.getTrackedESDTTransfer(tx.Data)
+	if isESDTTransfer {
+		value = transfer.value.String()
+		currency = esdtCurrency
+	}
+
+	opType := opTransfer
+	var opMetadata map[string]interface{}
+	call, isSystemSCCall := tp.getSystemSCCall(tx.Receiver, tx.Data)
+	if isSystemSCCall {
+		opType = call.operationType
+		opMetadata = call.metadata()
+	}
+
 	operations = append(operations, &types.Operation{
 		OperationIdentifier: &types.OperationIdentifier{
 			Index: 0,
 		},
-		Type: opTransfer,
+		Type: opType,
 		Account: &types.AccountIdentifier{
 			Address: tx.Sender,
 		},
 		Amount: &types.Amount{
-			Value:    "-" + tx.Value,
-			Currency: tp.config.Currency,
+			Value:    negateValue(value),
+			Currency: currency,
 		},
+		Metadata: opMetadata,
 	})
 
 	operations = append(operations, &types.Operation{
@@ -257,13 +424,13 @@ func (tp *transactionsParser) createOperationsFromPreparedTx(tx *data.Transactio
 		RelatedOperations: []*types.OperationIdentifier{
 			{Index: 0},
 		},
-		Type: opTransfer,
+		Type: opType,
 		Account: &types.AccountIdentifier{
 			Address: tx.Receiver,
 		},
 		Amount: &types.Amount{
-			Value:    tx.Value,
-			Currency: tp.config.Currency,
+			Value:    value,
+			Currency: currency,
 		},
 	})
 
@@ -298,8 +465,9 @@ func (tp *transactionsParser) computeTxFee(eTx *data.FullTransaction) string {
 	// errors should never appear because transaction is included in a block and receiver address is a valid address
 	decodedAddr, _ := tp.elrondProvider.DecodeAddress(eTx.Receiver)
 
-	if core.IsSmartContractAddress(decodedAddr) {
-		// we have a smart contract call
+	_, isESDTTransfer := parseESDTTransfer(eTx.Data)
+	if core.IsSmartContractAddress(decodedAddr) || isESDTTransfer {
+		// we have a smart contract or a built-in function call
 		fee := big.NewInt(0)
 		fee.Mul(big.NewInt(0).SetUint64(eTx.GasPrice), big.NewInt(0).SetUint64(eTx.GasLimit))
 
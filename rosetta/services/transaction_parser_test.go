@@ -1,14 +1,18 @@
 package services
 
 import (
+	"bytes"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/vm"
 	"github.com/ElrondNetwork/elrond-proxy-go/data"
 	"github.com/ElrondNetwork/elrond-proxy-go/rosetta/configuration"
 	"github.com/ElrondNetwork/elrond-proxy-go/rosetta/mocks"
 	"github.com/ElrondNetwork/elrond-proxy-go/rosetta/provider"
 	"github.com/coinbase/rosetta-sdk-go/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCreateRosettaTxFromUnsignedTxSendFunds(t *testing.T) {
@@ -191,3 +195,175 @@ func TestCreateOperationsFromPreparedTx(t *testing.T) {
 	operations := tp.createOperationsFromPreparedTx(preparedTx)
 	assert.Equal(t, expectedOperations, operations)
 }
+
+func TestParseTxWithESDTTransfer(t *testing.T) {
+	t.Parallel()
+
+	networkCfg := &provider.NetworkConfig{
+		GasPerDataByte: 1,
+		MinGasPrice:    10,
+		MinGasLimit:    100,
+	}
+	esdtCurrency := &types.Currency{Symbol: "TKN-0102", Decimals: 6}
+	cfg := &configuration.Configuration{
+		Currency:       &types.Currency{Symbol: "eGLD", Decimals: 18},
+		ESDTCurrencies: []*types.Currency{esdtCurrency},
+	}
+	tp := newTransactionParser(&mocks.ElrondProviderMock{}, cfg, networkCfg)
+
+	tx := &data.FullTransaction{
+		Type:     string(transaction.TxTypeNormal),
+		Hash:     "hash-hash",
+		Sender:   "senderAddress",
+		Receiver: "receiverAddress",
+		Value:    "0",
+		Data:     []byte("ESDTTransfer@544b4e2d30313032@03e8"),
+		GasPrice: 10,
+		GasLimit: 500,
+		Status:   transaction.TxStatusSuccess,
+	}
+
+	// intra-shard transfers should debit the sender and credit the receiver
+	rosettaTx, ok := tp.parseTx(tx, false)
+	assert.True(t, ok)
+	require.Equal(t, 3, len(rosettaTx.Operations))
+	assert.Equal(t, &types.Amount{Value: "-1000", Currency: esdtCurrency}, rosettaTx.Operations[0].Amount)
+	assert.Equal(t, tx.Sender, rosettaTx.Operations[0].Account.Address)
+	assert.Equal(t, &types.Amount{Value: "1000", Currency: esdtCurrency}, rosettaTx.Operations[1].Amount)
+	assert.Equal(t, tx.Receiver, rosettaTx.Operations[1].Account.Address)
+	assert.Equal(t, opFee, rosettaTx.Operations[2].Type)
+	assert.Equal(t, int64(2), rosettaTx.Operations[2].OperationIdentifier.Index)
+	// built-in function calls pay the whole gas limit, the refund coming as a SCR
+	assert.Equal(t, "-5000", rosettaTx.Operations[2].Amount.Value)
+
+	// cross-shard transfers are executed by the transaction itself in the destination shard
+	tx.DestinationShard = 1
+	rosettaTx, ok = tp.parseTx(tx, false)
+	assert.True(t, ok)
+	require.Equal(t, 3, len(rosettaTx.Operations))
+	assert.Equal(t, "-1000", rosettaTx.Operations[0].Amount.Value)
+	assert.Equal(t, "1000", rosettaTx.Operations[1].Amount.Value)
+	assert.Equal(t, tx.Receiver, rosettaTx.Operations[1].Account.Address)
+	assert.Equal(t, int64(2), rosettaTx.Operations[2].OperationIdentifier.Index)
+
+	// failed transfers and transfers of untracked tokens should only pay the fee
+	tx.DestinationShard = 0
+	tx.Status = transaction.TxStatusFail
+	rosettaTx, _ = tp.parseTx(tx, false)
+	require.Equal(t, 1, len(rosettaTx.Operations))
+	assert.Equal(t, opFee, rosettaTx.Operations[0].Type)
+
+	tx.Status = transaction.TxStatusSuccess
+	tx.Data = []byte("ESDTTransfer@4f544845522d30333034@03e8")
+	rosettaTx, _ = tp.parseTx(tx, false)
+	require.Equal(t, 1, len(rosettaTx.Operations))
+	assert.Equal(t, opFee, rosettaTx.Operations[0].Type)
+}
+
+func TestParseTxWithESDTTransferSCR(t *testing.T) {
+	t.Parallel()
+
+	esdtCurrency := &types.Currency{Symbol: "TKN-0102", Decimals: 6}
+	cfg := &configuration.Configuration{
+		Currency:       &types.Currency{Symbol: "eGLD", Decimals: 18},
+		ESDTCurrencies: []*types.Currency{esdtCurrency},
+	}
+	tp := newTransactionParser(&mocks.ElrondProviderMock{}, cfg, &provider.NetworkConfig{})
+
+	scr := &data.FullTransaction{
+		Type:     string(transaction.TxTypeUnsigned),
+		Hash:     "scr-hash",
+		Sender:   "contractAddress",
+		Receiver: "receiverAddress",
+		Value:    "0",
+		Data:     []byte("ESDTTransfer@544b4e2d30313032@03e8"),
+	}
+
+	checkOperations := func(rosettaTx *types.Transaction) {
+		require.Equal(t, 2, len(rosettaTx.Operations))
+		assert.Equal(t, opScResult, rosettaTx.Operations[0].Type)
+		assert.Equal(t, scr.Sender, rosettaTx.Operations[0].Account.Address)
+		assert.Equal(t, &types.Amount{Value: "-1000", Currency: esdtCurrency}, rosettaTx.Operations[0].Amount)
+		assert.Equal(t, opScResult, rosettaTx.Operations[1].Type)
+		assert.Equal(t, int64(1), rosettaTx.Operations[1].OperationIdentifier.Index)
+		assert.Equal(t, scr.Receiver, rosettaTx.Operations[1].Account.Address)
+		assert.Equal(t, &types.Amount{Value: "1000", Currency: esdtCurrency}, rosettaTx.Operations[1].Amount)
+	}
+
+	// intra-shard transfer issued by a contract
+	rosettaTx, ok := tp.parseTx(scr, false)
+	assert.True(t, ok)
+	checkOperations(rosettaTx)
+
+	// cross-shard transfer issued by a contract
+	scr.DestinationShard = 1
+	rosettaTx, ok = tp.parseTx(scr, false)
+	assert.True(t, ok)
+	checkOperations(rosettaTx)
+
+	// transfers of untracked tokens are ignored
+	scr.Data = []byte("ESDTTransfer@4f544845522d30333034@03e8")
+	_, ok = tp.parseTx(scr, false)
+	assert.False(t, ok)
+}
+
+func TestParseTxWithDelegationCalls(t *testing.T) {
+	t.Parallel()
+
+	networkCfg := &provider.NetworkConfig{
+		GasPerDataByte: 1,
+		MinGasPrice:    10,
+		MinGasLimit:    100,
+	}
+	cfg := &configuration.Configuration{
+		Currency: &types.Currency{Symbol: "eGLD", Decimals: 18},
+	}
+	delegationAddress := "delegationAddress"
+	elrondProviderMock := &mocks.ElrondProviderMock{
+		DecodeAddressCalled: func(address string) ([]byte, error) {
+			if address == delegationAddress {
+				return vm.FirstDelegationSCAddress, nil
+			}
+			return bytes.Repeat([]byte{1}, 32), nil
+		},
+	}
+	tp := newTransactionParser(elrondProviderMock, cfg, networkCfg)
+
+	tx := &data.FullTransaction{
+		Type:     string(transaction.TxTypeNormal),
+		Hash:     "hash-hash",
+		Sender:   "senderAddress",
+		Receiver: delegationAddress,
+		Value:    "1000",
+		Data:     []byte("delegate"),
+		GasPrice: 10,
+		GasLimit: 12000000,
+		Status:   transaction.TxStatusSuccess,
+	}
+
+	rosettaTx, ok := tp.parseTx(tx, false)
+	assert.True(t, ok)
+	require.Equal(t, 3, len(rosettaTx.Operations))
+	assert.Equal(t, opDelegate, rosettaTx.Operations[0].Type)
+	assert.Equal(t, "-1000", rosettaTx.Operations[0].Amount.Value)
+	assert.Equal(t, opDelegate, rosettaTx.Operations[1].Type)
+	assert.Equal(t, delegationAddress, rosettaTx.Operations[1].Account.Address)
+	assert.Equal(t, "1000", rosettaTx.Operations[1].Amount.Value)
+	assert.Equal(t, opFee, rosettaTx.Operations[2].Type)
+
+	// calls without value should still be explicit
+	tx.Value = "0"
+	tx.Data = []byte("unDelegate@03e8")
+	rosettaTx, _ = tp.parseTx(tx, false)
+	require.Equal(t, 3, len(rosettaTx.Operations))
+	assert.Equal(t, opUnDelegate, rosettaTx.Operations[0].Type)
+	assert.Equal(t, "0", rosettaTx.Operations[0].Amount.Value)
+	assert.Equal(t, map[string]interface{}{undelegatedValueKey: "1000"}, rosettaTx.Operations[0].Metadata)
+	assert.Equal(t, opUnDelegate, rosettaTx.Operations[1].Type)
+
+	// the same calls on other contracts should be regular transactions
+	tx.Receiver = "receiverAddress"
+	rosettaTx, _ = tp.parseTx(tx, false)
+	require.Equal(t, 1, len(rosettaTx.Operations))
+	assert.Equal(t, opFee, rosettaTx.Operations[0].Type)
+}
@@ -0,0 +1,100 @@
+package tlsconfig
+
+import (
+	"crypto/x509"
+	"sync"
+	"time"
+)
+
+type certPoolReloader struct {
+	caFile         string
+	reloadInterval time.Duration
+
+	mutCertPool sync.RWMutex
+	certPool    *x509.CertPool
+	lastModTime time.Time
+
+	closeChan chan struct{}
+	closeOnce sync.Once
+}
+
+// NewCertPoolReloader loads the CA bundle from the given file and starts a go routine that will reload it whenever
+// the file changes on disk. A reload interval of 0 disables the hot reload
+func NewCertPoolReloader(caFile string, reloadInterval time.Duration) (*certPoolReloader, error) {
+	if len(caFile) == 0 {
+		return nil, ErrEmptyCAFile
+	}
+	if reloadInterval < 0 {
+		return nil, ErrInvalidReloadInterval
+	}
+
+	cpr := &certPoolReloader{
+		caFile:         caFile,
+		reloadInterval: reloadInterval,
+		closeChan:      make(chan struct{}),
+	}
+
+	err := cpr.reload()
+	if err != nil {
+		return nil, err
+	}
+
+	if reloadInterval > 0 {
+		go watchFiles(reloadInterval, cpr.closeChan, cpr.fileChanged, cpr.reload, "CA bundle", caFile)
+	}
+
+	return cpr, nil
+}
+
+func (cpr *certPoolReloader) fileChanged() bool {
+	modTime, err := latestModTime(cpr.caFile)
+	if err != nil {
+		return false
+	}
+
+	cpr.mutCertPool.RLock()
+	defer cpr.mutCertPool.RUnlock()
+
+	return modTime.After(cpr.lastModTime)
+}
+
+func (cpr *certPoolReloader) reload() error {
+	modTime, err := latestModTime(cpr.caFile)
+	if err != nil {
+		return err
+	}
+
+	certPool, err := loadCertPool(cpr.caFile)
+	if err != nil {
+		return err
+	}
+
+	cpr.mutCertPool.Lock()
+	cpr.certPool = certPool
+	cpr.lastModTime = modTime
+	cpr.mutCertPool.Unlock()
+
+	return nil
+}
+
+// GetCertPool returns the currently loaded CA bundle
+func (cpr *certPoolReloader) GetCertPool() *x509.CertPool {
+	cpr.mutCertPool.RLock()
+	defer cpr.mutCertPool.RUnlock()
+
+	return cpr.certPool
+}
+
+// Close stops the file watching go routine
+func (cpr *certPoolReloader) Close() error {
+	cpr.closeOnce.Do(func() {
+		close(cpr.closeChan)
+	})
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cpr *certPoolReloader) IsInterfaceNil() bool {
+	return cpr == nil
+}
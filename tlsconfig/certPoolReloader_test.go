@@ -0,0 +1,79 @@
+package tlsconfig
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCertPoolReloader_EmptyCAFileShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cpr, err := NewCertPoolReloader("", time.Second)
+	assert.True(t, check.IfNil(cpr))
+	assert.Equal(t, ErrEmptyCAFile, err)
+}
+
+func TestNewCertPoolReloader_NegativeIntervalShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cpr, err := NewCertPoolReloader("ca.pem", -time.Second)
+	assert.True(t, check.IfNil(cpr))
+	assert.Equal(t, ErrInvalidReloadInterval, err)
+}
+
+func TestNewCertPoolReloader_InvalidBundleShouldErr(t *testing.T) {
+	t.Parallel()
+
+	_, keyFile := writeSelfSignedCertificate(t, t.TempDir(), "CA")
+	cpr, err := NewCertPoolReloader(keyFile, 0)
+	assert.True(t, check.IfNil(cpr))
+	assert.Error(t, err)
+}
+
+func TestCertPoolReloader_ShouldReloadChangedFile(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	caFile, _ := writeSelfSignedCertificate(t, dir, "first CA")
+	cpr, err := NewCertPoolReloader(caFile, 10*time.Millisecond)
+	require.Nil(t, err)
+	defer func() {
+		_ = cpr.Close()
+	}()
+
+	require.True(t, containsSubject(cpr.GetCertPool(), "first CA"))
+
+	_, _ = writeSelfSignedCertificate(t, dir, "second CA")
+	future := time.Now().Add(time.Minute)
+	require.Nil(t, os.Chtimes(caFile, future, future))
+
+	assert.Eventually(t, func() bool {
+		return containsSubject(cpr.GetCertPool(), "second CA")
+	}, time.Second, 10*time.Millisecond)
+}
+
+func containsSubject(certPool *x509.CertPool, commonName string) bool {
+	for _, rawSubject := range certPool.Subjects() {
+		var subject pkix.RDNSequence
+		_, err := asn1.Unmarshal(rawSubject, &subject)
+		if err != nil {
+			continue
+		}
+
+		var name pkix.Name
+		name.FillFromRDNSequence(&subject)
+		if name.CommonName == commonName {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,169 @@
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"os"
+	"sync"
+	"time"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+)
+
+var log = logger.GetOrCreate("tlsconfig")
+
+type certificateReloader struct {
+	certFile       string
+	keyFile        string
+	reloadInterval time.Duration
+
+	mutCertificate sync.RWMutex
+	certificate    *tls.Certificate
+	lastModTime    time.Time
+
+	closeChan chan struct{}
+	closeOnce sync.Once
+}
+
+// NewCertificateReloader loads the key pair from the given files and starts a go routine that will reload it
+// whenever one of the files changes on disk. A reload interval of 0 disables the hot reload
+func NewCertificateReloader(certFile string, keyFile string, reloadInterval time.Duration) (*certificateReloader, error) {
+	if len(certFile) == 0 {
+		return nil, ErrEmptyCertificateFile
+	}
+	if len(keyFile) == 0 {
+		return nil, ErrEmptyKeyFile
+	}
+	if reloadInterval < 0 {
+		return nil, ErrInvalidReloadInterval
+	}
+
+	cr := &certificateReloader{
+		certFile:       certFile,
+		keyFile:        keyFile,
+		reloadInterval: reloadInterval,
+		closeChan:      make(chan struct{}),
+	}
+
+	err := cr.reload()
+	if err != nil {
+		return nil, err
+	}
+
+	if reloadInterval > 0 {
+		go cr.watchFiles()
+	}
+
+	return cr, nil
+}
+
+func (cr *certificateReloader) watchFiles() {
+	watchFiles(cr.reloadInterval, cr.closeChan, cr.filesChanged, cr.reload, "certificate", cr.certFile, "key", cr.keyFile)
+}
+
+// watchFiles calls the reload handler whenever the watched files change on disk, until the close channel is closed
+func watchFiles(
+	reloadInterval time.Duration,
+	closeChan chan struct{},
+	filesChanged func() bool,
+	reload func() error,
+	logArgs ...interface{},
+) {
+	ticker := time.NewTicker(reloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-closeChan:
+			return
+		case <-ticker.C:
+			if !filesChanged() {
+				continue
+			}
+
+			err := reload()
+			if err != nil {
+				log.Warn("cannot reload TLS files, will keep the old ones", append(logArgs, "error", err)...)
+				continue
+			}
+
+			log.Info("TLS files reloaded", logArgs...)
+		}
+	}
+}
+
+func (cr *certificateReloader) filesChanged() bool {
+	modTime, err := latestModTime(cr.certFile, cr.keyFile)
+	if err != nil {
+		return false
+	}
+
+	cr.mutCertificate.RLock()
+	defer cr.mutCertificate.RUnlock()
+
+	return modTime.After(cr.lastModTime)
+}
+
+func (cr *certificateReloader) reload() error {
+	modTime, err := latestModTime(cr.certFile, cr.keyFile)
+	if err != nil {
+		return err
+	}
+
+	certificate, err := tls.LoadX509KeyPair(cr.certFile, cr.keyFile)
+	if err != nil {
+		return err
+	}
+
+	cr.mutCertificate.Lock()
+	cr.certificate = &certificate
+	cr.lastModTime = modTime
+	cr.mutCertificate.Unlock()
+
+	return nil
+}
+
+func latestModTime(files ...string) (time.Time, error) {
+	latest := time.Time{}
+	for _, file := range files {
+		fileInfo, err := os.Stat(file)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		if fileInfo.ModTime().After(latest) {
+			latest = fileInfo.ModTime()
+		}
+	}
+
+	return latest, nil
+}
+
+// GetCertificate returns the currently loaded certificate. Can be used as tls.Config.GetCertificate
+func (cr *certificateReloader) GetCertificate(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	cr.mutCertificate.RLock()
+	defer cr.mutCertificate.RUnlock()
+
+	return cr.certificate, nil
+}
+
+// GetClientCertificate returns the currently loaded certificate. Can be used as tls.Config.GetClientCertificate
+func (cr *certificateReloader) GetClientCertificate(_ *tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	cr.mutCertificate.RLock()
+	defer cr.mutCertificate.RUnlock()
+
+	return cr.certificate, nil
+}
+
+// Close stops the files watching go routine
+func (cr *certificateReloader) Close() error {
+	cr.closeOnce.Do(func() {
+		close(cr.closeChan)
+	})
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cr *certificateReloader) IsInterfaceNil() bool {
+	return cr == nil
+}
@@ -0,0 +1,114 @@
+package tlsconfig
+
+import (
+	"crypto/x509"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCertificateReloader_EmptyCertificateFileShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cr, err := NewCertificateReloader("", "key.pem", time.Second)
+	assert.True(t, check.IfNil(cr))
+	assert.Equal(t, ErrEmptyCertificateFile, err)
+}
+
+func TestNewCertificateReloader_EmptyKeyFileShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cr, err := NewCertificateReloader("cert.pem", "", time.Second)
+	assert.True(t, check.IfNil(cr))
+	assert.Equal(t, ErrEmptyKeyFile, err)
+}
+
+func TestNewCertificateReloader_NegativeIntervalShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cr, err := NewCertificateReloader("cert.pem", "key.pem", -time.Second)
+	assert.True(t, check.IfNil(cr))
+	assert.Equal(t, ErrInvalidReloadInterval, err)
+}
+
+func TestNewCertificateReloader_MissingFilesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	cr, err := NewCertificateReloader("missing-cert.pem", "missing-key.pem", time.Second)
+	assert.True(t, check.IfNil(cr))
+	assert.Error(t, err)
+}
+
+func TestNewCertificateReloader_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	certFile, keyFile := writeSelfSignedCertificate(t, t.TempDir(), "first")
+	cr, err := NewCertificateReloader(certFile, keyFile, 0)
+	require.Nil(t, err)
+	require.False(t, check.IfNil(cr))
+
+	certificate, err := cr.GetCertificate(nil)
+	require.Nil(t, err)
+	require.NotNil(t, certificate)
+
+	clientCertificate, err := cr.GetClientCertificate(nil)
+	require.Nil(t, err)
+	require.Equal(t, certificate, clientCertificate)
+}
+
+func TestCertificateReloader_ShouldReloadChangedFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	certFile, keyFile := writeSelfSignedCertificate(t, dir, "first")
+	cr, err := NewCertificateReloader(certFile, keyFile, 10*time.Millisecond)
+	require.Nil(t, err)
+	defer func() {
+		_ = cr.Close()
+	}()
+
+	require.Equal(t, "first", getLeafCommonName(t, cr))
+
+	_, _ = writeSelfSignedCertificate(t, dir, "second")
+	// make sure the modification time is newer regardless of the file system's time resolution
+	future := time.Now().Add(time.Minute)
+	require.Nil(t, os.Chtimes(certFile, future, future))
+	require.Nil(t, os.Chtimes(keyFile, future, future))
+
+	assert.Eventually(t, func() bool {
+		return getLeafCommonName(t, cr) == "second"
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestCertificateReloader_InvalidNewFilesShouldKeepOldCertificate(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	certFile, keyFile := writeSelfSignedCertificate(t, dir, "first")
+	cr, err := NewCertificateReloader(certFile, keyFile, 0)
+	require.Nil(t, err)
+
+	require.Nil(t, ioutil.WriteFile(certFile, []byte("not a certificate"), 0600))
+	future := time.Now().Add(time.Minute)
+	require.Nil(t, os.Chtimes(certFile, future, future))
+	require.True(t, cr.filesChanged())
+
+	err = cr.reload()
+	assert.Error(t, err)
+	assert.Equal(t, "first", getLeafCommonName(t, cr))
+}
+
+func getLeafCommonName(t *testing.T, cr *certificateReloader) string {
+	certificate, err := cr.GetCertificate(nil)
+	require.Nil(t, err)
+
+	leaf, err := x509.ParseCertificate(certificate.Certificate[0])
+	require.Nil(t, err)
+
+	return leaf.Subject.CommonName
+}
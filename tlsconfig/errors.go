@@ -0,0 +1,24 @@
+package tlsconfig
+
+import "errors"
+
+// ErrEmptyCertificateFile signals that an empty certificate file path has been provided
+var ErrEmptyCertificateFile = errors.New("empty certificate file path")
+
+// ErrEmptyKeyFile signals that an empty key file path has been provided
+var ErrEmptyKeyFile = errors.New("empty key file path")
+
+// ErrInvalidReloadInterval signals that an invalid reload interval has been provided
+var ErrInvalidReloadInterval = errors.New("invalid reload interval")
+
+// ErrInvalidTLSVersion signals that an unknown TLS version has been provided
+var ErrInvalidTLSVersion = errors.New("invalid TLS version")
+
+// ErrNoCertificatesInCABundle signals that no PEM certificate could be read from the CA bundle
+var ErrNoCertificatesInCABundle = errors.New("no certificates found in the CA bundle")
+
+// ErrClientCertificateWithoutCA signals that client certificates were required but no CA bundle was provided
+var ErrClientCertificateWithoutCA = errors.New("client certificates are required but no client CA file was provided")
+
+// ErrEmptyCAFile signals that an empty CA bundle file path has been provided
+var ErrEmptyCAFile = errors.New("empty CA file path")
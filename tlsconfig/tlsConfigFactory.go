@@ -0,0 +1,187 @@
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/config"
+)
+
+const defaultMinVersion = tls.VersionTLS12
+
+// serverProtocols holds the application protocols of the REST and gRPC servers, in the order of preference. The
+// servers add them to their own copies of the TLS configuration, which are not seen by the per connection one
+var serverProtocols = []string{"h2", "http/1.1"}
+
+var tlsVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// reloadersCloser stops the files watching go routines of the reloaders used by a TLS configuration
+type reloadersCloser []io.Closer
+
+// Close closes all the reloaders
+func (rc reloadersCloser) Close() error {
+	for _, reloader := range rc {
+		_ = reloader.Close()
+	}
+
+	return nil
+}
+
+// CreateServerTLSConfig creates the TLS configuration used by the proxy's web server. The server certificate and the
+// client CA bundle are reloaded whenever their files change and, if a client CA bundle is provided, client certificates
+// are verified against it. The returned closer stops the reloading and should be called on shutdown
+func CreateServerTLSConfig(cfg config.ServerTLSConfig) (*tls.Config, io.Closer, error) {
+	minVersion, err := parseTLSVersion(cfg.MinVersion)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hasClientCA := len(cfg.ClientCAFile) > 0
+	if cfg.RequireClientCertificate && !hasClientCA {
+		return nil, nil, ErrClientCertificateWithoutCA
+	}
+
+	reloadInterval := secondsToDuration(cfg.ReloadIntervalSec)
+	reloader, err := NewCertificateReloader(cfg.CertificateFile, cfg.KeyFile, reloadInterval)
+	if err != nil {
+		return nil, nil, err
+	}
+	closer := reloadersCloser{reloader}
+
+	tlsConfig := &tls.Config{
+		MinVersion:     minVersion,
+		GetCertificate: reloader.GetCertificate,
+		ClientAuth:     tls.NoClientCert,
+	}
+	if !hasClientCA {
+		return tlsConfig, closer, nil
+	}
+
+	caReloader, err := NewCertPoolReloader(cfg.ClientCAFile, reloadInterval)
+	if err != nil {
+		_ = closer.Close()
+		return nil, nil, err
+	}
+	closer = append(closer, caReloader)
+
+	tlsConfig.ClientCAs = caReloader.GetCertPool()
+	tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+	if cfg.RequireClientCertificate {
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	tlsConfig.GetConfigForClient = func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+		connectionConfig := tlsConfig.Clone()
+		connectionConfig.ClientCAs = caReloader.GetCertPool()
+		connectionConfig.GetConfigForClient = nil
+		if hello != nil {
+			connectionConfig.NextProtos = negotiableProtocols(tlsConfig.NextProtos, hello.SupportedProtos)
+		}
+
+		return connectionConfig, nil
+	}
+
+	return tlsConfig, closer, nil
+}
+
+// CreateClientTLSConfig creates the TLS configuration used when connecting to the nodes over https. It returns
+// a nil configuration if no custom setting was provided, so the system defaults will be used. The returned closer
+// stops the reloading of the client certificate and should be called on shutdown
+func CreateClientTLSConfig(cfg config.ClientTLSConfig) (*tls.Config, io.Closer, error) {
+	hasCA := len(cfg.CAFile) > 0
+	hasClientCertificate := len(cfg.CertificateFile) > 0 || len(cfg.KeyFile) > 0
+	if !hasCA && !hasClientCertificate && len(cfg.MinVersion) == 0 {
+		return nil, reloadersCloser{}, nil
+	}
+
+	minVersion, err := parseTLSVersion(cfg.MinVersion)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: minVersion,
+	}
+
+	if hasCA {
+		tlsConfig.RootCAs, err = loadCertPool(cfg.CAFile)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if !hasClientCertificate {
+		return tlsConfig, reloadersCloser{}, nil
+	}
+
+	reloader, err := NewCertificateReloader(cfg.CertificateFile, cfg.KeyFile, secondsToDuration(cfg.ReloadIntervalSec))
+	if err != nil {
+		return nil, nil, err
+	}
+	tlsConfig.GetClientCertificate = reloader.GetClientCertificate
+
+	return tlsConfig, reloadersCloser{reloader}, nil
+}
+
+// negotiableProtocols returns the application protocols of the servers which are offered by the client, so that the
+// per connection configuration keeps negotiating them (e.g. h2 for HTTP/2 and gRPC)
+func negotiableProtocols(configuredProtocols []string, offeredProtocols []string) []string {
+	protocols := make([]string, 0, len(configuredProtocols)+len(serverProtocols))
+	for _, protocol := range append(append([]string{}, configuredProtocols...), serverProtocols...) {
+		if containsString(offeredProtocols, protocol) && !containsString(protocols, protocol) {
+			protocols = append(protocols, protocol)
+		}
+	}
+
+	return protocols
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func parseTLSVersion(version string) (uint16, error) {
+	if len(version) == 0 {
+		return defaultMinVersion, nil
+	}
+
+	tlsVersion, ok := tlsVersions[strings.TrimPrefix(strings.ToLower(version), "tls")]
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrInvalidTLSVersion, version)
+	}
+
+	return tlsVersion, nil
+}
+
+func loadCertPool(caFile string) (*x509.CertPool, error) {
+	caBundle, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return nil, err
+	}
+
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(caBundle) {
+		return nil, fmt.Errorf("%w: %s", ErrNoCertificatesInCABundle, caFile)
+	}
+
+	return certPool, nil
+}
+
+func secondsToDuration(seconds int) time.Duration {
+	return time.Duration(seconds) * time.Second
+}
@@ -0,0 +1,245 @@
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-proxy-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateServerTLSConfig_InvalidMinVersionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	certFile, keyFile := writeSelfSignedCertificate(t, t.TempDir(), "server")
+	tlsConfig, _, err := CreateServerTLSConfig(config.ServerTLSConfig{
+		CertificateFile: certFile,
+		KeyFile:         keyFile,
+		MinVersion:      "0.9",
+	})
+
+	assert.Nil(t, tlsConfig)
+	assert.True(t, errors.Is(err, ErrInvalidTLSVersion))
+}
+
+func TestCreateServerTLSConfig_RequireClientCertificateWithoutCAShouldErr(t *testing.T) {
+	t.Parallel()
+
+	certFile, keyFile := writeSelfSignedCertificate(t, t.TempDir(), "server")
+	tlsConfig, _, err := CreateServerTLSConfig(config.ServerTLSConfig{
+		CertificateFile:          certFile,
+		KeyFile:                  keyFile,
+		RequireClientCertificate: true,
+	})
+
+	assert.Nil(t, tlsConfig)
+	assert.Equal(t, ErrClientCertificateWithoutCA, err)
+}
+
+func TestCreateServerTLSConfig_InvalidCABundleShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	certFile, keyFile := writeSelfSignedCertificate(t, dir, "server")
+	tlsConfig, _, err := CreateServerTLSConfig(config.ServerTLSConfig{
+		CertificateFile: certFile,
+		KeyFile:         keyFile,
+		ClientCAFile:    keyFile,
+	})
+
+	assert.Nil(t, tlsConfig)
+	assert.True(t, errors.Is(err, ErrNoCertificatesInCABundle))
+}
+
+func TestCreateServerTLSConfig_WithoutClientCA(t *testing.T) {
+	t.Parallel()
+
+	certFile, keyFile := writeSelfSignedCertificate(t, t.TempDir(), "server")
+	tlsConfig, _, err := CreateServerTLSConfig(config.ServerTLSConfig{
+		CertificateFile: certFile,
+		KeyFile:         keyFile,
+	})
+
+	require.Nil(t, err)
+	assert.Equal(t, uint16(tls.VersionTLS12), tlsConfig.MinVersion)
+	assert.Equal(t, tls.NoClientCert, tlsConfig.ClientAuth)
+	assert.Nil(t, tlsConfig.ClientCAs)
+	assert.NotNil(t, tlsConfig.GetCertificate)
+}
+
+func TestCreateServerTLSConfig_WithClientCA(t *testing.T) {
+	t.Parallel()
+
+	certFile, keyFile := writeSelfSignedCertificate(t, t.TempDir(), "server")
+	caFile, _ := writeSelfSignedCertificate(t, t.TempDir(), "client CA")
+
+	tlsConfig, _, err := CreateServerTLSConfig(config.ServerTLSConfig{
+		CertificateFile: certFile,
+		KeyFile:         keyFile,
+		MinVersion:      "1.3",
+		ClientCAFile:    caFile,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, uint16(tls.VersionTLS13), tlsConfig.MinVersion)
+	assert.Equal(t, tls.VerifyClientCertIfGiven, tlsConfig.ClientAuth)
+	assert.NotNil(t, tlsConfig.ClientCAs)
+	connectionConfig, err := tlsConfig.GetConfigForClient(nil)
+	require.Nil(t, err)
+	assert.NotNil(t, connectionConfig.ClientCAs)
+	assert.Nil(t, connectionConfig.GetConfigForClient)
+
+	tlsConfig, _, err = CreateServerTLSConfig(config.ServerTLSConfig{
+		CertificateFile:          certFile,
+		KeyFile:                  keyFile,
+		ClientCAFile:             caFile,
+		RequireClientCertificate: true,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, tls.RequireAndVerifyClientCert, tlsConfig.ClientAuth)
+}
+
+func TestCreateClientTLSConfig_EmptyConfigShouldReturnNil(t *testing.T) {
+	t.Parallel()
+
+	tlsConfig, _, err := CreateClientTLSConfig(config.ClientTLSConfig{})
+	assert.Nil(t, err)
+	assert.Nil(t, tlsConfig)
+}
+
+func TestCreateClientTLSConfig_WithCAAndClientCertificate(t *testing.T) {
+	t.Parallel()
+
+	certFile, keyFile := writeSelfSignedCertificate(t, t.TempDir(), "client")
+	caFile, _ := writeSelfSignedCertificate(t, t.TempDir(), "observers CA")
+
+	tlsConfig, _, err := CreateClientTLSConfig(config.ClientTLSConfig{
+		CAFile:          caFile,
+		CertificateFile: certFile,
+		KeyFile:         keyFile,
+		MinVersion:      "TLS1.2",
+	})
+	require.Nil(t, err)
+	assert.NotNil(t, tlsConfig.RootCAs)
+	assert.NotNil(t, tlsConfig.GetClientCertificate)
+	assert.Equal(t, uint16(tls.VersionTLS12), tlsConfig.MinVersion)
+}
+
+func TestCreateClientTLSConfig_MissingKeyFileShouldErr(t *testing.T) {
+	t.Parallel()
+
+	certFile, _ := writeSelfSignedCertificate(t, t.TempDir(), "client")
+
+	tlsConfig, _, err := CreateClientTLSConfig(config.ClientTLSConfig{
+		CertificateFile: certFile,
+	})
+	assert.Nil(t, tlsConfig)
+	assert.Equal(t, ErrEmptyKeyFile, err)
+}
+
+func TestCreateServerTLSConfig_WithClientCAShouldNegotiateHTTP2(t *testing.T) {
+	t.Parallel()
+
+	certFile, keyFile := writeSelfSignedCertificate(t, t.TempDir(), "server")
+	clientCertFile, clientKeyFile := writeSelfSignedCertificate(t, t.TempDir(), "client")
+
+	tlsConfig, closer, err := CreateServerTLSConfig(config.ServerTLSConfig{
+		CertificateFile:          certFile,
+		KeyFile:                  keyFile,
+		ClientCAFile:             clientCertFile,
+		RequireClientCertificate: true,
+	})
+	require.Nil(t, err)
+	defer func() {
+		_ = closer.Close()
+	}()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	server := &http.Server{
+		TLSConfig: tlsConfig,
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = fmt.Fprintf(w, "%s %d", r.Proto, len(r.TLS.VerifiedChains))
+		}),
+	}
+	go func() {
+		_ = server.ServeTLS(listener, "", "")
+	}()
+	defer func() {
+		_ = server.Close()
+	}()
+
+	rootCAs, err := loadCertPool(certFile)
+	require.Nil(t, err)
+	clientCertificate, err := tls.LoadX509KeyPair(clientCertFile, clientKeyFile)
+	require.Nil(t, err)
+	clientConfig := &tls.Config{
+		RootCAs:      rootCAs,
+		Certificates: []tls.Certificate{clientCertificate},
+		ServerName:   "localhost",
+	}
+
+	// a gRPC like client, which only offers h2
+	h2ClientConfig := clientConfig.Clone()
+	h2ClientConfig.NextProtos = []string{"h2"}
+	conn, err := tls.Dial("tcp", listener.Addr().String(), h2ClientConfig)
+	require.Nil(t, err)
+	assert.Equal(t, "h2", conn.ConnectionState().NegotiatedProtocol)
+	_ = conn.Close()
+
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: clientConfig, ForceAttemptHTTP2: true}}
+	response, err := client.Get("https://" + listener.Addr().String())
+	require.Nil(t, err)
+	defer func() {
+		_ = response.Body.Close()
+	}()
+	body, err := ioutil.ReadAll(response.Body)
+	require.Nil(t, err)
+	assert.Equal(t, "HTTP/2.0 1", string(body))
+}
+
+func TestCreateServerTLSConfig_ShouldReloadTheClientCA(t *testing.T) {
+	t.Parallel()
+
+	certFile, keyFile := writeSelfSignedCertificate(t, t.TempDir(), "server")
+	caDir := t.TempDir()
+	caFile, _ := writeSelfSignedCertificate(t, caDir, "first CA")
+
+	tlsConfig, closer, err := CreateServerTLSConfig(config.ServerTLSConfig{
+		CertificateFile:   certFile,
+		KeyFile:           keyFile,
+		ClientCAFile:      caFile,
+		ReloadIntervalSec: 1,
+	})
+	require.Nil(t, err)
+	defer func() {
+		_ = closer.Close()
+	}()
+
+	_, _ = writeSelfSignedCertificate(t, caDir, "second CA")
+	future := time.Now().Add(time.Minute)
+	require.Nil(t, os.Chtimes(caFile, future, future))
+
+	assert.Eventually(t, func() bool {
+		connectionConfig, errConfig := tlsConfig.GetConfigForClient(nil)
+		require.Nil(t, errConfig)
+
+		return containsSubject(connectionConfig.ClientCAs, "second CA")
+	}, 3*time.Second, 50*time.Millisecond)
+}
+
+func TestCreateClientTLSConfig_ShouldReturnACloser(t *testing.T) {
+	t.Parallel()
+
+	_, closer, err := CreateClientTLSConfig(config.ClientTLSConfig{})
+	require.Nil(t, err)
+	require.NotNil(t, closer)
+	assert.Nil(t, closer.Close())
+}